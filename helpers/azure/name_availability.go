@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NameAvailabilityResult is the common subset of the responses returned from the CheckNameAvailability
+// API's exposed by the Resource Providers for globally unique resource names
+type NameAvailabilityResult struct {
+	// NameAvailable specifies whether the name is available, when nil the name is assumed to be available
+	NameAvailable *bool
+
+	// Reason is the reason returned by the service when the name isn't available, e.g. `AlreadyExists` or `Invalid`
+	Reason string
+
+	// Message is the message returned by the service explaining the Reason in more detail
+	Message *string
+}
+
+// CheckNameAvailability returns an error containing the reason reported by the service (and a suggestion
+// for how to resolve it) when the name of a globally unique resource isn't available, so that this can be
+// surfaced prior to creating the resource rather than as a failure part-way through the apply
+func CheckNameAvailability(resourceType string, name string, result NameAvailabilityResult) error {
+	if result.NameAvailable == nil || *result.NameAvailable {
+		return nil
+	}
+
+	message := "the name isn't available"
+	if result.Message != nil && *result.Message != "" {
+		message = strings.TrimSuffix(*result.Message, ".")
+	}
+
+	suggestion := fmt.Sprintf("the name for a %s must be globally unique - please choose a different name", resourceType)
+	reason := strings.ToLower(result.Reason)
+	if strings.Contains(reason, "invalid") {
+		suggestion = fmt.Sprintf("please choose a name which meets the naming requirements for a %s", resourceType)
+	}
+
+	if result.Reason != "" {
+		return fmt.Errorf("the name %q for the %s failed the availability check (Reason %q): %s - %s", name, resourceType, result.Reason, message, suggestion)
+	}
+
+	return fmt.Errorf("the name %q for the %s failed the availability check: %s - %s", name, resourceType, message, suggestion)
+}
@@ -0,0 +1,63 @@
+package azure_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestCheckNameAvailability(t *testing.T) {
+	testData := []struct {
+		input    azure.NameAvailabilityResult
+		expected string
+	}{
+		{
+			// no information returned, assume it's available
+			input:    azure.NameAvailabilityResult{},
+			expected: "",
+		},
+		{
+			input: azure.NameAvailabilityResult{
+				NameAvailable: utils.Bool(true),
+			},
+			expected: "",
+		},
+		{
+			input: azure.NameAvailabilityResult{
+				NameAvailable: utils.Bool(false),
+				Reason:        "AlreadyExists",
+				Message:       utils.String("The storage account named example is already taken."),
+			},
+			expected: `the name "example" for the Storage Account failed the availability check (Reason "AlreadyExists"): The storage account named example is already taken - the name for a Storage Account must be globally unique - please choose a different name`,
+		},
+		{
+			input: azure.NameAvailabilityResult{
+				NameAvailable: utils.Bool(false),
+				Reason:        "AccountNameInvalid",
+				Message:       utils.String("example is not a valid storage account name."),
+			},
+			expected: `the name "example" for the Storage Account failed the availability check (Reason "AccountNameInvalid"): example is not a valid storage account name - please choose a name which meets the naming requirements for a Storage Account`,
+		},
+		{
+			input: azure.NameAvailabilityResult{
+				NameAvailable: utils.Bool(false),
+			},
+			expected: `the name "example" for the Storage Account failed the availability check: the name isn't available - the name for a Storage Account must be globally unique - please choose a different name`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.input)
+
+		err := azure.CheckNameAvailability("Storage Account", "example", v.input)
+		actual := ""
+		if err != nil {
+			actual = err.Error()
+		}
+
+		if actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	authWrapper "github.com/manicminer/hamilton-autorest/auth"
+	"github.com/manicminer/hamilton/auth"
+	"github.com/manicminer/hamilton/environments"
+	"golang.org/x/oauth2"
+)
+
+// OIDCTokenOptions specifies an OIDC ID Token which has already been issued to the current workload, for example by a
+// CI system or Kubernetes cluster supporting Workload Identity Federation. This is exchanged for an Access Token using a
+// Client Assertion - which is handled here since the authentication helpers only support requesting an ID Token from
+// the GitHub Actions OIDC provider (via `oidc_request_url` and `oidc_request_token`).
+type OIDCTokenOptions struct {
+	// Token is the ID Token
+	Token string
+
+	// TokenFilePath is the path to a file containing the ID Token, which is read each time an Access Token
+	// is required so that the ID Token can be rotated during long-running operations
+	TokenFilePath string
+}
+
+func (o OIDCTokenOptions) idToken() (string, error) {
+	if o.Token != "" {
+		return o.Token, nil
+	}
+
+	contents, err := os.ReadFile(o.TokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("reading OIDC Token from %q: %+v", o.TokenFilePath, err)
+	}
+
+	token := strings.TrimSpace(string(contents))
+	if token == "" {
+		return "", fmt.Errorf("the OIDC Token file %q was empty", o.TokenFilePath)
+	}
+
+	return token, nil
+}
+
+// BuildOIDCTokenAuthConfig returns an authentication Config for authenticating as a Service Principal using an OIDC ID Token
+func BuildOIDCTokenAuthConfig(b authentication.Builder, options OIDCTokenOptions) (*authentication.Config, error) {
+	if b.TenantID == "" {
+		return nil, fmt.Errorf("a Tenant ID must be configured when authenticating with an OIDC Token")
+	}
+	if b.ClientID == "" {
+		return nil, fmt.Errorf("a Client ID must be configured when authenticating with an OIDC Token")
+	}
+	if _, err := options.idToken(); err != nil {
+		return nil, err
+	}
+
+	config := &authentication.Config{
+		ClientID:                         b.ClientID,
+		SubscriptionID:                   b.SubscriptionID,
+		TenantID:                         b.TenantID,
+		AuxiliaryTenantIDs:               b.AuxiliaryTenantIDs,
+		Environment:                      b.Environment,
+		MetadataHost:                     b.MetadataHost,
+		CustomResourceManagerEndpoint:    b.CustomResourceManagerEndpoint,
+		UseMicrosoftGraph:                b.UseMicrosoftGraph,
+		AuthenticatedAsAServicePrincipal: true,
+		AuthenticatedViaOIDC:             true,
+	}
+
+	config.GetAuthenticatedObjectID = func(ctx context.Context) (*string, error) {
+		environment, err := environments.EnvironmentFromString(config.Environment)
+		if err != nil {
+			return nil, fmt.Errorf("environment config error: %v", err)
+		}
+
+		token, err := newOIDCTokenAuthorizer(ctx, *config, environment, environment.ResourceManager, options).Token()
+		if err != nil {
+			return nil, fmt.Errorf("acquiring access token: %v", err)
+		}
+
+		claims, err := auth.ParseClaims(token)
+		if err != nil {
+			return nil, fmt.Errorf("parsing claims from access token: %v", err)
+		}
+
+		return &claims.ObjectId, nil
+	}
+
+	return config, nil
+}
+
+// oidcTokenAuthorizer exchanges the OIDC ID Token for an Access Token for the specified API
+type oidcTokenAuthorizer struct {
+	ctx     context.Context
+	conf    auth.ClientCredentialsConfig
+	options OIDCTokenOptions
+}
+
+func newOIDCTokenAuthorizer(ctx context.Context, config authentication.Config, environment environments.Environment, api environments.Api, options OIDCTokenOptions) auth.Authorizer {
+	return auth.NewCachedAuthorizer(&oidcTokenAuthorizer{
+		ctx: ctx,
+		conf: auth.ClientCredentialsConfig{
+			Environment:        environment,
+			TenantID:           config.TenantID,
+			AuxiliaryTenantIDs: config.AuxiliaryTenantIDs,
+			ClientID:           config.ClientID,
+			Scopes:             []string{api.DefaultScope()},
+			TokenVersion:       auth.TokenVersion2,
+		},
+		options: options,
+	})
+}
+
+func (a *oidcTokenAuthorizer) source() (auth.Authorizer, error) {
+	idToken, err := a.options.idToken()
+	if err != nil {
+		return nil, err
+	}
+
+	conf := a.conf
+	conf.FederatedAssertion = idToken
+	return conf.TokenSource(a.ctx, auth.ClientCredentialsAssertionType), nil
+}
+
+func (a *oidcTokenAuthorizer) Token() (*oauth2.Token, error) {
+	source, err := a.source()
+	if err != nil {
+		return nil, err
+	}
+
+	return source.Token()
+}
+
+func (a *oidcTokenAuthorizer) AuxiliaryTokens() ([]*oauth2.Token, error) {
+	source, err := a.source()
+	if err != nil {
+		return nil, err
+	}
+
+	return source.AuxiliaryTokens()
+}
+
+// oidcTokenAutorestAuthorizer returns an autorest Authorizer for the specified API
+func oidcTokenAutorestAuthorizer(ctx context.Context, config authentication.Config, environment environments.Environment, api environments.Api, options OIDCTokenOptions) *authWrapper.Authorizer {
+	return &authWrapper.Authorizer{Authorizer: newOIDCTokenAuthorizer(ctx, config, environment, api, options)}
+}
@@ -24,8 +24,14 @@ type ClientBuilder struct {
 	PartnerId                   string
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
+	SensitiveValuesInState      bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	RetryOptions                *common.RetryOptions
+	PollingOptions              *common.PollingOptions
+
+	// OIDCToken is specified when authenticating using an OIDC ID Token which has already been issued
+	OIDCToken *OIDCTokenOptions
 }
 
 const azureStackEnvironmentError = `
@@ -89,18 +95,25 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	var keyVaultAuth *autorest.BearerAuthorizerCallback
 	var tokenFunc common.EndpointTokenFunc
 
-	auth, err = builder.AuthConfig.GetMSALToken(ctx, environment.ResourceManager, sender, oauthConfig, string(environment.ResourceManager.Endpoint))
+	getAuthorizer := func(api environments.Api, endpoint string) (autorest.Authorizer, error) {
+		if builder.OIDCToken != nil {
+			return oidcTokenAutorestAuthorizer(ctx, *builder.AuthConfig, environment, api, *builder.OIDCToken), nil
+		}
+		return builder.AuthConfig.GetMSALToken(ctx, api, sender, oauthConfig, endpoint)
+	}
+
+	auth, err = getAuthorizer(environment.ResourceManager, string(environment.ResourceManager.Endpoint))
 	if err != nil {
 		return nil, fmt.Errorf("unable to get MSAL authorization token for resource manager API: %+v", err)
 	}
 
-	storageAuth, err = builder.AuthConfig.GetMSALToken(ctx, environment.Storage, sender, oauthConfig, string(environment.Storage.Endpoint))
+	storageAuth, err = getAuthorizer(environment.Storage, string(environment.Storage.Endpoint))
 	if err != nil {
 		return nil, fmt.Errorf("unable to get MSAL authorization token for storage API: %+v", err)
 	}
 
 	if environment.Synapse.IsAvailable() {
-		synapseAuth, err = builder.AuthConfig.GetMSALToken(ctx, environment.Synapse, sender, oauthConfig, string(environment.Synapse.Endpoint))
+		synapseAuth, err = getAuthorizer(environment.Synapse, string(environment.Synapse.Endpoint))
 		if err != nil {
 			return nil, fmt.Errorf("unable to get MSAL authorization token for synapse API: %+v", err)
 		}
@@ -108,17 +121,21 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		log.Printf("[DEBUG] Skipping building the Synapse MSAL Authorizer since this is not supported in the current Azure Environment")
 	}
 
-	batchManagementAuth, err = builder.AuthConfig.GetMSALToken(ctx, environment.BatchManagement, sender, oauthConfig, string(environment.BatchManagement.Endpoint))
+	batchManagementAuth, err = getAuthorizer(environment.BatchManagement, string(environment.BatchManagement.Endpoint))
 	if err != nil {
 		return nil, fmt.Errorf("unable to get MSAL authorization token for batch management API: %+v", err)
 	}
 
-	keyVaultAuth = builder.AuthConfig.MSALBearerAuthorizerCallback(ctx, environment.KeyVault, sender, oauthConfig, string(environment.KeyVault.Endpoint))
+	if builder.OIDCToken != nil {
+		keyVaultAuth = oidcTokenAutorestAuthorizer(ctx, *builder.AuthConfig, environment, environment.KeyVault, *builder.OIDCToken).BearerAuthorizerCallback()
+	} else {
+		keyVaultAuth = builder.AuthConfig.MSALBearerAuthorizerCallback(ctx, environment.KeyVault, sender, oauthConfig, string(environment.KeyVault.Endpoint))
+	}
 
 	// Helper for obtaining endpoint-specific tokens
 	tokenFunc = func(endpoint string) (autorest.Authorizer, error) {
 		api := environments.Api{Endpoint: environments.ApiEndpoint(endpoint)}
-		authorizer, err := builder.AuthConfig.GetMSALToken(ctx, api, sender, oauthConfig, endpoint)
+		authorizer, err := getAuthorizer(api, endpoint)
 		if err != nil {
 			return nil, fmt.Errorf("getting MSAL authorization token for endpoint %s: %+v", endpoint, err)
 		}
@@ -143,7 +160,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Environment:                 *env,
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		SensitiveValuesInState:      builder.SensitiveValuesInState,
 		TokenFunc:                   tokenFunc,
+		RetryOptions:                builder.RetryOptions,
+		PollingOptions:              builder.PollingOptions,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
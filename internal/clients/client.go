@@ -28,6 +28,7 @@ import (
 	confidentialledger "github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger/client"
 	connections "github.com/hashicorp/terraform-provider-azurerm/internal/services/connections/client"
 	consumption "github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/client"
+	containerapps "github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/client"
 	containerServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	cosmosdb "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/client"
 	costmanagement "github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/client"
@@ -118,6 +119,9 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// SensitiveValuesInState specifies whether designated secrets are stored in the state as-is, when false only a hash is stored
+	SensitiveValuesInState bool
+
 	AadB2c                *aadb2c.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
@@ -140,6 +144,7 @@ type Client struct {
 	ConfidentialLedger    *confidentialledger.Client
 	Connections           *connections.Client
 	Consumption           *consumption.Client
+	ContainerApps         *containerapps.Client
 	Containers            *containerServices.Client
 	Cosmos                *cosmosdb.Client
 	CostManagement        *costmanagement.Client
@@ -222,6 +227,12 @@ type Client struct {
 	Web                   *web.Client
 }
 
+// ParseResourceIdsInsensitivelyDuringImport returns whether the Resource ID provided at import time should be
+// parsed insensitively, as configured in the `features` block
+func (client *Client) ParseResourceIdsInsensitivelyDuringImport() bool {
+	return client.Features.ResourceId.CaseInsensitiveImport
+}
+
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
 
 func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error {
@@ -230,6 +241,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	validation.Disabled = true
 
 	client.Features = o.Features
+	client.SensitiveValuesInState = o.SensitiveValuesInState
 	client.StopContext = ctx
 
 	client.AadB2c = aadb2c.NewClient(o)
@@ -254,6 +266,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.ConfidentialLedger = confidentialledger.NewClient(o)
 	client.Connections = connections.NewClient(o)
 	client.Consumption = consumption.NewClient(o)
+	client.ContainerApps = containerapps.NewClient(o)
 	client.Containers = containerServices.NewClient(o)
 	client.Cosmos = cosmosdb.NewClient(o)
 	client.CostManagement = costmanagement.NewClient(o)
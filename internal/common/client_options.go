@@ -36,6 +36,15 @@ type ClientOptions struct {
 	Features                    features.UserFeatures
 	StorageUseAzureAD           bool
 
+	// SensitiveValuesInState specifies whether designated secrets are stored in the state as-is, when false only a hash is stored
+	SensitiveValuesInState bool
+
+	// RetryOptions configures how requests which fail with a transient error are retried, when nil these aren't retried
+	RetryOptions *RetryOptions
+
+	// PollingOptions configures how the status of Long Running Operations is polled, when nil the SDK defaults are used
+	PollingOptions *PollingOptions
+
 	// Some Dataplane APIs require a token scoped for a specific endpoint
 	TokenFunc EndpointTokenFunc
 
@@ -48,7 +57,14 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = autorest.DecorateSender(sender.BuildSender("AzureRM"), withProgressLogging())
+	if o.PollingOptions != nil {
+		c.PollingDelay = o.PollingOptions.Interval
+		c.Sender = autorest.DecorateSender(c.Sender, withPollingInterval(*o.PollingOptions))
+	}
+	if o.RetryOptions != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withRetries(*o.RetryOptions))
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -57,6 +73,14 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 		}
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
+
+	if o.RetryOptions != nil {
+		// setting SendDecorators replaces `azure.DoRetryWithRegistration` which the SDK passes to `Send`, since that would
+		// otherwise retry the responses returned once the configured retries are exhausted. The registration of Resource
+		// Providers is retained, with any other retries left to the Sender configured above. This is done last so that
+		// the registration requests are sent using the fully configured client.
+		c.SendDecorators = []autorest.SendDecorator{withResourceProviderRegistration(*c)}
+	}
 }
 
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
@@ -0,0 +1,155 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// PollingOptions configures how the status of Long Running Operations is polled
+type PollingOptions struct {
+	// Interval is the minimum delay between polling the status of a Long Running Operation
+	Interval time.Duration
+}
+
+// withPollingInterval returns a SendDecorator which raises the delay requested by the API in the `Retry-After` header of
+// a successful response to the configured Interval. This is needed since the `Retry-After` header takes precedence over
+// the Polling Delay configured on the client when polling a Long Running Operation.
+func withPollingInterval(options PollingOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err != nil || !autorest.ResponseHasStatusCode(resp, http.StatusOK, http.StatusCreated, http.StatusAccepted) {
+				return resp, err
+			}
+
+			if resp.Header.Get("Retry-After") != "" && retryAfter(resp) < options.Interval {
+				// the `Retry-After` header is specified in whole seconds, so this is rounded up
+				seconds := (options.Interval + time.Second - 1) / time.Second
+				resp.Header.Set("Retry-After", strconv.Itoa(int(seconds)))
+			}
+			return resp, err
+		})
+	}
+}
+
+// terminalOperationStates are the states after which a Long Running Operation is no longer polled
+var terminalOperationStates = []string{
+	"Succeeded",
+	"Failed",
+	"Canceled",
+	"Cancelled",
+}
+
+// operationStatus is the subset of the payload returned when polling a Long Running Operation which contains its progress
+type operationStatus struct {
+	Status          string   `json:"status"`
+	PercentComplete *float64 `json:"percentComplete"`
+	Properties      *struct {
+		ProvisioningState string `json:"provisioningState"`
+	} `json:"properties"`
+}
+
+// progressLogger tracks the last logged progress of each Long Running Operation, so that only changes are logged
+type progressLogger struct {
+	sync.Mutex
+	inProgress map[string]string
+}
+
+// withProgressLogging returns a SendDecorator which logs when a Long Running Operation is started, each time the
+// status (or percentage complete) returned when polling it changes - and once it reaches a terminal state.
+func withProgressLogging() autorest.SendDecorator {
+	logger := &progressLogger{
+		inProgress: make(map[string]string),
+	}
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err != nil || resp == nil || r.URL == nil {
+				return resp, err
+			}
+
+			if r.Method != http.MethodGet {
+				if autorest.ResponseHasStatusCode(resp, http.StatusCreated, http.StatusAccepted) {
+					if pollingUrl := operationPollingUrl(resp); pollingUrl != "" {
+						log.Printf("[INFO] Long Running Operation %s %q started, polling %q", r.Method, r.URL.Path, pollingUrl)
+					}
+				}
+				return resp, err
+			}
+
+			if !autorest.ResponseHasStatusCode(resp, http.StatusOK, http.StatusCreated, http.StatusAccepted) || !strings.Contains(resp.Header.Get("Content-Type"), "json") || resp.Body == nil {
+				return resp, err
+			}
+
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if readErr != nil {
+				return resp, err
+			}
+
+			var status operationStatus
+			if json.Unmarshal(body, &status) == nil {
+				logger.log(r.URL.Path, status)
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// log logs the progress of the Long Running Operation available at the specified path, when it's changed
+func (l *progressLogger) log(path string, input operationStatus) {
+	state := input.Status
+	if state == "" && input.Properties != nil {
+		state = input.Properties.ProvisioningState
+	}
+	if state == "" {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	previous, inProgress := l.inProgress[path]
+	for _, v := range terminalOperationStates {
+		if strings.EqualFold(state, v) {
+			// a terminal state is returned by every request to retrieve a Resource, so is only logged for an operation being polled
+			if inProgress {
+				log.Printf("[INFO] Long Running Operation %q completed with the status %q", path, state)
+				delete(l.inProgress, path)
+			}
+			return
+		}
+	}
+
+	progress := state
+	if input.PercentComplete != nil {
+		progress = strconv.FormatFloat(*input.PercentComplete, 'f', -1, 64) + "% complete, " + state
+	}
+	if progress != previous {
+		log.Printf("[INFO] Long Running Operation %q is in progress (%s)", path, progress)
+		l.inProgress[path] = progress
+	}
+}
+
+// operationPollingUrl returns the URL which will be polled to determine the status of a Long Running Operation, if any
+func operationPollingUrl(resp *http.Response) string {
+	for _, header := range []string{"Azure-AsyncOperation", "Location"} {
+		if v := resp.Header.Get(header); v != "" {
+			// the query string is omitted since this can contain a signature
+			return strings.SplitN(v, "?", 2)[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,146 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithPollingInterval(t *testing.T) {
+	options := PollingOptions{
+		Interval: 1500 * time.Millisecond,
+	}
+
+	testData := []struct {
+		Name       string
+		StatusCode int
+		RetryAfter string
+		Expected   string
+	}{
+		{
+			Name:       "Not Specified",
+			StatusCode: http.StatusAccepted,
+			RetryAfter: "",
+			Expected:   "",
+		},
+		{
+			Name:       "Shorter Than Interval",
+			StatusCode: http.StatusAccepted,
+			RetryAfter: "1",
+			Expected:   "2",
+		},
+		{
+			Name:       "Longer Than Interval",
+			StatusCode: http.StatusOK,
+			RetryAfter: "30",
+			Expected:   "30",
+		},
+		{
+			Name:       "Throttled",
+			StatusCode: http.StatusTooManyRequests,
+			RetryAfter: "1",
+			Expected:   "1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		s := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			header := http.Header{}
+			if v.RetryAfter != "" {
+				header.Set("Retry-After", v.RetryAfter)
+			}
+			return &http.Response{
+				Status:     http.StatusText(v.StatusCode),
+				StatusCode: v.StatusCode,
+				Header:     header,
+				Body:       http.NoBody,
+				Request:    r,
+			}, nil
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com", nil)
+		resp, err := autorest.SendWithSender(s, req, withPollingInterval(options))
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual := resp.Header.Get("Retry-After"); actual != v.Expected {
+			t.Fatalf("expected a Retry-After of %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithProgressLoggingPreservesBody(t *testing.T) {
+	payload := `{"status": "InProgress", "percentComplete": 50}`
+	s := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Status:     http.StatusText(http.StatusOK),
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+			Body:       io.NopCloser(strings.NewReader(payload)),
+			Request:    r,
+		}, nil
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/operations/abc123", nil)
+	resp, err := autorest.SendWithSender(s, req, withProgressLogging())
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %+v", err)
+	}
+	if string(body) != payload {
+		t.Fatalf("expected the body %q but got %q", payload, string(body))
+	}
+}
+
+func TestProgressLogger(t *testing.T) {
+	percentComplete := func(input float64) *float64 {
+		return &input
+	}
+
+	logger := &progressLogger{
+		inProgress: make(map[string]string),
+	}
+	path := "/operations/abc123"
+
+	logger.log(path, operationStatus{Status: "Succeeded"})
+	if _, ok := logger.inProgress[path]; ok {
+		t.Fatalf("expected a terminal state to not be tracked when the operation wasn't in progress")
+	}
+
+	logger.log(path, operationStatus{Status: "InProgress", PercentComplete: percentComplete(25)})
+	if actual := logger.inProgress[path]; actual != "25% complete, InProgress" {
+		t.Fatalf("expected the progress to be %q but got %q", "25% complete, InProgress", actual)
+	}
+
+	logger.log(path, operationStatus{Status: "InProgress", PercentComplete: percentComplete(75.5)})
+	if actual := logger.inProgress[path]; actual != "75.5% complete, InProgress" {
+		t.Fatalf("expected the progress to be %q but got %q", "75.5% complete, InProgress", actual)
+	}
+
+	logger.log(path, operationStatus{Status: "succeeded"})
+	if _, ok := logger.inProgress[path]; ok {
+		t.Fatalf("expected the operation to no longer be tracked once it reached a terminal state")
+	}
+
+	resourcePath := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	input := operationStatus{}
+	input.Properties = &struct {
+		ProvisioningState string `json:"provisioningState"`
+	}{
+		ProvisioningState: "Updating",
+	}
+	logger.log(resourcePath, input)
+	if actual := logger.inProgress[resourcePath]; actual != "Updating" {
+		t.Fatalf("expected the progress to be %q but got %q", "Updating", actual)
+	}
+}
@@ -0,0 +1,157 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const resourceProviderRegistrationAPIVersion = "2016-09-01"
+
+// withResourceProviderRegistration returns a SendDecorator which registers the Resource Provider when the API returns a
+// `MissingSubscriptionRegistration` error, and then sends the request again.
+//
+// This is used in place of `azure.DoRetryWithRegistration` (which the SDK passes to `Send`) when RetryOptions are
+// configured, since that also wraps each request in `autorest.DoRetryForStatusCodes` - which retries the responses
+// returned once the configured retries have been exhausted.
+func withResourceProviderRegistration(client autorest.Client) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(rr.Request())
+			if err != nil || resp.StatusCode != http.StatusConflict || client.SkipResourceProviderRegistration {
+				return resp, err
+			}
+
+			namespace, err := missingResourceProviderRegistration(resp)
+			if err != nil || namespace == "" {
+				return resp, err
+			}
+
+			if err := registerResourceProvider(client, r, namespace); err != nil {
+				return resp, fmt.Errorf("registering Resource Provider %q: %+v", namespace, err)
+			}
+
+			if err := rr.Prepare(); err != nil {
+				return resp, err
+			}
+			_ = autorest.DrainResponseBody(resp)
+			return s.Do(rr.Request())
+		})
+	}
+}
+
+// missingResourceProviderRegistration returns the namespace of the Resource Provider which needs to be registered when
+// the response is a `MissingSubscriptionRegistration` error - the response body is left intact for the caller.
+func missingResourceProviderRegistration(resp *http.Response) (string, error) {
+	if resp.Body == nil {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+
+	var re azure.RequestError
+	if err := json.Unmarshal(body, &re); err != nil || re.ServiceError == nil {
+		return "", nil
+	}
+	if re.ServiceError.Code != "MissingSubscriptionRegistration" || len(re.ServiceError.Details) == 0 {
+		return "", nil
+	}
+
+	namespace, _ := re.ServiceError.Details[0]["target"].(string)
+	return namespace, nil
+}
+
+// registerResourceProvider registers the specified Resource Provider within the Subscription used for the original
+// request, and then waits for the registration to complete
+func registerResourceProvider(client autorest.Client, originalReq *http.Request, namespace string) error {
+	subscriptionId := ""
+	segments := strings.Split(originalReq.URL.Path, "/")
+	for i, v := range segments {
+		if strings.EqualFold(v, "subscriptions") && i+1 < len(segments) {
+			subscriptionId = segments[i+1]
+			break
+		}
+	}
+	if subscriptionId == "" {
+		return fmt.Errorf("the Subscription ID couldn't be determined from %q", originalReq.URL.Path)
+	}
+
+	ctx := originalReq.Context()
+	baseURI := (&url.URL{Scheme: originalReq.URL.Scheme, Host: originalReq.URL.Host}).String()
+	pathParameters := map[string]interface{}{
+		"resourceProviderNamespace": autorest.Encode("path", namespace),
+		"subscriptionId":            autorest.Encode("path", subscriptionId),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version": resourceProviderRegistrationAPIVersion,
+	}
+
+	log.Printf("[DEBUG] Registering Resource Provider %q within Subscription %q..", namespace, subscriptionId)
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsPost(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/{resourceProviderNamespace}/register", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	if err != nil {
+		return err
+	}
+	resp, err := autorest.SendWithSender(client, req)
+	if err != nil {
+		return err
+	}
+	if err := autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK), autorest.ByClosing()); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	for {
+		req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+			autorest.AsGet(),
+			autorest.WithBaseURL(baseURI),
+			autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/{resourceProviderNamespace}", pathParameters),
+			autorest.WithQueryParameters(queryParameters))
+		if err != nil {
+			return err
+		}
+		resp, err := autorest.SendWithSender(client, req)
+		if err != nil {
+			return err
+		}
+
+		var provider struct {
+			RegistrationState *string `json:"registrationState,omitempty"`
+		}
+		if err := autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK), autorest.ByUnmarshallingJSON(&provider), autorest.ByClosing()); err != nil {
+			return err
+		}
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "Registered") {
+			return nil
+		}
+
+		if client.PollingDuration != 0 && time.Since(start) >= client.PollingDuration {
+			return fmt.Errorf("timed out waiting for the registration to complete")
+		}
+		if !autorest.DelayForBackoff(client.PollingDelay, 0, ctx.Done()) {
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWithResourceProviderRegistration(t *testing.T) {
+	missingRegistration := `{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.Example'.","details":[{"code":"MissingSubscriptionRegistration","target":"Microsoft.Example","message":"The subscription is not registered to use namespace 'Microsoft.Example'."}]}}`
+
+	testData := []struct {
+		Name                 string
+		Responses            []int
+		Body                 string
+		ExpectedStatus       int
+		ExpectedRequests     int
+		ExpectedRegistration bool
+	}{
+		{
+			Name:             "Success",
+			Responses:        []int{http.StatusOK},
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Retryable Status Code Not Retried",
+			Responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			ExpectedStatus:   http.StatusServiceUnavailable,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Other Conflict Not Retried",
+			Responses:        []int{http.StatusConflict, http.StatusOK},
+			Body:             `{"error":{"code":"Conflict","message":"Another operation is in progress."}}`,
+			ExpectedStatus:   http.StatusConflict,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:                 "Missing Registration",
+			Responses:            []int{http.StatusConflict, http.StatusOK},
+			Body:                 missingRegistration,
+			ExpectedStatus:       http.StatusOK,
+			ExpectedRequests:     2,
+			ExpectedRegistration: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		requests := 0
+		registered := false
+		s := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			code := http.StatusOK
+			body := ""
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Example/register"):
+				registered = true
+			case strings.HasSuffix(r.URL.Path, "/providers/Microsoft.Example"):
+				body = `{"registrationState":"Registered"}`
+			default:
+				code = v.Responses[requests]
+				requests++
+				if code != http.StatusOK {
+					body = v.Body
+				}
+			}
+			return &http.Response{
+				Status:     http.StatusText(code),
+				StatusCode: code,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    r,
+			}, nil
+		})
+
+		client := autorest.NewClientWithUserAgent("")
+		client.Sender = s
+
+		req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/things/thing1", nil)
+		resp, err := autorest.SendWithSender(s, req, withResourceProviderRegistration(client))
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("expected a %d response but got %d", v.ExpectedStatus, resp.StatusCode)
+		}
+		if requests != v.ExpectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.ExpectedRequests, requests)
+		}
+		if registered != v.ExpectedRegistration {
+			t.Fatalf("expected the Resource Provider registration to be %t but got %t", v.ExpectedRegistration, registered)
+		}
+
+		// the body of an unsuccessful response must still be available to the caller
+		if v.ExpectedStatus == http.StatusConflict {
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != v.Body {
+				t.Fatalf("expected the response body %q but got %q", v.Body, string(body))
+			}
+		}
+	}
+}
+
+func TestConfigureClientRetainsResourceProviderRegistration(t *testing.T) {
+	client := autorest.NewClientWithUserAgent("")
+	ClientOptions{
+		RetryOptions: &RetryOptions{
+			MaxRetries: 1,
+		},
+	}.ConfigureClient(&client, nil)
+
+	if len(client.SendDecorators) != 1 {
+		t.Fatalf("expected a single SendDecorator to handle the registration of Resource Providers but got %d", len(client.SendDecorators))
+	}
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RetryOptions configures how requests to Azure are retried when they fail with a transient error
+type RetryOptions struct {
+	// MaxRetries is the maximum number of times that a request will be retried
+	MaxRetries int
+
+	// MinBackoff is the delay before the first retry, which is doubled for each subsequent retry
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries
+	MaxBackoff time.Duration
+
+	// StatusCodes are the HTTP Status Codes which should cause a request to be retried
+	StatusCodes []int
+}
+
+// backoff returns the delay before the specified retry, which grows exponentially from MinBackoff up to MaxBackoff
+func (o RetryOptions) backoff(attempt int) time.Duration {
+	delay := o.MinBackoff
+	for i := 0; i < attempt; i++ {
+		delay *= 2
+		if delay <= 0 || delay >= o.MaxBackoff {
+			return o.MaxBackoff
+		}
+	}
+
+	if delay > o.MaxBackoff {
+		return o.MaxBackoff
+	}
+	return delay
+}
+
+// retryAfter returns the delay requested by the API in the `Retry-After` header, or 0 when no (valid) delay is specified
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// withRetries returns a SendDecorator which retries requests which fail with one of the configured Status Codes.
+// When a `Retry-After` header is returned by the API this takes precedence over the configured backoff, but is
+// still capped at MaxBackoff.
+func withRetries(options RetryOptions) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			var resp *http.Response
+			var err error
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				_ = autorest.DrainResponseBody(resp)
+				resp, err = s.Do(rr.Request())
+				if err != nil || attempt >= options.MaxRetries || !autorest.ResponseHasStatusCode(resp, options.StatusCodes...) {
+					return resp, err
+				}
+
+				delay := options.backoff(attempt)
+				if v := retryAfter(resp); v > 0 {
+					delay = v
+					if delay > options.MaxBackoff {
+						delay = options.MaxBackoff
+					}
+				}
+
+				log.Printf("[DEBUG] Retrying %s request to %s in %s after receiving a %d response (retry %d of %d)", r.Method, r.URL, delay, resp.StatusCode, attempt+1, options.MaxRetries)
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
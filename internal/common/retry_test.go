@@ -0,0 +1,172 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryOptionsBackoff(t *testing.T) {
+	options := RetryOptions{
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 5 * time.Second,
+	}
+
+	expected := []time.Duration{
+		500 * time.Millisecond,
+		1 * time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second,
+	}
+
+	for attempt, v := range expected {
+		if actual := options.backoff(attempt); actual != v {
+			t.Fatalf("expected a backoff of %s for attempt %d but got %s", v, attempt, actual)
+		}
+	}
+
+	if actual := options.backoff(100); actual != options.MaxBackoff {
+		t.Fatalf("expected a backoff of %s for attempt 100 but got %s", options.MaxBackoff, actual)
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	options := RetryOptions{
+		MaxRetries:  2,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+		StatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}
+
+	testData := []struct {
+		Name             string
+		Responses        []int
+		ExpectedStatus   int
+		ExpectedAttempts int
+	}{
+		{
+			Name:             "Success",
+			Responses:        []int{http.StatusOK},
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Retried Then Success",
+			Responses:        []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "Retries Exhausted",
+			Responses:        []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			ExpectedStatus:   http.StatusServiceUnavailable,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "Status Code Not Retried",
+			Responses:        []int{http.StatusInternalServerError, http.StatusOK},
+			ExpectedStatus:   http.StatusInternalServerError,
+			ExpectedAttempts: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		attempts := 0
+		s := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			code := v.Responses[attempts]
+			attempts++
+			return &http.Response{
+				Status:     http.StatusText(code),
+				StatusCode: code,
+				Body:       http.NoBody,
+				Request:    r,
+			}, nil
+		})
+
+		req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com", nil)
+		resp, err := autorest.SendWithSender(s, req, withRetries(options))
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("expected a %d response but got %d", v.ExpectedStatus, resp.StatusCode)
+		}
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("expected %d attempts but got %d", v.ExpectedAttempts, attempts)
+		}
+	}
+}
+
+func TestWithRetriesCapsRetryAfter(t *testing.T) {
+	options := RetryOptions{
+		MaxRetries:  1,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  10 * time.Millisecond,
+		StatusCodes: []int{http.StatusTooManyRequests},
+	}
+
+	attempts := 0
+	s := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		code := http.StatusOK
+		if attempts == 1 {
+			code = http.StatusTooManyRequests
+		}
+		return &http.Response{
+			Status:     http.StatusText(code),
+			StatusCode: code,
+			Header:     http.Header{"Retry-After": []string{"3600"}},
+			Body:       http.NoBody,
+			Request:    r,
+		}, nil
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com", nil)
+	start := time.Now()
+	resp, err := autorest.SendWithSender(s, req, withRetries(options))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a %d response but got %d", http.StatusOK, resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the Retry-After delay to be capped at %s but the request took %s", options.MaxBackoff, elapsed)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	testData := []struct {
+		Value    string
+		Expected time.Duration
+	}{
+		{
+			Value:    "",
+			Expected: 0,
+		},
+		{
+			Value:    "30",
+			Expected: 30 * time.Second,
+		},
+		{
+			Value:    "invalid",
+			Expected: 0,
+		},
+	}
+
+	for _, v := range testData {
+		resp := &http.Response{Header: http.Header{}}
+		if v.Value != "" {
+			resp.Header.Set("Retry-After", v.Value)
+		}
+		if actual := retryAfter(resp); actual != v.Expected {
+			t.Fatalf("expected %s for %q but got %s", v.Expected, v.Value, actual)
+		}
+	}
+}
@@ -10,6 +10,10 @@ func Default() UserFeatures {
 		ApplicationInsights: ApplicationInsightFeatures{
 			DisableGeneratedRule: false,
 		},
+		AppService: AppServiceFeatures{
+			SkipReadingSiteCredentials: false,
+			ValidateKeyVaultReferences: false,
+		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
@@ -23,6 +27,7 @@ func Default() UserFeatures {
 			RecoverSoftDeletedKeys:           true,
 			RecoverSoftDeletedCerts:          true,
 			RecoverSoftDeletedSecrets:        true,
+			ValidateDataPlanePermissions:     false,
 		},
 		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
 			PermanentlyDeleteOnDestroy: true,
@@ -30,6 +35,13 @@ func Default() UserFeatures {
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: true,
 		},
+		ResourceId: ResourceIdFeatures{
+			CaseInsensitiveImport: false,
+		},
+		Storage: StorageFeatures{
+			DataPlaneAvailable:          true,
+			RedundancyConversionEnabled: false,
+		},
 		TemplateDeployment: TemplateDeploymentFeatures{
 			DeleteNestedItemsDuringDeletion: true,
 		},
@@ -3,6 +3,7 @@ package features
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
 	ApplicationInsights    ApplicationInsightFeatures
+	AppService             AppServiceFeatures
 	CognitiveAccount       CognitiveAccountFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
@@ -10,6 +11,8 @@ type UserFeatures struct {
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
+	ResourceId             ResourceIdFeatures
+	Storage                StorageFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -38,6 +41,7 @@ type KeyVaultFeatures struct {
 	RecoverSoftDeletedKeys           bool
 	RecoverSoftDeletedCerts          bool
 	RecoverSoftDeletedSecrets        bool
+	ValidateDataPlanePermissions     bool
 }
 
 type TemplateDeploymentFeatures struct {
@@ -52,6 +56,15 @@ type ResourceGroupFeatures struct {
 	PreventDeletionIfContainsResources bool
 }
 
+type ResourceIdFeatures struct {
+	CaseInsensitiveImport bool
+}
+
+type StorageFeatures struct {
+	DataPlaneAvailable          bool
+	RedundancyConversionEnabled bool
+}
+
 type ApiManagementFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 	RecoverSoftDeleted       bool
@@ -60,3 +73,8 @@ type ApiManagementFeatures struct {
 type ApplicationInsightFeatures struct {
 	DisableGeneratedRule bool
 }
+
+type AppServiceFeatures struct {
+	SkipReadingSiteCredentials bool
+	ValidateKeyVaultReferences bool
+}
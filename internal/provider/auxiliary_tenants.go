@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// maxAuxiliaryTenants is the maximum number of tokens which Azure Resource Manager accepts in the
+// `x-ms-authorization-auxiliary` header
+const maxAuxiliaryTenants = 3
+
+// expandAuxiliaryTenantIDs returns the Auxiliary Tenant IDs specified in the Provider block, falling back to
+// the semicolon separated list in the `ARM_AUXILIARY_TENANT_IDS` Environment Variable
+func expandAuxiliaryTenantIDs(input []interface{}, tenantId string) ([]string, error) {
+	auxTenants := make([]string, 0)
+	if len(input) > 0 {
+		auxTenants = *utils.ExpandStringSlice(input)
+	} else if v := os.Getenv("ARM_AUXILIARY_TENANT_IDS"); v != "" {
+		for _, id := range strings.Split(v, ";") {
+			if id = strings.TrimSpace(id); id != "" {
+				auxTenants = append(auxTenants, id)
+			}
+		}
+	}
+
+	if len(auxTenants) > maxAuxiliaryTenants {
+		return nil, fmt.Errorf("the provider only supports %d auxiliary tenant IDs but got %d", maxAuxiliaryTenants, len(auxTenants))
+	}
+
+	seen := make(map[string]struct{})
+	for _, id := range auxTenants {
+		if _, err := uuid.ParseUUID(id); err != nil {
+			return nil, fmt.Errorf("expected the auxiliary tenant ID %q to be a valid UUID", id)
+		}
+
+		// the primary Tenant is authenticated against already, a token for it is not an auxiliary token
+		if tenantId != "" && strings.EqualFold(id, tenantId) {
+			return nil, fmt.Errorf("the auxiliary tenant IDs should not contain the primary tenant ID %q", tenantId)
+		}
+
+		if _, ok := seen[strings.ToLower(id)]; ok {
+			return nil, fmt.Errorf("the auxiliary tenant ID %q was specified more than once", id)
+		}
+		seen[strings.ToLower(id)] = struct{}{}
+	}
+
+	return auxTenants, nil
+}
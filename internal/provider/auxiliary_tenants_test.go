@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandAuxiliaryTenantIDs(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       []interface{}
+		EnvVar      string
+		TenantId    string
+		Expected    []string
+		ExpectError bool
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: []string{},
+		},
+		{
+			Name:     "From Provider Block",
+			Input:    []interface{}{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222"},
+			TenantId: "00000000-0000-0000-0000-000000000000",
+			Expected: []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222"},
+		},
+		{
+			Name:     "From Environment Variable",
+			Input:    []interface{}{},
+			EnvVar:   "11111111-1111-1111-1111-111111111111; 22222222-2222-2222-2222-222222222222;",
+			Expected: []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222"},
+		},
+		{
+			Name:     "Provider Block Takes Precedence",
+			Input:    []interface{}{"11111111-1111-1111-1111-111111111111"},
+			EnvVar:   "22222222-2222-2222-2222-222222222222",
+			Expected: []string{"11111111-1111-1111-1111-111111111111"},
+		},
+		{
+			Name:        "Invalid UUID in Environment Variable",
+			Input:       []interface{}{},
+			EnvVar:      "not-a-tenant",
+			ExpectError: true,
+		},
+		{
+			Name:        "Too Many",
+			Input:       []interface{}{},
+			EnvVar:      "11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222;33333333-3333-3333-3333-333333333333;44444444-4444-4444-4444-444444444444",
+			ExpectError: true,
+		},
+		{
+			Name:        "Contains Primary Tenant",
+			Input:       []interface{}{"00000000-0000-0000-0000-000000000000"},
+			TenantId:    "00000000-0000-0000-0000-000000000000",
+			ExpectError: true,
+		},
+		{
+			Name:        "Duplicate",
+			Input:       []interface{}{"11111111-1111-1111-1111-111111111111", "11111111-1111-1111-1111-111111111111"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		t.Setenv("ARM_AUXILIARY_TENANT_IDS", testCase.EnvVar)
+
+		result, err := expandAuxiliaryTenantIDs(testCase.Input, testCase.TenantId)
+		if err != nil {
+			if testCase.ExpectError {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if testCase.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// defaultTags holds the Tags specified within the `default_tags` block in the Provider configuration,
+// which are merged into the Tags for each Resource which supports them
+type defaultTags struct {
+	tags map[string]string
+}
+
+func (t *defaultTags) get() map[string]string {
+	return t.tags
+}
+
+// expand sets `tags` to the configured Tags merged with the Default Tags, so that these are sent to the API by the
+// Resource - returning the configured Tags so that these can be restored once the Resource has been applied
+func (t *defaultTags) expand(d *schema.ResourceData) (map[string]interface{}, error) {
+	configured, _ := d.Get("tags").(map[string]interface{})
+	if len(t.tags) == 0 {
+		return configured, nil
+	}
+
+	return configured, d.Set("tags", tags.MergeDefault(t.tags, configured))
+}
+
+// flatten sets `tags_all` to all of the Tags read from the API and `tags` to only the configured Tags, so that the
+// Default Tags aren't written into `tags` in the state, where they'd differ from the configuration
+func (t *defaultTags) flatten(d *schema.ResourceData, configured map[string]interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	all, _ := d.Get("tags").(map[string]interface{})
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	return d.Set("tags", tags.FilterDefault(t.tags, all, configured))
+}
+
+// apply runs the Create/Update function `f` with the Default Tags merged into `tags`
+func (t *defaultTags) apply(d *schema.ResourceData, f func() error) error {
+	configured, err := t.expand(d)
+	if err != nil {
+		return err
+	}
+
+	if err := f(); err != nil {
+		// the Resource may still be persisted to the state, in which case `tags` should match the configuration
+		d.Set("tags", configured)
+		return err
+	}
+
+	return t.flatten(d, configured)
+}
+
+// read runs the Read function `f`, using the Tags in the state to determine which Tags have been configured
+func (t *defaultTags) read(d *schema.ResourceData, f func() error) error {
+	configured, _ := d.Get("tags").(map[string]interface{})
+	if err := f(); err != nil {
+		return err
+	}
+
+	return t.flatten(d, configured)
+}
+
+// customizeDiff plans `tags_all` as the configured Tags merged with the Default Tags, so that a change to the Default
+// Tags shows up as a change to `tags_all` (which is applied by an Update) rather than as a change to `tags`
+func (t *defaultTags) customizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	configured, _ := d.Get("tags").(map[string]interface{})
+	merged := tags.MergeDefault(t.tags, configured)
+	if existing, _ := d.Get("tags_all").(map[string]interface{}); reflect.DeepEqual(existing, merged) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", merged)
+}
+
+// requiresDefaultTagsUpdate returns whether only the Default Tags have changed, in which case the Tags need to be
+// updated using the Tags API since most Resources only send the Tags to the API when `tags` itself has changed
+func requiresDefaultTagsUpdate(d *schema.ResourceData) bool {
+	return d.HasChange("tags_all") && !d.HasChange("tags")
+}
+
+// updateDefaultTags updates the Tags on the Resource to match `tags_all` using the Tags API
+func updateDefaultTags(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	// the Tags API can only be used for Resources within Azure Resource Manager
+	if !strings.HasPrefix(strings.ToLower(d.Id()), "/subscriptions/") {
+		log.Printf("[DEBUG] Skipping updating the Default Tags for %q since this isn't a Resource Manager ID", d.Id())
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("tags_all")
+	oldTags, _ := oldRaw.(map[string]interface{})
+	newTags, _ := newRaw.(map[string]interface{})
+
+	updated := make(map[string]interface{})
+	for k, v := range newTags {
+		if existing, ok := oldTags[k]; !ok || existing != v {
+			updated[k] = v
+		}
+	}
+	removed := make(map[string]interface{})
+	for k, v := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			removed[k] = v
+		}
+	}
+
+	client := meta.(*clients.Client).Resource.TagsClient
+	scope := strings.TrimPrefix(d.Id(), "/")
+
+	if len(updated) > 0 {
+		log.Printf("[DEBUG] Updating the Default Tags for %q..", d.Id())
+		parameters := resources.TagsPatchResource{
+			Operation: resources.TagsPatchOperationMerge,
+			Properties: &resources.Tags{
+				Tags: tags.Expand(updated),
+			},
+		}
+		if _, err := client.UpdateAtScope(ctx, scope, parameters); err != nil {
+			return fmt.Errorf("updating the Default Tags for %q: %+v", d.Id(), err)
+		}
+	}
+
+	if len(removed) > 0 {
+		log.Printf("[DEBUG] Removing the Default Tags for %q..", d.Id())
+		parameters := resources.TagsPatchResource{
+			Operation: resources.TagsPatchOperationDelete,
+			Properties: &resources.Tags{
+				Tags: tags.Expand(removed),
+			},
+		}
+		if _, err := client.UpdateAtScope(ctx, scope, parameters); err != nil {
+			return fmt.Errorf("removing the Default Tags for %q: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func schemaDefaultTags() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags which should be applied to all Resources which support Tags.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": tags.Schema(),
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	for k, v := range raw["tags"].(map[string]interface{}) {
+		output[k] = v.(string)
+	}
+
+	return output
+}
+
+// supportsDefaultTags returns whether the Default Tags can be merged into the `tags` field for this Resource
+// Resources where `tags` is ForceNew are intentionally excluded, so that changing the Default Tags doesn't
+// recreate these Resources
+func supportsDefaultTags(resource *schema.Resource) bool {
+	s, ok := resource.Schema["tags"]
+	if !ok || s == nil {
+		return false
+	}
+
+	return s.Type == schema.TypeMap && s.Optional && !s.ForceNew
+}
+
+// withDefaultTags wraps the Create, Read and Update functions for the Resource so that the Default Tags are sent
+// to the API alongside the configured Tags, whilst only the configured Tags are stored in `tags` - all of the Tags
+// (including the Default Tags) are exposed in the `tags_all` attribute, which is planned by a CustomizeDiff so
+// that changes to the Default Tags are applied to existing Resources
+func withDefaultTags(resource *schema.Resource, defaults *defaultTags) {
+	tagsSchema := *resource.Schema["tags"]
+	existingDiffSuppressFunc := tagsSchema.DiffSuppressFunc
+	defaultDiffSuppressFunc := tags.DefaultDiffSuppressFunc(defaults.get)
+	tagsSchema.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		if existingDiffSuppressFunc != nil && existingDiffSuppressFunc(k, old, new, d) {
+			return true
+		}
+
+		return defaultDiffSuppressFunc(k, old, new, d)
+	}
+	resource.Schema["tags"] = &tagsSchema
+
+	resource.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	if existing := resource.CustomizeDiff; existing != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffWithAll(existing, defaults.customizeDiff)
+	} else {
+		resource.CustomizeDiff = defaults.customizeDiff
+	}
+
+	//nolint:staticcheck
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return defaults.apply(d, func() error {
+				return create(d, meta)
+			})
+		}
+	}
+	if create := resource.CreateContext; create != nil {
+		resource.CreateContext = withDefaultTagsContext(create, defaults, false)
+	}
+	if create := resource.CreateWithoutTimeout; create != nil {
+		resource.CreateWithoutTimeout = withDefaultTagsContext(create, defaults, false)
+	}
+
+	//nolint:staticcheck
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return defaults.read(d, func() error {
+				return read(d, meta)
+			})
+		}
+	}
+	if read := resource.ReadContext; read != nil {
+		resource.ReadContext = withDefaultTagsReadContext(read, defaults)
+	}
+	if read := resource.ReadWithoutTimeout; read != nil {
+		resource.ReadWithoutTimeout = withDefaultTagsReadContext(read, defaults)
+	}
+
+	//nolint:staticcheck
+	if update := resource.Update; update != nil {
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return defaults.apply(d, func() error {
+				if requiresDefaultTagsUpdate(d) {
+					ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+					defer cancel()
+
+					if err := updateDefaultTags(ctx, d, meta); err != nil {
+						return err
+					}
+				}
+				return update(d, meta)
+			})
+		}
+	}
+	if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = withDefaultTagsContext(update, defaults, true)
+	}
+	if update := resource.UpdateWithoutTimeout; update != nil {
+		resource.UpdateWithoutTimeout = withDefaultTagsContext(update, defaults, true)
+	}
+}
+
+// errDefaultTagsDiagnostics is returned from the wrapped function when the Diagnostics contain an error, so that these
+// Diagnostics are returned as-is
+var errDefaultTagsDiagnostics = errors.New("the wrapped function returned an error")
+
+func withDefaultTagsContext(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, defaults *defaultTags, update bool) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		err := defaults.apply(d, func() error {
+			if update && requiresDefaultTagsUpdate(d) {
+				if err := updateDefaultTags(ctx, d, meta); err != nil {
+					return err
+				}
+			}
+
+			if diags = f(ctx, d, meta); diags.HasError() {
+				return errDefaultTagsDiagnostics
+			}
+			return nil
+		})
+		if err != nil && err != errDefaultTagsDiagnostics {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+func withDefaultTagsReadContext(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, defaults *defaultTags) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		err := defaults.read(d, func() error {
+			if diags = f(ctx, d, meta); diags.HasError() {
+				return errDefaultTagsDiagnostics
+			}
+			return nil
+		})
+		if err != nil && err != errDefaultTagsDiagnostics {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
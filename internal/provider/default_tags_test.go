@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+)
+
+func TestResourcesSupportDefaultTags(t *testing.T) {
+	provider := TestAzureProvider()
+	for resourceName, resource := range provider.ResourcesMap {
+		if !supportsDefaultTags(resource) {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("Resource/%s", resourceName), func(t *testing.T) {
+			tagsAll, ok := resource.Schema["tags_all"]
+			if !ok || !tagsAll.Computed || tagsAll.Optional {
+				t.Fatalf("expected %q to expose a Computed `tags_all` attribute", resourceName)
+			}
+
+			if resource.CustomizeDiff == nil {
+				t.Fatalf("expected %q to have a CustomizeDiff to plan `tags_all`", resourceName)
+			}
+		})
+	}
+}
+
+func TestSupportsDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Schema   map[string]*schema.Schema
+		Expected bool
+	}{
+		{
+			Name:     "No Tags",
+			Schema:   map[string]*schema.Schema{},
+			Expected: false,
+		},
+		{
+			Name: "Tags",
+			Schema: map[string]*schema.Schema{
+				"tags": tags.Schema(),
+			},
+			Expected: true,
+		},
+		{
+			Name: "Tags ForceNew",
+			Schema: map[string]*schema.Schema{
+				"tags": tags.ForceNewSchema(),
+			},
+			Expected: false,
+		},
+		{
+			Name: "Tags Computed",
+			Schema: map[string]*schema.Schema{
+				"tags": tags.SchemaDataSource(),
+			},
+			Expected: false,
+		},
+		{
+			Name: "Tags Not A Map",
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := supportsDefaultTags(&schema.Resource{Schema: v.Schema})
+		if actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithDefaultTags(t *testing.T) {
+	// the Tags assigned to the Resource within the API
+	var remote map[string]interface{}
+
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		return d.Set("tags", remote)
+	}
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			remote = d.Get("tags").(map[string]interface{})
+			d.SetId("example")
+			return read(d, meta)
+		},
+		Read: read,
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			remote = d.Get("tags").(map[string]interface{})
+			return read(d, meta)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"tags": tags.Schema(),
+		},
+	}
+
+	defaults := &defaultTags{
+		tags: map[string]string{
+			"environment": "production",
+		},
+	}
+	withDefaultTags(resource, defaults)
+
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"hello": "there",
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	if err := resource.Create(d, nil); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expectedRemote := map[string]interface{}{
+		"environment": "production",
+		"hello":       "there",
+	}
+	if !reflect.DeepEqual(remote, expectedRemote) {
+		t.Fatalf("expected the Default Tags to be sent to the API as %+v but got %+v", expectedRemote, remote)
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, config["tags"]) {
+		t.Fatalf("expected `tags` to only contain the configured Tags but got %+v", actual)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedRemote) {
+		t.Fatalf("expected `tags_all` to contain all of the Tags but got %+v", actual)
+	}
+
+	// the Read should retain the configured Tags, rather than the Default Tags read from the API
+	if err := resource.Read(d, nil); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, config["tags"]) {
+		t.Fatalf("expected `tags` to only contain the configured Tags after a Read but got %+v", actual)
+	}
+
+	// no changes should be planned when the configuration and the Default Tags are unchanged
+	diff, err := resource.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("computing the diff: %+v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no changes but got %+v", diff.Attributes)
+	}
+
+	// changing the Default Tags should plan a change to `tags_all` rather than `tags`
+	defaults.tags = map[string]string{
+		"environment": "staging",
+	}
+	diff, err = resource.Diff(context.TODO(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("computing the diff: %+v", err)
+	}
+	if diff == nil {
+		t.Fatalf("expected a change to `tags_all` but got no changes")
+	}
+	for k := range diff.Attributes {
+		if strings.HasPrefix(k, "tags.") {
+			t.Fatalf("expected no changes to `tags` but got a change to %q", k)
+		}
+	}
+	if v, ok := diff.Attributes["tags_all.environment"]; !ok || v.Old != "production" || v.New != "staging" {
+		t.Fatalf("expected `tags_all.environment` to change from `production` to `staging` but got %+v", v)
+	}
+}
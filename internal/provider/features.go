@@ -49,6 +49,28 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"app_service": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"skip_reading_site_credentials": {
+						Description: "When enabled the Publishing Credentials for Function Apps, Web Apps and their Slots won't be retrieved when reading these resources",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"validate_key_vault_references": {
+						Description: "When enabled Key Vault References in the App Settings and Connection Strings of Function Apps, Web Apps and their Slots are validated at plan time",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+
 		"cognitive_account": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -132,6 +154,13 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional:    true,
 						Default:     true,
 					},
+
+					"validate_data_plane_permissions": {
+						Description: "When enabled the data plane permissions required to manage `azurerm_key_vault_certificate`, `azurerm_key_vault_key` and `azurerm_key_vault_secret` resources will be checked during plan",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
 				},
 			},
 		},
@@ -243,6 +272,45 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"resource_id": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"case_insensitive_import": {
+						Description: "When enabled the casing of the segments within a Resource ID is normalized during import, for example `resourcegroups` becomes `resourceGroups`",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+
+		"storage": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"data_plane_available": {
+						Description: "When disabled `azurerm_storage_container` and `azurerm_storage_share` resources are managed using the Resource Manager API rather than the Storage Data Plane API",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+
+					"redundancy_conversion_enabled": {
+						Description: "When enabled, changing the `account_replication_type` of an `azurerm_storage_account` between a locally redundant and a zone redundant type performs a Customer-Initiated Migration rather than recreating the Storage Account",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -301,6 +369,19 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["app_service"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			appServiceRaw := items[0].(map[string]interface{})
+			if v, ok := appServiceRaw["skip_reading_site_credentials"]; ok {
+				featuresMap.AppService.SkipReadingSiteCredentials = v.(bool)
+			}
+			if v, ok := appServiceRaw["validate_key_vault_references"]; ok {
+				featuresMap.AppService.ValidateKeyVaultReferences = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["cognitive_account"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -342,6 +423,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := keyVaultRaw["recover_soft_deleted_secrets"]; ok {
 				featuresMap.KeyVault.RecoverSoftDeletedSecrets = v.(bool)
 			}
+			if v, ok := keyVaultRaw["validate_data_plane_permissions"]; ok {
+				featuresMap.KeyVault.ValidateDataPlanePermissions = v.(bool)
+			}
 		}
 	}
 
@@ -407,5 +491,28 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["resource_id"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			resourceIdRaw := items[0].(map[string]interface{})
+			if v, ok := resourceIdRaw["case_insensitive_import"]; ok {
+				featuresMap.ResourceId.CaseInsensitiveImport = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["storage"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			storageRaw := items[0].(map[string]interface{})
+			if v, ok := storageRaw["data_plane_available"]; ok {
+				featuresMap.Storage.DataPlaneAvailable = v.(bool)
+			}
+			if v, ok := storageRaw["redundancy_conversion_enabled"]; ok {
+				featuresMap.Storage.RedundancyConversionEnabled = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
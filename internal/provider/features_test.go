@@ -25,6 +25,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApplicationInsights: features.ApplicationInsightFeatures{
 					DisableGeneratedRule: false,
 				},
+				AppService: features.AppServiceFeatures{
+					SkipReadingSiteCredentials: false,
+					ValidateKeyVaultReferences: false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -38,6 +42,7 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					ValidateDataPlanePermissions:     false,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
@@ -58,6 +63,13 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				ResourceId: features.ResourceIdFeatures{
+					CaseInsensitiveImport: false,
+				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable:          true,
+					RedundancyConversionEnabled: false,
+				},
 			},
 		},
 		{
@@ -75,6 +87,12 @@ func TestExpandFeatures(t *testing.T) {
 							"disable_generated_rule": true,
 						},
 					},
+					"app_service": []interface{}{
+						map[string]interface{}{
+							"skip_reading_site_credentials": true,
+							"validate_key_vault_references": true,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
@@ -91,6 +109,7 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_keys":                               true,
 							"recover_soft_deleted_key_vaults":                         true,
 							"recover_soft_deleted_secrets":                            true,
+							"validate_data_plane_permissions":                         true,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -108,6 +127,17 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": true,
 						},
 					},
+					"resource_id": []interface{}{
+						map[string]interface{}{
+							"case_insensitive_import": true,
+						},
+					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available":          true,
+							"redundancy_conversion_enabled": true,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": true,
@@ -137,6 +167,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApplicationInsights: features.ApplicationInsightFeatures{
 					DisableGeneratedRule: true,
 				},
+				AppService: features.AppServiceFeatures{
+					SkipReadingSiteCredentials: true,
+					ValidateKeyVaultReferences: true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -150,6 +184,7 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					ValidateDataPlanePermissions:     true,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
@@ -157,6 +192,13 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
+				ResourceId: features.ResourceIdFeatures{
+					CaseInsensitiveImport: true,
+				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable:          true,
+					RedundancyConversionEnabled: true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -187,6 +229,12 @@ func TestExpandFeatures(t *testing.T) {
 							"disable_generated_rule": false,
 						},
 					},
+					"app_service": []interface{}{
+						map[string]interface{}{
+							"skip_reading_site_credentials": false,
+							"validate_key_vault_references": false,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
@@ -203,6 +251,7 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_keys":                               false,
 							"recover_soft_deleted_key_vaults":                         false,
 							"recover_soft_deleted_secrets":                            false,
+							"validate_data_plane_permissions":                         false,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -220,6 +269,17 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_deletion_if_contains_resources": false,
 						},
 					},
+					"resource_id": []interface{}{
+						map[string]interface{}{
+							"case_insensitive_import": false,
+						},
+					},
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available":          false,
+							"redundancy_conversion_enabled": false,
+						},
+					},
 					"template_deployment": []interface{}{
 						map[string]interface{}{
 							"delete_nested_items_during_deletion": false,
@@ -249,6 +309,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApplicationInsights: features.ApplicationInsightFeatures{
 					DisableGeneratedRule: false,
 				},
+				AppService: features.AppServiceFeatures{
+					SkipReadingSiteCredentials: false,
+					ValidateKeyVaultReferences: false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
@@ -262,6 +326,7 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           false,
 					RecoverSoftDeletedKeyVaults:      false,
 					RecoverSoftDeletedSecrets:        false,
+					ValidateDataPlanePermissions:     false,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
@@ -269,6 +334,13 @@ func TestExpandFeatures(t *testing.T) {
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
+				ResourceId: features.ResourceIdFeatures{
+					CaseInsensitiveImport: false,
+				},
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable:          false,
+					RedundancyConversionEnabled: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: false,
 				},
@@ -430,6 +502,92 @@ func TestExpandFeaturesApplicationInsights(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesAppService(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_service": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppService: features.AppServiceFeatures{
+					SkipReadingSiteCredentials: false,
+					ValidateKeyVaultReferences: false,
+				},
+			},
+		},
+		{
+			Name: "Skip Reading Site Credentials",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_service": []interface{}{
+						map[string]interface{}{
+							"skip_reading_site_credentials": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppService: features.AppServiceFeatures{
+					SkipReadingSiteCredentials: true,
+				},
+			},
+		},
+		{
+			Name: "Read Site Credentials",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_service": []interface{}{
+						map[string]interface{}{
+							"skip_reading_site_credentials": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppService: features.AppServiceFeatures{
+					SkipReadingSiteCredentials: false,
+					ValidateKeyVaultReferences: false,
+				},
+			},
+		},
+		{
+			Name: "Validate Key Vault References",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_service": []interface{}{
+						map[string]interface{}{
+							"skip_reading_site_credentials": false,
+							"validate_key_vault_references": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppService: features.AppServiceFeatures{
+					SkipReadingSiteCredentials: false,
+					ValidateKeyVaultReferences: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.AppService, testCase.Expected.AppService) {
+			t.Fatalf("Expected %+v but got %+v", result.AppService, testCase.Expected.AppService)
+		}
+	}
+}
+
 func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -520,6 +678,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					ValidateDataPlanePermissions:     false,
 				},
 			},
 		},
@@ -538,6 +697,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_keys":                               true,
 							"recover_soft_deleted_key_vaults":                         true,
 							"recover_soft_deleted_secrets":                            true,
+							"validate_data_plane_permissions":                         true,
 						},
 					},
 				},
@@ -553,6 +713,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					ValidateDataPlanePermissions:     true,
 				},
 			},
 		},
@@ -571,6 +732,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_keys":                               false,
 							"recover_soft_deleted_key_vaults":                         false,
 							"recover_soft_deleted_secrets":                            false,
+							"validate_data_plane_permissions":                         false,
 						},
 					},
 				},
@@ -586,6 +748,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      false,
 					RecoverSoftDeletedKeys:           false,
 					RecoverSoftDeletedSecrets:        false,
+					ValidateDataPlanePermissions:     false,
 				},
 			},
 		},
@@ -1025,3 +1188,153 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesResourceId(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_id": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceId: features.ResourceIdFeatures{
+					CaseInsensitiveImport: false,
+				},
+			},
+		},
+		{
+			Name: "Case Insensitive Import Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_id": []interface{}{
+						map[string]interface{}{
+							"case_insensitive_import": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceId: features.ResourceIdFeatures{
+					CaseInsensitiveImport: true,
+				},
+			},
+		},
+		{
+			Name: "Case Insensitive Import Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_id": []interface{}{
+						map[string]interface{}{
+							"case_insensitive_import": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceId: features.ResourceIdFeatures{
+					CaseInsensitiveImport: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ResourceId, testCase.Expected.ResourceId) {
+			t.Fatalf("Expected %+v but got %+v", result.ResourceId, testCase.Expected.ResourceId)
+		}
+	}
+}
+
+func TestExpandFeaturesStorage(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable:          true,
+					RedundancyConversionEnabled: false,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Available Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable:          true,
+					RedundancyConversionEnabled: false,
+				},
+			},
+		},
+		{
+			Name: "Data Plane Available Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"data_plane_available": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable:          false,
+					RedundancyConversionEnabled: false,
+				},
+			},
+		}, {
+			Name: "Redundancy Conversion Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"storage": []interface{}{
+						map[string]interface{}{
+							"redundancy_conversion_enabled": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Storage: features.StorageFeatures{
+					DataPlaneAvailable:          true,
+					RedundancyConversionEnabled: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Storage, testCase.Expected.Storage) {
+			t.Fatalf("Expected %+v but got %+v", result.Storage, testCase.Expected.Storage)
+		}
+	}
+}
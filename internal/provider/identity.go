@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+)
+
+// supportsNormalizedIdentityIds returns whether the Resource exposes a top-level `identity` block containing
+// a Set of `identity_ids`, as defined by the Identity schemas within `commonschema`
+func supportsNormalizedIdentityIds(resource *schema.Resource) bool {
+	s, ok := resource.Schema["identity"]
+	if !ok || s == nil || s.Type != schema.TypeList {
+		return false
+	}
+
+	elem, ok := s.Elem.(*schema.Resource)
+	if !ok || elem == nil {
+		return false
+	}
+
+	ids, ok := elem.Schema["identity_ids"]
+	if !ok || ids == nil || ids.Type != schema.TypeSet {
+		return false
+	}
+
+	_, ok = ids.Elem.(*schema.Schema)
+	return ok
+}
+
+// withNormalizedIdentityIds updates the `identity_ids` field within the `identity` block so that the User Assigned
+// Identity IDs are compared case-insensitively, since the casing returned by the API (for example `resourcegroups`)
+// doesn't necessarily match the casing in the configuration. This is done here rather than in each Resource since
+// the Identity schemas and Expand/Flatten functions are shared by all Resources supporting Managed Identities.
+func withNormalizedIdentityIds(resource *schema.Resource) {
+	identitySchema := *resource.Schema["identity"]
+	identityElem := *identitySchema.Elem.(*schema.Resource)
+
+	identityElemSchema := make(map[string]*schema.Schema, len(identityElem.Schema))
+	for k, v := range identityElem.Schema {
+		identityElemSchema[k] = v
+	}
+
+	idsSchema := *identityElemSchema["identity_ids"]
+	idsElem := *idsSchema.Elem.(*schema.Schema)
+	if idsElem.DiffSuppressFunc == nil {
+		idsElem.DiffSuppressFunc = suppress.CaseDifference
+	}
+	idsSchema.Elem = &idsElem
+	idsSchema.Set = set.HashStringIgnoreCase
+	identityElemSchema["identity_ids"] = &idsSchema
+
+	identityElem.Schema = identityElemSchema
+	identitySchema.Elem = &identityElem
+	resource.Schema["identity"] = &identitySchema
+}
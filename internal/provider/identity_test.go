@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourcesNormalizeIdentityIds(t *testing.T) {
+	provider := TestAzureProvider()
+	for resourceName, resource := range provider.ResourcesMap {
+		if !supportsNormalizedIdentityIds(resource) {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("Resource/%s", resourceName), func(t *testing.T) {
+			ids := resource.Schema["identity"].Elem.(*schema.Resource).Schema["identity_ids"]
+
+			first := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+			second := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+			if ids.Set == nil || ids.Set(first) != ids.Set(second) {
+				t.Fatalf("expected the `identity_ids` for %q to be hashed case-insensitively", resourceName)
+			}
+
+			if ids.Elem.(*schema.Schema).DiffSuppressFunc == nil {
+				t.Fatalf("expected the `identity_ids` for %q to have a DiffSuppressFunc", resourceName)
+			}
+		})
+	}
+}
+
+func TestWithNormalizedIdentityIds(t *testing.T) {
+	original := &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"identity_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"identity": original,
+		},
+	}
+
+	if !supportsNormalizedIdentityIds(resource) {
+		t.Fatalf("expected the resource to support normalized identity ids")
+	}
+
+	withNormalizedIdentityIds(resource)
+
+	ids := resource.Schema["identity"].Elem.(*schema.Resource).Schema["identity_ids"]
+	input := []interface{}{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity2",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+		"/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/GROUP1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+	}
+	actual := schema.NewSet(ids.Set, input)
+	if actual.Len() != 2 {
+		t.Fatalf("expected 2 identity ids but got %d", actual.Len())
+	}
+
+	if !ids.Elem.(*schema.Schema).DiffSuppressFunc("identity.0.identity_ids.1", input[1].(string), input[2].(string), nil) {
+		t.Fatalf("expected a difference in casing to be suppressed")
+	}
+
+	// the shared schema must not be modified, since it may be referenced by other Resources
+	if original.Elem.(*schema.Resource).Schema["identity_ids"].Set != nil {
+		t.Fatalf("expected the original schema to be left unchanged")
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func schemaPolling() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures how the status of Long Running Operations is polled.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"interval": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateRetryDuration,
+					Description:  "The minimum delay between polling the status of a Long Running Operation, for example `30s`.",
+				},
+			},
+		},
+	}
+}
+
+func expandPolling(input []interface{}) *common.PollingOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	// this has already been validated by the schema
+	interval, _ := time.ParseDuration(raw["interval"].(string))
+
+	return &common.PollingOptions{
+		Interval: interval,
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestExpandPolling(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *common.PollingOptions
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "Interval",
+			Input: []interface{}{
+				map[string]interface{}{
+					"interval": "1m30s",
+				},
+			},
+			Expected: &common.PollingOptions{
+				Interval: 90 * time.Second,
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandPolling(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
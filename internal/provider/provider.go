@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func AzureProvider() *schema.Provider {
@@ -97,6 +95,16 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	defaults := &defaultTags{}
+	for _, resource := range resources {
+		if supportsDefaultTags(resource) {
+			withDefaultTags(resource, defaults)
+		}
+		if supportsNormalizedIdentityIds(resource) {
+			withNormalizedIdentityIds(resource)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -123,10 +131,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			"auxiliary_tenant_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 3,
+				MaxItems: maxAuxiliaryTenants,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
 				},
+				Description: "List of auxiliary Tenant IDs required for multi-tenancy and cross-tenant scenarios.",
 			},
 
 			"environment": {
@@ -179,6 +189,19 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL"}, ""),
 				Description: "The URL for the OIDC provider from which to request an ID token. For use When authenticating as a Service Principal using OpenID Connect.",
 			},
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Description: "The OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
+			"oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN_FILE_PATH", ""),
+				Description: "The path to a file containing an OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
+			},
 
 			"use_oidc": {
 				Type:        schema.TypeBool,
@@ -226,6 +249,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"retry": schemaRetry(),
+
+			"polling": schemaPolling(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -240,28 +269,31 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use AzureAD to access the Storage Data Plane API's?",
 			},
+
+			"sensitive_values_in_state": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_SENSITIVE_VALUES_IN_STATE", true),
+				Description: "Should designated secrets (such as the `storage_account_access_key` of a Function App) be stored in the state? When disabled only a hash of these values is stored.",
+			},
 		},
 
 		DataSourcesMap: dataSources,
 		ResourcesMap:   resources,
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	p.ConfigureContextFunc = providerConfigure(p, defaults)
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+func providerConfigure(p *schema.Provider, defaults *defaultTags) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		var auxTenants []string
-		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
-			auxTenants = *utils.ExpandStringSlice(v)
-		} else if v := os.Getenv("ARM_AUXILIARY_TENANT_IDS"); v != "" {
-			auxTenants = strings.Split(v, ";")
-		}
+		defaults.tags = expandDefaultTags(d.Get("default_tags").([]interface{}))
 
-		if len(auxTenants) > 3 {
-			return nil, diag.Errorf("The provider only supports 3 auxiliary tenant IDs")
+		auxTenants, err := expandAuxiliaryTenantIDs(d.Get("auxiliary_tenant_ids").([]interface{}), d.Get("tenant_id").(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
 		}
 
 		metadataHost := d.Get("metadata_host").(string)
@@ -295,7 +327,30 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			UseMicrosoftGraph: true,
 		}
 
-		config, err := builder.Build()
+		var oidcToken *clients.OIDCTokenOptions
+		idToken := d.Get("oidc_token").(string)
+		idTokenFilePath := d.Get("oidc_token_file_path").(string)
+		if builder.SupportsOIDCAuth && builder.ClientSecret == "" && builder.ClientCertPath == "" && (idToken != "" || idTokenFilePath != "") {
+			oidcToken = &clients.OIDCTokenOptions{
+				Token:         idToken,
+				TokenFilePath: idTokenFilePath,
+			}
+		}
+
+		// Managed Identity can only obtain tokens for the Tenant it belongs to, so the auxiliary tokens required for
+		// cross-tenant requests can't be obtained - rather than silently omitting these we raise an error
+		usingClientCredentials := builder.ClientSecret != "" || builder.ClientCertPath != "" || oidcToken != nil || (builder.SupportsOIDCAuth && builder.IDTokenRequestURL != "" && builder.IDTokenRequestToken != "")
+		if len(auxTenants) > 0 && builder.SupportsManagedServiceIdentity && !usingClientCredentials {
+			return nil, diag.Errorf("auxiliary tenant IDs are not supported when authenticating using Managed Identity - please authenticate using a Service Principal or the Azure CLI")
+		}
+
+		var config *authentication.Config
+		if oidcToken != nil {
+			// an ID Token which has already been issued is exchanged directly, rather than being requested from the OIDC provider
+			config, err = clients.BuildOIDCTokenAuthConfig(*builder, *oidcToken)
+		} else {
+			config, err = builder.Build()
+		}
 		if err != nil {
 			return nil, diag.Errorf("building AzureRM Client: %s", err)
 		}
@@ -307,6 +362,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
+		retryOptions, err := expandRetry(d.Get("retry").([]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		pollingOptions := expandPolling(d.Get("polling").([]interface{}))
+
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
@@ -317,6 +379,10 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			SensitiveValuesInState:      d.Get("sensitive_values_in_state").(bool),
+			RetryOptions:                retryOptions,
+			PollingOptions:              pollingOptions,
+			OIDCToken:                   oidcToken,
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
 			// platform level tracing
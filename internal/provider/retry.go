@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// defaultRetryStatusCodes are the HTTP Status Codes which are retried when `retry_on_status_codes` isn't specified
+var defaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+func schemaRetry() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures how requests to Azure which fail with a transient error are retried.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      3,
+					ValidateFunc: validation.IntBetween(0, 20),
+					Description:  "The maximum number of times a request will be retried.",
+				},
+
+				"min_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "5s",
+					ValidateFunc: validateRetryDuration,
+					Description:  "The delay before the first retry, which is doubled for each subsequent retry, for example `5s`.",
+				},
+
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "60s",
+					ValidateFunc: validateRetryDuration,
+					Description:  "The maximum delay between retries, for example `60s`.",
+				},
+
+				"retry_on_status_codes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeInt,
+						ValidateFunc: validation.IntBetween(400, 599),
+					},
+					Description: "The HTTP Status Codes which should be retried. Defaults to `429`, `502`, `503` and `504`.",
+				},
+			},
+		},
+	}
+}
+
+func expandRetry(input []interface{}) (*common.RetryOptions, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	// these have already been validated by the schema
+	minBackoff, _ := time.ParseDuration(raw["min_backoff"].(string))
+	maxBackoff, _ := time.ParseDuration(raw["max_backoff"].(string))
+	if minBackoff > maxBackoff {
+		return nil, fmt.Errorf("`retry.0.min_backoff` (%s) must be less than or equal to `retry.0.max_backoff` (%s)", minBackoff, maxBackoff)
+	}
+
+	statusCodes := make([]int, 0)
+	if v, ok := raw["retry_on_status_codes"].(*schema.Set); ok {
+		for _, code := range v.List() {
+			statusCodes = append(statusCodes, code.(int))
+		}
+	}
+	if len(statusCodes) == 0 {
+		statusCodes = defaultRetryStatusCodes
+	}
+
+	return &common.RetryOptions{
+		MaxRetries:  raw["max_retries"].(int),
+		MinBackoff:  minBackoff,
+		MaxBackoff:  maxBackoff,
+		StatusCodes: statusCodes,
+	}, nil
+}
+
+func validateRetryDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a duration such as `5s` or `1m`: %+v", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be a positive duration but got %q", k, v))
+	}
+
+	return
+}
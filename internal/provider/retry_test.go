@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestExpandRetry(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       []interface{}
+		Expected    *common.RetryOptions
+		ExpectError bool
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "Default Status Codes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_retries":           3,
+					"min_backoff":           "5s",
+					"max_backoff":           "60s",
+					"retry_on_status_codes": schema.NewSet(schema.HashInt, []interface{}{}),
+				},
+			},
+			Expected: &common.RetryOptions{
+				MaxRetries:  3,
+				MinBackoff:  5 * time.Second,
+				MaxBackoff:  time.Minute,
+				StatusCodes: defaultRetryStatusCodes,
+			},
+		},
+		{
+			Name: "Custom Status Codes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_retries":           10,
+					"min_backoff":           "500ms",
+					"max_backoff":           "2m",
+					"retry_on_status_codes": schema.NewSet(schema.HashInt, []interface{}{429}),
+				},
+			},
+			Expected: &common.RetryOptions{
+				MaxRetries:  10,
+				MinBackoff:  500 * time.Millisecond,
+				MaxBackoff:  2 * time.Minute,
+				StatusCodes: []int{429},
+			},
+		},
+		{
+			Name: "Min Backoff Greater Than Max Backoff",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_retries":           3,
+					"min_backoff":           "2m",
+					"max_backoff":           "1m",
+					"retry_on_status_codes": schema.NewSet(schema.HashInt, []interface{}{}),
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result, err := expandRetry(testCase.Input)
+		if err != nil {
+			if testCase.ExpectError {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if testCase.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
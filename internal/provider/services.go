@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/confidentialledger"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/connections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement"
@@ -120,7 +121,9 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		bot.Registration{},
 		compute.Registration{},
 		consumption.Registration{},
+		containerapps.Registration{},
 		containers.Registration{},
+		cosmos.Registration{},
 		costmanagement.Registration{},
 		disks.Registration{},
 		domainservices.Registration{},
@@ -129,12 +132,16 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		keyvault.Registration{},
 		loadbalancer.Registration{},
 		loadtest.Registration{},
+		loganalytics.Registration{},
 		monitor.Registration{},
 		mssql.Registration{},
+		mysql.Registration{},
 		policy.Registration{},
+		postgres.Registration{},
 		resource.Registration{},
 		sentinel.Registration{},
 		servicefabricmanaged.Registration{},
+		storage.Registration{},
 		streamanalytics.Registration{},
 		web.Registration{},
 	}
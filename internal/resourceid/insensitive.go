@@ -0,0 +1,68 @@
+package resourceid
+
+import (
+	"regexp"
+	"strings"
+)
+
+// the error returned by the generated Resource ID Parsers when a segment couldn't be found
+var missingSegmentRegex = regexp.MustCompile("ID was missing the [`']([^`']+)[`'] element")
+
+// the segments which are parsed case-sensitively ahead of the Resource specific segments
+var wellKnownSegments = []string{"subscriptions", "resourceGroups", "providers"}
+
+// NormalizeSegmentCasing attempts to rewrite the casing of the segment keys within the Resource ID `input`
+// such that it can be parsed by `validateFunc` - returning the rewritten Resource ID.
+//
+// This allows importing Resource IDs returned by the API (or the Portal/CLI) using a different casing,
+// for example `resourcegroups` rather than `resourceGroups` - only the segment keys are rewritten, the
+// values (e.g. the names of Resources) are returned as-is.
+func NormalizeSegmentCasing(input string, validateFunc func(id string) error) (string, error) {
+	segments := strings.Split(input, "/")
+
+	// the segment keys are at odd indexes when the Resource ID has a leading slash
+	offset := 0
+	if strings.HasPrefix(input, "/") {
+		offset = 1
+	}
+
+	for i := offset; i < len(segments); i += 2 {
+		for _, key := range wellKnownSegments {
+			if strings.EqualFold(segments[i], key) {
+				segments[i] = key
+			}
+		}
+	}
+
+	output := strings.Join(segments, "/")
+	err := validateFunc(output)
+
+	// each attempt fixes the casing of a single segment, so there's at most one attempt per segment
+	for attempt := 0; err != nil && attempt < len(segments); attempt++ {
+		match := missingSegmentRegex.FindStringSubmatch(err.Error())
+		if match == nil {
+			break
+		}
+
+		found := false
+		for i := offset; i < len(segments); i += 2 {
+			if segments[i] != match[1] && strings.EqualFold(segments[i], match[1]) {
+				segments[i] = match[1]
+				found = true
+				break
+			}
+		}
+		if !found {
+			break
+		}
+
+		output = strings.Join(segments, "/")
+		err = validateFunc(output)
+	}
+
+	if err != nil {
+		return input, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,83 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeSegmentCasing(t *testing.T) {
+	validateFunc := func(id string) error {
+		expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Sql/servers/Server1/databases/database1"
+		if id == expected {
+			return nil
+		}
+
+		for _, segment := range []string{"resourceGroups", "servers", "databases"} {
+			if !containsSegment(id, segment) {
+				return fmt.Errorf("ID was missing the `%s` element", segment)
+			}
+		}
+
+		return fmt.Errorf("unexpected ID %q", id)
+	}
+
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			// already valid
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Sql/servers/Server1/databases/database1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Sql/servers/Server1/databases/database1",
+		},
+		{
+			// well-known segments
+			Input:    "/Subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1/Providers/Microsoft.Sql/servers/Server1/databases/database1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Sql/servers/Server1/databases/database1",
+		},
+		{
+			// resource specific segments, the values retain their casing
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/Group1/providers/Microsoft.Sql/Servers/Server1/DataBases/database1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Sql/servers/Server1/databases/database1",
+		},
+		{
+			// a different resource type can't be fixed
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Sql/managedInstances/Server1/databases/database1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NormalizeSegmentCasing(v.Input, validateFunc)
+		if err != nil {
+			if v.Error {
+				if actual != v.Input {
+					t.Fatalf("expected the input to be returned when erroring but got %q", actual)
+				}
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func containsSegment(id, segment string) bool {
+	for _, v := range strings.Split(id, "/") {
+		if v == segment {
+			return true
+		}
+	}
+	return false
+}
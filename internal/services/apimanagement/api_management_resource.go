@@ -708,6 +708,21 @@ func resourceApiManagementServiceCreateUpdate(d *pluginsdk.ResourceData, meta in
 			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 				return fmt.Errorf("waiting for %s to become ready: %+v", id, err)
 			}
+		} else {
+			availabilityRequest := apimanagement.ServiceCheckNameAvailabilityParameters{
+				Name: utils.String(id.ServiceName),
+			}
+			available, err := client.CheckNameAvailability(ctx, availabilityRequest)
+			if err != nil {
+				return fmt.Errorf("checking if the name %q was available: %+v", id.ServiceName, err)
+			}
+			if err := azure.CheckNameAvailability("API Management Service", id.ServiceName, azure.NameAvailabilityResult{
+				NameAvailable: available.NameAvailable,
+				Reason:        string(available.Reason),
+				Message:       available.Message,
+			}); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1106,13 +1121,19 @@ func resourceApiManagementServiceDelete(d *pluginsdk.ResourceData, meta interfac
 	// Purge the soft deleted Api Management permanently if the feature flag is enabled
 	if meta.(*clients.Client).Features.ApiManagement.PurgeSoftDeleteOnDestroy {
 		log.Printf("[DEBUG] %s marked for purge - executing purge", *id)
-		_, err := deletedServicesClient.GetByName(ctx, id.ServiceName, azure.NormalizeLocation(d.Get("location").(string)))
+		location := azure.NormalizeLocation(d.Get("location").(string))
+		softDeleted, err := deletedServicesClient.GetByName(ctx, id.ServiceName, location)
 		if err != nil {
-			return err
+			// not all SKUs are soft-deleted (e.g. Consumption), in which case there's nothing to purge
+			if utils.ResponseWasNotFound(softDeleted.Response) {
+				log.Printf("[DEBUG] %s was not Soft-Deleted - skipping purge", *id)
+				return nil
+			}
+			return fmt.Errorf("retrieving Soft-Deleted %s: %+v", *id, err)
 		}
-		future, err := deletedServicesClient.Purge(ctx, id.ServiceName, azure.NormalizeLocation(d.Get("location").(string)))
+		future, err := deletedServicesClient.Purge(ctx, id.ServiceName, location)
 		if err != nil {
-			return err
+			return fmt.Errorf("purging %s: %+v", *id, err)
 		}
 
 		log.Printf("[DEBUG] Waiting for purge of %s..", *id)
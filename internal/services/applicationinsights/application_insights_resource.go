@@ -1,6 +1,7 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -77,7 +78,6 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 			"workspace_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: azure.ValidateResourceIDOrEmpty,
 			},
 
@@ -166,6 +166,14 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// a classic Application Insights can be migrated in-place to a workspace-based one (and a workspace-based
+			// one can be moved between workspaces), however a workspace-based one can't be reverted back to classic
+			pluginsdk.ForceNewIfChange("workspace_id", func(ctx context.Context, old, new, _ interface{}) bool {
+				return old.(string) != "" && new.(string) == ""
+			}),
+		),
 	}
 }
 
@@ -0,0 +1,638 @@
+package applicationinsights
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/sdk/2022-06-15/webtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationInsightsStandardWebTestModel struct {
+	Name                  string                                      `tfschema:"name"`
+	ResourceGroupName     string                                      `tfschema:"resource_group_name"`
+	ApplicationInsightsID string                                      `tfschema:"application_insights_id"`
+	Location              string                                      `tfschema:"location"`
+	Description           string                                      `tfschema:"description"`
+	Enabled               bool                                        `tfschema:"enabled"`
+	Frequency             int64                                       `tfschema:"frequency"`
+	GeoLocations          []string                                    `tfschema:"geo_locations"`
+	Request               []ApplicationInsightsStandardWebTestRequest `tfschema:"request"`
+	RetryEnabled          bool                                        `tfschema:"retry_enabled"`
+	Timeout               int64                                       `tfschema:"timeout"`
+	ValidationRules       []ApplicationInsightsStandardWebTestRules   `tfschema:"validation_rules"`
+	Tags                  map[string]string                           `tfschema:"tags"`
+	SyntheticMonitorId    string                                      `tfschema:"synthetic_monitor_id"`
+}
+
+type ApplicationInsightsStandardWebTestRequest struct {
+	Body                          string                                     `tfschema:"body"`
+	FollowRedirectsEnabled        bool                                       `tfschema:"follow_redirects_enabled"`
+	Header                        []ApplicationInsightsStandardWebTestHeader `tfschema:"header"`
+	HTTPVerb                      string                                     `tfschema:"http_verb"`
+	ParseDependentRequestsEnabled bool                                       `tfschema:"parse_dependent_requests_enabled"`
+	URL                           string                                     `tfschema:"url"`
+}
+
+type ApplicationInsightsStandardWebTestHeader struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type ApplicationInsightsStandardWebTestRules struct {
+	Content                  []ApplicationInsightsStandardWebTestContent `tfschema:"content"`
+	ExpectedStatusCode       int64                                       `tfschema:"expected_status_code"`
+	SSLCertRemainingLifetime int64                                       `tfschema:"ssl_cert_remaining_lifetime"`
+	SSLCheckEnabled          bool                                        `tfschema:"ssl_check_enabled"`
+}
+
+type ApplicationInsightsStandardWebTestContent struct {
+	ContentMatch    string `tfschema:"content_match"`
+	IgnoreCase      bool   `tfschema:"ignore_case"`
+	PassIfTextFound bool   `tfschema:"pass_if_text_found"`
+}
+
+type ApplicationInsightsStandardWebTestResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationInsightsStandardWebTestResource{}
+
+func (r ApplicationInsightsStandardWebTestResource) ResourceType() string {
+	return "azurerm_application_insights_standard_web_test"
+}
+
+func (r ApplicationInsightsStandardWebTestResource) ModelObject() interface{} {
+	return &ApplicationInsightsStandardWebTestModel{}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return webtests.ValidateWebTestID
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"application_insights_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ComponentID,
+		},
+
+		"geo_locations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"request": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"body": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"follow_redirects_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"header": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"http_verb": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  "GET",
+						ValidateFunc: validation.StringInSlice([]string{
+							"GET",
+							"POST",
+							"PUT",
+							"PATCH",
+							"DELETE",
+						}, false),
+					},
+
+					"parse_dependent_requests_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frequency": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  300,
+			ValidateFunc: validation.IntInSlice([]int{
+				300,
+				600,
+				900,
+			}),
+		},
+
+		"retry_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"timeout": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      30,
+			ValidateFunc: validation.IntBetween(30, 120),
+		},
+
+		"validation_rules": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"content": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"content_match": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"ignore_case": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+
+								"pass_if_text_found": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+							},
+						},
+					},
+
+					"expected_status_code": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      200,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+
+					"ssl_cert_remaining_lifetime": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+
+					"ssl_check_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"synthetic_monitor_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ApplicationInsightsStandardWebTestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := webtests.NewWebTestID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandApplicationInsightsStandardWebTest(id, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationInsightsStandardWebTestModel{
+				Name:              id.WebTestName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				// the Application Insights this Web Test belongs to is only exposed via the `hidden-link` tag, which
+				// we therefore omit from the `tags` exposed to users
+				tags := make(map[string]string)
+				if model.Tags != nil {
+					for k, v := range *model.Tags {
+						if strings.HasPrefix(k, "hidden-link:") {
+							appInsightsId, err := parse.ComponentIDInsensitively(strings.TrimPrefix(k, "hidden-link:"))
+							if err != nil {
+								return fmt.Errorf("parsing Application Insights ID from the `hidden-link` tag: %+v", err)
+							}
+							state.ApplicationInsightsID = appInsightsId.ID()
+							continue
+						}
+						tags[k] = v
+					}
+				}
+				state.Tags = tags
+
+				if props := model.Properties; props != nil {
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Enabled = utils.NormaliseNilableBool(props.Enabled)
+					state.RetryEnabled = utils.NormaliseNilableBool(props.RetryEnabled)
+					state.SyntheticMonitorId = props.SyntheticMonitorId
+
+					if props.Frequency != nil {
+						state.Frequency = *props.Frequency
+					}
+					if props.Timeout != nil {
+						state.Timeout = *props.Timeout
+					}
+
+					geoLocations := make([]string, 0)
+					for _, v := range props.Locations {
+						if v.Location != nil {
+							geoLocations = append(geoLocations, *v.Location)
+						}
+					}
+					state.GeoLocations = geoLocations
+
+					request, err := flattenApplicationInsightsStandardWebTestRequest(props.Request)
+					if err != nil {
+						return err
+					}
+					state.Request = request
+					state.ValidationRules = flattenApplicationInsightsStandardWebTestValidationRules(props.ValidationRules)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationInsightsStandardWebTestModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandApplicationInsightsStandardWebTest(*id, model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.StandardWebTestsClient
+
+			id, err := webtests.ParseWebTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationInsightsStandardWebTest(id webtests.WebTestId, model ApplicationInsightsStandardWebTestModel) (*webtests.WebTest, error) {
+	appInsightsId, err := parse.ComponentID(model.ApplicationInsightsID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := expandApplicationInsightsStandardWebTestRequest(model.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	validationRules, err := expandApplicationInsightsStandardWebTestValidationRules(model.ValidationRules, model.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	geoLocations := make([]webtests.WebTestGeolocation, 0)
+	for _, v := range model.GeoLocations {
+		geoLocations = append(geoLocations, webtests.WebTestGeolocation{
+			Location: utils.String(v),
+		})
+	}
+
+	// the Web Test is linked to the Application Insights by this tag
+	tags := make(map[string]string)
+	for k, v := range model.Tags {
+		tags[k] = v
+	}
+	tags[fmt.Sprintf("hidden-link:%s", appInsightsId.ID())] = "Resource"
+
+	kind := webtests.WebTestKindStandard
+	payload := webtests.WebTest{
+		Kind:     &kind,
+		Location: location.Normalize(model.Location),
+		Properties: &webtests.WebTestProperties{
+			Enabled:            utils.Bool(model.Enabled),
+			Frequency:          utils.Int64(model.Frequency),
+			Kind:               webtests.WebTestKindStandard,
+			Locations:          geoLocations,
+			Name:               id.WebTestName,
+			Request:            request,
+			RetryEnabled:       utils.Bool(model.RetryEnabled),
+			SyntheticMonitorId: id.WebTestName,
+			Timeout:            utils.Int64(model.Timeout),
+			ValidationRules:    validationRules,
+		},
+		Tags: &tags,
+	}
+
+	if model.Description != "" {
+		payload.Properties.Description = utils.String(model.Description)
+	}
+
+	return &payload, nil
+}
+
+func expandApplicationInsightsStandardWebTestRequest(input []ApplicationInsightsStandardWebTestRequest) (*webtests.WebTestPropertiesRequest, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	request := input[0]
+	if request.Body != "" && request.HTTPVerb == "GET" {
+		return nil, fmt.Errorf("`request.0.body` cannot be set when `request.0.http_verb` is `GET`")
+	}
+
+	headers := make([]webtests.HeaderField, 0)
+	for _, v := range request.Header {
+		headers = append(headers, webtests.HeaderField{
+			HeaderFieldName:  utils.String(v.Name),
+			HeaderFieldValue: utils.String(v.Value),
+		})
+	}
+
+	output := &webtests.WebTestPropertiesRequest{
+		FollowRedirects:        utils.Bool(request.FollowRedirectsEnabled),
+		Headers:                &headers,
+		HTTPVerb:               utils.String(request.HTTPVerb),
+		ParseDependentRequests: utils.Bool(request.ParseDependentRequestsEnabled),
+		RequestUrl:             utils.String(request.URL),
+	}
+
+	// the API expects the request body to be base64 encoded
+	if request.Body != "" {
+		output.RequestBody = utils.String(base64.StdEncoding.EncodeToString([]byte(request.Body)))
+	}
+
+	return output, nil
+}
+
+func flattenApplicationInsightsStandardWebTestRequest(input *webtests.WebTestPropertiesRequest) ([]ApplicationInsightsStandardWebTestRequest, error) {
+	if input == nil {
+		return []ApplicationInsightsStandardWebTestRequest{}, nil
+	}
+
+	headers := make([]ApplicationInsightsStandardWebTestHeader, 0)
+	if input.Headers != nil {
+		for _, v := range *input.Headers {
+			headers = append(headers, ApplicationInsightsStandardWebTestHeader{
+				Name:  utils.NormalizeNilableString(v.HeaderFieldName),
+				Value: utils.NormalizeNilableString(v.HeaderFieldValue),
+			})
+		}
+	}
+
+	body := ""
+	if input.RequestBody != nil && *input.RequestBody != "" {
+		decoded, err := base64.StdEncoding.DecodeString(*input.RequestBody)
+		if err != nil {
+			return nil, fmt.Errorf("decoding `request.0.body`: %+v", err)
+		}
+		body = string(decoded)
+	}
+
+	return []ApplicationInsightsStandardWebTestRequest{
+		{
+			Body:                          body,
+			FollowRedirectsEnabled:        utils.NormaliseNilableBool(input.FollowRedirects),
+			Header:                        headers,
+			HTTPVerb:                      utils.NormalizeNilableString(input.HTTPVerb),
+			ParseDependentRequestsEnabled: utils.NormaliseNilableBool(input.ParseDependentRequests),
+			URL:                           utils.NormalizeNilableString(input.RequestUrl),
+		},
+	}, nil
+}
+
+func expandApplicationInsightsStandardWebTestValidationRules(input []ApplicationInsightsStandardWebTestRules, request []ApplicationInsightsStandardWebTestRequest) (*webtests.WebTestPropertiesValidationRules, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	rules := input[0]
+	if rules.SSLCertRemainingLifetime != 0 && !rules.SSLCheckEnabled {
+		return nil, fmt.Errorf("`validation_rules.0.ssl_cert_remaining_lifetime` can only be set when `validation_rules.0.ssl_check_enabled` is `true`")
+	}
+	if rules.SSLCheckEnabled && len(request) > 0 && !strings.HasPrefix(strings.ToLower(request[0].URL), "https://") {
+		return nil, fmt.Errorf("`validation_rules.0.ssl_check_enabled` can only be `true` when `request.0.url` uses `https`")
+	}
+
+	output := &webtests.WebTestPropertiesValidationRules{
+		ExpectedHTTPStatusCode: utils.Int64(rules.ExpectedStatusCode),
+		SSLCheck:               utils.Bool(rules.SSLCheckEnabled),
+	}
+
+	if rules.SSLCertRemainingLifetime != 0 {
+		output.SSLCertRemainingLifetimeCheck = utils.Int64(rules.SSLCertRemainingLifetime)
+	}
+
+	if len(rules.Content) > 0 {
+		content := rules.Content[0]
+		output.ContentValidation = &webtests.WebTestPropertiesValidationRulesContentValidation{
+			ContentMatch:    utils.String(content.ContentMatch),
+			IgnoreCase:      utils.Bool(content.IgnoreCase),
+			PassIfTextFound: utils.Bool(content.PassIfTextFound),
+		}
+	}
+
+	return output, nil
+}
+
+func flattenApplicationInsightsStandardWebTestValidationRules(input *webtests.WebTestPropertiesValidationRules) []ApplicationInsightsStandardWebTestRules {
+	if input == nil {
+		return []ApplicationInsightsStandardWebTestRules{}
+	}
+
+	rules := ApplicationInsightsStandardWebTestRules{
+		SSLCheckEnabled: utils.NormaliseNilableBool(input.SSLCheck),
+		Content:         []ApplicationInsightsStandardWebTestContent{},
+	}
+
+	if input.ExpectedHTTPStatusCode != nil {
+		rules.ExpectedStatusCode = *input.ExpectedHTTPStatusCode
+	}
+
+	if input.SSLCertRemainingLifetimeCheck != nil {
+		rules.SSLCertRemainingLifetime = *input.SSLCertRemainingLifetimeCheck
+	}
+
+	if content := input.ContentValidation; content != nil {
+		rules.Content = []ApplicationInsightsStandardWebTestContent{
+			{
+				ContentMatch:    utils.NormalizeNilableString(content.ContentMatch),
+				IgnoreCase:      utils.NormaliseNilableBool(content.IgnoreCase),
+				PassIfTextFound: utils.NormaliseNilableBool(content.PassIfTextFound),
+			},
+		}
+	}
+
+	return []ApplicationInsightsStandardWebTestRules{rules}
+}
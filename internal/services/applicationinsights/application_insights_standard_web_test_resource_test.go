@@ -0,0 +1,218 @@
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/sdk/2022-06-15/webtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationInsightsStandardWebTestResource struct{}
+
+func TestAccApplicationInsightsStandardWebTest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_standard_web_test", "test")
+	r := ApplicationInsightsStandardWebTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("synthetic_monitor_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationInsightsStandardWebTest_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_standard_web_test", "test")
+	r := ApplicationInsightsStandardWebTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationInsightsStandardWebTest_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_standard_web_test", "test")
+	r := ApplicationInsightsStandardWebTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationInsightsStandardWebTest_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_standard_web_test", "test")
+	r := ApplicationInsightsStandardWebTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationInsightsStandardWebTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := webtests.ParseWebTestID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppInsights.StandardWebTestsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApplicationInsightsStandardWebTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_standard_web_test" "test" {
+  name                    = "acctestappinsightswebtests-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  geo_locations           = ["us-tx-sn1-azr"]
+
+  request {
+    url = "http://microsoft.com"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationInsightsStandardWebTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_standard_web_test" "import" {
+  name                    = azurerm_application_insights_standard_web_test.test.name
+  location                = azurerm_application_insights_standard_web_test.test.location
+  resource_group_name     = azurerm_application_insights_standard_web_test.test.resource_group_name
+  application_insights_id = azurerm_application_insights_standard_web_test.test.application_insights_id
+  geo_locations           = azurerm_application_insights_standard_web_test.test.geo_locations
+
+  request {
+    url = "http://microsoft.com"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ApplicationInsightsStandardWebTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_standard_web_test" "test" {
+  name                    = "acctestappinsightswebtests-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  geo_locations           = ["us-tx-sn1-azr", "us-il-ch1-azr"]
+  description             = "web test"
+  enabled                 = true
+  frequency               = 900
+  retry_enabled           = true
+  timeout                 = 120
+
+  request {
+    url                              = "https://microsoft.com"
+    http_verb                        = "POST"
+    body                             = "{\"test\": \"value\"}"
+    follow_redirects_enabled         = false
+    parse_dependent_requests_enabled = false
+
+    header {
+      name  = "x-header"
+      value = "testheader"
+    }
+
+    header {
+      name  = "x-header-2"
+      value = "testheader2"
+    }
+  }
+
+  validation_rules {
+    expected_status_code        = 200
+    ssl_cert_remaining_lifetime = 20
+    ssl_check_enabled           = true
+
+    content {
+      content_match      = "Unknown"
+      ignore_case        = true
+      pass_if_text_found = true
+    }
+  }
+
+  tags = {
+    ENV = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ApplicationInsightsStandardWebTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appinsights-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -6,6 +6,7 @@ import (
 	workbook "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/applicationinsights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/sdk/2022-06-15/webtests"
 )
 
 type Client struct {
@@ -13,6 +14,7 @@ type Client struct {
 	APIKeysClient            *insights.APIKeysClient
 	ComponentsClient         *insights.ComponentsClient
 	WebTestsClient           *azuresdkhacks.WebTestsClient
+	StandardWebTestsClient   *webtests.WebTestsClient
 	BillingClient            *insights.ComponentCurrentBillingFeaturesClient
 	SmartDetectionRuleClient *insights.ProactiveDetectionConfigurationsClient
 	WorkbookClient           *workbook.ApplicationInsightsClient
@@ -33,6 +35,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&webTestsClient.Client, o.ResourceManagerAuthorizer)
 	webTestsWorkaroundClient := azuresdkhacks.NewWebTestsClient(webTestsClient)
 
+	standardWebTestsClient := webtests.NewWebTestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&standardWebTestsClient.Client, o.ResourceManagerAuthorizer)
+
 	billingClient := insights.NewComponentCurrentBillingFeaturesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&billingClient.Client, o.ResourceManagerAuthorizer)
 
@@ -50,6 +55,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		APIKeysClient:            &apiKeysClient,
 		ComponentsClient:         &componentsClient,
 		WebTestsClient:           &webTestsWorkaroundClient,
+		StandardWebTestsClient:   &standardWebTestsClient,
 		BillingClient:            &billingClient,
 		SmartDetectionRuleClient: &smartDetectionRuleClient,
 		WorkbookClient:           &workbookClient,
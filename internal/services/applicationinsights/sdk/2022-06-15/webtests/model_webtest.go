@@ -0,0 +1,11 @@
+package webtests
+
+type WebTest struct {
+	Id         *string            `json:"id,omitempty"`
+	Kind       *WebTestKind       `json:"kind,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *WebTestProperties `json:"properties,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webtests
+
+type WebTestGeolocation struct {
+	Location *string `json:"Id,omitempty"`
+}
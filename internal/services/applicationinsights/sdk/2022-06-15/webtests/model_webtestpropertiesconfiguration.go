@@ -0,0 +1,5 @@
+package webtests
+
+type WebTestPropertiesConfiguration struct {
+	WebTest *string `json:"WebTest,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webtests
+
+type WebTestPropertiesValidationRulesContentValidation struct {
+	ContentMatch    *string `json:"ContentMatch,omitempty"`
+	IgnoreCase      *bool   `json:"IgnoreCase,omitempty"`
+	PassIfTextFound *bool   `json:"PassIfTextFound,omitempty"`
+}
@@ -0,0 +1,222 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppServiceDeploymentStatusDataSource struct{}
+
+type AppServiceDeploymentStatusDataSourceModel struct {
+	AppServiceId                string                            `tfschema:"app_service_id"`
+	SlotName                    string                            `tfschema:"slot_name"`
+	DeploymentId                string                            `tfschema:"deployment_id"`
+	Status                      string                            `tfschema:"status"`
+	NumberOfInstancesInProgress int                               `tfschema:"number_of_instances_in_progress"`
+	NumberOfInstancesSuccessful int                               `tfschema:"number_of_instances_successful"`
+	NumberOfInstancesFailed     int                               `tfschema:"number_of_instances_failed"`
+	FailedInstancesLogs         []string                          `tfschema:"failed_instances_logs"`
+	Errors                      []AppServiceDeploymentStatusError `tfschema:"error"`
+}
+
+type AppServiceDeploymentStatusError struct {
+	Code    string `tfschema:"code"`
+	Message string `tfschema:"message"`
+}
+
+var _ sdk.DataSource = AppServiceDeploymentStatusDataSource{}
+
+func (d AppServiceDeploymentStatusDataSource) ModelObject() interface{} {
+	return &AppServiceDeploymentStatusDataSourceModel{}
+}
+
+func (d AppServiceDeploymentStatusDataSource) ResourceType() string {
+	return "azurerm_app_service_deployment_status"
+}
+
+func (d AppServiceDeploymentStatusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.WebAppID,
+		},
+
+		"slot_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.WebAppName,
+		},
+
+		"deployment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d AppServiceDeploymentStatusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"number_of_instances_in_progress": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"number_of_instances_successful": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"number_of_instances_failed": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"failed_instances_logs": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"error": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"code": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d AppServiceDeploymentStatusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewDeploymentStatusClient(metadata.Client.AppService.WebAppsClient)
+
+			var deploymentStatus AppServiceDeploymentStatusDataSourceModel
+			if err := metadata.Decode(&deploymentStatus); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			appId, err := parse.WebAppID(deploymentStatus.AppServiceId)
+			if err != nil {
+				return err
+			}
+
+			description := appId.String()
+			if deploymentStatus.SlotName != "" {
+				description = parse.NewWebAppSlotID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, deploymentStatus.SlotName).String()
+			}
+
+			var status *azuresdkhacks.DeploymentStatus
+			if deploymentStatus.DeploymentId != "" {
+				resp, err := client.Get(ctx, appId.ResourceGroup, appId.SiteName, deploymentStatus.SlotName, deploymentStatus.DeploymentId)
+				if err != nil {
+					if utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("Deployment Status %q for %s was not found", deploymentStatus.DeploymentId, description)
+					}
+					return fmt.Errorf("retrieving Deployment Status %q for %s: %+v", deploymentStatus.DeploymentId, description, err)
+				}
+				status = &resp
+			} else {
+				statuses, err := client.ListComplete(ctx, appId.ResourceGroup, appId.SiteName, deploymentStatus.SlotName)
+				if err != nil {
+					return fmt.Errorf("listing Deployment Statuses for %s: %+v", description, err)
+				}
+				if len(statuses) == 0 {
+					return fmt.Errorf("no Deployment Statuses were found for %s", description)
+				}
+				status = mostRecentDeploymentStatus(statuses)
+			}
+
+			if status.ID == nil || *status.ID == "" {
+				return fmt.Errorf("retrieving Deployment Status for %s: `id` was nil", description)
+			}
+
+			if props := status.Properties; props != nil {
+				deploymentStatus.DeploymentId = utils.NormalizeNilableString(props.DeploymentID)
+				deploymentStatus.Status = utils.NormalizeNilableString(props.Status)
+
+				if props.NumberOfInstancesInProgress != nil {
+					deploymentStatus.NumberOfInstancesInProgress = int(*props.NumberOfInstancesInProgress)
+				}
+				if props.NumberOfInstancesSuccessful != nil {
+					deploymentStatus.NumberOfInstancesSuccessful = int(*props.NumberOfInstancesSuccessful)
+				}
+				if props.NumberOfInstancesFailed != nil {
+					deploymentStatus.NumberOfInstancesFailed = int(*props.NumberOfInstancesFailed)
+				}
+				if props.FailedInstancesLogs != nil {
+					deploymentStatus.FailedInstancesLogs = *props.FailedInstancesLogs
+				}
+				if props.Errors != nil {
+					for _, e := range *props.Errors {
+						deploymentStatus.Errors = append(deploymentStatus.Errors, AppServiceDeploymentStatusError{
+							Code:    utils.NormalizeNilableString(e.Code),
+							Message: utils.NormalizeNilableString(e.Message),
+						})
+					}
+				}
+			}
+
+			metadata.ResourceData.SetId(*status.ID)
+
+			return metadata.Encode(&deploymentStatus)
+		},
+	}
+}
+
+// mostRecentDeploymentStatus returns the Deployment Status which finished (or, when still in progress, started) most
+// recently - falling back to the order returned by the API when neither time is available.
+func mostRecentDeploymentStatus(input []azuresdkhacks.DeploymentStatus) *azuresdkhacks.DeploymentStatus {
+	var mostRecent *azuresdkhacks.DeploymentStatus
+	var mostRecentTime time.Time
+	for i := range input {
+		item := &input[i]
+
+		var itemTime time.Time
+		if props := item.Properties; props != nil {
+			if props.EndTime != nil {
+				itemTime = props.EndTime.ToTime()
+			} else if props.StartTime != nil {
+				itemTime = props.StartTime.ToTime()
+			}
+		}
+
+		if mostRecent == nil || itemTime.After(mostRecentTime) {
+			mostRecent = item
+			mostRecentTime = itemTime
+		}
+	}
+
+	return mostRecent
+}
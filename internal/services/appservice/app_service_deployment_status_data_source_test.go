@@ -0,0 +1,36 @@
+package appservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AppServiceDeploymentStatusDataSource struct{}
+
+func TestAccAppServiceDeploymentStatusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_app_service_deployment_status", "test")
+	d := AppServiceDeploymentStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deployment_id").Exists(),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+	})
+}
+
+func (AppServiceDeploymentStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_service_deployment_status" "test" {
+  app_service_id = azurerm_app_service_source_control.test.app_id
+}
+`, AppServiceSourceControlResource{}.windowsExternalGit(data))
+}
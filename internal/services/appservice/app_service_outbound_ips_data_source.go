@@ -0,0 +1,178 @@
+package appservice
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// appServiceOutboundIPsConcurrency is the number of Apps which are retrieved at once, this is limited to avoid being
+// throttled by the API when a large number of Apps are specified
+const appServiceOutboundIPsConcurrency = 5
+
+type AppServiceOutboundIPsDataSource struct{}
+
+type AppServiceOutboundIPsDataSourceModel struct {
+	AppIds                        []string `tfschema:"app_ids"`
+	OutboundIPAddresses           string   `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList         []string `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses   string   `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList []string `tfschema:"possible_outbound_ip_address_list"`
+}
+
+var _ sdk.DataSource = AppServiceOutboundIPsDataSource{}
+
+func (d AppServiceOutboundIPsDataSource) ModelObject() interface{} {
+	return &AppServiceOutboundIPsDataSourceModel{}
+}
+
+func (d AppServiceOutboundIPsDataSource) ResourceType() string {
+	return "azurerm_app_service_outbound_ips"
+}
+
+func (d AppServiceOutboundIPsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.WebAppID,
+			},
+		},
+	}
+}
+
+func (d AppServiceOutboundIPsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"possible_outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"possible_outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (d AppServiceOutboundIPsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var outboundIPs AppServiceOutboundIPsDataSourceModel
+			if err := metadata.Decode(&outboundIPs); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			ids := make([]*parse.WebAppId, 0, len(outboundIPs.AppIds))
+			for _, v := range outboundIPs.AppIds {
+				id, err := parse.WebAppID(v)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+
+			// each App is only written to by its own func, so no further synchronisation is needed
+			outbound := make([]string, len(ids))
+			possibleOutbound := make([]string, len(ids))
+			funcs := make([]func(ctx context.Context) error, 0, len(ids))
+			for i, id := range ids {
+				i, id := i, id
+				funcs = append(funcs, func(ctx context.Context) error {
+					resp, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						if utils.ResponseWasNotFound(resp.Response) {
+							return fmt.Errorf("%s was not found", id)
+						}
+						return fmt.Errorf("retrieving %s: %+v", id, err)
+					}
+					if props := resp.SiteProperties; props != nil {
+						outbound[i] = utils.NormalizeNilableString(props.OutboundIPAddresses)
+						possibleOutbound[i] = utils.NormalizeNilableString(props.PossibleOutboundIPAddresses)
+					}
+					return nil
+				})
+			}
+
+			if err := utils.RunConcurrently(ctx, appServiceOutboundIPsConcurrency, funcs...); err != nil {
+				return err
+			}
+
+			outboundIPs.OutboundIPAddressList = mergeIPAddresses(outbound)
+			outboundIPs.OutboundIPAddresses = strings.Join(outboundIPs.OutboundIPAddressList, ",")
+			outboundIPs.PossibleOutboundIPAddressList = mergeIPAddresses(possibleOutbound)
+			outboundIPs.PossibleOutboundIPAddresses = strings.Join(outboundIPs.PossibleOutboundIPAddressList, ",")
+
+			metadata.ResourceData.SetId(appServiceOutboundIPsID(ids))
+
+			return metadata.Encode(&outboundIPs)
+		},
+	}
+}
+
+// mergeIPAddresses combines the comma separated lists of IP Addresses returned for each App into a single sorted list
+// without any duplicates, since Apps on the same Service Plan or App Service Environment share their outbound IPs.
+func mergeIPAddresses(input []string) []string {
+	seen := make(map[string]struct{})
+	result := make([]string, 0)
+	for _, addresses := range input {
+		for _, v := range strings.Split(addresses, ",") {
+			v = strings.TrimSpace(v)
+			if v == "" {
+				continue
+			}
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// appServiceOutboundIPsID returns an ID which is stable for the same set of Apps regardless of the order they're specified in
+func appServiceOutboundIPsID(ids []*parse.WebAppId) string {
+	input := make([]string, 0, len(ids))
+	for _, id := range ids {
+		input = append(input, strings.ToLower(id.ID()))
+	}
+	sort.Strings(input)
+
+	hash := sha1.Sum([]byte(strings.Join(input, ",")))
+	return fmt.Sprintf("appServiceOutboundIPs/%s", hex.EncodeToString(hash[:]))
+}
@@ -0,0 +1,52 @@
+package appservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AppServiceOutboundIPsDataSource struct{}
+
+func TestAccAppServiceOutboundIPsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_app_service_outbound_ips", "test")
+	d := AppServiceOutboundIPsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("outbound_ip_addresses").Exists(),
+				check.That(data.ResourceName).Key("possible_outbound_ip_addresses").Exists(),
+				// both Apps share a Service Plan and so have the same outbound IPs, which should be de-duplicated
+				check.That(data.ResourceName).Key("possible_outbound_ip_address_list.#").MatchesOtherKey(
+					check.That("azurerm_linux_web_app.test").Key("possible_outbound_ip_address_list.#"),
+				),
+			),
+		},
+	})
+}
+
+func (AppServiceOutboundIPsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_web_app" "second" {
+  name                = "acctestWA2-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+data "azurerm_app_service_outbound_ips" "test" {
+  app_ids = [
+    azurerm_linux_web_app.test.id,
+    azurerm_linux_web_app.second.id,
+  ]
+}
+`, LinuxWebAppResource{}.basic(data), data.RandomInteger)
+}
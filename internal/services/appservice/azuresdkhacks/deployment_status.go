@@ -0,0 +1,194 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// NOTE: this workaround client exists since the `deploymentStatus` API is only available from API Version
+// `2022-03-01` of the Web API, whereas the App Service resources are built against API Version `2021-02-01`.
+// Once the App Service resources have been migrated to a newer API Version this can be removed.
+
+const deploymentStatusAPIVersion = "2022-03-01"
+
+type DeploymentStatusClient struct {
+	sdkClient *web.AppsClient
+}
+
+func NewDeploymentStatusClient(client *web.AppsClient) DeploymentStatusClient {
+	return DeploymentStatusClient{
+		sdkClient: client,
+	}
+}
+
+type DeploymentStatusCollection struct {
+	autorest.Response `json:"-"`
+	Value             *[]DeploymentStatus `json:"value,omitempty"`
+	NextLink          *string             `json:"nextLink,omitempty"`
+}
+
+type DeploymentStatus struct {
+	autorest.Response `json:"-"`
+	ID                *string                     `json:"id,omitempty"`
+	Name              *string                     `json:"name,omitempty"`
+	Properties        *DeploymentStatusProperties `json:"properties,omitempty"`
+}
+
+type DeploymentStatusProperties struct {
+	DeploymentID                *string                  `json:"deploymentId,omitempty"`
+	Status                      *string                  `json:"status,omitempty"`
+	NumberOfInstancesInProgress *int32                   `json:"numberOfInstancesInProgress,omitempty"`
+	NumberOfInstancesSuccessful *int32                   `json:"numberOfInstancesSuccessful,omitempty"`
+	NumberOfInstancesFailed     *int32                   `json:"numberOfInstancesFailed,omitempty"`
+	FailedInstancesLogs         *[]string                `json:"failedInstancesLogs,omitempty"`
+	Errors                      *[]DeploymentStatusError `json:"errors,omitempty"`
+	StartTime                   *date.Time               `json:"startTime,omitempty"`
+	EndTime                     *date.Time               `json:"endTime,omitempty"`
+}
+
+type DeploymentStatusError struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// List returns the Deployment Statuses for the App - or for the specified Slot when `slotName` is not empty.
+func (c DeploymentStatusClient) List(ctx context.Context, resourceGroupName string, name string, slotName string) (result DeploymentStatusCollection, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, name, slotName, "")
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "ListDeploymentStatus", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "ListDeploymentStatus", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "ListDeploymentStatus", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// ListComplete returns all of the Deployment Statuses for the App - or for the specified Slot when `slotName` is not
+// empty - following the `nextLink` of each page.
+func (c DeploymentStatusClient) ListComplete(ctx context.Context, resourceGroupName string, name string, slotName string) ([]DeploymentStatus, error) {
+	output := make([]DeploymentStatus, 0)
+
+	page, err := c.List(ctx, resourceGroupName, name, slotName)
+	for {
+		if err != nil {
+			return nil, err
+		}
+		if page.Value != nil {
+			output = append(output, *page.Value...)
+		}
+		if page.NextLink == nil || *page.NextLink == "" {
+			break
+		}
+		page, err = c.listNextResults(ctx, *page.NextLink)
+	}
+
+	return output, nil
+}
+
+func (c DeploymentStatusClient) listNextResults(ctx context.Context, nextLink string) (result DeploymentStatusCollection, err error) {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(nextLink))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "listDeploymentStatusNextResults", nil, "Failure preparing next results request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "listDeploymentStatusNextResults", resp, "Failure sending next results request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "listDeploymentStatusNextResults", resp, "Failure responding to next results request")
+	}
+
+	return
+}
+
+// Get returns the specified Deployment Status for the App - or for the specified Slot when `slotName` is not empty.
+func (c DeploymentStatusClient) Get(ctx context.Context, resourceGroupName string, name string, slotName string, deploymentId string) (result DeploymentStatus, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, name, slotName, deploymentId)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "GetDeploymentStatus", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "GetDeploymentStatus", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "GetDeploymentStatus", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c DeploymentStatusClient) preparer(ctx context.Context, resourceGroupName string, name string, slotName string, deploymentId string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"name":              autorest.Encode("path", name),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	path := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/sites/{name}"
+	if slotName != "" {
+		pathParameters["slot"] = autorest.Encode("path", slotName)
+		path += "/slots/{slot}"
+	}
+	path += "/deploymentStatus"
+	if deploymentId != "" {
+		pathParameters["deploymentStatusId"] = autorest.Encode("path", deploymentId)
+		path += "/{deploymentStatusId}"
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": deploymentStatusAPIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,123 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the `ipSecurityRestrictionsDefaultAction` and
+// `scmIpSecurityRestrictionsDefaultAction` Site Config properties are only available from API Version `2022-09-01`
+// of the Web API, whereas the App Service resources are built against API Version `2021-02-01`.
+// Once the App Service resources have been migrated to a newer API Version this can be removed.
+
+const siteConfigDefaultActionsAPIVersion = "2022-09-01"
+
+type SiteConfigDefaultActionsClient struct {
+	sdkClient *web.AppsClient
+}
+
+func NewSiteConfigDefaultActionsClient(client *web.AppsClient) SiteConfigDefaultActionsClient {
+	return SiteConfigDefaultActionsClient{
+		sdkClient: client,
+	}
+}
+
+type SiteConfigDefaultActionsResource struct {
+	autorest.Response `json:"-"`
+	Properties        *SiteConfigDefaultActions `json:"properties,omitempty"`
+}
+
+type SiteConfigDefaultActions struct {
+	IPSecurityRestrictionsDefaultAction    *string `json:"ipSecurityRestrictionsDefaultAction,omitempty"`
+	ScmIPSecurityRestrictionsDefaultAction *string `json:"scmIpSecurityRestrictionsDefaultAction,omitempty"`
+}
+
+// Get returns the IP Restriction Default Actions for the App - or for the specified Slot when `slotName` is not empty.
+func (c SiteConfigDefaultActionsClient) Get(ctx context.Context, resourceGroupName string, name string, slotName string) (result SiteConfigDefaultActionsResource, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, name, slotName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "GetConfiguration", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "GetConfiguration", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "GetConfiguration", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+// Update sets the IP Restriction Default Actions for the App - or for the specified Slot when `slotName` is not empty -
+// leaving the remainder of the Site Config untouched.
+func (c SiteConfigDefaultActionsClient) Update(ctx context.Context, resourceGroupName string, name string, slotName string, input SiteConfigDefaultActions) (result SiteConfigDefaultActionsResource, err error) {
+	payload := SiteConfigDefaultActionsResource{
+		Properties: &input,
+	}
+	req, err := c.preparer(ctx, resourceGroupName, name, slotName, autorest.AsPatch(), autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(payload))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "UpdateConfiguration", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "UpdateConfiguration", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "web.AppsClient", "UpdateConfiguration", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c SiteConfigDefaultActionsClient) preparer(ctx context.Context, resourceGroupName string, name string, slotName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"name":              autorest.Encode("path", name),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	path := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Web/sites/{name}"
+	if slotName != "" {
+		pathParameters["slot"] = autorest.Encode("path", slotName)
+		path += "/slots/{slot}"
+	}
+	path += "/config/web"
+
+	queryParameters := map[string]interface{}{
+		"api-version": siteConfigDefaultActionsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
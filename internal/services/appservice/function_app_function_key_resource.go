@@ -0,0 +1,289 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FunctionAppFunctionKeyResource struct{}
+
+type FunctionAppFunctionKeyModel struct {
+	Name       string `tfschema:"name"`
+	FunctionId string `tfschema:"function_id"`
+	Value      string `tfschema:"value"`
+}
+
+var _ sdk.ResourceWithUpdate = FunctionAppFunctionKeyResource{}
+
+func (r FunctionAppFunctionKeyResource) ModelObject() interface{} {
+	return &FunctionAppFunctionKeyModel{}
+}
+
+func (r FunctionAppFunctionKeyResource) ResourceType() string {
+	return "azurerm_function_app_function_key"
+}
+
+func (r FunctionAppFunctionKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(validate.FunctionAppFunctionKeyID, validate.FunctionAppSlotFunctionKeyID)
+}
+
+func (r FunctionAppFunctionKeyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FunctionAppKeyName,
+			Description:  "The name of the Function Key.",
+		},
+
+		"function_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(validate.FunctionAppFunctionID, validate.FunctionAppSlotFunctionID),
+			Description:  "The ID of the Function App Function for this Function Key.",
+		},
+
+		"value": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The value of the Function Key. If not specified a value will be generated.",
+		},
+	}
+}
+
+func (r FunctionAppFunctionKeyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FunctionAppFunctionKeyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var functionKey FunctionAppFunctionKeyModel
+			if err := metadata.Decode(&functionKey); err != nil {
+				return err
+			}
+
+			functionId, err := parseFunctionAppFunctionID(functionKey.FunctionId)
+			if err != nil {
+				return err
+			}
+
+			id := functionAppFunctionKeyId{
+				FunctionAppFunctionKeyId: parse.NewFunctionAppFunctionKeyID(functionId.SubscriptionId, functionId.ResourceGroup, functionId.SiteName, functionId.FunctionName, functionKey.Name),
+				SlotName:                 functionId.SlotName,
+			}
+
+			locks.ByID(functionId.functionAppId())
+			defer locks.UnlockByID(functionId.functionAppId())
+
+			existing, err := id.list(ctx, client)
+			if err != nil {
+				return fmt.Errorf("listing Function Keys for %s: %+v", functionId, err)
+			}
+			if _, ok := existing.Properties[id.KeyName]; ok {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			key := web.KeyInfo{
+				Name: utils.String(id.KeyName),
+			}
+			if functionKey.Value != "" {
+				key.Value = utils.String(functionKey.Value)
+			}
+
+			if err := id.createOrUpdate(ctx, client, key); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFunctionKeyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parseFunctionAppFunctionKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := id.list(ctx, client)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing Function Keys for %s: %+v", id.functionId(), err)
+			}
+
+			value, ok := existing.Properties[id.KeyName]
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+
+			functionKey := FunctionAppFunctionKeyModel{
+				Name:       id.KeyName,
+				FunctionId: id.functionId().ID(),
+				Value:      utils.NormalizeNilableString(value),
+			}
+
+			return metadata.Encode(&functionKey)
+		},
+	}
+}
+
+func (r FunctionAppFunctionKeyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parseFunctionAppFunctionKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var functionKey FunctionAppFunctionKeyModel
+			if err := metadata.Decode(&functionKey); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("value") {
+				locks.ByID(id.functionId().functionAppId())
+				defer locks.UnlockByID(id.functionId().functionAppId())
+
+				key := web.KeyInfo{
+					Name:  utils.String(id.KeyName),
+					Value: utils.String(functionKey.Value),
+				}
+				if err := id.createOrUpdate(ctx, client, key); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFunctionKeyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parseFunctionAppFunctionKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.functionId().functionAppId())
+			defer locks.UnlockByID(id.functionId().functionAppId())
+
+			metadata.Logger.Infof("deleting %s", *id)
+
+			if resp, err := id.delete(ctx, client); err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// functionAppFunctionKeyId identifies a Key for a Function within either a Function App or, when SlotName is set, a
+// Function App Slot - since the Web Apps API exposes separate operations for each.
+type functionAppFunctionKeyId struct {
+	parse.FunctionAppFunctionKeyId
+	SlotName string
+}
+
+func parseFunctionAppFunctionKeyID(input string) (*functionAppFunctionKeyId, error) {
+	if slotId, err := parse.FunctionAppSlotFunctionKeyID(input); err == nil {
+		return &functionAppFunctionKeyId{
+			FunctionAppFunctionKeyId: parse.NewFunctionAppFunctionKeyID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName, slotId.FunctionName, slotId.KeyName),
+			SlotName:                 slotId.SlotName,
+		}, nil
+	}
+
+	id, err := parse.FunctionAppFunctionKeyID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &functionAppFunctionKeyId{
+		FunctionAppFunctionKeyId: *id,
+	}, nil
+}
+
+func (id functionAppFunctionKeyId) ID() string {
+	if id.SlotName != "" {
+		return id.slotId().ID()
+	}
+	return id.FunctionAppFunctionKeyId.ID()
+}
+
+func (id functionAppFunctionKeyId) String() string {
+	if id.SlotName != "" {
+		return id.slotId().String()
+	}
+	return id.FunctionAppFunctionKeyId.String()
+}
+
+func (id functionAppFunctionKeyId) slotId() parse.FunctionAppSlotFunctionKeyId {
+	return parse.NewFunctionAppSlotFunctionKeyID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.FunctionName, id.KeyName)
+}
+
+func (id functionAppFunctionKeyId) functionId() functionAppFunctionId {
+	return functionAppFunctionId{
+		FunctionAppFunctionId: parse.NewFunctionAppFunctionID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.FunctionName),
+		SlotName:              id.SlotName,
+	}
+}
+
+func (id functionAppFunctionKeyId) list(ctx context.Context, client *web.AppsClient) (web.StringDictionary, error) {
+	if id.SlotName != "" {
+		return client.ListFunctionKeysSlot(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.SlotName)
+	}
+	return client.ListFunctionKeys(ctx, id.ResourceGroup, id.SiteName, id.FunctionName)
+}
+
+func (id functionAppFunctionKeyId) createOrUpdate(ctx context.Context, client *web.AppsClient, key web.KeyInfo) error {
+	var err error
+	if id.SlotName != "" {
+		_, err = client.CreateOrUpdateFunctionSecretSlot(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.KeyName, id.SlotName, key)
+	} else {
+		_, err = client.CreateOrUpdateFunctionSecret(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.KeyName, key)
+	}
+	return err
+}
+
+func (id functionAppFunctionKeyId) delete(ctx context.Context, client *web.AppsClient) (autorest.Response, error) {
+	if id.SlotName != "" {
+		return client.DeleteFunctionSecretSlot(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.KeyName, id.SlotName)
+	}
+	return client.DeleteFunctionSecret(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.KeyName)
+}
@@ -0,0 +1,134 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FunctionAppFunctionKeyResource struct{}
+
+func TestAccFunctionAppFunctionKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function_key", "test")
+	r := FunctionAppFunctionKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppFunctionKey_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function_key", "test")
+	r := FunctionAppFunctionKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withValue(data, "acctestvalue1acctestvalue1acctestvalue1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withValue(data, "acctestvalue2acctestvalue2acctestvalue2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppFunctionKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function_key", "test")
+	r := FunctionAppFunctionKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r FunctionAppFunctionKeyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if slotId, err := parse.FunctionAppSlotFunctionKeyID(state.ID); err == nil {
+		resp, err := client.AppService.WebAppsClient.ListFunctionKeysSlot(ctx, slotId.ResourceGroup, slotId.SiteName, slotId.FunctionName, slotId.SlotName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("listing Function Keys for %s: %+v", *slotId, err)
+		}
+
+		_, ok := resp.Properties[slotId.KeyName]
+		return utils.Bool(ok), nil
+	}
+
+	id, err := parse.FunctionAppFunctionKeyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.ListFunctionKeys(ctx, id.ResourceGroup, id.SiteName, id.FunctionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing Function Keys for %s: %+v", *id, err)
+	}
+
+	_, ok := resp.Properties[id.KeyName]
+	return utils.Bool(ok), nil
+}
+
+func (r FunctionAppFunctionKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_function_key" "test" {
+  name        = "acctest-key-%d"
+  function_id = azurerm_function_app_function.test.id
+}
+`, FunctionAppFunctionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r FunctionAppFunctionKeyResource) withValue(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_function_key" "test" {
+  name        = "acctest-key-%d"
+  function_id = azurerm_function_app_function.test.id
+  value       = "%s"
+}
+`, FunctionAppFunctionResource{}.basic(data), data.RandomInteger, value)
+}
+
+func (r FunctionAppFunctionKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_function_key" "import" {
+  name        = azurerm_function_app_function_key.test.name
+  function_id = azurerm_function_app_function_key.test.function_id
+}
+`, r.basic(data))
+}
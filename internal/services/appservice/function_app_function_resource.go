@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
@@ -52,7 +53,7 @@ func (r FunctionAppFunctionResource) ResourceType() string {
 }
 
 func (r FunctionAppFunctionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
-	return validate.FunctionAppFunctionID
+	return validation.Any(validate.FunctionAppFunctionID, validate.FunctionAppSlotFunctionID)
 }
 
 func (r FunctionAppFunctionResource) Arguments() map[string]*pluginsdk.Schema {
@@ -68,9 +69,9 @@ func (r FunctionAppFunctionResource) Arguments() map[string]*pluginsdk.Schema {
 		"function_app_id": {
 			Type:         pluginsdk.TypeString,
 			Required:     true,
-			ValidateFunc: validate.FunctionAppID,
+			ValidateFunc: validation.Any(validate.FunctionAppID, validate.FunctionAppSlotID),
 			ForceNew:     true,
-			Description:  "The ID of the Function App in which this function should reside.",
+			Description:  "The ID of the Function App or Function App Slot in which this function should reside.",
 		},
 
 		"config_json": {
@@ -193,14 +194,23 @@ func (r FunctionAppFunctionResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
-			appId, err := parse.FunctionAppID(appFunction.AppID)
-			if err != nil {
-				return err
+			var id functionAppFunctionId
+			if slotId, err := parse.FunctionAppSlotID(appFunction.AppID); err == nil {
+				id = functionAppFunctionId{
+					FunctionAppFunctionId: parse.NewFunctionAppFunctionID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName, appFunction.Name),
+					SlotName:              slotId.SlotName,
+				}
+			} else {
+				appId, err := parse.FunctionAppID(appFunction.AppID)
+				if err != nil {
+					return err
+				}
+				id = functionAppFunctionId{
+					FunctionAppFunctionId: parse.NewFunctionAppFunctionID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, appFunction.Name),
+				}
 			}
 
-			id := parse.NewFunctionAppFunctionID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, appFunction.Name)
-
-			existing, err := client.GetFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName)
+			existing, err := id.get(ctx, client)
 			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
 				if !utils.ResponseWasBadRequest(existing.Response) {
 					return fmt.Errorf("checking for presence of %s: %+v", id, err)
@@ -236,7 +246,7 @@ func (r FunctionAppFunctionResource) Create() sdk.ResourceFunc {
 				Pending: []string{"busy", "unknown"},
 				Target:  []string{"ready"},
 				Refresh: func() (result interface{}, state string, err error) {
-					function, err := client.Get(ctx, appId.ResourceGroup, appId.SiteName)
+					function, err := id.getApp(ctx, client)
 					if err != nil || function.SiteConfig == nil {
 						return "unknown", "unknown", err
 					}
@@ -251,23 +261,18 @@ func (r FunctionAppFunctionResource) Create() sdk.ResourceFunc {
 			}
 
 			if _, err = createWait.WaitForStateContext(ctx); err != nil {
-				return fmt.Errorf("waiting for %s to be ready", *appId)
+				return fmt.Errorf("waiting for %s to be ready", id.functionAppId())
 			}
 
-			locks.ByID(appId.ID())
-			defer locks.UnlockByID(appId.ID())
+			locks.ByID(id.functionAppId())
+			defer locks.UnlockByID(id.functionAppId())
 
-			future, err := client.CreateFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, fnEnvelope)
-			if err != nil {
-				fn, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
-				if err != nil {
-					return fmt.Errorf("reading parent %s: %+v", appId, err)
+			if err := id.createOrUpdate(ctx, client, fnEnvelope); err != nil {
+				fn, getErr := id.getApp(ctx, client)
+				if getErr != nil || fn.SiteProperties == nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
 				}
-				return fmt.Errorf("creating %s - State: %#v / InProgressOperationID: %#v", id, *fn.SiteProperties.State, fn.SiteProperties.InProgressOperationID)
-			}
-
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for %s: %+v", id, err)
+				return fmt.Errorf("creating %s - State: %#v / InProgressOperationID: %#v: %+v", id, utils.NormalizeNilableString(fn.SiteProperties.State), fn.SiteProperties.InProgressOperationID, err)
 			}
 
 			metadata.SetID(id)
@@ -282,12 +287,12 @@ func (r FunctionAppFunctionResource) Read() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.WebAppsClient
 
-			id, err := parse.FunctionAppFunctionID(metadata.ResourceData.Id())
+			id, err := parseFunctionAppFunctionID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			existing, err := client.GetFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName)
+			existing, err := id.get(ctx, client)
 			if err != nil || existing.FunctionEnvelopeProperties == nil {
 				if utils.ResponseWasNotFound(existing.Response) {
 					return metadata.MarkAsGone(id)
@@ -298,7 +303,7 @@ func (r FunctionAppFunctionResource) Read() sdk.ResourceFunc {
 
 			appFunc := FunctionAppFunctionModel{
 				Name:              id.FunctionName,
-				AppID:             parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID(),
+				AppID:             id.functionAppId(),
 				ConfigURL:         utils.NormalizeNilableString(existing.ConfigHref),
 				Enabled:           !utils.NormaliseNilableBool(existing.IsDisabled),
 				FunctionURL:       utils.NormalizeNilableString(existing.Href),
@@ -343,7 +348,7 @@ func (r FunctionAppFunctionResource) Delete() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.WebAppsClient
 
-			id, err := parse.FunctionAppFunctionID(metadata.ResourceData.Id())
+			id, err := parseFunctionAppFunctionID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
@@ -359,7 +364,7 @@ func (r FunctionAppFunctionResource) Delete() sdk.ResourceFunc {
 				Pending: []string{"busy", "unknown"},
 				Target:  []string{"ready"},
 				Refresh: func() (result interface{}, state string, err error) {
-					function, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+					function, err := id.getApp(ctx, client)
 					if err != nil || function.SiteConfig == nil {
 						return "unknown", "unknown", err
 					}
@@ -377,11 +382,10 @@ func (r FunctionAppFunctionResource) Delete() sdk.ResourceFunc {
 				return fmt.Errorf("waiting for %s to be settled", *id)
 			}
 
-			fnID := parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.FunctionName).ID()
-			locks.ByID(fnID)
-			defer locks.UnlockByID(fnID)
+			locks.ByID(id.functionAppId())
+			defer locks.UnlockByID(id.functionAppId())
 
-			if _, err = client.DeleteFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName); err != nil {
+			if _, err = id.delete(ctx, client); err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
 
@@ -396,7 +400,7 @@ func (r FunctionAppFunctionResource) Update() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.WebAppsClient
 
-			id, err := parse.FunctionAppFunctionID(metadata.ResourceData.Id())
+			id, err := parseFunctionAppFunctionID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
@@ -406,7 +410,7 @@ func (r FunctionAppFunctionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			existing, err := client.GetFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName)
+			existing, err := id.get(ctx, client)
 			if err != nil || existing.FunctionEnvelopeProperties == nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
@@ -437,7 +441,7 @@ func (r FunctionAppFunctionResource) Update() sdk.ResourceFunc {
 				Pending: []string{"busy", "unknown"},
 				Target:  []string{"ready"},
 				Refresh: func() (result interface{}, state string, err error) {
-					function, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+					function, err := id.getApp(ctx, client)
 					if err != nil || function.SiteConfig == nil {
 						return "unknown", "unknown", err
 					}
@@ -455,17 +459,11 @@ func (r FunctionAppFunctionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("waiting for %s to be ready", *id)
 			}
 
-			fnID := parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.FunctionName).ID()
-			locks.ByID(fnID)
-			defer locks.UnlockByID(fnID)
+			locks.ByID(id.functionAppId())
+			defer locks.UnlockByID(id.functionAppId())
 
-			future, err := client.CreateFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, existing)
-			if err != nil {
-				return fmt.Errorf("creating %s: %+v", id, err)
-			}
-
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for %s: %+v", id, err)
+			if err := id.createOrUpdate(ctx, client, existing); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
 			return nil
@@ -497,3 +495,90 @@ func flattenFunctionFiles(input interface{}) (*string, error) {
 	result := string(raw)
 	return &result, nil
 }
+
+// functionAppFunctionId identifies a Function within either a Function App or, when SlotName is set, a Function App
+// Slot - since the Web Apps API exposes separate operations for each.
+type functionAppFunctionId struct {
+	parse.FunctionAppFunctionId
+	SlotName string
+}
+
+func parseFunctionAppFunctionID(input string) (*functionAppFunctionId, error) {
+	if slotId, err := parse.FunctionAppSlotFunctionID(input); err == nil {
+		return &functionAppFunctionId{
+			FunctionAppFunctionId: parse.NewFunctionAppFunctionID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName, slotId.FunctionName),
+			SlotName:              slotId.SlotName,
+		}, nil
+	}
+
+	id, err := parse.FunctionAppFunctionID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &functionAppFunctionId{
+		FunctionAppFunctionId: *id,
+	}, nil
+}
+
+func (id functionAppFunctionId) ID() string {
+	if id.SlotName != "" {
+		return id.slotId().ID()
+	}
+	return id.FunctionAppFunctionId.ID()
+}
+
+func (id functionAppFunctionId) String() string {
+	if id.SlotName != "" {
+		return id.slotId().String()
+	}
+	return id.FunctionAppFunctionId.String()
+}
+
+func (id functionAppFunctionId) slotId() parse.FunctionAppSlotFunctionId {
+	return parse.NewFunctionAppSlotFunctionID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.FunctionName)
+}
+
+func (id functionAppFunctionId) functionAppId() string {
+	if id.SlotName != "" {
+		return parse.NewFunctionAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName).ID()
+	}
+	return parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID()
+}
+
+func (id functionAppFunctionId) getApp(ctx context.Context, client *web.AppsClient) (web.Site, error) {
+	if id.SlotName != "" {
+		return client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	}
+	return client.Get(ctx, id.ResourceGroup, id.SiteName)
+}
+
+func (id functionAppFunctionId) get(ctx context.Context, client *web.AppsClient) (web.FunctionEnvelope, error) {
+	if id.SlotName != "" {
+		return client.GetInstanceFunctionSlot(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.SlotName)
+	}
+	return client.GetFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName)
+}
+
+func (id functionAppFunctionId) createOrUpdate(ctx context.Context, client *web.AppsClient, envelope web.FunctionEnvelope) error {
+	if id.SlotName != "" {
+		future, err := client.CreateInstanceFunctionSlot(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.SlotName, envelope)
+		if err != nil {
+			return err
+		}
+		return future.WaitForCompletionRef(ctx, client.Client)
+	}
+
+	future, err := client.CreateFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, envelope)
+	if err != nil {
+		return err
+	}
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
+
+func (id functionAppFunctionId) delete(ctx context.Context, client *web.AppsClient) (autorest.Response, error) {
+	if id.SlotName != "" {
+		return client.DeleteInstanceFunctionSlot(ctx, id.ResourceGroup, id.SiteName, id.FunctionName, id.SlotName)
+	}
+	return client.DeleteFunction(ctx, id.ResourceGroup, id.SiteName, id.FunctionName)
+}
@@ -82,7 +82,34 @@ func TestAccFunctionAppFunction_withFiles(t *testing.T) {
 	})
 }
 
+func TestAccFunctionAppFunction_slot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function", "test")
+	r := FunctionAppFunctionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.slot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("language"),
+	})
+}
+
 func (r FunctionAppFunctionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if slotId, err := parse.FunctionAppSlotFunctionID(state.ID); err == nil {
+		resp, err := client.AppService.WebAppsClient.GetInstanceFunctionSlot(ctx, slotId.ResourceGroup, slotId.SiteName, slotId.FunctionName, slotId.SlotName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *slotId, err)
+		}
+
+		return utils.Bool(true), nil
+	}
+
 	id, err := parse.FunctionAppFunctionID(state.ID)
 	if err != nil {
 		return nil, err
@@ -221,6 +248,57 @@ resource "azurerm_function_app_function" "test" {
 `, r.templateWindows(data), data.RandomInteger)
 }
 
+func (r FunctionAppFunctionResource) slot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_function_app_slot" "test" {
+  name                       = "acctest-LFAS-%[2]d"
+  function_app_id            = azurerm_linux_function_app.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {
+    application_stack {
+      python_version = "3.9"
+    }
+  }
+}
+
+resource "azurerm_function_app_function" "test" {
+  name            = "testAcc-FnAppFn-%[2]d"
+  function_app_id = azurerm_linux_function_app_slot.test.id
+  language        = "Python"
+  test_data = jsonencode({
+    "name" = "Azure"
+  })
+  config_json = jsonencode({
+    "bindings" = [
+      {
+        "authLevel" = "function"
+        "direction" = "in"
+        "methods" = [
+          "get",
+          "post",
+        ]
+        "name" = "req"
+        "type" = "httpTrigger"
+      },
+      {
+        "direction" = "out"
+        "name"      = "$return"
+        "type"      = "http"
+      },
+    ]
+  })
+}
+`, r.templateLinux(data), data.RandomInteger)
+}
+
 func (r FunctionAppFunctionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
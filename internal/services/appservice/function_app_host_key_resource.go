@@ -0,0 +1,391 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	functionAppHostKeyTypeFunctionKeys = "functionKeys"
+	functionAppHostKeyTypeSystemKeys   = "systemKeys"
+)
+
+type FunctionAppHostKeyResource struct{}
+
+type FunctionAppHostKeyModel struct {
+	Name          string `tfschema:"name"`
+	FunctionAppId string `tfschema:"function_app_id"`
+	Type          string `tfschema:"type"`
+	Value         string `tfschema:"value"`
+}
+
+var _ sdk.ResourceWithUpdate = FunctionAppHostKeyResource{}
+
+func (r FunctionAppHostKeyResource) ModelObject() interface{} {
+	return &FunctionAppHostKeyModel{}
+}
+
+func (r FunctionAppHostKeyResource) ResourceType() string {
+	return "azurerm_function_app_host_key"
+}
+
+func (r FunctionAppHostKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		if _, err := parseFunctionAppHostKeyID(v); err != nil {
+			errors = append(errors, err)
+		}
+
+		return
+	}
+}
+
+func (r FunctionAppHostKeyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FunctionAppKeyName,
+			Description:  "The name of the Host Key.",
+		},
+
+		"function_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(validate.FunctionAppID, validate.FunctionAppSlotID),
+			Description:  "The ID of the Function App or Function App Slot for this Host Key.",
+		},
+
+		"type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  functionAppHostKeyTypeFunctionKeys,
+			ValidateFunc: validation.StringInSlice([]string{
+				functionAppHostKeyTypeFunctionKeys,
+				functionAppHostKeyTypeSystemKeys,
+			}, false),
+			Description: "The type of Host Key, either `functionKeys` or `systemKeys`. Defaults to `functionKeys`.",
+		},
+
+		"value": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The value of the Host Key. If not specified a value will be generated.",
+		},
+	}
+}
+
+func (r FunctionAppHostKeyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r FunctionAppHostKeyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var hostKey FunctionAppHostKeyModel
+			if err := metadata.Decode(&hostKey); err != nil {
+				return err
+			}
+
+			var id functionAppHostKeyId
+			if slotId, err := parse.FunctionAppSlotID(hostKey.FunctionAppId); err == nil {
+				id = functionAppHostKeyId{
+					SubscriptionId: slotId.SubscriptionId,
+					ResourceGroup:  slotId.ResourceGroup,
+					SiteName:       slotId.SiteName,
+					SlotName:       slotId.SlotName,
+					KeyType:        hostKey.Type,
+					KeyName:        hostKey.Name,
+				}
+			} else {
+				appId, err := parse.FunctionAppID(hostKey.FunctionAppId)
+				if err != nil {
+					return err
+				}
+				id = functionAppHostKeyId{
+					SubscriptionId: appId.SubscriptionId,
+					ResourceGroup:  appId.ResourceGroup,
+					SiteName:       appId.SiteName,
+					KeyType:        hostKey.Type,
+					KeyName:        hostKey.Name,
+				}
+			}
+
+			locks.ByID(id.functionAppId())
+			defer locks.UnlockByID(id.functionAppId())
+
+			existing, err := id.list(ctx, client)
+			if err != nil {
+				return fmt.Errorf("listing Host Keys for %s: %+v", id.functionAppId(), err)
+			}
+			if _, ok := id.keysOfType(existing)[id.KeyName]; ok {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			key := web.KeyInfo{
+				Name: utils.String(id.KeyName),
+			}
+			if hostKey.Value != "" {
+				key.Value = utils.String(hostKey.Value)
+			}
+
+			if err := id.createOrUpdate(ctx, client, key); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppHostKeyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parseFunctionAppHostKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := id.list(ctx, client)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing Host Keys for %s: %+v", id.functionAppId(), err)
+			}
+
+			value, ok := id.keysOfType(existing)[id.KeyName]
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+
+			hostKey := FunctionAppHostKeyModel{
+				Name:          id.KeyName,
+				FunctionAppId: id.functionAppId(),
+				Type:          id.KeyType,
+				Value:         utils.NormalizeNilableString(value),
+			}
+
+			return metadata.Encode(&hostKey)
+		},
+	}
+}
+
+func (r FunctionAppHostKeyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parseFunctionAppHostKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var hostKey FunctionAppHostKeyModel
+			if err := metadata.Decode(&hostKey); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("value") {
+				locks.ByID(id.functionAppId())
+				defer locks.UnlockByID(id.functionAppId())
+
+				key := web.KeyInfo{
+					Name:  utils.String(id.KeyName),
+					Value: utils.String(hostKey.Value),
+				}
+				if err := id.createOrUpdate(ctx, client, key); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppHostKeyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parseFunctionAppHostKeyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.functionAppId())
+			defer locks.UnlockByID(id.functionAppId())
+
+			metadata.Logger.Infof("deleting %s", *id)
+
+			if resp, err := id.delete(ctx, client); err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// functionAppHostKeyId identifies a Host Key within either a Function App or, when SlotName is set, a Function App
+// Slot. The Key Type forms part of the path, so this is parsed here rather than by a generated parser.
+type functionAppHostKeyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	SlotName       string
+	KeyType        string
+	KeyName        string
+}
+
+func parseFunctionAppHostKeyID(input string) (*functionAppHostKeyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := functionAppHostKeyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if _, ok := id.Path["slots"]; ok {
+		if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+			return nil, err
+		}
+	}
+
+	host, err := id.PopSegment("host")
+	if err != nil {
+		return nil, err
+	}
+	if host != "default" {
+		return nil, fmt.Errorf("expected the 'host' element to be 'default' but got %q", host)
+	}
+
+	for _, keyType := range []string{functionAppHostKeyTypeFunctionKeys, functionAppHostKeyTypeSystemKeys} {
+		if _, ok := id.Path[keyType]; ok {
+			resourceId.KeyType = keyType
+			if resourceId.KeyName, err = id.PopSegment(keyType); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	if resourceId.KeyType == "" {
+		return nil, fmt.Errorf("ID was missing either the '%s' or '%s' element", functionAppHostKeyTypeFunctionKeys, functionAppHostKeyTypeSystemKeys)
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+func (id functionAppHostKeyId) ID() string {
+	if id.SlotName != "" {
+		fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s/host/default/%s/%s"
+		return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.KeyType, id.KeyName)
+	}
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/host/default/%s/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.KeyType, id.KeyName)
+}
+
+func (id functionAppHostKeyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Key Name %q", id.KeyName),
+		fmt.Sprintf("Key Type %q", id.KeyType),
+	}
+	if id.SlotName != "" {
+		segments = append(segments, fmt.Sprintf("Slot Name %q", id.SlotName))
+	}
+	segments = append(segments,
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	)
+	return fmt.Sprintf("%s: (%s)", "Function App Host Key", strings.Join(segments, " / "))
+}
+
+func (id functionAppHostKeyId) functionAppId() string {
+	if id.SlotName != "" {
+		return parse.NewFunctionAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName).ID()
+	}
+	return parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID()
+}
+
+func (id functionAppHostKeyId) keysOfType(input web.HostKeys) map[string]*string {
+	if id.KeyType == functionAppHostKeyTypeSystemKeys {
+		return input.SystemKeys
+	}
+	return input.FunctionKeys
+}
+
+func (id functionAppHostKeyId) list(ctx context.Context, client *web.AppsClient) (web.HostKeys, error) {
+	if id.SlotName != "" {
+		return client.ListHostKeysSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	}
+	return client.ListHostKeys(ctx, id.ResourceGroup, id.SiteName)
+}
+
+func (id functionAppHostKeyId) createOrUpdate(ctx context.Context, client *web.AppsClient, key web.KeyInfo) error {
+	var err error
+	if id.SlotName != "" {
+		_, err = client.CreateOrUpdateHostSecretSlot(ctx, id.ResourceGroup, id.SiteName, id.KeyType, id.KeyName, id.SlotName, key)
+	} else {
+		_, err = client.CreateOrUpdateHostSecret(ctx, id.ResourceGroup, id.SiteName, id.KeyType, id.KeyName, key)
+	}
+	return err
+}
+
+func (id functionAppHostKeyId) delete(ctx context.Context, client *web.AppsClient) (autorest.Response, error) {
+	if id.SlotName != "" {
+		return client.DeleteHostSecretSlot(ctx, id.ResourceGroup, id.SiteName, id.KeyType, id.KeyName, id.SlotName)
+	}
+	return client.DeleteHostSecret(ctx, id.ResourceGroup, id.SiteName, id.KeyType, id.KeyName)
+}
@@ -0,0 +1,215 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FunctionAppHostKeyResource struct{}
+
+func TestAccFunctionAppHostKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("type").HasValue("functionKeys"),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHostKey_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withValue(data, "acctestvalue1acctestvalue1acctestvalue1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withValue(data, "acctestvalue2acctestvalue2acctestvalue2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHostKey_systemKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.systemKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("type").HasValue("systemKeys"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHostKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFunctionAppHostKey_slot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_host_key", "test")
+	r := FunctionAppHostKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.slot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r FunctionAppHostKeyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	var hostKeys web.HostKeys
+	var err error
+	if slotId, parseErr := parse.FunctionAppSlotID(state.Attributes["function_app_id"]); parseErr == nil {
+		hostKeys, err = client.AppService.WebAppsClient.ListHostKeysSlot(ctx, slotId.ResourceGroup, slotId.SiteName, slotId.SlotName)
+	} else {
+		appId, parseErr := parse.FunctionAppID(state.Attributes["function_app_id"])
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		hostKeys, err = client.AppService.WebAppsClient.ListHostKeys(ctx, appId.ResourceGroup, appId.SiteName)
+	}
+	if err != nil {
+		if utils.ResponseWasNotFound(hostKeys.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing Host Keys for %q: %+v", state.Attributes["function_app_id"], err)
+	}
+
+	keys := hostKeys.FunctionKeys
+	if state.Attributes["type"] == "systemKeys" {
+		keys = hostKeys.SystemKeys
+	}
+	_, ok := keys[state.Attributes["name"]]
+	return utils.Bool(ok), nil
+}
+
+func (r FunctionAppHostKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_host_key" "test" {
+  name            = "acctest-key-%d"
+  function_app_id = azurerm_linux_function_app.test.id
+}
+`, FunctionAppFunctionResource{}.templateLinux(data), data.RandomInteger)
+}
+
+func (r FunctionAppHostKeyResource) withValue(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_host_key" "test" {
+  name            = "acctest-key-%d"
+  function_app_id = azurerm_linux_function_app.test.id
+  value           = "%s"
+}
+`, FunctionAppFunctionResource{}.templateLinux(data), data.RandomInteger, value)
+}
+
+func (r FunctionAppHostKeyResource) systemKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_function_app_host_key" "test" {
+  name            = "acctest-key-%d"
+  function_app_id = azurerm_linux_function_app.test.id
+  type            = "systemKeys"
+}
+`, FunctionAppFunctionResource{}.templateLinux(data), data.RandomInteger)
+}
+
+func (r FunctionAppHostKeyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_host_key" "import" {
+  name            = azurerm_function_app_host_key.test.name
+  function_app_id = azurerm_function_app_host_key.test.function_app_id
+}
+`, r.basic(data))
+}
+
+func (r FunctionAppHostKeyResource) slot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_function_app_slot" "test" {
+  name                       = "acctest-LFAS-%[2]d"
+  function_app_id            = azurerm_linux_function_app.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {
+    application_stack {
+      python_version = "3.9"
+    }
+  }
+}
+
+resource "azurerm_function_app_host_key" "test" {
+  name            = "acctest-key-%[2]d"
+  function_app_id = azurerm_linux_function_app_slot.test.id
+}
+`, FunctionAppFunctionResource{}.templateLinux(data), data.RandomInteger)
+}
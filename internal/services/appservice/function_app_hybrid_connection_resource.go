@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/namespaces"
@@ -48,7 +49,7 @@ func (r FunctionAppHybridConnectionResource) ResourceType() string {
 }
 
 func (r FunctionAppHybridConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
-	return validate.AppHybridConnectionID
+	return validation.Any(validate.AppHybridConnectionID, validate.AppSlotHybridConnectionID)
 }
 
 func (r FunctionAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
@@ -57,8 +58,8 @@ func (r FunctionAppHybridConnectionResource) Arguments() map[string]*pluginsdk.S
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validate.FunctionAppID,
-			Description:  "The ID of the Function App for this Hybrid Connection.",
+			ValidateFunc: validation.Any(validate.FunctionAppID, validate.FunctionAppSlotID),
+			Description:  "The ID of the Function App or Function App Slot for this Hybrid Connection.",
 		},
 
 		"relay_id": {
@@ -139,18 +140,28 @@ func (r FunctionAppHybridConnectionResource) Create() sdk.ResourceFunc {
 			if err := metadata.Decode(&appHybridConn); err != nil {
 				return err
 			}
-			appId, err := parse.FunctionAppID(appHybridConn.FunctionAppId)
-			if err != nil {
-				return err
-			}
 			relayId, err := hybridconnections.ParseHybridConnectionID(appHybridConn.RelayId)
 			if err != nil {
 				return err
 			}
 
-			id := parse.NewAppHybridConnectionID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, relayId.NamespaceName, relayId.HybridConnectionName)
+			var id functionAppHybridConnectionId
+			if slotId, err := parse.FunctionAppSlotID(appHybridConn.FunctionAppId); err == nil {
+				id = functionAppHybridConnectionId{
+					AppHybridConnectionId: parse.NewAppHybridConnectionID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName, relayId.NamespaceName, relayId.HybridConnectionName),
+					SlotName:              slotId.SlotName,
+				}
+			} else {
+				appId, err := parse.FunctionAppID(appHybridConn.FunctionAppId)
+				if err != nil {
+					return err
+				}
+				id = functionAppHybridConnectionId{
+					AppHybridConnectionId: parse.NewAppHybridConnectionID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, relayId.NamespaceName, relayId.HybridConnectionName),
+				}
+			}
 
-			existing, err := client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			existing, err := id.get(ctx, client)
 			if err != nil {
 				if !utils.ResponseWasNotFound(existing.Response) {
 					return fmt.Errorf("checking for presence of existing %s: %s", id, err)
@@ -170,8 +181,7 @@ func (r FunctionAppHybridConnectionResource) Create() sdk.ResourceFunc {
 				},
 			}
 
-			_, err = client.CreateOrUpdateHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, envelope)
-			if err != nil {
+			if err := id.createOrUpdate(ctx, client, envelope); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -188,12 +198,12 @@ func (r FunctionAppHybridConnectionResource) Read() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.WebAppsClient
 
-			id, err := parse.AppHybridConnectionID(metadata.ResourceData.Id())
+			id, err := parseFunctionAppHybridConnectionID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			existing, err := client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			existing, err := id.get(ctx, client)
 			if err != nil {
 				if utils.ResponseWasNotFound(existing.Response) {
 					return metadata.MarkAsGone(id)
@@ -203,7 +213,7 @@ func (r FunctionAppHybridConnectionResource) Read() sdk.ResourceFunc {
 			}
 
 			appHybridConn := FunctionAppHybridConnectionModel{
-				FunctionAppId: parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID(),
+				FunctionAppId: id.functionAppId(),
 				RelayName:     id.RelayName,
 				NamespaceName: id.HybridConnectionNamespaceName,
 			}
@@ -254,12 +264,12 @@ func (r FunctionAppHybridConnectionResource) Delete() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.WebAppsClient
 
-			id, err := parse.AppHybridConnectionID(metadata.ResourceData.Id())
+			id, err := parseFunctionAppHybridConnectionID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			resp, err := client.DeleteHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			resp, err := id.delete(ctx, client)
 			if err != nil {
 				if !response.WasNotFound(resp.Response) {
 					return fmt.Errorf("deleting %s: %+v", id, err)
@@ -277,7 +287,7 @@ func (r FunctionAppHybridConnectionResource) Update() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.AppService.WebAppsClient
 
-			id, err := parse.AppHybridConnectionID(metadata.ResourceData.Id())
+			id, err := parseFunctionAppHybridConnectionID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
@@ -287,7 +297,7 @@ func (r FunctionAppHybridConnectionResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			existing, err := client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			existing, err := id.get(ctx, client)
 			if err != nil {
 				if utils.ResponseWasNotFound(existing.Response) {
 					return metadata.MarkAsGone(id)
@@ -305,15 +315,14 @@ func (r FunctionAppHybridConnectionResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("send_key_name") {
-				key, err := helpers.GetSendKeyValue(ctx, metadata, *id, appHybridConn.SendKeyName)
+				key, err := helpers.GetSendKeyValue(ctx, metadata, id.AppHybridConnectionId, appHybridConn.SendKeyName)
 				if err != nil {
 					return err
 				}
 				existing.HybridConnectionProperties.SendKeyValue = key
 			}
 
-			_, err = client.CreateOrUpdateHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, existing)
-			if err != nil {
+			if err := id.createOrUpdate(ctx, client, existing); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
@@ -324,7 +333,7 @@ func (r FunctionAppHybridConnectionResource) Update() sdk.ResourceFunc {
 
 func (r FunctionAppHybridConnectionResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-		id, err := parse.AppHybridConnectionID(metadata.ResourceData.Id())
+		id, err := parseFunctionAppHybridConnectionID(metadata.ResourceData.Id())
 		if err != nil {
 			return err
 		}
@@ -343,3 +352,77 @@ func (r FunctionAppHybridConnectionResource) CustomImporter() sdk.ResourceRunFun
 		return nil
 	}
 }
+
+// functionAppHybridConnectionId identifies a Hybrid Connection on either a Function App or, when SlotName is set, a
+// Function App Slot - since the Web Apps API exposes separate operations for each.
+type functionAppHybridConnectionId struct {
+	parse.AppHybridConnectionId
+	SlotName string
+}
+
+func parseFunctionAppHybridConnectionID(input string) (*functionAppHybridConnectionId, error) {
+	if slotId, err := parse.AppSlotHybridConnectionID(input); err == nil {
+		return &functionAppHybridConnectionId{
+			AppHybridConnectionId: parse.NewAppHybridConnectionID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName, slotId.HybridConnectionNamespaceName, slotId.RelayName),
+			SlotName:              slotId.SlotName,
+		}, nil
+	}
+
+	id, err := parse.AppHybridConnectionID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &functionAppHybridConnectionId{
+		AppHybridConnectionId: *id,
+	}, nil
+}
+
+func (id functionAppHybridConnectionId) ID() string {
+	if id.SlotName != "" {
+		return id.slotId().ID()
+	}
+	return id.AppHybridConnectionId.ID()
+}
+
+func (id functionAppHybridConnectionId) String() string {
+	if id.SlotName != "" {
+		return id.slotId().String()
+	}
+	return id.AppHybridConnectionId.String()
+}
+
+func (id functionAppHybridConnectionId) slotId() parse.AppSlotHybridConnectionId {
+	return parse.NewAppSlotHybridConnectionID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.HybridConnectionNamespaceName, id.RelayName)
+}
+
+func (id functionAppHybridConnectionId) functionAppId() string {
+	if id.SlotName != "" {
+		return parse.NewFunctionAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName).ID()
+	}
+	return parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID()
+}
+
+func (id functionAppHybridConnectionId) get(ctx context.Context, client *web.AppsClient) (web.HybridConnection, error) {
+	if id.SlotName != "" {
+		return client.GetHybridConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, id.SlotName)
+	}
+	return client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+}
+
+func (id functionAppHybridConnectionId) createOrUpdate(ctx context.Context, client *web.AppsClient, envelope web.HybridConnection) error {
+	var err error
+	if id.SlotName != "" {
+		_, err = client.CreateOrUpdateHybridConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, envelope, id.SlotName)
+	} else {
+		_, err = client.CreateOrUpdateHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, envelope)
+	}
+	return err
+}
+
+func (id functionAppHybridConnectionId) delete(ctx context.Context, client *web.AppsClient) (autorest.Response, error) {
+	if id.SlotName != "" {
+		return client.DeleteHybridConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, id.SlotName)
+	}
+	return client.DeleteHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+}
@@ -96,7 +96,34 @@ func TestAccFunctionAppHybridConnection_complete(t *testing.T) {
 	})
 }
 
+func TestAccFunctionAppHybridConnection_slot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_hybrid_connection", "test")
+	r := FunctionAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.slot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r FunctionAppHybridConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if slotId, err := parse.AppSlotHybridConnectionID(state.ID); err == nil {
+		resp, err := clients.AppService.WebAppsClient.GetHybridConnectionSlot(ctx, slotId.ResourceGroup, slotId.SiteName, slotId.HybridConnectionNamespaceName, slotId.RelayName, slotId.SlotName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *slotId, err)
+		}
+
+		return utils.Bool(true), nil
+	}
+
 	id, err := parse.AppHybridConnectionID(state.ID)
 	if err != nil {
 		return nil, err
@@ -198,6 +225,32 @@ resource "azurerm_function_app_hybrid_connection" "test" {
 `, r.authRuleInRemoteResourceGroupTemplate(data), data.RandomStringOfLength(8))
 }
 
+func (r FunctionAppHybridConnectionResource) slot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_windows_function_app_slot" "test" {
+  name                       = "acctest-WFAS-%[2]d"
+  function_app_id            = azurerm_windows_function_app.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {}
+}
+
+resource "azurerm_function_app_hybrid_connection" "test" {
+  function_app_id = azurerm_windows_function_app_slot.test.id
+  relay_id        = azurerm_relay_hybrid_connection.test.id
+  hostname        = "acctest%[3]s.hostname"
+  port            = 8081
+}
+`, r.template(data), data.RandomInteger, data.RandomStringOfLength(8))
+}
+
 func (r FunctionAppHybridConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+)
+
+// AppServiceEnvironmentDNSSuffix returns the DNS Suffix used for Apps hosted within the specified App Service Environment.
+// This varies between internal and external App Service Environments and between Clouds, so where possible this is retrieved
+// from the App Service Environment. If this can't be determined the default suffix for an App Service Environment v3 within
+// the current Cloud is returned alongside the error, so that callers can choose to continue using the fallback value.
+func AppServiceEnvironmentDNSSuffix(ctx context.Context, client *web.AppServiceEnvironmentsClient, environment azure.Environment, hostingEnvironmentId string) (string, error) {
+	suffix := defaultAppServiceEnvironmentDNSSuffix(environment)
+
+	id, err := parse.AppServiceEnvironmentID(hostingEnvironmentId)
+	if err != nil {
+		return suffix, err
+	}
+
+	suffix = fmt.Sprintf("%s.%s", id.HostingEnvironmentName, suffix)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.HostingEnvironmentName)
+	if err != nil {
+		return suffix, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if props := existing.AppServiceEnvironment; props != nil && props.DNSSuffix != nil && *props.DNSSuffix != "" {
+		suffix = *props.DNSSuffix
+	}
+
+	return suffix, nil
+}
+
+func defaultAppServiceEnvironmentDNSSuffix(environment azure.Environment) string {
+	switch environment.Name {
+	case azure.USGovernmentCloud.Name:
+		return "appserviceenvironment.us"
+	case azure.ChinaCloud.Name:
+		return "appserviceenvironment.cn"
+	}
+
+	return "appserviceenvironment.net"
+}
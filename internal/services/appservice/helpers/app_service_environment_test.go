@@ -0,0 +1,39 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestDefaultAppServiceEnvironmentDNSSuffix(t *testing.T) {
+	cases := []struct {
+		Environment azure.Environment
+		Expected    string
+	}{
+		{
+			Environment: azure.PublicCloud,
+			Expected:    "appserviceenvironment.net",
+		},
+		{
+			Environment: azure.USGovernmentCloud,
+			Expected:    "appserviceenvironment.us",
+		},
+		{
+			Environment: azure.ChinaCloud,
+			Expected:    "appserviceenvironment.cn",
+		},
+		{
+			Environment: azure.Environment{},
+			Expected:    "appserviceenvironment.net",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Environment.Name)
+
+		if actual := defaultAppServiceEnvironmentDNSSuffix(tc.Environment); actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
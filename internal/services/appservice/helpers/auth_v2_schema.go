@@ -0,0 +1,1378 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	authV1ConfigVersion = "v1"
+	authV2ConfigVersion = "v2"
+)
+
+type AuthV2Settings struct {
+	AuthEnabled                        bool                       `tfschema:"auth_enabled"`
+	RuntimeVersion                     string                     `tfschema:"runtime_version"`
+	ConfigFilePath                     string                     `tfschema:"config_file_path"`
+	RequireAuth                        bool                       `tfschema:"require_authentication"`
+	UnauthenticatedAction              string                     `tfschema:"unauthenticated_action"`
+	DefaultAuthProvider                string                     `tfschema:"default_provider"`
+	ExcludedPaths                      []string                   `tfschema:"excluded_paths"`
+	RequireHttps                       bool                       `tfschema:"require_https"`
+	HttpRoutesAPIPrefix                string                     `tfschema:"http_route_api_prefix"`
+	ForwardProxyConvention             string                     `tfschema:"forward_proxy_convention"`
+	ForwardProxyCustomHostHeaderName   string                     `tfschema:"forward_proxy_custom_host_header_name"`
+	ForwardProxyCustomSchemeHeaderName string                     `tfschema:"forward_proxy_custom_scheme_header_name"`
+	AppleAuth                          []AppleAuthV2Settings      `tfschema:"apple_v2"`
+	AzureActiveDirectoryAuth           []AadAuthV2Settings        `tfschema:"active_directory_v2"`
+	FacebookAuth                       []FacebookAuthV2Settings   `tfschema:"facebook_v2"`
+	GithubAuth                         []GithubAuthV2Settings     `tfschema:"github_v2"`
+	GoogleAuth                         []GoogleAuthV2Settings     `tfschema:"google_v2"`
+	TwitterAuth                        []TwitterAuthV2Settings    `tfschema:"twitter_v2"`
+	CustomOIDCAuth                     []CustomOIDCAuthV2Settings `tfschema:"custom_oidc_v2"`
+	Login                              []AuthV2Login              `tfschema:"login"`
+}
+
+func AuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"auth_settings"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"auth_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the AuthV2 Settings be enabled. Defaults to `false`",
+				},
+
+				"runtime_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "~1",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Runtime Version of the Authentication and Authorisation feature of this App. Defaults to `~1`",
+				},
+
+				"config_file_path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The path to the App Auth settings. **Note:** Relative Paths are evaluated from the Site Root directory.",
+				},
+
+				"require_authentication": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the authentication flow be used for all requests.",
+				},
+
+				"unauthenticated_action": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(web.UnauthenticatedClientActionV2RedirectToLoginPage),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.UnauthenticatedClientActionV2RedirectToLoginPage),
+						string(web.UnauthenticatedClientActionV2AllowAnonymous),
+						string(web.UnauthenticatedClientActionV2Return401),
+						string(web.UnauthenticatedClientActionV2Return403),
+					}, false),
+					Description: "The action to take for requests made without authentication. Possible values include `RedirectToLoginPage`, `AllowAnonymous`, `Return401`, and `Return403`. Defaults to `RedirectToLoginPage`.",
+				},
+
+				"default_provider": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The Default Authentication Provider to use when the `unauthenticated_action` is set to `RedirectToLoginPage`. Possible values include: `apple`, `azureactivedirectory`, `facebook`, `github`, `google`, `twitter` and the `name` of your `custom_oidc_v2` provider.",
+				},
+
+				"excluded_paths": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The paths which should be excluded from the `unauthenticated_action` when it is set to `RedirectToLoginPage`.",
+				},
+
+				"require_https": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should HTTPS be required on connections? Defaults to `true`.",
+				},
+
+				"http_route_api_prefix": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "/.auth",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The prefix that should precede all the authentication and authorisation paths. Defaults to `/.auth`",
+				},
+
+				"forward_proxy_convention": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(web.ForwardProxyConventionNoProxy),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.ForwardProxyConventionNoProxy),
+						string(web.ForwardProxyConventionStandard),
+						string(web.ForwardProxyConventionCustom),
+					}, false),
+					Description: "The convention used to determine the url of the request made. Possible values include `NoProxy`, `Standard`, `Custom`. Defaults to `NoProxy`",
+				},
+
+				"forward_proxy_custom_host_header_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the header containing the host of the request.",
+				},
+
+				"forward_proxy_custom_scheme_header_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the header containing the scheme of the request.",
+				},
+
+				"apple_v2": AppleAuthV2SettingsSchema(),
+
+				"active_directory_v2": AadAuthV2SettingsSchema(),
+
+				"facebook_v2": FacebookAuthV2SettingsSchema(),
+
+				"github_v2": GithubAuthV2SettingsSchema(),
+
+				"google_v2": GoogleAuthV2SettingsSchema(),
+
+				"twitter_v2": TwitterAuthV2SettingsSchema(),
+
+				"custom_oidc_v2": CustomOIDCAuthV2SettingsSchema(),
+
+				"login": AuthV2LoginSchema(),
+			},
+		},
+	}
+}
+
+type AuthV2Login struct {
+	LogoutEndpoint                string   `tfschema:"logout_endpoint"`
+	TokenStoreEnabled             bool     `tfschema:"token_store_enabled"`
+	TokenRefreshExtension         float64  `tfschema:"token_refresh_extension_time"`
+	TokenFilesystemPath           string   `tfschema:"token_store_path"`
+	TokenBlobStorageSAS           string   `tfschema:"token_store_sas_setting_name"`
+	PreserveURLFragmentsForLogins bool     `tfschema:"preserve_url_fragments_for_logins"`
+	AllowedExternalRedirectURLs   []string `tfschema:"allowed_external_redirect_urls"`
+	CookieExpirationConvention    string   `tfschema:"cookie_expiration_convention"`
+	CookieExpirationTime          string   `tfschema:"cookie_expiration_time"`
+	ValidateNonce                 bool     `tfschema:"validate_nonce"`
+	NonceExpirationTime           string   `tfschema:"nonce_expiration_time"`
+}
+
+func AuthV2LoginSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"logout_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The endpoint to which logout requests should be made.",
+				},
+
+				"token_store_enabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the Token Store configuration Enabled. Defaults to `false`",
+				},
+
+				"token_refresh_extension_time": {
+					Type:        pluginsdk.TypeFloat,
+					Optional:    true,
+					Default:     72,
+					Description: "The number of hours after session token expiration that a session token can be used to call the token refresh API. Defaults to `72` hours.",
+				},
+
+				"token_store_path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ConflictsWith: []string{
+						"auth_settings_v2.0.login.0.token_store_sas_setting_name",
+					},
+					Description: "The directory path in the App Filesystem in which the tokens will be stored.",
+				},
+
+				"token_store_sas_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ConflictsWith: []string{
+						"auth_settings_v2.0.login.0.token_store_path",
+					},
+					Description: "The name of the app setting which contains the SAS URL of the blob storage containing the tokens.",
+				},
+
+				"preserve_url_fragments_for_logins": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the fragments from the request be preserved after the login request is made. Defaults to `false`.",
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "External URLs that can be redirected to as part of logging in or logging out of the app. This is an advanced setting typically only needed by Windows Store application backends. **Note:** URLs within the current domain are always implicitly allowed.",
+				},
+
+				"cookie_expiration_convention": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(web.CookieExpirationConventionFixedTime),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.CookieExpirationConventionFixedTime),
+						string(web.CookieExpirationConventionIdentityProviderDerived),
+					}, false),
+					Description: "The method by which cookies expire. Possible values include: `FixedTime`, and `IdentityProviderDerived`. Defaults to `FixedTime`.",
+				},
+
+				"cookie_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "08:00:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The time after the request is made when the session cookie should expire. Defaults to `08:00:00`.",
+				},
+
+				"validate_nonce": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Should the nonce be validated while completing the login flow. Defaults to `true`.",
+				},
+
+				"nonce_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "00:05:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The time after the request is made when the nonce should expire. Defaults to `00:05:00`.",
+				},
+			},
+		},
+	}
+}
+
+type AppleAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func AppleAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OpenID Connect Client ID for the Apple web application.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `client_secret` value used for Apple Login.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+type AadAuthV2Settings struct {
+	ClientId                          string            `tfschema:"client_id"`
+	TenantAuthURI                     string            `tfschema:"tenant_auth_endpoint"`
+	ClientSecretSettingName           string            `tfschema:"client_secret_setting_name"`
+	ClientSecretCertificateThumbprint string            `tfschema:"client_secret_certificate_thumbprint"`
+	JWTAllowedGroups                  []string          `tfschema:"jwt_allowed_groups"`
+	JWTAllowedClientApps              []string          `tfschema:"jwt_allowed_client_applications"`
+	DisableWWWAuth                    bool              `tfschema:"www_authentication_disabled"`
+	AllowedGroups                     []string          `tfschema:"allowed_groups"`
+	AllowedIdentities                 []string          `tfschema:"allowed_identities"`
+	AllowedApplications               []string          `tfschema:"allowed_applications"`
+	LoginParameters                   map[string]string `tfschema:"login_parameters"`
+	AllowedAudiences                  []string          `tfschema:"allowed_audiences"`
+}
+
+func AadAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with Azure Active Directory.",
+				},
+
+				"tenant_auth_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The Azure Tenant Endpoint for the Authenticating Tenant. e.g. `https://login.microsoftonline.com/v2.0/{tenant-guid}/`.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ConflictsWith: []string{
+						"auth_settings_v2.0.active_directory_v2.0.client_secret_certificate_thumbprint",
+					},
+					Description: "The App Setting name that contains the client secret of the Client.",
+				},
+
+				"client_secret_certificate_thumbprint": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ConflictsWith: []string{
+						"auth_settings_v2.0.active_directory_v2.0.client_secret_setting_name",
+					},
+					Description: "The thumbprint of the certificate used for signing purposes.",
+				},
+
+				"jwt_allowed_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of Allowed Groups in the JWT Claim.",
+				},
+
+				"jwt_allowed_client_applications": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of Allowed Client Applications in the JWT Claim.",
+				},
+
+				"www_authentication_disabled": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Should the www-authenticate provider should be omitted from the request? Defaults to `false`",
+				},
+
+				"allowed_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Group Names for the Default Authorisation Policy.",
+				},
+
+				"allowed_identities": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Identities for the Default Authorisation Policy.",
+				},
+
+				"allowed_applications": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of allowed Applications for the Default Authorisation Policy.",
+				},
+
+				"login_parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Description: "A map of key-value pairs to send to the Authorisation Endpoint when a user logs in.",
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Allowed audience values to consider when validating JWTs issued by Azure Active Directory.",
+				},
+			},
+		},
+	}
+}
+
+type FacebookAuthV2Settings struct {
+	AppId                string   `tfschema:"app_id"`
+	AppSecretSettingName string   `tfschema:"app_secret_setting_name"`
+	GraphAPIVersion      string   `tfschema:"graph_api_version"`
+	LoginScopes          []string `tfschema:"login_scopes"`
+}
+
+func FacebookAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The App ID of the Facebook app used for login.",
+				},
+
+				"app_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `app_secret` value used for Facebook Login.",
+				},
+
+				"graph_api_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The version of the Facebook API to be used while logging in.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of scopes to be requested as part of Facebook Login authentication.",
+				},
+			},
+		},
+	}
+}
+
+type GithubAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func GithubAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the GitHub app used for login.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `client_secret` value used for GitHub Login.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of OAuth 2.0 scopes that will be requested as part of GitHub Login authentication.",
+				},
+			},
+		},
+	}
+}
+
+type GoogleAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	AllowedAudiences        []string `tfschema:"allowed_audiences"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+func GoogleAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OpenID Connect Client ID for the Google web application.",
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the `client_secret` value used for Google Login.",
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Allowed Audiences that will be requested as part of Google Sign-In authentication.",
+				},
+
+				"login_scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "Specifies a list of Login scopes that will be requested as part of Google Sign-In authentication.",
+				},
+			},
+		},
+	}
+}
+
+type TwitterAuthV2Settings struct {
+	ConsumerKey               string `tfschema:"consumer_key"`
+	ConsumerSecretSettingName string `tfschema:"consumer_secret_setting_name"`
+}
+
+func TwitterAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"consumer_key": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The OAuth 1.0a consumer key of the Twitter application used for sign-in.",
+				},
+
+				"consumer_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The app setting name that contains the OAuth 1.0a consumer secret of the Twitter application used for sign-in.",
+				},
+			},
+		},
+	}
+}
+
+type CustomOIDCAuthV2Settings struct {
+	Name                        string   `tfschema:"name"`
+	ClientId                    string   `tfschema:"client_id"`
+	ClientCredentialMethod      string   `tfschema:"client_credential_method"`
+	ClientSecretSettingName     string   `tfschema:"client_secret_setting_name"`
+	AuthorizationEndpoint       string   `tfschema:"authorisation_endpoint"`
+	TokenEndpoint               string   `tfschema:"token_endpoint"`
+	IssuerEndpoint              string   `tfschema:"issuer_endpoint"`
+	CertificationURI            string   `tfschema:"certification_uri"`
+	OpenIDConfigurationEndpoint string   `tfschema:"openid_configuration_endpoint"`
+	NameClaimType               string   `tfschema:"name_claim_type"`
+	Scopes                      []string `tfschema:"scopes"`
+}
+
+func CustomOIDCAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the Custom OIDC Authentication Provider.",
+				},
+
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the Client to use to authenticate with this Custom OIDC.",
+				},
+
+				"openid_configuration_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "The endpoint that contains all the configuration endpoints for this Custom OIDC provider.",
+				},
+
+				"name_claim_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The name of the claim that contains the users name.",
+				},
+
+				"scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "The list of the scopes that should be requested while authenticating.",
+				},
+
+				"client_credential_method": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"client_secret_setting_name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"authorisation_endpoint": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"token_endpoint": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"issuer_endpoint": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"certification_uri": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// AuthV2ConfigVersionInUse returns whether the V2 (rather than the legacy V1) Authentication / Authorisation
+// configuration is in use for the App, based on the V1 settings which report the config version.
+func AuthV2ConfigVersionInUse(auth web.SiteAuthSettings) bool {
+	if props := auth.SiteAuthSettingsProperties; props != nil {
+		return strings.EqualFold(utils.NormalizeNilableString(props.ConfigVersion), authV2ConfigVersion)
+	}
+	return false
+}
+
+// RevertAuthV2ConfigVersion switches the App back to the legacy V1 Authentication / Authorisation configuration once
+// the `auth_settings_v2` block has been removed - since the V2 configuration can't be deleted, this is how the block
+// is known to have been removed when the App is next read.
+func RevertAuthV2ConfigVersion(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string) error {
+	auth, err := client.GetAuthSettings(ctx, resourceGroup, siteName)
+	if err != nil {
+		return fmt.Errorf("reading Auth Settings: %+v", err)
+	}
+
+	if auth.SiteAuthSettingsProperties == nil {
+		auth.SiteAuthSettingsProperties = &web.SiteAuthSettingsProperties{}
+	}
+	auth.SiteAuthSettingsProperties.ConfigVersion = utils.String(authV1ConfigVersion)
+
+	if _, err := client.UpdateAuthSettings(ctx, resourceGroup, siteName, auth); err != nil {
+		return fmt.Errorf("updating Auth Settings: %+v", err)
+	}
+
+	return nil
+}
+
+// RevertAuthV2ConfigVersionSlot switches the App Slot back to the legacy V1 Authentication / Authorisation configuration
+// once the `auth_settings_v2` block has been removed.
+func RevertAuthV2ConfigVersionSlot(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, slotName string) error {
+	auth, err := client.GetAuthSettingsSlot(ctx, resourceGroup, siteName, slotName)
+	if err != nil {
+		return fmt.Errorf("reading Auth Settings: %+v", err)
+	}
+
+	if auth.SiteAuthSettingsProperties == nil {
+		auth.SiteAuthSettingsProperties = &web.SiteAuthSettingsProperties{}
+	}
+	auth.SiteAuthSettingsProperties.ConfigVersion = utils.String(authV1ConfigVersion)
+
+	if _, err := client.UpdateAuthSettingsSlot(ctx, resourceGroup, siteName, auth, slotName); err != nil {
+		return fmt.Errorf("updating Auth Settings: %+v", err)
+	}
+
+	return nil
+}
+
+func ExpandAuthV2Settings(input []AuthV2Settings) *web.SiteAuthSettingsV2 {
+	result := &web.SiteAuthSettingsV2{}
+	if len(input) != 1 {
+		// when the block is removed the V2 platform is disabled, since the configuration can't be deleted
+		result.SiteAuthSettingsV2Properties = &web.SiteAuthSettingsV2Properties{
+			Platform: &web.AuthPlatform{
+				Enabled: utils.Bool(false),
+			},
+		}
+		return result
+	}
+
+	settings := input[0]
+
+	props := &web.SiteAuthSettingsV2Properties{
+		Platform: &web.AuthPlatform{
+			Enabled:        utils.Bool(settings.AuthEnabled),
+			RuntimeVersion: utils.String(settings.RuntimeVersion),
+		},
+		GlobalValidation: &web.GlobalValidation{
+			RequireAuthentication:       utils.Bool(settings.RequireAuth),
+			UnauthenticatedClientAction: web.UnauthenticatedClientActionV2(settings.UnauthenticatedAction),
+			ExcludedPaths:               &settings.ExcludedPaths,
+		},
+		IdentityProviders: &web.IdentityProviders{
+			AzureActiveDirectory:         expandAadAuthV2Settings(settings.AzureActiveDirectoryAuth),
+			Facebook:                     expandFacebookAuthV2Settings(settings.FacebookAuth),
+			GitHub:                       expandGithubAuthV2Settings(settings.GithubAuth),
+			Google:                       expandGoogleAuthV2Settings(settings.GoogleAuth),
+			Twitter:                      expandTwitterAuthV2Settings(settings.TwitterAuth),
+			CustomOpenIDConnectProviders: expandCustomOIDCAuthV2Settings(settings.CustomOIDCAuth),
+			Apple:                        expandAppleAuthV2Settings(settings.AppleAuth),
+		},
+		Login: expandAuthV2LoginSettings(settings.Login),
+		HTTPSettings: &web.HTTPSettings{
+			RequireHTTPS: utils.Bool(settings.RequireHttps),
+			Routes: &web.HTTPSettingsRoutes{
+				APIPrefix: utils.String(settings.HttpRoutesAPIPrefix),
+			},
+			ForwardProxy: &web.ForwardProxy{
+				Convention: web.ForwardProxyConvention(settings.ForwardProxyConvention),
+			},
+		},
+	}
+
+	if settings.ConfigFilePath != "" {
+		props.Platform.ConfigFilePath = utils.String(settings.ConfigFilePath)
+	}
+
+	if settings.DefaultAuthProvider != "" {
+		props.GlobalValidation.RedirectToProvider = utils.String(settings.DefaultAuthProvider)
+	}
+
+	if settings.ForwardProxyCustomHostHeaderName != "" {
+		props.HTTPSettings.ForwardProxy.CustomHostHeaderName = utils.String(settings.ForwardProxyCustomHostHeaderName)
+	}
+
+	if settings.ForwardProxyCustomSchemeHeaderName != "" {
+		props.HTTPSettings.ForwardProxy.CustomProtoHeaderName = utils.String(settings.ForwardProxyCustomSchemeHeaderName)
+	}
+
+	result.SiteAuthSettingsV2Properties = props
+
+	return result
+}
+
+func expandAuthV2LoginSettings(input []AuthV2Login) *web.Login {
+	result := &web.Login{}
+	if len(input) != 1 {
+		return result
+	}
+
+	login := input[0]
+	result.Routes = &web.LoginRoutes{}
+	if login.LogoutEndpoint != "" {
+		result.Routes.LogoutEndpoint = utils.String(login.LogoutEndpoint)
+	}
+
+	result.TokenStore = &web.TokenStore{
+		Enabled:                    utils.Bool(login.TokenStoreEnabled),
+		TokenRefreshExtensionHours: utils.Float(login.TokenRefreshExtension),
+	}
+	if login.TokenFilesystemPath != "" {
+		result.TokenStore.FileSystem = &web.FileSystemTokenStore{
+			Directory: utils.String(login.TokenFilesystemPath),
+		}
+	}
+	if login.TokenBlobStorageSAS != "" {
+		result.TokenStore.AzureBlobStorage = &web.BlobStorageTokenStore{
+			BlobStorageTokenStoreProperties: &web.BlobStorageTokenStoreProperties{
+				SasURLSettingName: utils.String(login.TokenBlobStorageSAS),
+			},
+		}
+	}
+
+	result.PreserveURLFragmentsForLogins = utils.Bool(login.PreserveURLFragmentsForLogins)
+	result.AllowedExternalRedirectUrls = &login.AllowedExternalRedirectURLs
+	result.CookieExpiration = &web.CookieExpiration{
+		Convention:       web.CookieExpirationConvention(login.CookieExpirationConvention),
+		TimeToExpiration: utils.String(login.CookieExpirationTime),
+	}
+	result.Nonce = &web.Nonce{
+		ValidateNonce:           utils.Bool(login.ValidateNonce),
+		NonceExpirationInterval: utils.String(login.NonceExpirationTime),
+	}
+
+	return result
+}
+
+func expandAppleAuthV2Settings(input []AppleAuthV2Settings) *web.Apple {
+	if len(input) != 1 {
+		return &web.Apple{
+			AppleProperties: &web.AppleProperties{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	apple := input[0]
+	return &web.Apple{
+		AppleProperties: &web.AppleProperties{
+			Enabled: utils.Bool(true),
+			Registration: &web.AppleRegistration{
+				ClientID:                utils.String(apple.ClientId),
+				ClientSecretSettingName: utils.String(apple.ClientSecretSettingName),
+			},
+			Login: &web.LoginScopes{
+				Scopes: &apple.LoginScopes,
+			},
+		},
+	}
+}
+
+func expandAadAuthV2Settings(input []AadAuthV2Settings) *web.AzureActiveDirectory {
+	if len(input) != 1 {
+		return &web.AzureActiveDirectory{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	aad := input[0]
+	registration := &web.AzureActiveDirectoryRegistrationProperties{
+		OpenIDIssuer: utils.String(aad.TenantAuthURI),
+		ClientID:     utils.String(aad.ClientId),
+	}
+	if aad.ClientSecretSettingName != "" {
+		registration.ClientSecretSettingName = utils.String(aad.ClientSecretSettingName)
+	}
+	if aad.ClientSecretCertificateThumbprint != "" {
+		registration.ClientSecretCertificateThumbprint = utils.String(aad.ClientSecretCertificateThumbprint)
+	}
+
+	loginParameters := make([]string, 0)
+	for k, v := range aad.LoginParameters {
+		loginParameters = append(loginParameters, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	return &web.AzureActiveDirectory{
+		Enabled: utils.Bool(true),
+		Registration: &web.AzureActiveDirectoryRegistration{
+			AzureActiveDirectoryRegistrationProperties: registration,
+		},
+		Login: &web.AzureActiveDirectoryLogin{
+			AzureActiveDirectoryLoginProperties: &web.AzureActiveDirectoryLoginProperties{
+				LoginParameters:        &loginParameters,
+				DisableWWWAuthenticate: utils.Bool(aad.DisableWWWAuth),
+			},
+		},
+		Validation: &web.AzureActiveDirectoryValidation{
+			AzureActiveDirectoryValidationProperties: &web.AzureActiveDirectoryValidationProperties{
+				JwtClaimChecks: &web.JwtClaimChecks{
+					AllowedGroups:             &aad.JWTAllowedGroups,
+					AllowedClientApplications: &aad.JWTAllowedClientApps,
+				},
+				AllowedAudiences: &aad.AllowedAudiences,
+				DefaultAuthorizationPolicy: &web.DefaultAuthorizationPolicy{
+					AllowedPrincipals: &web.AllowedPrincipals{
+						AllowedPrincipalsProperties: &web.AllowedPrincipalsProperties{
+							Groups:     &aad.AllowedGroups,
+							Identities: &aad.AllowedIdentities,
+						},
+					},
+					AllowedApplications: &aad.AllowedApplications,
+				},
+			},
+		},
+	}
+}
+
+func expandFacebookAuthV2Settings(input []FacebookAuthV2Settings) *web.Facebook {
+	if len(input) != 1 {
+		return &web.Facebook{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	facebook := input[0]
+	result := &web.Facebook{
+		Enabled: utils.Bool(true),
+		Registration: &web.AppRegistration{
+			AppRegistrationProperties: &web.AppRegistrationProperties{
+				AppID:                utils.String(facebook.AppId),
+				AppSecretSettingName: utils.String(facebook.AppSecretSettingName),
+			},
+		},
+		Login: &web.LoginScopes{
+			Scopes: &facebook.LoginScopes,
+		},
+	}
+	if facebook.GraphAPIVersion != "" {
+		result.GraphAPIVersion = utils.String(facebook.GraphAPIVersion)
+	}
+
+	return result
+}
+
+func expandGithubAuthV2Settings(input []GithubAuthV2Settings) *web.GitHub {
+	if len(input) != 1 {
+		return &web.GitHub{
+			GitHubProperties: &web.GitHubProperties{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	github := input[0]
+	return &web.GitHub{
+		GitHubProperties: &web.GitHubProperties{
+			Enabled: utils.Bool(true),
+			Registration: &web.ClientRegistration{
+				ClientID:                utils.String(github.ClientId),
+				ClientSecretSettingName: utils.String(github.ClientSecretSettingName),
+			},
+			Login: &web.LoginScopes{
+				Scopes: &github.LoginScopes,
+			},
+		},
+	}
+}
+
+func expandGoogleAuthV2Settings(input []GoogleAuthV2Settings) *web.Google {
+	if len(input) != 1 {
+		return &web.Google{
+			GoogleProperties: &web.GoogleProperties{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	google := input[0]
+	return &web.Google{
+		GoogleProperties: &web.GoogleProperties{
+			Enabled: utils.Bool(true),
+			Registration: &web.ClientRegistration{
+				ClientID:                utils.String(google.ClientId),
+				ClientSecretSettingName: utils.String(google.ClientSecretSettingName),
+			},
+			Login: &web.LoginScopes{
+				Scopes: &google.LoginScopes,
+			},
+			Validation: &web.AllowedAudiencesValidation{
+				AllowedAudiences: &google.AllowedAudiences,
+			},
+		},
+	}
+}
+
+func expandTwitterAuthV2Settings(input []TwitterAuthV2Settings) *web.Twitter {
+	if len(input) != 1 {
+		return &web.Twitter{
+			TwitterProperties: &web.TwitterProperties{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	twitter := input[0]
+	return &web.Twitter{
+		TwitterProperties: &web.TwitterProperties{
+			Enabled: utils.Bool(true),
+			Registration: &web.TwitterRegistration{
+				ConsumerKey:               utils.String(twitter.ConsumerKey),
+				ConsumerSecretSettingName: utils.String(twitter.ConsumerSecretSettingName),
+			},
+		},
+	}
+}
+
+func expandCustomOIDCAuthV2Settings(input []CustomOIDCAuthV2Settings) map[string]*web.CustomOpenIDConnectProvider {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*web.CustomOpenIDConnectProvider)
+	for _, v := range input {
+		scopes := v.Scopes
+		provider := &web.CustomOpenIDConnectProvider{
+			CustomOpenIDConnectProviderProperties: &web.CustomOpenIDConnectProviderProperties{
+				Enabled: utils.Bool(true),
+				Registration: &web.OpenIDConnectRegistration{
+					ClientID: utils.String(v.ClientId),
+					ClientCredential: &web.OpenIDConnectClientCredential{
+						Method:                  web.ClientCredentialMethodClientSecretPost,
+						ClientSecretSettingName: utils.String(customOIDCClientSecretSettingName(v.Name)),
+					},
+					OpenIDConnectConfiguration: &web.OpenIDConnectConfig{
+						WellKnownOpenIDConfiguration: utils.String(v.OpenIDConfigurationEndpoint),
+					},
+				},
+				Login: &web.OpenIDConnectLogin{
+					Scopes: &scopes,
+				},
+			},
+		}
+		if v.NameClaimType != "" {
+			provider.Login.NameClaimType = utils.String(v.NameClaimType)
+		}
+
+		result[v.Name] = provider
+	}
+
+	return result
+}
+
+// customOIDCClientSecretSettingName returns the name of the App Setting which the service expects to contain the
+// Client Secret for the specified Custom OIDC Provider.
+func customOIDCClientSecretSettingName(providerName string) string {
+	return fmt.Sprintf("%s_PROVIDER_AUTHENTICATION_SECRET", strings.ToUpper(providerName))
+}
+
+func FlattenAuthV2Settings(input web.SiteAuthSettingsV2) []AuthV2Settings {
+	props := input.SiteAuthSettingsV2Properties
+	if props == nil {
+		return []AuthV2Settings{}
+	}
+
+	result := AuthV2Settings{}
+
+	if platform := props.Platform; platform != nil {
+		result.AuthEnabled = utils.NormaliseNilableBool(platform.Enabled)
+		result.RuntimeVersion = utils.NormalizeNilableString(platform.RuntimeVersion)
+		result.ConfigFilePath = utils.NormalizeNilableString(platform.ConfigFilePath)
+	}
+
+	if globalValidation := props.GlobalValidation; globalValidation != nil {
+		result.RequireAuth = utils.NormaliseNilableBool(globalValidation.RequireAuthentication)
+		result.UnauthenticatedAction = string(globalValidation.UnauthenticatedClientAction)
+		result.DefaultAuthProvider = utils.NormalizeNilableString(globalValidation.RedirectToProvider)
+		result.ExcludedPaths = flattenAuthV2StringList(globalValidation.ExcludedPaths)
+	}
+
+	if httpSettings := props.HTTPSettings; httpSettings != nil {
+		result.RequireHttps = utils.NormaliseNilableBool(httpSettings.RequireHTTPS)
+		if routes := httpSettings.Routes; routes != nil {
+			result.HttpRoutesAPIPrefix = utils.NormalizeNilableString(routes.APIPrefix)
+		}
+		if proxy := httpSettings.ForwardProxy; proxy != nil {
+			result.ForwardProxyConvention = string(proxy.Convention)
+			result.ForwardProxyCustomHostHeaderName = utils.NormalizeNilableString(proxy.CustomHostHeaderName)
+			result.ForwardProxyCustomSchemeHeaderName = utils.NormalizeNilableString(proxy.CustomProtoHeaderName)
+		}
+	}
+
+	if providers := props.IdentityProviders; providers != nil {
+		result.AppleAuth = flattenAppleAuthV2Settings(providers.Apple)
+		result.AzureActiveDirectoryAuth = flattenAadAuthV2Settings(providers.AzureActiveDirectory)
+		result.FacebookAuth = flattenFacebookAuthV2Settings(providers.Facebook)
+		result.GithubAuth = flattenGithubAuthV2Settings(providers.GitHub)
+		result.GoogleAuth = flattenGoogleAuthV2Settings(providers.Google)
+		result.TwitterAuth = flattenTwitterAuthV2Settings(providers.Twitter)
+		result.CustomOIDCAuth = flattenCustomOIDCAuthV2Settings(providers.CustomOpenIDConnectProviders)
+	}
+
+	result.Login = flattenAuthV2LoginSettings(props.Login)
+
+	return []AuthV2Settings{result}
+}
+
+func flattenAuthV2LoginSettings(input *web.Login) []AuthV2Login {
+	if input == nil {
+		return []AuthV2Login{}
+	}
+
+	result := AuthV2Login{
+		PreserveURLFragmentsForLogins: utils.NormaliseNilableBool(input.PreserveURLFragmentsForLogins),
+		AllowedExternalRedirectURLs:   flattenAuthV2StringList(input.AllowedExternalRedirectUrls),
+	}
+
+	if routes := input.Routes; routes != nil {
+		result.LogoutEndpoint = utils.NormalizeNilableString(routes.LogoutEndpoint)
+	}
+
+	if tokenStore := input.TokenStore; tokenStore != nil {
+		result.TokenStoreEnabled = utils.NormaliseNilableBool(tokenStore.Enabled)
+		if tokenStore.TokenRefreshExtensionHours != nil {
+			result.TokenRefreshExtension = *tokenStore.TokenRefreshExtensionHours
+		}
+		if fs := tokenStore.FileSystem; fs != nil {
+			result.TokenFilesystemPath = utils.NormalizeNilableString(fs.Directory)
+		}
+		if blob := tokenStore.AzureBlobStorage; blob != nil && blob.BlobStorageTokenStoreProperties != nil {
+			result.TokenBlobStorageSAS = utils.NormalizeNilableString(blob.SasURLSettingName)
+		}
+	}
+
+	if cookie := input.CookieExpiration; cookie != nil {
+		result.CookieExpirationConvention = string(cookie.Convention)
+		result.CookieExpirationTime = utils.NormalizeNilableString(cookie.TimeToExpiration)
+	}
+
+	if nonce := input.Nonce; nonce != nil {
+		result.ValidateNonce = utils.NormaliseNilableBool(nonce.ValidateNonce)
+		result.NonceExpirationTime = utils.NormalizeNilableString(nonce.NonceExpirationInterval)
+	}
+
+	return []AuthV2Login{result}
+}
+
+func flattenAppleAuthV2Settings(input *web.Apple) []AppleAuthV2Settings {
+	if input == nil || input.AppleProperties == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []AppleAuthV2Settings{}
+	}
+
+	result := AppleAuthV2Settings{}
+	if registration := input.Registration; registration != nil {
+		result.ClientId = utils.NormalizeNilableString(registration.ClientID)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = flattenAuthV2StringList(login.Scopes)
+	}
+
+	return []AppleAuthV2Settings{result}
+}
+
+func flattenAadAuthV2Settings(input *web.AzureActiveDirectory) []AadAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []AadAuthV2Settings{}
+	}
+
+	result := AadAuthV2Settings{}
+	if registration := input.Registration; registration != nil && registration.AzureActiveDirectoryRegistrationProperties != nil {
+		result.ClientId = utils.NormalizeNilableString(registration.ClientID)
+		result.TenantAuthURI = utils.NormalizeNilableString(registration.OpenIDIssuer)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+		result.ClientSecretCertificateThumbprint = utils.NormalizeNilableString(registration.ClientSecretCertificateThumbprint)
+	}
+
+	if login := input.Login; login != nil && login.AzureActiveDirectoryLoginProperties != nil {
+		result.DisableWWWAuth = utils.NormaliseNilableBool(login.DisableWWWAuthenticate)
+		if login.LoginParameters != nil {
+			loginParameters := make(map[string]string)
+			for _, v := range *login.LoginParameters {
+				if parts := strings.SplitN(v, "=", 2); len(parts) == 2 {
+					loginParameters[parts[0]] = parts[1]
+				}
+			}
+			result.LoginParameters = loginParameters
+		}
+	}
+
+	if aadValidation := input.Validation; aadValidation != nil && aadValidation.AzureActiveDirectoryValidationProperties != nil {
+		result.AllowedAudiences = flattenAuthV2StringList(aadValidation.AllowedAudiences)
+		if jwt := aadValidation.JwtClaimChecks; jwt != nil {
+			result.JWTAllowedGroups = flattenAuthV2StringList(jwt.AllowedGroups)
+			result.JWTAllowedClientApps = flattenAuthV2StringList(jwt.AllowedClientApplications)
+		}
+		if policy := aadValidation.DefaultAuthorizationPolicy; policy != nil {
+			result.AllowedApplications = flattenAuthV2StringList(policy.AllowedApplications)
+			if principals := policy.AllowedPrincipals; principals != nil && principals.AllowedPrincipalsProperties != nil {
+				result.AllowedGroups = flattenAuthV2StringList(principals.Groups)
+				result.AllowedIdentities = flattenAuthV2StringList(principals.Identities)
+			}
+		}
+	}
+
+	return []AadAuthV2Settings{result}
+}
+
+func flattenFacebookAuthV2Settings(input *web.Facebook) []FacebookAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []FacebookAuthV2Settings{}
+	}
+
+	result := FacebookAuthV2Settings{
+		GraphAPIVersion: utils.NormalizeNilableString(input.GraphAPIVersion),
+	}
+	if registration := input.Registration; registration != nil && registration.AppRegistrationProperties != nil {
+		result.AppId = utils.NormalizeNilableString(registration.AppID)
+		result.AppSecretSettingName = utils.NormalizeNilableString(registration.AppSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = flattenAuthV2StringList(login.Scopes)
+	}
+
+	return []FacebookAuthV2Settings{result}
+}
+
+func flattenGithubAuthV2Settings(input *web.GitHub) []GithubAuthV2Settings {
+	if input == nil || input.GitHubProperties == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []GithubAuthV2Settings{}
+	}
+
+	result := GithubAuthV2Settings{}
+	if registration := input.Registration; registration != nil {
+		result.ClientId = utils.NormalizeNilableString(registration.ClientID)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = flattenAuthV2StringList(login.Scopes)
+	}
+
+	return []GithubAuthV2Settings{result}
+}
+
+func flattenGoogleAuthV2Settings(input *web.Google) []GoogleAuthV2Settings {
+	if input == nil || input.GoogleProperties == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []GoogleAuthV2Settings{}
+	}
+
+	result := GoogleAuthV2Settings{}
+	if registration := input.Registration; registration != nil {
+		result.ClientId = utils.NormalizeNilableString(registration.ClientID)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(registration.ClientSecretSettingName)
+	}
+	if login := input.Login; login != nil {
+		result.LoginScopes = flattenAuthV2StringList(login.Scopes)
+	}
+	if googleValidation := input.Validation; googleValidation != nil {
+		result.AllowedAudiences = flattenAuthV2StringList(googleValidation.AllowedAudiences)
+	}
+
+	return []GoogleAuthV2Settings{result}
+}
+
+func flattenTwitterAuthV2Settings(input *web.Twitter) []TwitterAuthV2Settings {
+	if input == nil || input.TwitterProperties == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []TwitterAuthV2Settings{}
+	}
+
+	result := TwitterAuthV2Settings{}
+	if registration := input.Registration; registration != nil {
+		result.ConsumerKey = utils.NormalizeNilableString(registration.ConsumerKey)
+		result.ConsumerSecretSettingName = utils.NormalizeNilableString(registration.ConsumerSecretSettingName)
+	}
+
+	return []TwitterAuthV2Settings{result}
+}
+
+func flattenCustomOIDCAuthV2Settings(input map[string]*web.CustomOpenIDConnectProvider) []CustomOIDCAuthV2Settings {
+	if len(input) == 0 {
+		return []CustomOIDCAuthV2Settings{}
+	}
+
+	result := make([]CustomOIDCAuthV2Settings, 0)
+	for name, v := range input {
+		if v == nil || v.CustomOpenIDConnectProviderProperties == nil || !utils.NormaliseNilableBool(v.Enabled) {
+			continue
+		}
+
+		provider := CustomOIDCAuthV2Settings{
+			Name: name,
+		}
+
+		if registration := v.Registration; registration != nil {
+			provider.ClientId = utils.NormalizeNilableString(registration.ClientID)
+			if credential := registration.ClientCredential; credential != nil {
+				provider.ClientCredentialMethod = string(credential.Method)
+				provider.ClientSecretSettingName = utils.NormalizeNilableString(credential.ClientSecretSettingName)
+			}
+			if config := registration.OpenIDConnectConfiguration; config != nil {
+				provider.OpenIDConfigurationEndpoint = utils.NormalizeNilableString(config.WellKnownOpenIDConfiguration)
+				provider.AuthorizationEndpoint = utils.NormalizeNilableString(config.AuthorizationEndpoint)
+				provider.TokenEndpoint = utils.NormalizeNilableString(config.TokenEndpoint)
+				provider.IssuerEndpoint = utils.NormalizeNilableString(config.Issuer)
+				provider.CertificationURI = utils.NormalizeNilableString(config.CertificationURI)
+			}
+		}
+
+		if login := v.Login; login != nil {
+			provider.NameClaimType = utils.NormalizeNilableString(login.NameClaimType)
+			provider.Scopes = flattenAuthV2StringList(login.Scopes)
+		}
+
+		result = append(result, provider)
+	}
+
+	// the API returns the providers as a map, so these are sorted by name to give a consistent ordering in the state
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+func flattenAuthV2StringList(input *[]string) []string {
+	if input == nil {
+		return []string{}
+	}
+	return *input
+}
@@ -0,0 +1,152 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type functionAppRuntime string
+
+const (
+	functionAppRuntimeDotNet         functionAppRuntime = "dotnet"
+	functionAppRuntimeDotNetIsolated functionAppRuntime = "dotnet-isolated"
+	functionAppRuntimeJava           functionAppRuntime = "java"
+	functionAppRuntimeNode           functionAppRuntime = "node"
+	functionAppRuntimePowerShell     functionAppRuntime = "powershell"
+	functionAppRuntimePython         functionAppRuntime = "python"
+)
+
+// linuxFunctionAppRuntimeVersions and windowsFunctionAppRuntimeVersions are the versions of each runtime which can be
+// specified in the `application_stack` block of Function Apps and Function App Slots. Supporting a new version of a
+// runtime only requires adding it here.
+var linuxFunctionAppRuntimeVersions = map[functionAppRuntime][]string{
+	functionAppRuntimeDotNet:         {"3.1", "6.0"},
+	functionAppRuntimeDotNetIsolated: {"6.0", "7.0", "8.0"},
+	functionAppRuntimeJava:           {"8", "11", "17"},
+	functionAppRuntimeNode:           {"12", "14", "16", "18", "20"},
+	functionAppRuntimePowerShell:     {"7", "7.2"},
+	functionAppRuntimePython:         {"3.7", "3.8", "3.9", "3.10", "3.11", "3.12"},
+}
+
+var windowsFunctionAppRuntimeVersions = map[functionAppRuntime][]string{
+	functionAppRuntimeDotNet:         {"3.1", "6"},
+	functionAppRuntimeDotNetIsolated: {"6", "7", "8"},
+	functionAppRuntimeJava:           {"8", "11", "17"},
+	functionAppRuntimeNode:           {"~12", "~14", "~16", "~18", "~20"},
+	functionAppRuntimePowerShell:     {"7", "7.2"},
+}
+
+func functionAppRuntimeVersions(isLinux bool, runtimes ...functionAppRuntime) []string {
+	versions := linuxFunctionAppRuntimeVersions
+	if !isLinux {
+		versions = windowsFunctionAppRuntimeVersions
+	}
+
+	seen := make(map[string]struct{})
+	result := make([]string, 0)
+	for _, runtime := range runtimes {
+		for _, v := range versions[runtime] {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func functionAppOSName(isLinux bool) string {
+	if isLinux {
+		return "Linux"
+	}
+	return "Windows"
+}
+
+// formatFunctionAppRuntimeVersions returns the list of versions in the form "`a`, `b` and `c`" for use in descriptions
+// and error messages
+func formatFunctionAppRuntimeVersions(versions []string) string {
+	quoted := make([]string, 0, len(versions))
+	for _, v := range versions {
+		quoted = append(quoted, fmt.Sprintf("`%s`", v))
+	}
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+	return fmt.Sprintf("%s and %s", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+}
+
+func functionAppRuntimeVersionDescription(prefix string, isLinux bool, runtimes ...functionAppRuntime) string {
+	return fmt.Sprintf("%s Possible values are %s.", prefix, formatFunctionAppRuntimeVersions(functionAppRuntimeVersions(isLinux, runtimes...)))
+}
+
+// validateFunctionAppRuntimeVersion returns a SchemaValidateFunc which checks that the value is a supported version of
+// any of the specified runtimes on the specified Operating System.
+func validateFunctionAppRuntimeVersion(isLinux bool, runtimes ...functionAppRuntime) pluginsdk.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		supported := functionAppRuntimeVersions(isLinux, runtimes...)
+		if utils.SliceContainsValue(supported, v) {
+			return
+		}
+
+		message := fmt.Sprintf("%q is not a supported value for %q on %s Function Apps, possible values are %s", v, k, functionAppOSName(isLinux), formatFunctionAppRuntimeVersions(supported))
+		if utils.SliceContainsValue(functionAppRuntimeVersions(!isLinux, runtimes...), v) {
+			message = fmt.Sprintf("%s - %q is only supported on %s Function Apps", message, v, functionAppOSName(!isLinux))
+		}
+		errors = append(errors, fmt.Errorf("%s", message))
+		return
+	}
+}
+
+// ValidateLinuxFunctionAppApplicationStack checks at plan time that the combination of options in the
+// `application_stack` block of a Linux Function App or Linux Function App Slot is supported.
+func ValidateLinuxFunctionAppApplicationStack(rd *pluginsdk.ResourceDiff) error {
+	return validateFunctionAppApplicationStack(rd, true)
+}
+
+// ValidateWindowsFunctionAppApplicationStack checks at plan time that the combination of options in the
+// `application_stack` block of a Windows Function App or Windows Function App Slot is supported.
+func ValidateWindowsFunctionAppApplicationStack(rd *pluginsdk.ResourceDiff) error {
+	return validateFunctionAppApplicationStack(rd, false)
+}
+
+func validateFunctionAppApplicationStack(rd *pluginsdk.ResourceDiff, isLinux bool) error {
+	dotNetVersion, ok := rd.GetOk("site_config.0.application_stack.0.dotnet_version")
+	if !ok || dotNetVersion.(string) == "" {
+		return nil
+	}
+
+	return validateFunctionAppDotNetVersion(dotNetVersion.(string), rd.Get("site_config.0.application_stack.0.use_dotnet_isolated_runtime").(bool), isLinux)
+}
+
+// validateFunctionAppDotNetVersion checks that the .Net version is supported by the in-process or isolated worker runtime
+func validateFunctionAppDotNetVersion(version string, isolated bool, isLinux bool) error {
+	inProcessVersions := functionAppRuntimeVersions(isLinux, functionAppRuntimeDotNet)
+	isolatedVersions := functionAppRuntimeVersions(isLinux, functionAppRuntimeDotNetIsolated)
+
+	if isolated && !utils.SliceContainsValue(isolatedVersions, version) {
+		if utils.SliceContainsValue(inProcessVersions, version) {
+			return fmt.Errorf(".Net version %q is not supported by the isolated worker runtime on %s Function Apps, either set `use_dotnet_isolated_runtime` to `false` or use one of %s", version, functionAppOSName(isLinux), formatFunctionAppRuntimeVersions(isolatedVersions))
+		}
+		return fmt.Errorf(".Net (isolated) version %q is not supported on %s Function Apps, possible values are %s", version, functionAppOSName(isLinux), formatFunctionAppRuntimeVersions(isolatedVersions))
+	}
+
+	if !isolated && !utils.SliceContainsValue(inProcessVersions, version) {
+		if utils.SliceContainsValue(isolatedVersions, version) {
+			return fmt.Errorf(".Net version %q is only supported by the isolated worker runtime on %s Function Apps, either set `use_dotnet_isolated_runtime` to `true` or use one of %s", version, functionAppOSName(isLinux), formatFunctionAppRuntimeVersions(inProcessVersions))
+		}
+		return fmt.Errorf(".Net version %q is not supported on %s Function Apps, possible values are %s", version, functionAppOSName(isLinux), formatFunctionAppRuntimeVersions(inProcessVersions))
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package helpers
+
+import "testing"
+
+func TestValidateFunctionAppRuntimeVersion(t *testing.T) {
+	cases := []struct {
+		Input    string
+		IsLinux  bool
+		Runtimes []functionAppRuntime
+		Valid    bool
+	}{
+		{
+			Input:    "17",
+			IsLinux:  true,
+			Runtimes: []functionAppRuntime{functionAppRuntimeJava},
+			Valid:    true,
+		},
+		{
+			Input:    "17",
+			IsLinux:  false,
+			Runtimes: []functionAppRuntime{functionAppRuntimeJava},
+			Valid:    true,
+		},
+		{
+			Input:    "7",
+			IsLinux:  true,
+			Runtimes: []functionAppRuntime{functionAppRuntimeJava},
+			Valid:    false,
+		},
+		{
+			Input:    "20",
+			IsLinux:  true,
+			Runtimes: []functionAppRuntime{functionAppRuntimeNode},
+			Valid:    true,
+		},
+		{
+			// Windows Node versions are prefixed with `~`
+			Input:    "20",
+			IsLinux:  false,
+			Runtimes: []functionAppRuntime{functionAppRuntimeNode},
+			Valid:    false,
+		},
+		{
+			Input:    "~20",
+			IsLinux:  false,
+			Runtimes: []functionAppRuntime{functionAppRuntimeNode},
+			Valid:    true,
+		},
+		{
+			Input:    "3.12",
+			IsLinux:  true,
+			Runtimes: []functionAppRuntime{functionAppRuntimePython},
+			Valid:    true,
+		},
+		{
+			// Python is not supported on Windows
+			Input:    "3.12",
+			IsLinux:  false,
+			Runtimes: []functionAppRuntime{functionAppRuntimePython},
+			Valid:    false,
+		},
+		{
+			Input:    "8.0",
+			IsLinux:  true,
+			Runtimes: []functionAppRuntime{functionAppRuntimeDotNet, functionAppRuntimeDotNetIsolated},
+			Valid:    true,
+		},
+		{
+			Input:    "8.0",
+			IsLinux:  false,
+			Runtimes: []functionAppRuntime{functionAppRuntimeDotNet, functionAppRuntimeDotNetIsolated},
+			Valid:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q (Linux: %t)", tc.Input, tc.IsLinux)
+
+		_, errs := validateFunctionAppRuntimeVersion(tc.IsLinux, tc.Runtimes...)(tc.Input, "test")
+		if valid := len(errs) == 0; valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t (%+v)", tc.Valid, valid, errs)
+		}
+	}
+}
+
+func TestValidateFunctionAppDotNetVersion(t *testing.T) {
+	cases := []struct {
+		Version  string
+		Isolated bool
+		IsLinux  bool
+		Valid    bool
+	}{
+		{
+			Version: "3.1",
+			IsLinux: true,
+			Valid:   true,
+		},
+		{
+			Version:  "3.1",
+			Isolated: true,
+			IsLinux:  true,
+			Valid:    false,
+		},
+		{
+			Version:  "6.0",
+			Isolated: true,
+			IsLinux:  true,
+			Valid:    true,
+		},
+		{
+			// .Net 8 is only available using the isolated worker runtime
+			Version: "8.0",
+			IsLinux: true,
+			Valid:   false,
+		},
+		{
+			Version:  "8.0",
+			Isolated: true,
+			IsLinux:  true,
+			Valid:    true,
+		},
+		{
+			Version:  "8",
+			Isolated: true,
+			IsLinux:  false,
+			Valid:    true,
+		},
+		{
+			Version: "6",
+			IsLinux: false,
+			Valid:   true,
+		},
+		{
+			Version: "7",
+			IsLinux: false,
+			Valid:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q (Isolated: %t, Linux: %t)", tc.Version, tc.Isolated, tc.IsLinux)
+
+		err := validateFunctionAppDotNetVersion(tc.Version, tc.Isolated, tc.IsLinux)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t (%+v)", tc.Valid, valid, err)
+		}
+	}
+}
+
+func TestFormatFunctionAppRuntimeVersions(t *testing.T) {
+	cases := map[string][]string{
+		"":                   {},
+		"`8`":                {"8"},
+		"`8` and `11`":       {"8", "11"},
+		"`8`, `11` and `17`": {"8", "11", "17"},
+	}
+
+	for expected, input := range cases {
+		if actual := formatFunctionAppRuntimeVersions(input); actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
@@ -34,6 +34,7 @@ type SiteConfigLinuxFunctionApp struct {
 	ElasticInstanceMinimum        int                                `tfschema:"elastic_instance_minimum"`
 	Http2Enabled                  bool                               `tfschema:"http2_enabled"`
 	IpRestriction                 []IpRestriction                    `tfschema:"ip_restriction"`
+	IpRestrictionDefaultAction    string                             `tfschema:"ip_restriction_default_action"`
 	LoadBalancing                 string                             `tfschema:"load_balancing_mode"` // TODO - Valid for FunctionApps?
 	ManagedPipelineMode           string                             `tfschema:"managed_pipeline_mode"`
 	PreWarmedInstanceCount        int                                `tfschema:"pre_warmed_instance_count"`
@@ -41,6 +42,7 @@ type SiteConfigLinuxFunctionApp struct {
 	RemoteDebuggingVersion        string                             `tfschema:"remote_debugging_version"`
 	RuntimeScaleMonitoring        bool                               `tfschema:"runtime_scale_monitoring_enabled"`
 	ScmIpRestriction              []IpRestriction                    `tfschema:"scm_ip_restriction"`
+	ScmIpRestrictionDefaultAction string                             `tfschema:"scm_ip_restriction_default_action"`
 	ScmType                       string                             `tfschema:"scm_type"` // Computed?
 	ScmUseMainIpRestriction       bool                               `tfschema:"scm_use_main_ip_restriction"`
 	Use32BitWorker                bool                               `tfschema:"use_32_bit_worker"`
@@ -160,6 +162,8 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 
 				"ip_restriction": IpRestrictionSchema(),
 
+				"ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"scm_use_main_ip_restriction": {
 					Type:        pluginsdk.TypeBool,
 					Optional:    true,
@@ -169,6 +173,8 @@ func SiteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
 
 				"scm_ip_restriction": IpRestrictionSchema(),
 
+				"scm_ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"load_balancing_mode": { // Supported on Function Apps?
 					Type:     pluginsdk.TypeString,
 					Optional: true,
@@ -404,6 +410,8 @@ func SiteConfigSchemaLinuxFunctionAppComputed() *pluginsdk.Schema {
 
 				"ip_restriction": IpRestrictionSchemaComputed(),
 
+				"ip_restriction_default_action": IpRestrictionDefaultActionSchemaComputed(),
+
 				"scm_use_main_ip_restriction": {
 					Type:     pluginsdk.TypeBool,
 					Computed: true,
@@ -411,6 +419,8 @@ func SiteConfigSchemaLinuxFunctionAppComputed() *pluginsdk.Schema {
 
 				"scm_ip_restriction": IpRestrictionSchemaComputed(),
 
+				"scm_ip_restriction_default_action": IpRestrictionDefaultActionSchemaComputed(),
+
 				"load_balancing_mode": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -520,6 +530,7 @@ type SiteConfigWindowsFunctionApp struct {
 	ElasticInstanceMinimum        int                                  `tfschema:"elastic_instance_minimum"`
 	Http2Enabled                  bool                                 `tfschema:"http2_enabled"`
 	IpRestriction                 []IpRestriction                      `tfschema:"ip_restriction"`
+	IpRestrictionDefaultAction    string                               `tfschema:"ip_restriction_default_action"`
 	LoadBalancing                 string                               `tfschema:"load_balancing_mode"` // TODO - Valid for FunctionApps?
 	ManagedPipelineMode           string                               `tfschema:"managed_pipeline_mode"`
 	PreWarmedInstanceCount        int                                  `tfschema:"pre_warmed_instance_count"`
@@ -527,6 +538,7 @@ type SiteConfigWindowsFunctionApp struct {
 	RemoteDebuggingVersion        string                               `tfschema:"remote_debugging_version"`
 	RuntimeScaleMonitoring        bool                                 `tfschema:"runtime_scale_monitoring_enabled"`
 	ScmIpRestriction              []IpRestriction                      `tfschema:"scm_ip_restriction"`
+	ScmIpRestrictionDefaultAction string                               `tfschema:"scm_ip_restriction_default_action"`
 	ScmType                       string                               `tfschema:"scm_type"` // Computed?
 	ScmUseMainIpRestriction       bool                                 `tfschema:"scm_use_main_ip_restriction"`
 	Use32BitWorker                bool                                 `tfschema:"use_32_bit_worker"`
@@ -632,6 +644,8 @@ func SiteConfigSchemaWindowsFunctionApp() *pluginsdk.Schema {
 
 				"ip_restriction": IpRestrictionSchema(),
 
+				"ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"scm_use_main_ip_restriction": {
 					Type:        pluginsdk.TypeBool,
 					Optional:    true,
@@ -641,6 +655,8 @@ func SiteConfigSchemaWindowsFunctionApp() *pluginsdk.Schema {
 
 				"scm_ip_restriction": IpRestrictionSchema(),
 
+				"scm_ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"load_balancing_mode": { // Supported on Function Apps?
 					Type:     pluginsdk.TypeString,
 					Optional: true,
@@ -867,6 +883,8 @@ func SiteConfigSchemaWindowsFunctionAppComputed() *pluginsdk.Schema {
 
 				"ip_restriction": IpRestrictionSchemaComputed(),
 
+				"ip_restriction_default_action": IpRestrictionDefaultActionSchemaComputed(),
+
 				"scm_use_main_ip_restriction": {
 					Type:     pluginsdk.TypeBool,
 					Computed: true,
@@ -874,6 +892,8 @@ func SiteConfigSchemaWindowsFunctionAppComputed() *pluginsdk.Schema {
 
 				"scm_ip_restriction": IpRestrictionSchemaComputed(),
 
+				"scm_ip_restriction_default_action": IpRestrictionDefaultActionSchemaComputed(),
+
 				"load_balancing_mode": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -972,23 +992,25 @@ func SiteConfigSchemaWindowsFunctionAppComputed() *pluginsdk.Schema {
 
 type ApplicationStackLinuxFunctionApp struct {
 	// Note - Function Apps differ to Web Apps here. They do not use the named properties in the SiteConfig block and exclusively use the app_settings map
-	DotNetVersion         string                   `tfschema:"dotnet_version"`              // Supported values `3.1`, `6`.
+	// Supported versions for each runtime are listed in linuxFunctionAppRuntimeVersions
+	DotNetVersion         string                   `tfschema:"dotnet_version"`
 	DotNetIsolated        bool                     `tfschema:"use_dotnet_isolated_runtime"` // Supported values `true` for `dotnet-isolated`, `false` otherwise
-	NodeVersion           string                   `tfschema:"node_version"`                // Supported values `12LTS`, `14LTS`
-	PythonVersion         string                   `tfschema:"python_version"`              // Supported values `3.9`, `3.8`, `3.7`
-	PowerShellCoreVersion string                   `tfschema:"powershell_core_version"`     // Supported values are `7.0`, `7.2`
-	JavaVersion           string                   `tfschema:"java_version"`                // Supported values `8`, `11`
-	CustomHandler         bool                     `tfschema:"use_custom_runtime"`          // Supported values `true`
-	Docker                []ApplicationStackDocker `tfschema:"docker"`                      // Needs ElasticPremium or Basic (B1) Standard (S 1-3) or Premium(PxV2 or PxV3) LINUX Service Plan
+	NodeVersion           string                   `tfschema:"node_version"`
+	PythonVersion         string                   `tfschema:"python_version"`
+	PowerShellCoreVersion string                   `tfschema:"powershell_core_version"`
+	JavaVersion           string                   `tfschema:"java_version"`
+	CustomHandler         bool                     `tfschema:"use_custom_runtime"` // Supported values `true`
+	Docker                []ApplicationStackDocker `tfschema:"docker"`             // Needs ElasticPremium or Basic (B1) Standard (S 1-3) or Premium(PxV2 or PxV3) LINUX Service Plan
 }
 
 type ApplicationStackWindowsFunctionApp struct {
-	DotNetVersion         string `tfschema:"dotnet_version"`              // Supported values `3.1`. Version 6 is in preview on Windows Only
+	// Supported versions for each runtime are listed in windowsFunctionAppRuntimeVersions
+	DotNetVersion         string `tfschema:"dotnet_version"`
 	DotNetIsolated        bool   `tfschema:"use_dotnet_isolated_runtime"` // Supported values `true` for `dotnet-isolated`, `false` otherwise
-	NodeVersion           string `tfschema:"node_version"`                // Supported values `12LTS`, `14LTS`
-	JavaVersion           string `tfschema:"java_version"`                // Supported values `8`, `11`
-	PowerShellCoreVersion string `tfschema:"powershell_core_version"`     // Supported values are `7.0`, `7.2`
-	CustomHandler         bool   `tfschema:"use_custom_runtime"`          // Supported values `true`
+	NodeVersion           string `tfschema:"node_version"`
+	JavaVersion           string `tfschema:"java_version"`
+	PowerShellCoreVersion string `tfschema:"powershell_core_version"`
+	CustomHandler         bool   `tfschema:"use_custom_runtime"` // Supported values `true`
 }
 
 type ApplicationStackDocker struct {
@@ -1007,12 +1029,9 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
 				"dotnet_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"3.1",
-						"6.0",
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(true, functionAppRuntimeDotNet, functionAppRuntimeDotNetIsolated),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.python_version",
@@ -1022,7 +1041,7 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of .Net. Possible values are `3.1` and `6.0`",
+					Description: functionAppRuntimeVersionDescription("The version of .Net.", true, functionAppRuntimeDotNet, functionAppRuntimeDotNetIsolated),
 				},
 
 				"use_dotnet_isolated_runtime": {
@@ -1041,13 +1060,9 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 				},
 
 				"python_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"3.9",
-						"3.8",
-						"3.7",
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(true, functionAppRuntimePython),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.python_version",
@@ -1057,17 +1072,13 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of Python to use. Possible values include `3.9`, `3.8`, and `3.7`.",
+					Description: functionAppRuntimeVersionDescription("The version of Python to use.", true, functionAppRuntimePython),
 				},
 
 				"node_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"12",
-						"14",
-						"16", // preview LTS Support
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(true, functionAppRuntimeNode),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.python_version",
@@ -1077,16 +1088,13 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of Node to use. Possible values include `12`, and `14`",
+					Description: functionAppRuntimeVersionDescription("The version of Node to use.", true, functionAppRuntimeNode),
 				},
 
 				"powershell_core_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"7",
-						"7.2", // preview LTS Support
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(true, functionAppRuntimePowerShell),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.python_version",
@@ -1096,16 +1104,13 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of PowerShell Core to use. Possibles values are `7`, and `7.2`",
+					Description: functionAppRuntimeVersionDescription("The version of PowerShell Core to use.", true, functionAppRuntimePowerShell),
 				},
 
 				"java_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"8",
-						"11",
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(true, functionAppRuntimeJava),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.python_version",
@@ -1115,7 +1120,7 @@ func linuxFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.docker",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of Java to use. Possible values are `8`, and `11`",
+					Description: functionAppRuntimeVersionDescription("The version of Java to use.", true, functionAppRuntimeJava),
 				},
 
 				"docker": {
@@ -1276,12 +1281,9 @@ func windowsFunctionAppStackSchema() *pluginsdk.Schema {
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
 				"dotnet_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"3.1",
-						"6",
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(false, functionAppRuntimeDotNet, functionAppRuntimeDotNetIsolated),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.java_version",
@@ -1289,7 +1291,7 @@ func windowsFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of .Net. Possible values are `3.1` and `6`",
+					Description: functionAppRuntimeVersionDescription("The version of .Net.", false, functionAppRuntimeDotNet, functionAppRuntimeDotNetIsolated),
 				},
 
 				"use_dotnet_isolated_runtime": {
@@ -1306,13 +1308,9 @@ func windowsFunctionAppStackSchema() *pluginsdk.Schema {
 				},
 
 				"node_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"~12",
-						"~14",
-						"~16",
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(false, functionAppRuntimeNode),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.java_version",
@@ -1320,16 +1318,13 @@ func windowsFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of Node to use. Possible values include `12`, and `14`",
+					Description: functionAppRuntimeVersionDescription("The version of Node to use.", false, functionAppRuntimeNode),
 				},
 
 				"java_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"8",
-						"11",
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(false, functionAppRuntimeJava),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.java_version",
@@ -1337,16 +1332,13 @@ func windowsFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The version of Java to use. Possible values are `8`, and `11`",
+					Description: functionAppRuntimeVersionDescription("The version of Java to use.", false, functionAppRuntimeJava),
 				},
 
 				"powershell_core_version": {
-					Type:     pluginsdk.TypeString,
-					Optional: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						"7",
-						"7.2", // preview LTS Support
-					}, false),
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validateFunctionAppRuntimeVersion(false, functionAppRuntimePowerShell),
 					ExactlyOneOf: []string{
 						"site_config.0.application_stack.0.dotnet_version",
 						"site_config.0.application_stack.0.java_version",
@@ -1354,7 +1346,7 @@ func windowsFunctionAppStackSchema() *pluginsdk.Schema {
 						"site_config.0.application_stack.0.powershell_core_version",
 						"site_config.0.application_stack.0.use_custom_runtime",
 					},
-					Description: "The PowerShell Core version to use. Possible values are `7`, and `7.2`",
+					Description: functionAppRuntimeVersionDescription("The version of PowerShell Core to use.", false, functionAppRuntimePowerShell),
 				},
 
 				"use_custom_runtime": {
@@ -27,6 +27,7 @@ type SiteConfigWindowsFunctionAppSlot struct {
 	ElasticInstanceMinimum        int                                  `tfschema:"elastic_instance_minimum"`
 	Http2Enabled                  bool                                 `tfschema:"http2_enabled"`
 	IpRestriction                 []IpRestriction                      `tfschema:"ip_restriction"`
+	IpRestrictionDefaultAction    string                               `tfschema:"ip_restriction_default_action"`
 	LoadBalancing                 string                               `tfschema:"load_balancing_mode"` // TODO - Valid for FunctionApps?
 	ManagedPipelineMode           string                               `tfschema:"managed_pipeline_mode"`
 	PreWarmedInstanceCount        int                                  `tfschema:"pre_warmed_instance_count"`
@@ -34,6 +35,7 @@ type SiteConfigWindowsFunctionAppSlot struct {
 	RemoteDebuggingVersion        string                               `tfschema:"remote_debugging_version"`
 	RuntimeScaleMonitoring        bool                                 `tfschema:"runtime_scale_monitoring_enabled"`
 	ScmIpRestriction              []IpRestriction                      `tfschema:"scm_ip_restriction"`
+	ScmIpRestrictionDefaultAction string                               `tfschema:"scm_ip_restriction_default_action"`
 	ScmType                       string                               `tfschema:"scm_type"` // Computed?
 	ScmUseMainIpRestriction       bool                                 `tfschema:"scm_use_main_ip_restriction"`
 	Use32BitWorker                bool                                 `tfschema:"use_32_bit_worker"`
@@ -86,11 +88,11 @@ func SiteConfigSchemaWindowsFunctionAppSlot() *pluginsdk.Schema {
 				},
 
 				"app_scale_limit": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true,
-					Description: "The number of workers this function app can scale out to. Only applicable to apps on the Consumption and Premium plan.",
-					// TODO Validation?
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of workers this function app can scale out to. Only applicable to apps on the Consumption and Premium plan.",
 				},
 
 				"application_insights_key": {
@@ -136,10 +138,11 @@ func SiteConfigSchemaWindowsFunctionAppSlot() *pluginsdk.Schema {
 				},
 
 				"elastic_instance_minimum": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true,
-					Description: "The number of minimum instances for this Windows Function App. Only affects apps on Elastic Premium plans.",
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 20),
+					Description:  "The number of minimum instances for this Windows Function App. Only affects apps on Elastic Premium plans.",
 				},
 
 				"http2_enabled": {
@@ -151,6 +154,8 @@ func SiteConfigSchemaWindowsFunctionAppSlot() *pluginsdk.Schema {
 
 				"ip_restriction": IpRestrictionSchema(),
 
+				"ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"scm_use_main_ip_restriction": {
 					Type:        pluginsdk.TypeBool,
 					Optional:    true,
@@ -160,6 +165,8 @@ func SiteConfigSchemaWindowsFunctionAppSlot() *pluginsdk.Schema {
 
 				"scm_ip_restriction": IpRestrictionSchema(),
 
+				"scm_ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"load_balancing_mode": { // Supported on Function Apps?
 					Type:     pluginsdk.TypeString,
 					Optional: true,
@@ -187,10 +194,11 @@ func SiteConfigSchemaWindowsFunctionAppSlot() *pluginsdk.Schema {
 				},
 
 				"pre_warmed_instance_count": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true, // Variable defaults depending on plan etc
-					Description: "The number of pre-warmed instances for this function app. Only affects apps on an Elastic Premium plan.",
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true, // Variable defaults depending on plan etc
+					ValidateFunc: validation.IntBetween(0, 20),
+					Description:  "The number of pre-warmed instances for this function app. Only affects apps on an Elastic Premium plan.",
 				},
 
 				"remote_debugging_enabled": {
@@ -336,6 +344,7 @@ type SiteConfigLinuxFunctionAppSlot struct {
 	ElasticInstanceMinimum        int                                `tfschema:"elastic_instance_minimum"`
 	Http2Enabled                  bool                               `tfschema:"http2_enabled"`
 	IpRestriction                 []IpRestriction                    `tfschema:"ip_restriction"`
+	IpRestrictionDefaultAction    string                             `tfschema:"ip_restriction_default_action"`
 	LoadBalancing                 string                             `tfschema:"load_balancing_mode"` // TODO - Valid for FunctionApps?
 	ManagedPipelineMode           string                             `tfschema:"managed_pipeline_mode"`
 	PreWarmedInstanceCount        int                                `tfschema:"pre_warmed_instance_count"`
@@ -343,6 +352,7 @@ type SiteConfigLinuxFunctionAppSlot struct {
 	RemoteDebuggingVersion        string                             `tfschema:"remote_debugging_version"`
 	RuntimeScaleMonitoring        bool                               `tfschema:"runtime_scale_monitoring_enabled"`
 	ScmIpRestriction              []IpRestriction                    `tfschema:"scm_ip_restriction"`
+	ScmIpRestrictionDefaultAction string                             `tfschema:"scm_ip_restriction_default_action"`
 	ScmType                       string                             `tfschema:"scm_type"` // Computed?
 	ScmUseMainIpRestriction       bool                               `tfschema:"scm_use_main_ip_restriction"`
 	Use32BitWorker                bool                               `tfschema:"use_32_bit_worker"`
@@ -395,11 +405,11 @@ func SiteConfigSchemaLinuxFunctionAppSlot() *pluginsdk.Schema {
 				},
 
 				"app_scale_limit": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true,
-					Description: "The number of workers this function app can scale out to. Only applicable to apps on the Consumption and Premium plan.",
-					// TODO Validation?
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of workers this function app can scale out to. Only applicable to apps on the Consumption and Premium plan.",
 				},
 
 				"application_insights_key": {
@@ -459,10 +469,11 @@ func SiteConfigSchemaLinuxFunctionAppSlot() *pluginsdk.Schema {
 				},
 
 				"elastic_instance_minimum": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true,
-					Description: "The number of minimum instances for this Linux Function App. Only affects apps on Elastic Premium plans.",
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 20),
+					Description:  "The number of minimum instances for this Linux Function App. Only affects apps on Elastic Premium plans.",
 				},
 
 				"http2_enabled": {
@@ -474,6 +485,8 @@ func SiteConfigSchemaLinuxFunctionAppSlot() *pluginsdk.Schema {
 
 				"ip_restriction": IpRestrictionSchema(),
 
+				"ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"scm_use_main_ip_restriction": {
 					Type:        pluginsdk.TypeBool,
 					Optional:    true,
@@ -483,6 +496,8 @@ func SiteConfigSchemaLinuxFunctionAppSlot() *pluginsdk.Schema {
 
 				"scm_ip_restriction": IpRestrictionSchema(),
 
+				"scm_ip_restriction_default_action": IpRestrictionDefaultActionSchema(),
+
 				"load_balancing_mode": { // Supported on Function Apps?
 					Type:     pluginsdk.TypeString,
 					Optional: true,
@@ -510,10 +525,11 @@ func SiteConfigSchemaLinuxFunctionAppSlot() *pluginsdk.Schema {
 				},
 
 				"pre_warmed_instance_count": {
-					Type:        pluginsdk.TypeInt,
-					Optional:    true,
-					Computed:    true, // Variable defaults depending on plan etc
-					Description: "The number of pre-warmed instances for this function app. Only affects apps on an Elastic Premium plan.",
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true, // Variable defaults depending on plan etc
+					ValidateFunc: validation.IntBetween(0, 20),
+					Description:  "The number of pre-warmed instances for this function app. Only affects apps on an Elastic Premium plan.",
 				},
 
 				"remote_debugging_enabled": {
@@ -643,6 +659,45 @@ func SiteConfigSchemaLinuxFunctionAppSlot() *pluginsdk.Schema {
 	}
 }
 
+func SiteConfigSchemaLinuxFunctionAppSlotComputed() *pluginsdk.Schema {
+	// The Slot Site Config differs from the parent App only by the addition of `auto_swap_slot_name`
+	s := SiteConfigSchemaLinuxFunctionAppComputed()
+	s.Elem.(*pluginsdk.Resource).Schema["auto_swap_slot_name"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+
+	return s
+}
+
+// scaleSettingsSource is satisfied by both *pluginsdk.ResourceDiff and *pluginsdk.ResourceData
+type scaleSettingsSource interface {
+	GetOk(key string) (interface{}, bool)
+	HasChange(key string) bool
+}
+
+// CheckFunctionAppSlotScaleSettings ensures the scaling properties set in `site_config` are supported by the Service Plan SKU the Slot is running on.
+func CheckFunctionAppSlotScaleSettings(rd scaleSettingsSource, planSku *string) error {
+	// Note: `pre_warmed_instance_count` is accepted, and ignored, by the service on non-Elastic plans so is not checked here
+	if !PlanIsElastic(planSku) {
+		if _, ok := rd.GetOk("site_config.0.elastic_instance_minimum"); ok && rd.HasChange("site_config.0.elastic_instance_minimum") {
+			return fmt.Errorf("`site_config.0.elastic_instance_minimum` can only be set for Function App Slots on Elastic Premium Service Plans")
+		}
+
+		if v, ok := rd.GetOk("site_config.0.runtime_scale_monitoring_enabled"); ok && v.(bool) {
+			return fmt.Errorf("`site_config.0.runtime_scale_monitoring_enabled` can only be enabled for Function App Slots on Elastic Premium Service Plans")
+		}
+	}
+
+	if !PlanIsElastic(planSku) && !PlanIsConsumption(planSku) {
+		if _, ok := rd.GetOk("site_config.0.app_scale_limit"); ok && rd.HasChange("site_config.0.app_scale_limit") {
+			return fmt.Errorf("`site_config.0.app_scale_limit` can only be set for Function App Slots on Consumption or Elastic Premium Service Plans")
+		}
+	}
+
+	return nil
+}
+
 func ExpandSiteConfigWindowsFunctionAppSlot(siteConfig []SiteConfigWindowsFunctionAppSlot, existing *web.SiteConfig, metadata sdk.ResourceMetaData, version string, storageString string, storageUsesMSI bool) (*web.SiteConfig, error) {
 	if len(siteConfig) == 0 {
 		return nil, nil
@@ -864,6 +919,14 @@ func ExpandSiteConfigWindowsFunctionAppSlot(siteConfig []SiteConfigWindowsFuncti
 		expanded.PreWarmedInstanceCount = utils.Int32(int32(windowsSlotSiteConfig.PreWarmedInstanceCount))
 	}
 
+	if metadata.ResourceData.HasChange("site_config.0.runtime_scale_monitoring_enabled") {
+		expanded.FunctionsRuntimeScaleMonitoringEnabled = utils.Bool(windowsSlotSiteConfig.RuntimeScaleMonitoring)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.elastic_instance_minimum") {
+		expanded.MinimumElasticInstanceCount = utils.Int32(int32(windowsSlotSiteConfig.ElasticInstanceMinimum))
+	}
+
 	expanded.AppSettings = &appSettings
 
 	return expanded, nil
@@ -1198,6 +1261,14 @@ func ExpandSiteConfigLinuxFunctionAppSlot(siteConfig []SiteConfigLinuxFunctionAp
 		expanded.PreWarmedInstanceCount = utils.Int32(int32(linuxSlotSiteConfig.PreWarmedInstanceCount))
 	}
 
+	if metadata.ResourceData.HasChange("site_config.0.runtime_scale_monitoring_enabled") {
+		expanded.FunctionsRuntimeScaleMonitoringEnabled = utils.Bool(linuxSlotSiteConfig.RuntimeScaleMonitoring)
+	}
+
+	if metadata.ResourceData.HasChange("site_config.0.elastic_instance_minimum") {
+		expanded.MinimumElasticInstanceCount = utils.Int32(int32(linuxSlotSiteConfig.ElasticInstanceMinimum))
+	}
+
 	expanded.AppSettings = &appSettings
 
 	return expanded, nil
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	IpRestrictionDefaultActionAllow = "Allow"
+	IpRestrictionDefaultActionDeny  = "Deny"
+)
+
+func IpRestrictionDefaultActionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  IpRestrictionDefaultActionAllow,
+		ValidateFunc: validation.StringInSlice([]string{
+			IpRestrictionDefaultActionAllow,
+			IpRestrictionDefaultActionDeny,
+		}, false),
+		Description: "The action to take for requests which don't match any of the IP Restrictions. Possible values are `Allow` and `Deny`. Defaults to `Allow`.",
+	}
+}
+
+func IpRestrictionDefaultActionSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+}
+
+// UpdateIpRestrictionDefaultActions sets the default actions for the IP Restrictions and SCM IP Restrictions of the
+// App - or of the specified Slot when `slotName` is not empty.
+func UpdateIpRestrictionDefaultActions(ctx context.Context, client *web.AppsClient, resourceGroupName, name, slotName, ipRestrictionDefaultAction, scmIpRestrictionDefaultAction string) error {
+	input := azuresdkhacks.SiteConfigDefaultActions{
+		IPSecurityRestrictionsDefaultAction:    utils.String(ipRestrictionDefaultAction),
+		ScmIPSecurityRestrictionsDefaultAction: utils.String(scmIpRestrictionDefaultAction),
+	}
+	if _, err := azuresdkhacks.NewSiteConfigDefaultActionsClient(client).Update(ctx, resourceGroupName, name, slotName, input); err != nil {
+		return fmt.Errorf("updating IP Restriction Default Actions: %+v", err)
+	}
+
+	return nil
+}
+
+// GetIpRestrictionDefaultActions returns the default actions for the IP Restrictions and SCM IP Restrictions of the
+// App - or of the specified Slot when `slotName` is not empty. The API omits these for Apps where they have never been
+// set, which behave as if they're `Allow`.
+func GetIpRestrictionDefaultActions(ctx context.Context, client *web.AppsClient, resourceGroupName, name, slotName string) (ipRestrictionDefaultAction string, scmIpRestrictionDefaultAction string, err error) {
+	resp, err := azuresdkhacks.NewSiteConfigDefaultActionsClient(client).Get(ctx, resourceGroupName, name, slotName)
+	if err != nil {
+		return "", "", fmt.Errorf("retrieving IP Restriction Default Actions: %+v", err)
+	}
+
+	ipRestrictionDefaultAction = IpRestrictionDefaultActionAllow
+	scmIpRestrictionDefaultAction = IpRestrictionDefaultActionAllow
+	if props := resp.Properties; props != nil {
+		if v := props.IPSecurityRestrictionsDefaultAction; v != nil && *v != "" {
+			ipRestrictionDefaultAction = *v
+		}
+		if v := props.ScmIPSecurityRestrictionsDefaultAction; v != nil && *v != "" {
+			scmIpRestrictionDefaultAction = *v
+		}
+	}
+
+	return ipRestrictionDefaultAction, scmIpRestrictionDefaultAction, nil
+}
@@ -0,0 +1,188 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-cty/cty"
+	kvParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	kvValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const keyVaultReferencePrefix = "@Microsoft.KeyVault("
+
+// IsKeyVaultReference returns whether the value of an App Setting or Connection String is a Key Vault Reference
+func IsKeyVaultReference(input string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(input)), strings.ToLower(keyVaultReferencePrefix))
+}
+
+// ValidateKeyVaultReference validates the syntax of a Key Vault Reference, which takes either the form
+// `@Microsoft.KeyVault(SecretUri=https://{vault}.vault.azure.net/secrets/{name}/{version})` or
+// `@Microsoft.KeyVault(VaultName={vault};SecretName={name};SecretVersion={version})`, where the version is optional.
+func ValidateKeyVaultReference(input string) error {
+	value := strings.TrimSpace(input)
+	if !IsKeyVaultReference(value) {
+		return fmt.Errorf("expected the Key Vault Reference to start with %q", keyVaultReferencePrefix)
+	}
+	if !strings.HasSuffix(value, ")") {
+		return fmt.Errorf("expected the Key Vault Reference to end with `)`")
+	}
+
+	properties := make(map[string]string)
+	for _, v := range strings.Split(value[len(keyVaultReferencePrefix):len(value)-1], ";") {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+
+		pair := strings.SplitN(v, "=", 2)
+		if len(pair) != 2 || strings.TrimSpace(pair[1]) == "" {
+			return fmt.Errorf("expected %q in the Key Vault Reference to be in the format `Key=Value`", strings.TrimSpace(v))
+		}
+
+		key := strings.ToLower(strings.TrimSpace(pair[0]))
+		if _, ok := properties[key]; ok {
+			return fmt.Errorf("`%s` was specified more than once in the Key Vault Reference", strings.TrimSpace(pair[0]))
+		}
+		properties[key] = strings.TrimSpace(pair[1])
+	}
+
+	if secretUri, ok := properties["secreturi"]; ok {
+		if len(properties) != 1 {
+			return fmt.Errorf("`SecretUri` cannot be combined with `VaultName`, `SecretName` or `SecretVersion` in the Key Vault Reference")
+		}
+
+		id, err := kvParse.ParseOptionallyVersionedNestedItemID(secretUri)
+		if err != nil {
+			return fmt.Errorf("parsing `SecretUri` in the Key Vault Reference: %+v", err)
+		}
+		if !strings.HasPrefix(strings.ToLower(id.KeyVaultBaseUrl), "https://") {
+			return fmt.Errorf("expected `SecretUri` in the Key Vault Reference to use `https` but got %q", secretUri)
+		}
+		if id.NestedItemType != "secrets" {
+			return fmt.Errorf("expected `SecretUri` in the Key Vault Reference to refer to a Secret but got a %q item", id.NestedItemType)
+		}
+		return nil
+	}
+
+	vaultName, hasVaultName := properties["vaultname"]
+	secretName, hasSecretName := properties["secretname"]
+	if !hasVaultName || !hasSecretName {
+		return fmt.Errorf("expected the Key Vault Reference to contain either `SecretUri` or both `VaultName` and `SecretName`")
+	}
+	for key := range properties {
+		if key != "vaultname" && key != "secretname" && key != "secretversion" {
+			return fmt.Errorf("unsupported property %q in the Key Vault Reference", key)
+		}
+	}
+
+	if _, errs := kvValidate.VaultName(vaultName, "VaultName"); len(errs) > 0 {
+		return errs[0]
+	}
+	if _, errs := kvValidate.NestedItemName(secretName, "SecretName"); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// ValidateKeyVaultReferences checks at plan time the syntax of any Key Vault References in `app_settings` and
+// `connection_string`, and that an Identity which can resolve them is available to the App. Only values which are
+// known at plan time are checked.
+func ValidateKeyVaultReferences(rd *pluginsdk.ResourceDiff) error {
+	config := rd.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	hasReferences := false
+
+	if appSettings := config.GetAttr("app_settings"); !appSettings.IsNull() && appSettings.IsKnown() {
+		values := appSettings.AsValueMap()
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v := values[k]
+			if v.IsNull() || !v.IsKnown() || !IsKeyVaultReference(v.AsString()) {
+				continue
+			}
+			hasReferences = true
+			if err := ValidateKeyVaultReference(v.AsString()); err != nil {
+				return fmt.Errorf("the App Setting %q contains an invalid Key Vault Reference: %+v", k, err)
+			}
+		}
+	}
+
+	if connectionStrings := config.GetAttr("connection_string"); !connectionStrings.IsNull() && connectionStrings.IsKnown() {
+		for _, cs := range connectionStrings.AsValueSlice() {
+			if cs.IsNull() || !cs.IsKnown() {
+				continue
+			}
+			v := cs.GetAttr("value")
+			if v.IsNull() || !v.IsKnown() || !IsKeyVaultReference(v.AsString()) {
+				continue
+			}
+			hasReferences = true
+
+			name := ""
+			if n := cs.GetAttr("name"); !n.IsNull() && n.IsKnown() {
+				name = n.AsString()
+			}
+			if err := ValidateKeyVaultReference(v.AsString()); err != nil {
+				return fmt.Errorf("the Connection String %q contains an invalid Key Vault Reference: %+v", name, err)
+			}
+		}
+	}
+
+	if !hasReferences {
+		return nil
+	}
+
+	// when `key_vault_reference_identity_id` is specified this is checked by ValidateKeyVaultReferenceIdentity
+	if v := config.GetAttr("key_vault_reference_identity_id"); !v.IsNull() {
+		return nil
+	}
+
+	// otherwise the API resolves Key Vault References using the System Assigned Identity
+	identityType, known := configuredIdentityType(config)
+	if !known {
+		return nil
+	}
+	if identityType != string(identity.TypeSystemAssigned) && identityType != string(identity.TypeSystemAssignedUserAssigned) {
+		return fmt.Errorf("Key Vault References are resolved using the System Assigned Identity unless `key_vault_reference_identity_id` is specified, either `key_vault_reference_identity_id` must be set or `identity.0.type` must be either `%s` or `%s`", identity.TypeSystemAssigned, identity.TypeSystemAssignedUserAssigned)
+	}
+
+	return nil
+}
+
+// configuredIdentityType returns the `type` specified in the `identity` block, and whether this is known at plan time
+func configuredIdentityType(config cty.Value) (string, bool) {
+	identities := config.GetAttr("identity")
+	if !identities.IsKnown() {
+		return "", false
+	}
+	if identities.IsNull() {
+		return "", true
+	}
+
+	identityType := ""
+	for _, v := range identities.AsValueSlice() {
+		if v.IsNull() {
+			continue
+		}
+		if !v.IsKnown() || !v.GetAttr("type").IsKnown() {
+			return "", false
+		}
+		if t := v.GetAttr("type"); !t.IsNull() {
+			identityType = t.AsString()
+		}
+	}
+
+	return identityType, true
+}
@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ValidateKeyVaultReferenceIdentity checks at plan time that the Identity specified in `key_vault_reference_identity_id`
+// is assigned to the App through the `identity` block, since otherwise this isn't surfaced by the API until apply.
+// Only values which are present in the configuration are checked, since the API returns `SystemAssigned` when this is unset.
+func ValidateKeyVaultReferenceIdentity(rd *pluginsdk.ResourceDiff) error {
+	config := rd.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	raw := config.GetAttr("key_vault_reference_identity_id")
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	keyVaultReferenceIdentityId := raw.AsString()
+
+	identities := config.GetAttr("identity")
+	if !identities.IsKnown() {
+		return nil
+	}
+
+	identityType := ""
+	identityIds := make([]string, 0)
+	identityIdsKnown := true
+	if !identities.IsNull() {
+		for _, v := range identities.AsValueSlice() {
+			if v.IsNull() {
+				continue
+			}
+			if !v.IsKnown() || !v.GetAttr("type").IsKnown() {
+				return nil
+			}
+			if t := v.GetAttr("type"); !t.IsNull() {
+				identityType = t.AsString()
+			}
+
+			ids := v.GetAttr("identity_ids")
+			if !ids.IsWhollyKnown() {
+				identityIdsKnown = false
+				continue
+			}
+			if ids.IsNull() {
+				continue
+			}
+			for _, id := range ids.AsValueSlice() {
+				identityIds = append(identityIds, id.AsString())
+			}
+		}
+	}
+
+	systemAssigned := identityType == string(identity.TypeSystemAssigned) || identityType == string(identity.TypeSystemAssignedUserAssigned)
+	userAssigned := identityType == string(identity.TypeUserAssigned) || identityType == string(identity.TypeSystemAssignedUserAssigned)
+
+	if keyVaultReferenceIdentityId == validate.KeyVaultReferenceIdentitySystemAssigned {
+		if !systemAssigned {
+			return fmt.Errorf("`key_vault_reference_identity_id` is set to `%s` but the System Assigned Identity isn't enabled, `identity.0.type` must be either `%s` or `%s`", validate.KeyVaultReferenceIdentitySystemAssigned, identity.TypeSystemAssigned, identity.TypeSystemAssignedUserAssigned)
+		}
+		return nil
+	}
+
+	if !userAssigned {
+		return fmt.Errorf("`key_vault_reference_identity_id` is set to a User Assigned Identity but `identity.0.type` is not `%s` or `%s`", identity.TypeUserAssigned, identity.TypeSystemAssignedUserAssigned)
+	}
+
+	if !identityIdsKnown {
+		return nil
+	}
+	for _, id := range identityIds {
+		if strings.EqualFold(id, keyVaultReferenceIdentityId) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the User Assigned Identity %q specified in `key_vault_reference_identity_id` must also be specified in `identity.0.identity_ids`", keyVaultReferenceIdentityId)
+}
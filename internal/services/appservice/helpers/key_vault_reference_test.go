@@ -0,0 +1,95 @@
+package helpers
+
+import "testing"
+
+func TestValidateKeyVaultReference(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// not a reference
+			Input: "https://example.vault.azure.net/secrets/example",
+			Valid: false,
+		},
+		{
+			Input: "@Microsoft.KeyVault(SecretUri=https://example.vault.azure.net/secrets/example/)",
+			Valid: true,
+		},
+		{
+			Input: "@Microsoft.KeyVault(SecretUri=https://example.vault.azure.net/secrets/example/fdf067c93bbb4b22bff4d8b7a9a56217)",
+			Valid: true,
+		},
+		{
+			// case insensitive prefix and keys
+			Input: "@microsoft.keyvault(secreturi=https://example.vault.azure.net/secrets/example)",
+			Valid: true,
+		},
+		{
+			// missing closing bracket
+			Input: "@Microsoft.KeyVault(SecretUri=https://example.vault.azure.net/secrets/example",
+			Valid: false,
+		},
+		{
+			// not a secret
+			Input: "@Microsoft.KeyVault(SecretUri=https://example.vault.azure.net/keys/example)",
+			Valid: false,
+		},
+		{
+			// not https
+			Input: "@Microsoft.KeyVault(SecretUri=http://example.vault.azure.net/secrets/example)",
+			Valid: false,
+		},
+		{
+			// not a uri
+			Input: "@Microsoft.KeyVault(SecretUri=example)",
+			Valid: false,
+		},
+		{
+			Input: "@Microsoft.KeyVault(VaultName=example;SecretName=example)",
+			Valid: true,
+		},
+		{
+			Input: "@Microsoft.KeyVault(VaultName=example;SecretName=example;SecretVersion=fdf067c93bbb4b22bff4d8b7a9a56217)",
+			Valid: true,
+		},
+		{
+			// missing secret name
+			Input: "@Microsoft.KeyVault(VaultName=example)",
+			Valid: false,
+		},
+		{
+			// invalid vault name
+			Input: "@Microsoft.KeyVault(VaultName=ex;SecretName=example)",
+			Valid: false,
+		},
+		{
+			// unknown property
+			Input: "@Microsoft.KeyVault(VaultName=example;SecretName=example;Secret=example)",
+			Valid: false,
+		},
+		{
+			// mixed forms
+			Input: "@Microsoft.KeyVault(SecretUri=https://example.vault.azure.net/secrets/example;VaultName=example)",
+			Valid: false,
+		},
+		{
+			// missing value
+			Input: "@Microsoft.KeyVault(VaultName=;SecretName=example)",
+			Valid: false,
+		},
+		{
+			Input: "@Microsoft.KeyVault()",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		err := ValidateKeyVaultReference(tc.Input)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("Expected %t but got %t (%+v)", tc.Valid, valid, err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// managedAppSettings are app settings which are added to Function Apps by the provider, the platform or deployment
+// tooling (such as `WEBSITE_RUN_FROM_PACKAGE`), rather than by the user. These are only tracked in state when they are
+// also specified in `app_settings`, so that changes made outside of Terraform don't cause a perpetual diff.
+var managedAppSettings = map[string]struct{}{
+	"WEBSITE_CONTENTAZUREFILECONNECTIONSTRING": {},
+	"WEBSITE_CONTENTSHARE":                     {},
+	"WEBSITE_RUN_FROM_PACKAGE":                 {},
+}
+
+// argumentAppSettings are app settings which are controlled through a dedicated argument on Function Apps and so
+// cannot be listed in `ignore_app_settings`, the value is the argument which should be used instead.
+var argumentAppSettings = map[string]string{
+	"APPINSIGHTS_INSTRUMENTATIONKEY":        "site_config.0.application_insights_key",
+	"APPLICATIONINSIGHTS_CONNECTION_STRING": "site_config.0.application_insights_connection_string",
+	"AzureWebJobsDashboard":                 "builtin_logging_enabled",
+	"AzureWebJobsDashboard__accountName":    "builtin_logging_enabled",
+	"AzureWebJobsStorage":                   "storage_account_name",
+	"AzureWebJobsStorage__accountName":      "storage_uses_managed_identity",
+	"DOCKER_REGISTRY_SERVER_PASSWORD":       "site_config.0.application_stack.0.docker",
+	"DOCKER_REGISTRY_SERVER_URL":            "site_config.0.application_stack.0.docker",
+	"DOCKER_REGISTRY_SERVER_USERNAME":       "site_config.0.application_stack.0.docker",
+	"FUNCTIONS_EXTENSION_VERSION":           "functions_extension_version",
+	"FUNCTIONS_WORKER_RUNTIME":              "site_config.0.application_stack",
+	"WEBSITE_HEALTHCHECK_MAXPINGFAILURES":   "site_config.0.health_check_eviction_time_in_min",
+	"WEBSITE_HTTPLOGGING_RETENTION_DAYS":    "site_config.0.app_service_logs",
+	"WEBSITE_NODE_DEFAULT_VERSION":          "site_config.0.application_stack.0.node_version",
+}
+
+// IsManagedAppSetting returns whether the app setting is added to Function Apps outside of the user's configuration
+func IsManagedAppSetting(key string) bool {
+	_, ok := managedAppSettings[key]
+	return ok
+}
+
+func IgnoreAppSettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		Description: "A set of App Setting names whose values are managed outside of Terraform and should be excluded from drift detection.",
+	}
+}
+
+// ValidateIgnoreAppSettings checks at plan time that `ignore_app_settings` doesn't contain any app settings which are
+// managed by a dedicated argument, since these are always set from that argument and so can't be ignored.
+func ValidateIgnoreAppSettings(rd *pluginsdk.ResourceDiff) error {
+	raw, ok := rd.GetOk("ignore_app_settings")
+	if !ok {
+		return nil
+	}
+
+	invalid := make([]string, 0)
+	for _, v := range raw.(*pluginsdk.Set).List() {
+		key := v.(string)
+		for name, argument := range argumentAppSettings {
+			if strings.EqualFold(key, name) {
+				invalid = append(invalid, fmt.Sprintf("`%s` is managed by `%s`", key, argument))
+			}
+		}
+	}
+
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("`ignore_app_settings` cannot contain App Settings which are managed by a dedicated argument: %s", strings.Join(invalid, ", "))
+	}
+
+	return nil
+}
+
+// FlattenManagedAppSetting returns the value which should be stored in state for an app setting read from the API,
+// and whether it should be stored at all. App settings listed in `ignore_app_settings` keep the value from the
+// configuration (or are omitted if not configured) so that changes made outside of Terraform aren't detected as drift,
+// and managed app settings are only kept when they're specified in `app_settings`.
+func FlattenManagedAppSetting(key string, value *string, metadata sdk.ResourceMetaData) (string, bool) {
+	configured, isConfigured := metadata.ResourceData.Get("app_settings").(map[string]interface{})[key]
+
+	ignore := *utils.ExpandStringSlice(metadata.ResourceData.Get("ignore_app_settings").(*pluginsdk.Set).List())
+	if isIgnoredAppSetting(ignore, key) {
+		if !isConfigured {
+			return "", false
+		}
+		return configured.(string), true
+	}
+
+	if IsManagedAppSetting(key) && !isConfigured {
+		return "", false
+	}
+
+	return utils.NormalizeNilableString(value), true
+}
+
+// PreserveIgnoredAppSettings adds the current value of any app settings listed in `ignore` which aren't present in
+// `appSettings`, so that these are not removed from the Function App when it's updated.
+func PreserveIgnoredAppSettings(existing web.StringDictionary, appSettings map[string]string, ignore []string) map[string]string {
+	if existing.Properties == nil || len(ignore) == 0 {
+		return appSettings
+	}
+
+	if appSettings == nil {
+		appSettings = make(map[string]string)
+	}
+
+	for k, v := range existing.Properties {
+		if _, ok := appSettings[k]; ok || !isIgnoredAppSetting(ignore, k) {
+			continue
+		}
+		appSettings[k] = utils.NormalizeNilableString(v)
+	}
+
+	return appSettings
+}
+
+func isIgnoredAppSetting(ignore []string, key string) bool {
+	for _, v := range ignore {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestPreserveIgnoredAppSettings(t *testing.T) {
+	existing := web.StringDictionary{
+		Properties: map[string]*string{
+			"WEBSITE_RUN_FROM_PACKAGE": utils.String("https://example.blob.core.windows.net/packages/app.zip"),
+			"SOME_SETTING":             utils.String("remote"),
+			"OTHER_SETTING":            utils.String("remote"),
+		},
+	}
+
+	cases := []struct {
+		AppSettings map[string]string
+		Ignore      []string
+		Expected    map[string]string
+	}{
+		{
+			// nothing ignored
+			AppSettings: map[string]string{"SOME_SETTING": "config"},
+			Ignore:      nil,
+			Expected:    map[string]string{"SOME_SETTING": "config"},
+		},
+		{
+			AppSettings: map[string]string{"SOME_SETTING": "config"},
+			Ignore:      []string{"WEBSITE_RUN_FROM_PACKAGE"},
+			Expected: map[string]string{
+				"SOME_SETTING":             "config",
+				"WEBSITE_RUN_FROM_PACKAGE": "https://example.blob.core.windows.net/packages/app.zip",
+			},
+		},
+		{
+			// configured values take priority
+			AppSettings: map[string]string{"SOME_SETTING": "config"},
+			Ignore:      []string{"SOME_SETTING"},
+			Expected:    map[string]string{"SOME_SETTING": "config"},
+		},
+		{
+			// case insensitive
+			AppSettings: nil,
+			Ignore:      []string{"other_setting"},
+			Expected:    map[string]string{"OTHER_SETTING": "remote"},
+		},
+		{
+			// ignored but not present on the App
+			AppSettings: map[string]string{},
+			Ignore:      []string{"MISSING_SETTING"},
+			Expected:    map[string]string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %+v ignoring %+v", tc.AppSettings, tc.Ignore)
+		actual := PreserveIgnoredAppSettings(existing, tc.AppSettings, tc.Ignore)
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestIsManagedAppSetting(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "WEBSITE_CONTENTSHARE",
+			Expected: true,
+		},
+		{
+			Input:    "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING",
+			Expected: true,
+		},
+		{
+			Input:    "WEBSITE_RUN_FROM_PACKAGE",
+			Expected: true,
+		},
+		{
+			Input:    "AzureWebJobsStorage",
+			Expected: false,
+		},
+		{
+			Input:    "SOME_SETTING",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		if actual := IsManagedAppSetting(tc.Input); actual != tc.Expected {
+			t.Fatalf("expected %t but got %t for %q", tc.Expected, actual, tc.Input)
+		}
+	}
+}
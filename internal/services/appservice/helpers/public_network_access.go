@@ -0,0 +1,56 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	PublicNetworkAccessEnabled  = "Enabled"
+	PublicNetworkAccessDisabled = "Disabled"
+)
+
+func PublicNetworkAccessSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Should public network access be enabled for the App. Defaults to `true`.",
+	}
+}
+
+func ExpandPublicNetworkAccess(enabled bool) *string {
+	if enabled {
+		return utils.String(PublicNetworkAccessEnabled)
+	}
+	return utils.String(PublicNetworkAccessDisabled)
+}
+
+// FlattenPublicNetworkAccess returns whether public network access is enabled for the App, the API omits this value
+// for Apps where it has never been set, which behave as if it's `Enabled`.
+func FlattenPublicNetworkAccess(input *web.SiteConfig) bool {
+	if input == nil || input.PublicNetworkAccess == nil {
+		return true
+	}
+	return !strings.EqualFold(*input.PublicNetworkAccess, PublicNetworkAccessDisabled)
+}
+
+// ValidatePublicNetworkAccess checks at plan time that no `ip_restriction` or `scm_ip_restriction` blocks are specified
+// when public network access is disabled, since these only apply to public traffic and so would have no effect.
+func ValidatePublicNetworkAccess(rd *pluginsdk.ResourceDiff) error {
+	if rd.Get("public_network_access_enabled").(bool) {
+		return nil
+	}
+
+	for _, v := range []string{"ip_restriction", "scm_ip_restriction"} {
+		if restrictions, ok := rd.GetOk(fmt.Sprintf("site_config.0.%s", v)); ok && len(restrictions.([]interface{})) > 0 {
+			return fmt.Errorf("`site_config.0.%s` cannot be specified when `public_network_access_enabled` is `false`, since it only applies to public network access", v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenPublicNetworkAccess(t *testing.T) {
+	cases := []struct {
+		Input    *web.SiteConfig
+		Expected bool
+	}{
+		{
+			Input:    nil,
+			Expected: true,
+		},
+		{
+			// unset on Apps created before this was supported
+			Input:    &web.SiteConfig{},
+			Expected: true,
+		},
+		{
+			Input:    &web.SiteConfig{PublicNetworkAccess: utils.String("Enabled")},
+			Expected: true,
+		},
+		{
+			Input:    &web.SiteConfig{PublicNetworkAccess: utils.String("Disabled")},
+			Expected: false,
+		},
+		{
+			Input:    &web.SiteConfig{PublicNetworkAccess: utils.String("disabled")},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %+v", tc.Input)
+		if actual := FlattenPublicNetworkAccess(tc.Input); actual != tc.Expected {
+			t.Fatalf("expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestExpandPublicNetworkAccess(t *testing.T) {
+	if actual := *ExpandPublicNetworkAccess(true); actual != PublicNetworkAccessEnabled {
+		t.Fatalf("expected %q but got %q", PublicNetworkAccessEnabled, actual)
+	}
+	if actual := *ExpandPublicNetworkAccess(false); actual != PublicNetworkAccessDisabled {
+		t.Fatalf("expected %q but got %q", PublicNetworkAccessDisabled, actual)
+	}
+}
@@ -0,0 +1,5 @@
+package helpers
+
+// FunctionAppReadConcurrency is the number of requests which are made at once when reading a Function App (or Function
+// App Slot), this is limited to avoid being throttled by the API when refreshing a large number of Function Apps
+const FunctionAppReadConcurrency = 4
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const sensitiveValueHashPrefix = "sha256:"
+
+// HashSensitiveValue returns the value stored in the state for a sensitive field when `sensitive_values_in_state`
+// is disabled in the Provider block
+func HashSensitiveValue(input string) string {
+	hash := sha256.Sum256([]byte(input))
+	return sensitiveValueHashPrefix + hex.EncodeToString(hash[:])
+}
+
+func isHashedSensitiveValue(input string) bool {
+	return strings.HasPrefix(input, sensitiveValueHashPrefix)
+}
+
+// SuppressSensitiveValueHash suppresses the diff for a sensitive field when the state contains the hash of the
+// configured value, rather than the value itself
+func SuppressSensitiveValueHash(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return isHashedSensitiveValue(old) && old == HashSensitiveValue(new)
+}
+
+// RemoveSensitiveValuesFromState replaces the value of each sensitive field in the state with its hash when
+// `sensitive_values_in_state` is disabled in the Provider block. This should be called at the end of the Read function,
+// once the state has been encoded.
+func RemoveSensitiveValuesFromState(metadata sdk.ResourceMetaData, fields []WriteOnlyField) error {
+	if metadata.Client.SensitiveValuesInState {
+		return nil
+	}
+
+	for _, field := range fields {
+		if !field.Sensitive {
+			continue
+		}
+
+		value, ok := metadata.ResourceData.Get(field.Path).(string)
+		if !ok || value == "" || isHashedSensitiveValue(value) {
+			continue
+		}
+
+		if err := metadata.ResourceData.Set(field.Path, HashSensitiveValue(value)); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", field.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// SensitiveValueFromConfig returns the configured value for the top-level sensitive field `path` when `value` (taken
+// from the state) only contains its hash, so that the value itself can be sent to the API
+func SensitiveValueFromConfig(metadata sdk.ResourceMetaData, path string, value string) string {
+	if !isHashedSensitiveValue(value) {
+		return value
+	}
+
+	config := metadata.ResourceData.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(path) {
+		return value
+	}
+
+	raw := config.GetAttr(path)
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().Equals(cty.String) {
+		return value
+	}
+
+	return raw.AsString()
+}
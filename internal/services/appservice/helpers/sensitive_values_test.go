@@ -0,0 +1,47 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestSuppressSensitiveValueHash(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			// the state contains the value itself
+			Old:      "secret",
+			New:      "secret",
+			Suppress: false,
+		},
+		{
+			Old:      HashSensitiveValue("secret"),
+			New:      "secret",
+			Suppress: true,
+		},
+		{
+			Old:      HashSensitiveValue("secret"),
+			New:      "updated",
+			Suppress: false,
+		},
+		{
+			Old:      HashSensitiveValue("secret"),
+			New:      "",
+			Suppress: false,
+		},
+		{
+			Old:      "",
+			New:      "secret",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q -> %q", tc.Old, tc.New)
+		if actual := SuppressSensitiveValueHash("storage_account_access_key", tc.Old, tc.New, nil); actual != tc.Suppress {
+			t.Fatalf("expected %t but got %t", tc.Suppress, actual)
+		}
+	}
+}
@@ -1618,7 +1618,7 @@ func ExpandStickySettings(input []StickySettings) *web.SlotConfigNames {
 
 func FlattenStickySettings(input *web.SlotConfigNames) []StickySettings {
 	result := StickySettings{}
-	if input == nil || (input.AppSettingNames == nil && input.ConnectionStringNames == nil) || (len(*input.AppSettingNames) == 0 && len(*input.ConnectionStringNames) == 0) {
+	if input == nil {
 		return []StickySettings{}
 	}
 
@@ -1630,5 +1630,9 @@ func FlattenStickySettings(input *web.SlotConfigNames) []StickySettings {
 		result.ConnectionStringNames = *input.ConnectionStringNames
 	}
 
+	if len(result.AppSettingNames) == 0 && len(result.ConnectionStringNames) == 0 {
+		return []StickySettings{}
+	}
+
 	return []StickySettings{result}
 }
@@ -0,0 +1,71 @@
+package helpers_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+func TestFlattenStickySettings(t *testing.T) {
+	cases := []struct {
+		input    *web.SlotConfigNames
+		expected []helpers.StickySettings
+	}{
+		{
+			input:    nil,
+			expected: []helpers.StickySettings{},
+		},
+		{
+			input:    &web.SlotConfigNames{},
+			expected: []helpers.StickySettings{},
+		},
+		{
+			input: &web.SlotConfigNames{
+				AppSettingNames:       &[]string{},
+				ConnectionStringNames: &[]string{},
+			},
+			expected: []helpers.StickySettings{},
+		},
+		{
+			input: &web.SlotConfigNames{
+				AppSettingNames: &[]string{"foo"},
+			},
+			expected: []helpers.StickySettings{
+				{
+					AppSettingNames: []string{"foo"},
+				},
+			},
+		},
+		{
+			input: &web.SlotConfigNames{
+				ConnectionStringNames: &[]string{"First"},
+			},
+			expected: []helpers.StickySettings{
+				{
+					ConnectionStringNames: []string{"First"},
+				},
+			},
+		},
+		{
+			input: &web.SlotConfigNames{
+				AppSettingNames:       &[]string{"foo", "bar"},
+				ConnectionStringNames: &[]string{"First"},
+			},
+			expected: []helpers.StickySettings{
+				{
+					AppSettingNames:       []string{"foo", "bar"},
+					ConnectionStringNames: []string{"First"},
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual := helpers.FlattenStickySettings(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v, got %+v", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// ReadSiteCredentials retrieves the Publishing Credentials for the specified Site. When Basic Authentication has been
+// disabled for the SCM Site (as per the specified `scmBasicAuthPolicy`) the Publishing Credentials can't be used, so
+// these aren't retrieved and an empty User is returned.
+func ReadSiteCredentials(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, scmBasicAuthPolicy web.CsmPublishingCredentialsPoliciesEntity) (web.User, error) {
+	if !publishingCredentialsAllowed(scmBasicAuthPolicy) {
+		return web.User{}, nil
+	}
+
+	future, err := client.ListPublishingCredentials(ctx, resourceGroup, siteName)
+	if err != nil {
+		return web.User{}, fmt.Errorf("listing: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return web.User{}, fmt.Errorf("waiting for listing: %+v", err)
+	}
+
+	return future.Result(*client)
+}
+
+// ReadSiteCredentialsSlot retrieves the Publishing Credentials for the specified Site Slot. When Basic Authentication has
+// been disabled for the SCM Site (as per the specified `scmBasicAuthPolicy`) the Publishing Credentials can't be used, so
+// these aren't retrieved and an empty User is returned.
+func ReadSiteCredentialsSlot(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, slotName string, scmBasicAuthPolicy web.CsmPublishingCredentialsPoliciesEntity) (web.User, error) {
+	if !publishingCredentialsAllowed(scmBasicAuthPolicy) {
+		return web.User{}, nil
+	}
+
+	future, err := client.ListPublishingCredentialsSlot(ctx, resourceGroup, siteName, slotName)
+	if err != nil {
+		return web.User{}, fmt.Errorf("listing: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return web.User{}, fmt.Errorf("waiting for listing: %+v", err)
+	}
+
+	return future.Result(*client)
+}
+
+func publishingCredentialsAllowed(input web.CsmPublishingCredentialsPoliciesEntity) bool {
+	if props := input.CsmPublishingCredentialsPoliciesEntityProperties; props != nil && props.Allow != nil {
+		return *props.Allow
+	}
+
+	// the policy isn't available for all Sites, in which case Basic Authentication is enabled
+	return true
+}
+
+func ExpandBasicAuthPolicy(enabled bool) web.CsmPublishingCredentialsPoliciesEntity {
+	return web.CsmPublishingCredentialsPoliciesEntity{
+		CsmPublishingCredentialsPoliciesEntityProperties: &web.CsmPublishingCredentialsPoliciesEntityProperties{
+			Allow: utils.Bool(enabled),
+		},
+	}
+}
+
+func FlattenBasicAuthPolicy(input web.CsmPublishingCredentialsPoliciesEntity) bool {
+	return publishingCredentialsAllowed(input)
+}
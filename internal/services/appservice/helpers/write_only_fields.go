@@ -0,0 +1,55 @@
+package helpers
+
+// WriteOnlyField is an argument whose value is sent to the API but isn't returned as-is, and so needs special handling
+// when the resource is imported.
+type WriteOnlyField struct {
+	// Path is the path to the argument in the resource schema
+	Path string
+
+	// AppSetting is the App Setting the value is reconstructed from when the resource is read (and so imported), this is
+	// empty when the value isn't retrievable at all - in which case it can't be imported
+	AppSetting string
+
+	// Sensitive specifies whether this (top-level) argument contains a secret, which is only stored in the state as a
+	// hash when `sensitive_values_in_state` is disabled in the Provider block
+	Sensitive bool
+}
+
+var WebAppWriteOnlyFields = []WriteOnlyField{
+	{
+		Path: "zip_deploy_file",
+	},
+}
+
+var functionAppStorageAccountAccessKey = WriteOnlyField{
+	Path:       "storage_account_access_key",
+	AppSetting: "AzureWebJobsStorage",
+	Sensitive:  true,
+}
+
+var LinuxFunctionAppWriteOnlyFields = []WriteOnlyField{
+	functionAppStorageAccountAccessKey,
+	{
+		Path:       "site_config.0.application_stack.0.docker.0.registry_password",
+		AppSetting: "DOCKER_REGISTRY_SERVER_PASSWORD",
+	},
+	{
+		Path: "zip_deploy_file",
+	},
+}
+
+var WindowsFunctionAppWriteOnlyFields = []WriteOnlyField{
+	functionAppStorageAccountAccessKey,
+}
+
+// ImportVerifyIgnore returns the paths of the fields which are never retrievable from the API, for use with `ImportStep`
+// in acceptance tests.
+func ImportVerifyIgnore(fields []WriteOnlyField) []string {
+	result := make([]string, 0)
+	for _, v := range fields {
+		if v.AppSetting == "" {
+			result = append(result, v.Path)
+		}
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package helpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestImportVerifyIgnore(t *testing.T) {
+	cases := []struct {
+		Input    []WriteOnlyField
+		Expected []string
+	}{
+		{
+			Input:    WebAppWriteOnlyFields,
+			Expected: []string{"zip_deploy_file"},
+		},
+		{
+			// fields stored in App Settings are reconstructed on import
+			Input:    LinuxFunctionAppWriteOnlyFields,
+			Expected: []string{"zip_deploy_file"},
+		},
+		{
+			Input:    WindowsFunctionAppWriteOnlyFields,
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %+v", tc.Input)
+		if actual := ImportVerifyIgnore(tc.Input); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
@@ -264,17 +264,17 @@ func (d LinuxFunctionAppDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
 			}
 
-			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
-			}
+			var siteCredentials web.User
+			if !metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+				scmBasicAuthPolicy, err := client.GetScmAllowed(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil && !utils.ResponseWasNotFound(scmBasicAuthPolicy.Response) {
+					return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
 
-			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for Site Publishing Credential information for Linux %s: %+v", id, err)
-			}
-			siteCredentials, err := siteCredentialsFuture.Result(*client)
-			if err != nil {
-				return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
+				siteCredentials, err = helpers.ReadSiteCredentials(ctx, client, id.ResourceGroup, id.SiteName, scmBasicAuthPolicy)
+				if err != nil {
+					return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
+				}
 			}
 
 			auth, err := client.GetAuthSettings(ctx, id.ResourceGroup, id.SiteName)
@@ -320,6 +320,13 @@ func (d LinuxFunctionAppDataSource) Read() sdk.ResourceFunc {
 			}
 			state.SiteConfig = []helpers.SiteConfigLinuxFunctionApp{*siteConfig}
 
+			ipRestrictionDefaultAction, scmIpRestrictionDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading IP Restriction Default Actions for Linux %s: %+v", id, err)
+			}
+			state.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+			state.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+
 			state.unpackLinuxFunctionAppSettings(appSettingsResp, metadata)
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -9,7 +9,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/google/uuid"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -38,22 +37,28 @@ type LinuxFunctionAppModel struct {
 	StorageUsesMSI          bool   `tfschema:"storage_uses_managed_identity"` // Storage uses MSI not account key
 	StorageKeyVaultSecretID string `tfschema:"storage_key_vault_secret_id"`
 
-	AppSettings                 map[string]string                    `tfschema:"app_settings"`
-	StickySettings              []helpers.StickySettings             `tfschema:"sticky_settings"`
-	AuthSettings                []helpers.AuthSettings               `tfschema:"auth_settings"`
-	Backup                      []helpers.Backup                     `tfschema:"backup"` // Not supported on Dynamic or Basic plans
-	BuiltinLogging              bool                                 `tfschema:"builtin_logging_enabled"`
-	ClientCertEnabled           bool                                 `tfschema:"client_certificate_enabled"`
-	ClientCertMode              string                               `tfschema:"client_certificate_mode"`
-	ConnectionStrings           []helpers.ConnectionString           `tfschema:"connection_string"`
-	DailyMemoryTimeQuota        int                                  `tfschema:"daily_memory_time_quota"` // TODO - Value ignored in for linux apps, even in Consumption plans?
-	Enabled                     bool                                 `tfschema:"enabled"`
-	FunctionExtensionsVersion   string                               `tfschema:"functions_extension_version"`
-	ForceDisableContentShare    bool                                 `tfschema:"content_share_force_disabled"`
-	HttpsOnly                   bool                                 `tfschema:"https_only"`
-	KeyVaultReferenceIdentityID string                               `tfschema:"key_vault_reference_identity_id"`
-	SiteConfig                  []helpers.SiteConfigLinuxFunctionApp `tfschema:"site_config"`
-	Tags                        map[string]string                    `tfschema:"tags"`
+	AppSettings                      map[string]string                    `tfschema:"app_settings"`
+	IgnoreAppSettings                []string                             `tfschema:"ignore_app_settings"`
+	StickySettings                   []helpers.StickySettings             `tfschema:"sticky_settings"`
+	AuthSettings                     []helpers.AuthSettings               `tfschema:"auth_settings"`
+	AuthV2Settings                   []helpers.AuthV2Settings             `tfschema:"auth_settings_v2"`
+	Backup                           []helpers.Backup                     `tfschema:"backup"` // Not supported on Dynamic or Basic plans
+	BuiltinLogging                   bool                                 `tfschema:"builtin_logging_enabled"`
+	ClientCertEnabled                bool                                 `tfschema:"client_certificate_enabled"`
+	ClientCertMode                   string                               `tfschema:"client_certificate_mode"`
+	ConnectionStrings                []helpers.ConnectionString           `tfschema:"connection_string"`
+	DailyMemoryTimeQuota             int                                  `tfschema:"daily_memory_time_quota"` // TODO - Value ignored in for linux apps, even in Consumption plans?
+	Enabled                          bool                                 `tfschema:"enabled"`
+	PublishingFTPBasicAuthEnabled    bool                                 `tfschema:"ftp_publish_basic_authentication_enabled"`
+	PublishingDeployBasicAuthEnabled bool                                 `tfschema:"webdeploy_publish_basic_authentication_enabled"`
+	FunctionExtensionsVersion        string                               `tfschema:"functions_extension_version"`
+	ForceDisableContentShare         bool                                 `tfschema:"content_share_force_disabled"`
+	HttpsOnly                        bool                                 `tfschema:"https_only"`
+	PublicNetworkAccess              bool                                 `tfschema:"public_network_access_enabled"`
+	KeyVaultReferenceIdentityID      string                               `tfschema:"key_vault_reference_identity_id"`
+	SiteConfig                       []helpers.SiteConfigLinuxFunctionApp `tfschema:"site_config"`
+	Tags                             map[string]string                    `tfschema:"tags"`
+	ZipDeployFile                    string                               `tfschema:"zip_deploy_file"`
 
 	// Computed
 	CustomDomainVerificationId    string   `tfschema:"custom_domain_verification_id"`
@@ -118,10 +123,11 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 		},
 
 		"storage_account_access_key": {
-			Type:         pluginsdk.TypeString,
-			Optional:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.NoZeroValues,
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			ValidateFunc:     validation.NoZeroValues,
+			DiffSuppressFunc: helpers.SuppressSensitiveValueHash,
 			ConflictsWith: []string{
 				"storage_uses_managed_identity",
 				"storage_key_vault_secret_id",
@@ -160,8 +166,12 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Description: "A map of key-value pairs for [App Settings](https://docs.microsoft.com/en-us/azure/azure-functions/functions-app-settings) and custom values.",
 		},
 
+		"ignore_app_settings": helpers.IgnoreAppSettingsSchema(),
+
 		"auth_settings": helpers.AuthSettingsSchema(),
 
+		"auth_settings_v2": helpers.AuthV2SettingsSchema(),
+
 		"backup": helpers.BackupSchema(),
 
 		"builtin_logging_enabled": {
@@ -207,6 +217,20 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Description: "Is the Linux Function App enabled.",
 		},
 
+		"ftp_publish_basic_authentication_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should basic (username and password) authentication be enabled for FTP publishing to this Linux Function App.",
+		},
+
+		"webdeploy_publish_basic_authentication_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should basic (username and password) authentication be enabled for Web Deploy and SCM publishing to this Linux Function App.",
+		},
+
 		"content_share_force_disabled": {
 			Type:        pluginsdk.TypeBool,
 			Optional:    true,
@@ -228,14 +252,16 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Description: "Can the Function App only be accessed via HTTPS?",
 		},
 
+		"public_network_access_enabled": helpers.PublicNetworkAccessSchema(),
+
 		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 		"key_vault_reference_identity_id": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
-			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
-			Description:  "The User Assigned Identity to use for Key Vault access.",
+			ValidateFunc: validate.KeyVaultReferenceIdentityID,
+			Description:  "The Identity to use for Key Vault access, either `SystemAssigned` or the ID of a User Assigned Identity.",
 		},
 
 		"site_config": helpers.SiteConfigSchemaLinuxFunctionApp(),
@@ -243,6 +269,14 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 		"sticky_settings": helpers.StickySettingsSchema(),
 
 		"tags": tags.Schema(),
+
+		"zip_deploy_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The local path and filename of the Zip packaged application to deploy to this Linux Function App. **Note:** Using this value requires either `WEBSITE_RUN_FROM_PACKAGE=1` or `SCM_DO_BUILD_DURING_DEPLOYMENT=true` to be set on the App in `app_settings`.",
+		},
 	}
 }
 
@@ -304,6 +338,10 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := validateLinuxFunctionAppZipDeploy(functionApp.ZipDeployFile, functionApp.SiteConfig[0].ApplicationStack); err != nil {
+				return err
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			aseClient := metadata.Client.AppService.AppServiceEnvironmentClient
 			servicePlanClient := metadata.Client.AppService.ServicePlanClient
@@ -353,23 +391,11 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 			}
 
 			if ase := servicePlan.HostingEnvironmentProfile; ase != nil {
-				// Attempt to check the ASE for the appropriate suffix for the name availability request.
-				// This varies between internal and external ASE Types, and potentially has other names in other clouds
-				// We use the "internal" as the fallback here, if we can read the ASE, we'll get the full one
-				nameSuffix := "appserviceenvironment.net"
-				if ase.ID != nil {
-					aseId, err := parse.AppServiceEnvironmentID(*ase.ID)
-					nameSuffix = fmt.Sprintf("%s.%s", aseId.HostingEnvironmentName, nameSuffix)
-					if err != nil {
-						metadata.Logger.Warnf("could not parse App Service Environment ID determine FQDN for name availability check, defaulting to `%s.%s.appserviceenvironment.net`", functionApp.Name, servicePlanId)
-					} else {
-						existingASE, err := aseClient.Get(ctx, aseId.ResourceGroup, aseId.HostingEnvironmentName)
-						if err != nil {
-							metadata.Logger.Warnf("could not read App Service Environment to determine FQDN for name availability check, defaulting to `%s.%s.appserviceenvironment.net`", functionApp.Name, servicePlanId)
-						} else if props := existingASE.AppServiceEnvironment; props != nil && props.DNSSuffix != nil && *props.DNSSuffix != "" {
-							nameSuffix = *props.DNSSuffix
-						}
-					}
+				// Apps within an App Service Environment are checked using their FQDN, the suffix for which varies between
+				// internal and external ASE Types and between Clouds
+				nameSuffix, err := helpers.AppServiceEnvironmentDNSSuffix(ctx, aseClient, metadata.Client.Account.Environment, utils.NormalizeNilableString(ase.ID))
+				if err != nil {
+					metadata.Logger.Warnf("could not determine the DNS Suffix of the App Service Environment for the name availability check, defaulting to `%s`: %+v", nameSuffix, err)
 				}
 
 				availabilityRequest.Name = utils.String(fmt.Sprintf("%s.%s", functionApp.Name, nameSuffix))
@@ -380,8 +406,12 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 			if err != nil {
 				return fmt.Errorf("checking name availability for Linux %s: %+v", id, err)
 			}
-			if checkName.NameAvailable != nil && !*checkName.NameAvailable {
-				return fmt.Errorf("the Site Name %q failed the availability check: %+v", id.SiteName, *checkName.Message)
+			if err := azure.CheckNameAvailability("App Service", id.SiteName, azure.NameAvailabilityResult{
+				NameAvailable: checkName.NameAvailable,
+				Reason:        string(checkName.Reason),
+				Message:       checkName.Message,
+			}); err != nil {
+				return err
 			}
 
 			storageString := functionApp.StorageAccountName
@@ -430,6 +460,8 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
 
+			siteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(functionApp.PublicNetworkAccess)
+
 			siteEnvelope := web.Site{
 				Location: utils.String(functionApp.Location),
 				Tags:     tags.FromTypedObject(functionApp.Tags),
@@ -474,7 +506,7 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 					SlotConfigNames: stickySettings,
 				}
 				if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, stickySettingsUpdate); err != nil {
-					return fmt.Errorf("updating Sticky Settings for Windows %s: %+v", id, err)
+					return fmt.Errorf("updating Sticky Settings for Linux %s: %+v", id, err)
 				}
 			}
 
@@ -492,6 +524,13 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("auth_settings_v2") {
+				authV2 := helpers.ExpandAuthV2Settings(functionApp.AuthV2Settings)
+				if _, err := client.UpdateAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName, *authV2); err != nil {
+					return fmt.Errorf("setting AuthV2 Settings for Linux %s: %+v", id, err)
+				}
+			}
+
 			connectionStrings := helpers.ExpandConnectionStrings(functionApp.ConnectionStrings)
 			if connectionStrings.Properties != nil {
 				if _, err := client.UpdateConnectionStrings(ctx, id.ResourceGroup, id.SiteName, *connectionStrings); err != nil {
@@ -507,6 +546,31 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if functionApp.ZipDeployFile != "" {
+				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, functionApp.ZipDeployFile); err != nil {
+					return err
+				}
+			}
+
+			if !functionApp.PublishingFTPBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(functionApp.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			if !functionApp.PublishingDeployBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(functionApp.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "", functionApp.SiteConfig[0].IpRestrictionDefaultAction, functionApp.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+				return fmt.Errorf("setting IP Restriction Default Actions for Linux %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
@@ -534,49 +598,127 @@ func (r LinuxFunctionAppResource) Read() sdk.ResourceFunc {
 			}
 			props := *functionApp.SiteProperties
 
-			appSettingsResp, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("reading App Settings for Linux %s: %+v", id, err)
-			}
-
-			connectionStrings, err := client.ListConnectionStrings(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
-			}
-
-			stickySettings, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
-			}
-
-			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
-			}
-
-			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for Site Publishing Credential information for Linux %s: %+v", id, err)
-			}
-			siteCredentials, err := siteCredentialsFuture.Result(*client)
-			if err != nil {
-				return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
-			}
+			var (
+				appSettingsResp               web.StringDictionary
+				connectionStrings             web.ConnectionStringDictionary
+				stickySettings                web.SlotConfigNamesResource
+				siteCredentials               web.User
+				ftpBasicAuthPolicy            web.CsmPublishingCredentialsPoliciesEntity
+				scmBasicAuthPolicy            web.CsmPublishingCredentialsPoliciesEntity
+				auth                          web.SiteAuthSettings
+				authV2                        web.SiteAuthSettingsV2
+				backup                        web.BackupRequest
+				logs                          web.SiteLogsConfig
+				configResp                    web.SiteConfigResource
+				ipRestrictionDefaultAction    string
+				scmIpRestrictionDefaultAction string
+			)
+
+			// the remaining information for the Function App is independent, so is retrieved concurrently to speed up refreshes
+			err = utils.RunConcurrently(ctx, helpers.FunctionAppReadConcurrency,
+				func(ctx context.Context) error {
+					resp, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						return fmt.Errorf("reading App Settings for Linux %s: %+v", id, err)
+					}
+					appSettingsResp = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.ListConnectionStrings(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
+					}
+					connectionStrings = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
+					}
+					stickySettings = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetFtpAllowed(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("reading FTP Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+					}
+					ftpBasicAuthPolicy = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetScmAllowed(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+					}
+					scmBasicAuthPolicy = resp
 
-			auth, err := client.GetAuthSettings(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("reading Auth Settings for Linux %s: %+v", id, err)
-			}
+					// the Publishing Credentials are only retrieved when the SCM Basic Authentication Policy allows them
+					if metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+						return nil
+					}
 
-			backup, err := client.GetBackupConfiguration(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				if !utils.ResponseWasNotFound(backup.Response) {
-					return fmt.Errorf("reading Backup Settings for Linux %s: %+v", id, err)
-				}
-			}
+					credentials, err := helpers.ReadSiteCredentials(ctx, client, id.ResourceGroup, id.SiteName, resp)
+					if err != nil {
+						return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
+					}
+					siteCredentials = credentials
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetAuthSettings(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						return fmt.Errorf("reading Auth Settings for Linux %s: %+v", id, err)
+					}
+					auth = resp
 
-			logs, err := client.GetDiagnosticLogsConfiguration(ctx, id.ResourceGroup, id.SiteName)
+					if helpers.AuthV2ConfigVersionInUse(auth) {
+						resp, err := client.GetAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName)
+						if err != nil {
+							return fmt.Errorf("reading AuthV2 Settings for Linux %s: %+v", id, err)
+						}
+						authV2 = resp
+					}
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetBackupConfiguration(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("reading Backup Settings for Linux %s: %+v", id, err)
+					}
+					backup = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetDiagnosticLogsConfiguration(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						return fmt.Errorf("reading logs configuration for Linux %s: %+v", id, err)
+					}
+					logs = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
+					if err != nil {
+						return fmt.Errorf("making Read request on AzureRM Function App Configuration %q: %+v", id.SiteName, err)
+					}
+					configResp = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					defaultAction, scmDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "")
+					if err != nil {
+						return fmt.Errorf("reading IP Restriction Default Actions for Linux %s: %+v", id, err)
+					}
+					ipRestrictionDefaultAction = defaultAction
+					scmIpRestrictionDefaultAction = scmDefaultAction
+					return nil
+				},
+			)
 			if err != nil {
-				return fmt.Errorf("reading logs configuration for Linux %s: %+v", id, err)
+				return err
 			}
 
 			state := LinuxFunctionAppModel{
@@ -595,36 +737,51 @@ func (r LinuxFunctionAppResource) Read() sdk.ResourceFunc {
 				DefaultHostname:             utils.NormalizeNilableString(props.DefaultHostName),
 			}
 
-			configResp, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("making Read request on AzureRM Function App Configuration %q: %+v", id.SiteName, err)
-			}
-
 			siteConfig, err := helpers.FlattenSiteConfigLinuxFunctionApp(configResp.SiteConfig)
 			if err != nil {
 				return fmt.Errorf("reading Site Config for Linux %s: %+v", id, err)
 			}
 			state.SiteConfig = []helpers.SiteConfigLinuxFunctionApp{*siteConfig}
 
+			state.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+			state.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+
 			state.unpackLinuxFunctionAppSettings(appSettingsResp, metadata)
 
+			state.IgnoreAppSettings = *utils.ExpandStringSlice(metadata.ResourceData.Get("ignore_app_settings").(*pluginsdk.Set).List())
+
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
 			state.AuthSettings = helpers.FlattenAuthSettings(auth)
 
+			state.AuthV2Settings = helpers.FlattenAuthV2Settings(authV2)
+
+			// Zip Deploys are not retrievable, so attempt to get from config. This doesn't matter for imports as an unexpected value here could break the deployment.
+			if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
+				state.ZipDeployFile = deployFile
+			}
+
 			state.Backup = helpers.FlattenBackupConfig(backup)
 
 			state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs)
 
 			state.HttpsOnly = utils.NormaliseNilableBool(functionApp.HTTPSOnly)
+			state.PublicNetworkAccess = helpers.FlattenPublicNetworkAccess(configResp.SiteConfig)
 			state.ClientCertEnabled = utils.NormaliseNilableBool(functionApp.ClientCertEnabled)
 
+			state.PublishingFTPBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(ftpBasicAuthPolicy)
+			state.PublishingDeployBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(scmBasicAuthPolicy)
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
 
+			if err := helpers.RemoveSensitiveValuesFromState(metadata, helpers.LinuxFunctionAppWriteOnlyFields); err != nil {
+				return err
+			}
+
 			flattenedIdentity, err := flattenIdentity(functionApp.Identity)
 			if err != nil {
 				return fmt.Errorf("flattening `identity`: %+v", err)
@@ -676,6 +833,10 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if err := validateLinuxFunctionAppZipDeploy(state.ZipDeployFile, state.SiteConfig[0].ApplicationStack); err != nil {
+				return err
+			}
+
 			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("reading Linux %s: %v", id, err)
@@ -731,7 +892,7 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				if state.StorageKeyVaultSecretID != "" {
 					storageString = fmt.Sprintf(helpers.StorageStringFmtKV, state.StorageKeyVaultSecretID)
 				} else {
-					storageString = fmt.Sprintf(helpers.StorageStringFmt, state.StorageAccountName, state.StorageAccountKey, metadata.Client.Account.Environment.StorageEndpointSuffix)
+					storageString = fmt.Sprintf(helpers.StorageStringFmt, state.StorageAccountName, helpers.SensitiveValueFromConfig(metadata, "storage_account_access_key", state.StorageAccountKey), metadata.Client.Account.Environment.StorageEndpointSuffix)
 				}
 			}
 
@@ -771,8 +932,21 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				existing.SiteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(state.SiteConfig[0].ApplicationStack)
 			}
 
+			if len(state.IgnoreAppSettings) > 0 {
+				appSettingsResp, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil {
+					return fmt.Errorf("reading App Settings for Linux %s: %+v", id, err)
+				}
+				state.AppSettings = helpers.PreserveIgnoredAppSettings(appSettingsResp, state.AppSettings, state.IgnoreAppSettings)
+			}
+
+			if existing.SiteConfig == nil {
+				existing.SiteConfig = &web.SiteConfig{}
+			}
 			existing.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
 
+			existing.SiteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(state.PublicNetworkAccess)
+
 			updateFuture, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, existing)
 			if err != nil {
 				return fmt.Errorf("updating Linux %s: %+v", id, err)
@@ -819,6 +993,19 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			// the V2 settings are updated first, so that removing them reverts to the V1 settings before these are updated
+			if metadata.ResourceData.HasChange("auth_settings_v2") {
+				authV2Update := helpers.ExpandAuthV2Settings(state.AuthV2Settings)
+				if _, err := client.UpdateAuthSettingsV2(ctx, id.ResourceGroup, id.SiteName, *authV2Update); err != nil {
+					return fmt.Errorf("updating AuthV2 Settings for Linux %s: %+v", id, err)
+				}
+				if len(state.AuthV2Settings) == 0 {
+					if err := helpers.RevertAuthV2ConfigVersion(ctx, client, id.ResourceGroup, id.SiteName); err != nil {
+						return fmt.Errorf("reverting to the V1 Auth Settings for Linux %s: %+v", id, err)
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("auth_settings") {
 				authUpdate := helpers.ExpandAuthSettings(state.AuthSettings)
 				if _, err := client.UpdateAuthSettings(ctx, id.ResourceGroup, id.SiteName, *authUpdate); err != nil {
@@ -846,6 +1033,33 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("ftp_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("webdeploy_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			// the IP Restriction Default Actions aren't part of the Site Config sent above, so these are set separately
+			if metadata.ResourceData.HasChange("site_config") {
+				if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "", state.SiteConfig[0].IpRestrictionDefaultAction, state.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+					return fmt.Errorf("updating IP Restriction Default Actions for Linux %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("zip_deploy_file") {
+				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -892,6 +1106,28 @@ func (r LinuxFunctionAppResource) CustomizeDiff() sdk.ResourceFunc {
 			client := metadata.Client.AppService.ServicePlanClient
 			rd := metadata.ResourceDiff
 
+			if err := helpers.ValidateKeyVaultReferenceIdentity(rd); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidatePublicNetworkAccess(rd); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidateLinuxFunctionAppApplicationStack(rd); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidateIgnoreAppSettings(rd); err != nil {
+				return err
+			}
+
+			if metadata.Client.Features.AppService.ValidateKeyVaultReferences {
+				if err := helpers.ValidateKeyVaultReferences(rd); err != nil {
+					return err
+				}
+			}
+
 			if rd.HasChange("service_plan_id") {
 				currentPlanIdRaw, newPlanIdRaw := rd.GetChange("service_plan_id")
 				if newPlanIdRaw.(string) == "" {
@@ -967,14 +1203,6 @@ func (m *LinuxFunctionAppModel) unpackLinuxFunctionAppSettings(input web.StringD
 			m.FunctionExtensionsVersion = utils.NormalizeNilableString(v)
 
 		case "WEBSITE_NODE_DEFAULT_VERSION": // Note - This is only set if it's not the default of 12, but we collect it from LinuxFxVersion so can discard it here
-		case "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING":
-			if _, ok := metadata.ResourceData.GetOk("app_settings.WEBSITE_CONTENTAZUREFILECONNECTIONSTRING"); ok {
-				appSettings[k] = utils.NormalizeNilableString(v)
-			}
-		case "WEBSITE_CONTENTSHARE":
-			if _, ok := metadata.ResourceData.GetOk("app_settings.WEBSITE_CONTENTSHARE"); ok {
-				appSettings[k] = utils.NormalizeNilableString(v)
-			}
 		case "WEBSITE_HTTPLOGGING_RETENTION_DAYS":
 		case "FUNCTIONS_WORKER_RUNTIME":
 			if len(m.SiteConfig) > 0 && len(m.SiteConfig[0].ApplicationStack) == 0 {
@@ -1025,14 +1253,11 @@ func (m *LinuxFunctionAppModel) unpackLinuxFunctionAppSettings(input web.StringD
 		case "AzureWebJobsDashboard__accountName":
 			m.BuiltinLogging = true
 
-		case "WEBSITE_RUN_FROM_PACKAGE":
-			// Keep if user explicitly set, otherwise filter out as will have been added by ADO et al
-			if _, ok := metadata.ResourceData.GetOk("app_settings.WEBSITE_RUN_FROM_PACKAGE"); ok {
-				appSettings[k] = utils.NormalizeNilableString(v)
-			}
-
 		default:
-			appSettings[k] = utils.NormalizeNilableString(v)
+			// Note - managed app settings and those listed in `ignore_app_settings` are filtered here to prevent drift from changes made outside of Terraform
+			if value, ok := helpers.FlattenManagedAppSetting(k, v, metadata); ok {
+				appSettings[k] = value
+			}
 		}
 	}
 
@@ -1045,3 +1270,15 @@ func (m *LinuxFunctionAppModel) unpackLinuxFunctionAppSettings(input web.StringD
 
 	m.AppSettings = appSettings
 }
+
+func validateLinuxFunctionAppZipDeploy(zipDeployFile string, applicationStack []helpers.ApplicationStackLinuxFunctionApp) error {
+	if zipDeployFile == "" {
+		return nil
+	}
+
+	if len(applicationStack) > 0 && len(applicationStack[0].Docker) > 0 {
+		return fmt.Errorf("`zip_deploy_file` cannot be used with a `docker` `application_stack`, container based Function Apps must be deployed via their image")
+	}
+
+	return nil
+}
@@ -0,0 +1,460 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LinuxFunctionAppSlotDataSource struct{}
+
+type LinuxFunctionAppSlotDataSourceModel struct {
+	Name               string `tfschema:"name"`
+	FunctionAppID      string `tfschema:"function_app_id"`
+	StorageAccountName string `tfschema:"storage_account_name"`
+
+	StorageAccountKey       string `tfschema:"storage_account_access_key"`
+	StorageUsesMSI          bool   `tfschema:"storage_uses_managed_identity"` // Storage uses MSI not account key
+	StorageKeyVaultSecretID string `tfschema:"storage_key_vault_secret_id"`
+
+	AppSettings                 map[string]string                        `tfschema:"app_settings"`
+	AuthSettings                []helpers.AuthSettings                   `tfschema:"auth_settings"`
+	Backup                      []helpers.Backup                         `tfschema:"backup"` // Not supported on Dynamic or Basic plans
+	BuiltinLogging              bool                                     `tfschema:"builtin_logging_enabled"`
+	ClientCertEnabled           bool                                     `tfschema:"client_certificate_enabled"`
+	ClientCertMode              string                                   `tfschema:"client_certificate_mode"`
+	ConnectionStrings           []helpers.ConnectionString               `tfschema:"connection_string"`
+	DailyMemoryTimeQuota        int                                      `tfschema:"daily_memory_time_quota"`
+	Enabled                     bool                                     `tfschema:"enabled"`
+	FunctionExtensionsVersion   string                                   `tfschema:"functions_extension_version"`
+	ForceDisableContentShare    bool                                     `tfschema:"content_share_force_disabled"`
+	HttpsOnly                   bool                                     `tfschema:"https_only"`
+	KeyVaultReferenceIdentityID string                                   `tfschema:"key_vault_reference_identity_id"`
+	SiteConfig                  []helpers.SiteConfigLinuxFunctionAppSlot `tfschema:"site_config"`
+	VirtualNetworkSubnetID      string                                   `tfschema:"virtual_network_subnet_id"`
+	Tags                        map[string]string                        `tfschema:"tags"`
+
+	CustomDomainVerificationId    string   `tfschema:"custom_domain_verification_id"`
+	DefaultHostname               string   `tfschema:"default_hostname"`
+	Kind                          string   `tfschema:"kind"`
+	OutboundIPAddresses           string   `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList         []string `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses   string   `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList []string `tfschema:"possible_outbound_ip_address_list"`
+
+	SiteCredentials []helpers.SiteCredential `tfschema:"site_credential"`
+}
+
+var _ sdk.DataSource = LinuxFunctionAppSlotDataSource{}
+
+func (d LinuxFunctionAppSlotDataSource) ModelObject() interface{} {
+	return &LinuxFunctionAppSlotDataSourceModel{}
+}
+
+func (d LinuxFunctionAppSlotDataSource) ResourceType() string {
+	return "azurerm_linux_function_app_slot"
+}
+
+func (d LinuxFunctionAppSlotDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FunctionAppSlotID
+}
+
+func (d LinuxFunctionAppSlotDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.WebAppName,
+		},
+
+		"function_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.FunctionAppID,
+		},
+	}
+}
+
+func (d LinuxFunctionAppSlotDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"storage_account_access_key": {
+			Type:      pluginsdk.TypeString,
+			Sensitive: true,
+			Computed:  true,
+		},
+
+		"storage_uses_managed_identity": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"storage_key_vault_secret_id": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The Key Vault Secret ID, including version, that contains the Connection String used to connect to the storage account for this Function App Slot.",
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"auth_settings": helpers.AuthSettingsSchemaComputed(),
+
+		"backup": helpers.BackupSchemaComputed(),
+
+		"builtin_logging_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"client_certificate_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"client_certificate_mode": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"connection_string": helpers.ConnectionStringSchemaComputed(),
+
+		"daily_memory_time_quota": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"content_share_force_disabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"functions_extension_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
+
+		"key_vault_reference_identity_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"site_config": helpers.SiteConfigSchemaLinuxFunctionAppSlotComputed(),
+
+		"virtual_network_subnet_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": tags.SchemaDataSource(),
+
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"possible_outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"possible_outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"site_credential": helpers.SiteCredentialSchema(),
+	}
+}
+
+func (d LinuxFunctionAppSlotDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var functionAppSlot LinuxFunctionAppSlotDataSourceModel
+			if err := metadata.Decode(&functionAppSlot); err != nil {
+				return err
+			}
+
+			functionAppId, err := parse.FunctionAppID(functionAppSlot.FunctionAppID)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFunctionAppSlotID(functionAppId.SubscriptionId, functionAppId.ResourceGroup, functionAppId.SiteName, functionAppSlot.Name)
+
+			functionApp, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(functionApp.Response) {
+					return fmt.Errorf("Linux %s not found", id)
+				}
+				return fmt.Errorf("reading Linux %s: %+v", id, err)
+			}
+
+			if functionApp.SiteProperties == nil {
+				return fmt.Errorf("reading properties of Linux %s", id)
+			}
+			props := *functionApp.SiteProperties
+
+			appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading App Settings for Linux %s: %+v", id, err)
+			}
+
+			connectionStrings, err := client.ListConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
+			}
+
+			var siteCredentials web.User
+			if !metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+				scmBasicAuthPolicy, err := client.GetScmAllowedSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+				if err != nil && !utils.ResponseWasNotFound(scmBasicAuthPolicy.Response) {
+					return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+
+				siteCredentials, err = helpers.ReadSiteCredentialsSlot(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, scmBasicAuthPolicy)
+				if err != nil {
+					return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
+				}
+			}
+
+			auth, err := client.GetAuthSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading Auth Settings for Linux %s: %+v", id, err)
+			}
+
+			backup, err := client.GetBackupConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(backup.Response) {
+					return fmt.Errorf("reading Backup Settings for Linux %s: %+v", id, err)
+				}
+			}
+
+			logs, err := client.GetDiagnosticLogsConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading logs configuration for Linux %s: %+v", id, err)
+			}
+
+			swiftConnection, err := client.GetSwiftVirtualNetworkConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(swiftConnection.Response) {
+					return fmt.Errorf("reading Virtual Network Integration for Linux %s: %+v", id, err)
+				}
+			}
+
+			state := LinuxFunctionAppSlotDataSourceModel{
+				Name:                        id.SlotName,
+				FunctionAppID:               functionAppId.ID(),
+				Enabled:                     utils.NormaliseNilableBool(functionApp.Enabled),
+				ClientCertMode:              string(functionApp.ClientCertMode),
+				DailyMemoryTimeQuota:        int(utils.NormaliseNilableInt32(props.DailyMemoryTimeQuota)),
+				Tags:                        tags.ToTypedObject(functionApp.Tags),
+				Kind:                        utils.NormalizeNilableString(functionApp.Kind),
+				KeyVaultReferenceIdentityID: utils.NormalizeNilableString(props.KeyVaultReferenceIdentity),
+				CustomDomainVerificationId:  utils.NormalizeNilableString(props.CustomDomainVerificationID),
+				DefaultHostname:             utils.NormalizeNilableString(props.DefaultHostName),
+				OutboundIPAddresses:         utils.NormalizeNilableString(props.OutboundIPAddresses),
+				PossibleOutboundIPAddresses: utils.NormalizeNilableString(props.PossibleOutboundIPAddresses),
+			}
+
+			if props.OutboundIPAddresses != nil {
+				state.OutboundIPAddressList = strings.Split(*props.OutboundIPAddresses, ",")
+			}
+
+			if props.PossibleOutboundIPAddresses != nil {
+				state.PossibleOutboundIPAddressList = strings.Split(*props.PossibleOutboundIPAddresses, ",")
+			}
+
+			if swiftProps := swiftConnection.SwiftVirtualNetworkProperties; swiftProps != nil {
+				state.VirtualNetworkSubnetID = utils.NormalizeNilableString(swiftProps.SubnetResourceID)
+			}
+
+			configResp, err := client.GetConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("making Read request on AzureRM Function App Configuration %q: %+v", id.SiteName, err)
+			}
+
+			siteConfig, err := helpers.FlattenSiteConfigLinuxFunctionAppSlot(configResp.SiteConfig)
+			if err != nil {
+				return fmt.Errorf("reading Site Config for Linux %s: %+v", id, err)
+			}
+			state.SiteConfig = []helpers.SiteConfigLinuxFunctionAppSlot{*siteConfig}
+
+			ipRestrictionDefaultAction, scmIpRestrictionDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading IP Restriction Default Actions for Linux %s: %+v", id, err)
+			}
+			state.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+			state.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+
+			state.unpackLinuxFunctionAppSlotSettings(appSettingsResp)
+
+			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
+
+			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
+
+			state.AuthSettings = helpers.FlattenAuthSettings(auth)
+
+			state.Backup = helpers.FlattenBackupConfig(backup)
+
+			state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs)
+
+			state.HttpsOnly = utils.NormaliseNilableBool(functionApp.HTTPSOnly)
+			state.ClientCertEnabled = utils.NormaliseNilableBool(functionApp.ClientCertEnabled)
+
+			metadata.SetID(id)
+
+			if err := metadata.Encode(&state); err != nil {
+				return fmt.Errorf("encoding: %+v", err)
+			}
+
+			flattenedIdentity, err := flattenIdentity(functionApp.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (m *LinuxFunctionAppSlotDataSourceModel) unpackLinuxFunctionAppSlotSettings(input web.StringDictionary) {
+	if input.Properties == nil {
+		return
+	}
+
+	appSettings := make(map[string]string)
+	var dockerSettings helpers.ApplicationStackDocker
+	m.BuiltinLogging = false
+
+	for k, v := range input.Properties {
+		switch k {
+		case "FUNCTIONS_EXTENSION_VERSION":
+			m.FunctionExtensionsVersion = utils.NormalizeNilableString(v)
+
+		case "WEBSITE_NODE_DEFAULT_VERSION": // Note - This is only set if it's not the default of 12, but we collect it from LinuxFxVersion so can discard it here
+		case "WEBSITE_HTTPLOGGING_RETENTION_DAYS":
+		case "FUNCTIONS_WORKER_RUNTIME":
+			if len(m.SiteConfig) > 0 && len(m.SiteConfig[0].ApplicationStack) == 0 {
+				if utils.NormalizeNilableString(v) == "custom" {
+					m.SiteConfig[0].ApplicationStack = []helpers.ApplicationStackLinuxFunctionApp{{CustomHandler: true}}
+				}
+			}
+
+		case "DOCKER_REGISTRY_SERVER_URL":
+			dockerSettings.RegistryURL = utils.NormalizeNilableString(v)
+
+		case "DOCKER_REGISTRY_SERVER_USERNAME":
+			dockerSettings.RegistryUsername = utils.NormalizeNilableString(v)
+
+		case "DOCKER_REGISTRY_SERVER_PASSWORD":
+			dockerSettings.RegistryPassword = utils.NormalizeNilableString(v)
+
+		case "APPINSIGHTS_INSTRUMENTATIONKEY":
+			m.SiteConfig[0].AppInsightsInstrumentationKey = utils.NormalizeNilableString(v)
+
+		case "APPLICATIONINSIGHTS_CONNECTION_STRING":
+			m.SiteConfig[0].AppInsightsConnectionString = utils.NormalizeNilableString(v)
+
+		case "AzureWebJobsStorage":
+			if v != nil && strings.HasPrefix(*v, "@Microsoft.KeyVault") {
+				trimmed := strings.TrimPrefix(strings.TrimSuffix(*v, ")"), "@Microsoft.KeyVault(SecretUri=")
+				m.StorageKeyVaultSecretID = trimmed
+			} else {
+				m.StorageAccountName, m.StorageAccountKey = helpers.ParseWebJobsStorageString(v)
+			}
+
+		case "AzureWebJobsDashboard":
+			m.BuiltinLogging = true
+
+		case "WEBSITE_HEALTHCHECK_MAXPINGFAILURES":
+			i, _ := strconv.Atoi(utils.NormalizeNilableString(v))
+			m.SiteConfig[0].HealthCheckEvictionTime = utils.NormaliseNilableInt(&i)
+
+		case "AzureWebJobsStorage__accountName":
+			m.StorageUsesMSI = true
+			m.StorageAccountName = utils.NormalizeNilableString(v)
+
+		case "AzureWebJobsDashboard__accountName":
+			m.BuiltinLogging = true
+
+		default:
+			appSettings[k] = utils.NormalizeNilableString(v)
+		}
+	}
+
+	if dockerSettings.RegistryURL != "" {
+		appStack := make([]helpers.ApplicationStackLinuxFunctionApp, 0)
+		docker, _ := helpers.DecodeFunctionAppDockerFxString(m.SiteConfig[0].LinuxFxVersion, dockerSettings)
+		appStack = append(appStack, helpers.ApplicationStackLinuxFunctionApp{Docker: docker})
+		m.SiteConfig[0].ApplicationStack = appStack
+	}
+
+	m.AppSettings = appSettings
+}
@@ -0,0 +1,39 @@
+package appservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LinuxFunctionAppSlotDataSource struct{}
+
+func TestAccLinuxFunctionAppSlotDataSource_standardComplete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_linux_function_app_slot", "test")
+	d := LinuxFunctionAppSlotDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.standardComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("function_app_id").Exists(),
+				check.That(data.ResourceName).Key("default_hostname").Exists(),
+				check.That(data.ResourceName).Key("outbound_ip_address_list.#").Exists(),
+				check.That(data.ResourceName).Key("site_config.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (LinuxFunctionAppSlotDataSource) standardComplete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_linux_function_app_slot" "test" {
+  name            = azurerm_linux_function_app_slot.test.name
+  function_app_id = azurerm_linux_function_app.test.id
+}
+`, LinuxFunctionAppSlotResource{}.standardComplete(data))
+}
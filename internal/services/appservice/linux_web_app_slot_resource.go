@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
@@ -23,37 +22,42 @@ import (
 type LinuxWebAppSlotResource struct{}
 
 type LinuxWebAppSlotModel struct {
-	Name                          string                              `tfschema:"name"`
-	AppServiceId                  string                              `tfschema:"app_service_id"`
-	AppSettings                   map[string]string                   `tfschema:"app_settings"`
-	AuthSettings                  []helpers.AuthSettings              `tfschema:"auth_settings"`
-	Backup                        []helpers.Backup                    `tfschema:"backup"`
-	ClientAffinityEnabled         bool                                `tfschema:"client_affinity_enabled"`
-	ClientCertEnabled             bool                                `tfschema:"client_certificate_enabled"`
-	ClientCertMode                string                              `tfschema:"client_certificate_mode"`
-	Enabled                       bool                                `tfschema:"enabled"`
-	HttpsOnly                     bool                                `tfschema:"https_only"`
-	KeyVaultReferenceIdentityID   string                              `tfschema:"key_vault_reference_identity_id"`
-	LogsConfig                    []helpers.LogsConfig                `tfschema:"logs"`
-	MetaData                      map[string]string                   `tfschema:"app_metadata"`
-	SiteConfig                    []helpers.SiteConfigLinuxWebAppSlot `tfschema:"site_config"`
-	StorageAccounts               []helpers.StorageAccount            `tfschema:"storage_account"`
-	ConnectionStrings             []helpers.ConnectionString          `tfschema:"connection_string"`
-	ZipDeployFile                 string                              `tfschema:"zip_deploy_file"`
-	Tags                          map[string]string                   `tfschema:"tags"`
-	CustomDomainVerificationId    string                              `tfschema:"custom_domain_verification_id"`
-	DefaultHostname               string                              `tfschema:"default_hostname"`
-	Kind                          string                              `tfschema:"kind"`
-	OutboundIPAddresses           string                              `tfschema:"outbound_ip_addresses"`
-	OutboundIPAddressList         []string                            `tfschema:"outbound_ip_address_list"`
-	PossibleOutboundIPAddresses   string                              `tfschema:"possible_outbound_ip_addresses"`
-	PossibleOutboundIPAddressList []string                            `tfschema:"possible_outbound_ip_address_list"`
-	SiteCredentials               []helpers.SiteCredential            `tfschema:"site_credential"`
-	VirtualNetworkSubnetID        string                              `tfschema:"virtual_network_subnet_id"`
+	Name                             string                              `tfschema:"name"`
+	AppServiceId                     string                              `tfschema:"app_service_id"`
+	AppSettings                      map[string]string                   `tfschema:"app_settings"`
+	AuthSettings                     []helpers.AuthSettings              `tfschema:"auth_settings"`
+	Backup                           []helpers.Backup                    `tfschema:"backup"`
+	ClientAffinityEnabled            bool                                `tfschema:"client_affinity_enabled"`
+	ClientCertEnabled                bool                                `tfschema:"client_certificate_enabled"`
+	ClientCertMode                   string                              `tfschema:"client_certificate_mode"`
+	Enabled                          bool                                `tfschema:"enabled"`
+	PublishingFTPBasicAuthEnabled    bool                                `tfschema:"ftp_publish_basic_authentication_enabled"`
+	PublishingDeployBasicAuthEnabled bool                                `tfschema:"webdeploy_publish_basic_authentication_enabled"`
+	HttpsOnly                        bool                                `tfschema:"https_only"`
+	PublicNetworkAccess              bool                                `tfschema:"public_network_access_enabled"`
+	KeyVaultReferenceIdentityID      string                              `tfschema:"key_vault_reference_identity_id"`
+	LogsConfig                       []helpers.LogsConfig                `tfschema:"logs"`
+	MetaData                         map[string]string                   `tfschema:"app_metadata"`
+	SiteConfig                       []helpers.SiteConfigLinuxWebAppSlot `tfschema:"site_config"`
+	StorageAccounts                  []helpers.StorageAccount            `tfschema:"storage_account"`
+	ConnectionStrings                []helpers.ConnectionString          `tfschema:"connection_string"`
+	ZipDeployFile                    string                              `tfschema:"zip_deploy_file"`
+	Tags                             map[string]string                   `tfschema:"tags"`
+	CustomDomainVerificationId       string                              `tfschema:"custom_domain_verification_id"`
+	DefaultHostname                  string                              `tfschema:"default_hostname"`
+	Kind                             string                              `tfschema:"kind"`
+	OutboundIPAddresses              string                              `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList            []string                            `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses      string                              `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList    []string                            `tfschema:"possible_outbound_ip_address_list"`
+	SiteCredentials                  []helpers.SiteCredential            `tfschema:"site_credential"`
+	VirtualNetworkSubnetID           string                              `tfschema:"virtual_network_subnet_id"`
 }
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppSlotResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppSlotResource{}
+
 func (r LinuxWebAppSlotResource) ModelObject() interface{} {
 	return &LinuxWebAppSlotModel{}
 }
@@ -125,12 +129,26 @@ func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"ftp_publish_basic_authentication_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"webdeploy_publish_basic_authentication_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"https_only": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
 			Default:  false,
 		},
 
+		"public_network_access_enabled": helpers.PublicNetworkAccessSchema(),
+
 		"virtual_network_subnet_id": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -143,7 +161,7 @@ func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
-			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			ValidateFunc: validate.KeyVaultReferenceIdentityID,
 		},
 
 		"logs": helpers.LogsConfigSchema(),
@@ -270,6 +288,8 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
 
+			siteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(webAppSlot.PublicNetworkAccess)
+
 			siteEnvelope := web.Site{
 				Location: webApp.Location,
 				Identity: expandedIdentity,
@@ -360,6 +380,24 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if !webAppSlot.PublishingFTPBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(webAppSlot.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			if !webAppSlot.PublishingDeployBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(webAppSlot.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, webAppSlot.SiteConfig[0].IpRestrictionDefaultAction, webAppSlot.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+				return fmt.Errorf("setting IP Restriction Default Actions for Linux %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
@@ -425,17 +463,22 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
 			}
 
-			siteCredentialsFuture, err := client.ListPublishingCredentialsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
-			if err != nil {
-				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
+			ftpBasicAuthPolicy, err := client.GetFtpAllowedSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil && !utils.ResponseWasNotFound(ftpBasicAuthPolicy.Response) {
+				return fmt.Errorf("reading FTP Publish Basic Authentication Policy for Linux %s: %+v", id, err)
 			}
 
-			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for Site Publishing Credential information for Linux %s: %+v", id, err)
+			scmBasicAuthPolicy, err := client.GetScmAllowedSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil && !utils.ResponseWasNotFound(scmBasicAuthPolicy.Response) {
+				return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
 			}
-			siteCredentials, err := siteCredentialsFuture.Result(*client)
-			if err != nil {
-				return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
+
+			var siteCredentials web.User
+			if !metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+				siteCredentials, err = helpers.ReadSiteCredentialsSlot(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, scmBasicAuthPolicy)
+				if err != nil {
+					return fmt.Errorf("reading Site Publishing Credential information for Linux %s: %+v", id, err)
+				}
 			}
 
 			state := LinuxWebAppSlotModel{
@@ -475,6 +518,17 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 
 			state.SiteConfig = helpers.FlattenSiteConfigLinuxWebAppSlot(webAppSiteConfig.SiteConfig, healthCheckCount)
 
+			ipRestrictionDefaultAction, scmIpRestrictionDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading IP Restriction Default Actions for Linux %s: %+v", id, err)
+			}
+			if len(state.SiteConfig) > 0 {
+				state.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+				state.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+			}
+
+			state.PublicNetworkAccess = helpers.FlattenPublicNetworkAccess(webAppSiteConfig.SiteConfig)
+
 			state.StorageAccounts = helpers.FlattenStorageAccounts(storageAccounts)
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
@@ -486,6 +540,9 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 				state.ZipDeployFile = deployFile
 			}
 
+			state.PublishingFTPBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(ftpBasicAuthPolicy)
+			state.PublishingDeployBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(scmBasicAuthPolicy)
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
@@ -588,6 +645,11 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				existing.SiteConfig = siteConfig
 			}
 
+			if existing.SiteConfig == nil {
+				existing.SiteConfig = &web.SiteConfig{}
+			}
+			existing.SiteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(state.PublicNetworkAccess)
+
 			updateFuture, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, existing, id.SlotName)
 			if err != nil {
 				return fmt.Errorf("updating Linux %s: %+v", id, err)
@@ -654,6 +716,27 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("ftp_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("webdeploy_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Linux %s: %+v", id, err)
+				}
+			}
+
+			// the IP Restriction Default Actions aren't part of the Site Config sent above, so these are set separately
+			if metadata.ResourceData.HasChange("site_config") {
+				if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, state.SiteConfig[0].IpRestrictionDefaultAction, state.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+					return fmt.Errorf("updating IP Restriction Default Actions for Linux %s: %+v", id, err)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("zip_deploy_file") || metadata.ResourceData.HasChange("zip_deploy_file") {
 				if err = helpers.GetCredentialsAndPublishSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, id.SlotName); err != nil {
 					return err
@@ -664,3 +747,24 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r LinuxWebAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if err := helpers.ValidateKeyVaultReferenceIdentity(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidatePublicNetworkAccess(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if metadata.Client.Features.AppService.ValidateKeyVaultReferences {
+				return helpers.ValidateKeyVaultReferences(metadata.ResourceDiff)
+			}
+
+			return nil
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -277,6 +278,8 @@ func TestAccLinuxWebAppSlot_withIPRestrictionsUpdate(t *testing.T) {
 			Config: r.withIPRestrictionsUpdate(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.ip_restriction_default_action").HasValue("Deny"),
+				check.That(data.ResourceName).Key("site_config.0.scm_ip_restriction_default_action").HasValue("Deny"),
 			),
 		},
 		data.ImportStep(),
@@ -886,7 +889,7 @@ func TestAccLinuxWebAppSlot_zipDeploy(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("zip_deploy_file"),
+		data.ImportStep(helpers.ImportVerifyIgnore(helpers.WebAppWriteOnlyFields)...),
 	})
 }
 
@@ -1625,6 +1628,9 @@ resource "azurerm_linux_web_app_slot" "test" {
   app_service_id = azurerm_linux_web_app.test.id
 
   site_config {
+    ip_restriction_default_action     = "Deny"
+    scm_ip_restriction_default_action = "Deny"
+
     ip_restriction {
       ip_address = "10.10.10.10/32"
       name       = "test-restriction"
@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AppSlotHybridConnectionId struct {
+	SubscriptionId                string
+	ResourceGroup                 string
+	SiteName                      string
+	SlotName                      string
+	HybridConnectionNamespaceName string
+	RelayName                     string
+}
+
+func NewAppSlotHybridConnectionID(subscriptionId, resourceGroup, siteName, slotName, hybridConnectionNamespaceName, relayName string) AppSlotHybridConnectionId {
+	return AppSlotHybridConnectionId{
+		SubscriptionId:                subscriptionId,
+		ResourceGroup:                 resourceGroup,
+		SiteName:                      siteName,
+		SlotName:                      slotName,
+		HybridConnectionNamespaceName: hybridConnectionNamespaceName,
+		RelayName:                     relayName,
+	}
+}
+
+func (id AppSlotHybridConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Relay Name %q", id.RelayName),
+		fmt.Sprintf("Hybrid Connection Namespace Name %q", id.HybridConnectionNamespaceName),
+		fmt.Sprintf("Slot Name %q", id.SlotName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "App Slot Hybrid Connection", segmentsStr)
+}
+
+func (id AppSlotHybridConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s/hybridConnectionNamespaces/%s/relays/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.HybridConnectionNamespaceName, id.RelayName)
+}
+
+// AppSlotHybridConnectionID parses a AppSlotHybridConnection ID into an AppSlotHybridConnectionId struct
+func AppSlotHybridConnectionID(input string) (*AppSlotHybridConnectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AppSlotHybridConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+		return nil, err
+	}
+	if resourceId.HybridConnectionNamespaceName, err = id.PopSegment("hybridConnectionNamespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.RelayName, err = id.PopSegment("relays"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,160 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = AppSlotHybridConnectionId{}
+
+func TestAppSlotHybridConnectionIDFormatter(t *testing.T) {
+	actual := NewAppSlotHybridConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1", "hybridConnectionNamespace1", "relay1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hybridConnectionNamespaces/hybridConnectionNamespace1/relays/relay1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAppSlotHybridConnectionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *AppSlotHybridConnectionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing SiteName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/",
+			Error: true,
+		},
+
+		{
+			// missing value for SiteName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/",
+			Error: true,
+		},
+
+		{
+			// missing SlotName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/",
+			Error: true,
+		},
+
+		{
+			// missing value for SlotName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/",
+			Error: true,
+		},
+
+		{
+			// missing HybridConnectionNamespaceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/",
+			Error: true,
+		},
+
+		{
+			// missing value for HybridConnectionNamespaceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hybridConnectionNamespaces/",
+			Error: true,
+		},
+
+		{
+			// missing RelayName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hybridConnectionNamespaces/hybridConnectionNamespace1/",
+			Error: true,
+		},
+
+		{
+			// missing value for RelayName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hybridConnectionNamespaces/hybridConnectionNamespace1/relays/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hybridConnectionNamespaces/hybridConnectionNamespace1/relays/relay1",
+			Expected: &AppSlotHybridConnectionId{
+				SubscriptionId:                "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                 "resGroup1",
+				SiteName:                      "site1",
+				SlotName:                      "slot1",
+				HybridConnectionNamespaceName: "hybridConnectionNamespace1",
+				RelayName:                     "relay1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.WEB/SITES/SITE1/SLOTS/SLOT1/HYBRIDCONNECTIONNAMESPACES/HYBRIDCONNECTIONNAMESPACE1/RELAYS/RELAY1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := AppSlotHybridConnectionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.SiteName != v.Expected.SiteName {
+			t.Fatalf("Expected %q but got %q for SiteName", v.Expected.SiteName, actual.SiteName)
+		}
+		if actual.SlotName != v.Expected.SlotName {
+			t.Fatalf("Expected %q but got %q for SlotName", v.Expected.SlotName, actual.SlotName)
+		}
+		if actual.HybridConnectionNamespaceName != v.Expected.HybridConnectionNamespaceName {
+			t.Fatalf("Expected %q but got %q for HybridConnectionNamespaceName", v.Expected.HybridConnectionNamespaceName, actual.HybridConnectionNamespaceName)
+		}
+		if actual.RelayName != v.Expected.RelayName {
+			t.Fatalf("Expected %q but got %q for RelayName", v.Expected.RelayName, actual.RelayName)
+		}
+	}
+}
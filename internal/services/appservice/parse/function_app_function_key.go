@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FunctionAppFunctionKeyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	FunctionName   string
+	KeyName        string
+}
+
+func NewFunctionAppFunctionKeyID(subscriptionId, resourceGroup, siteName, functionName, keyName string) FunctionAppFunctionKeyId {
+	return FunctionAppFunctionKeyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		FunctionName:   functionName,
+		KeyName:        keyName,
+	}
+}
+
+func (id FunctionAppFunctionKeyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Key Name %q", id.KeyName),
+		fmt.Sprintf("Function Name %q", id.FunctionName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Function App Function Key", segmentsStr)
+}
+
+func (id FunctionAppFunctionKeyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/functions/%s/keys/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.FunctionName, id.KeyName)
+}
+
+// FunctionAppFunctionKeyID parses a FunctionAppFunctionKey ID into an FunctionAppFunctionKeyId struct
+func FunctionAppFunctionKeyID(input string) (*FunctionAppFunctionKeyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FunctionAppFunctionKeyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.FunctionName, err = id.PopSegment("functions"); err != nil {
+		return nil, err
+	}
+	if resourceId.KeyName, err = id.PopSegment("keys"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
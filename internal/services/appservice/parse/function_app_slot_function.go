@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FunctionAppSlotFunctionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	SlotName       string
+	FunctionName   string
+}
+
+func NewFunctionAppSlotFunctionID(subscriptionId, resourceGroup, siteName, slotName, functionName string) FunctionAppSlotFunctionId {
+	return FunctionAppSlotFunctionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		SlotName:       slotName,
+		FunctionName:   functionName,
+	}
+}
+
+func (id FunctionAppSlotFunctionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Function Name %q", id.FunctionName),
+		fmt.Sprintf("Slot Name %q", id.SlotName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Function App Slot Function", segmentsStr)
+}
+
+func (id FunctionAppSlotFunctionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s/functions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.FunctionName)
+}
+
+// FunctionAppSlotFunctionID parses a FunctionAppSlotFunction ID into an FunctionAppSlotFunctionId struct
+func FunctionAppSlotFunctionID(input string) (*FunctionAppSlotFunctionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FunctionAppSlotFunctionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+		return nil, err
+	}
+	if resourceId.FunctionName, err = id.PopSegment("functions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FunctionAppSlotFunctionKeyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	SlotName       string
+	FunctionName   string
+	KeyName        string
+}
+
+func NewFunctionAppSlotFunctionKeyID(subscriptionId, resourceGroup, siteName, slotName, functionName, keyName string) FunctionAppSlotFunctionKeyId {
+	return FunctionAppSlotFunctionKeyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		SlotName:       slotName,
+		FunctionName:   functionName,
+		KeyName:        keyName,
+	}
+}
+
+func (id FunctionAppSlotFunctionKeyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Key Name %q", id.KeyName),
+		fmt.Sprintf("Function Name %q", id.FunctionName),
+		fmt.Sprintf("Slot Name %q", id.SlotName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Function App Slot Function Key", segmentsStr)
+}
+
+func (id FunctionAppSlotFunctionKeyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s/functions/%s/keys/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.FunctionName, id.KeyName)
+}
+
+// FunctionAppSlotFunctionKeyID parses a FunctionAppSlotFunctionKey ID into an FunctionAppSlotFunctionKeyId struct
+func FunctionAppSlotFunctionKeyID(input string) (*FunctionAppSlotFunctionKeyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FunctionAppSlotFunctionKeyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+		return nil, err
+	}
+	if resourceId.FunctionName, err = id.PopSegment("functions"); err != nil {
+		return nil, err
+	}
+	if resourceId.KeyName, err = id.PopSegment("keys"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
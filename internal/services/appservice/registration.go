@@ -22,8 +22,11 @@ func (r Registration) Name() string {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		AppServiceDeploymentStatusDataSource{},
+		AppServiceOutboundIPsDataSource{},
 		AppServiceSourceControlTokenDataSource{},
 		LinuxFunctionAppDataSource{},
+		LinuxFunctionAppSlotDataSource{},
 		LinuxWebAppDataSource{},
 		ServicePlanDataSource{},
 		WindowsFunctionAppDataSource{},
@@ -35,7 +38,9 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AppServiceSourceControlTokenResource{},
 		FunctionAppActiveSlotResource{},
+		FunctionAppFunctionKeyResource{},
 		FunctionAppFunctionResource{},
+		FunctionAppHostKeyResource{},
 		FunctionAppHybridConnectionResource{},
 		LinuxFunctionAppResource{},
 		LinuxFunctionAppSlotResource{},
@@ -45,6 +50,7 @@ func (r Registration) Resources() []sdk.Resource {
 		SourceControlResource{},
 		SourceControlSlotResource{},
 		WebAppActiveSlotResource{},
+		WebAppBackupRestoreResource{},
 		WebAppHybridConnectionResource{},
 		WindowsFunctionAppResource{},
 		WindowsFunctionAppSlotResource{},
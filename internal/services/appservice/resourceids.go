@@ -7,4 +7,8 @@ package appservice
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ServicePlan -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/serverfarms/farm1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AppServiceEnvironment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/hostingEnvironments/hostingEnvironment1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=FunctionAppFunction -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/functions/function1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=FunctionAppSlotFunction -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/functions/function1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AppHybridConnection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/hybridConnectionNamespaces/hybridConnectionNamespace1/relays/relay1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AppSlotHybridConnection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/hybridConnectionNamespaces/hybridConnectionNamespace1/relays/relay1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=FunctionAppFunctionKey -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/functions/function1/keys/key1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=FunctionAppSlotFunctionKey -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/functions/function1/keys/key1
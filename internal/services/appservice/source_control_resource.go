@@ -35,11 +35,14 @@ var _ sdk.Resource = SourceControlResource{}
 func (r SourceControlResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"app_id": {
-			Type:         pluginsdk.TypeString,
-			Required:     true,
-			ForceNew:     true,
-			ValidateFunc: validate.WebAppID,
-			Description:  "The ID of the Windows or Linux Web App.",
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				validate.WebAppID,
+				validate.FunctionAppID,
+			),
+			Description: "The ID of the Windows or Linux Web App or Function App.",
 		},
 
 		"repo_url": {
@@ -174,6 +174,46 @@ func TestAccSourceControlResource_linuxGitHub(t *testing.T) {
 	})
 }
 
+func TestAccSourceControlResource_windowsFunctionAppGitHubAction(t *testing.T) {
+	if ok := os.Getenv("ARM_GITHUB_ACCESS_TOKEN"); ok == "" {
+		t.Skip("Skipping as `ARM_GITHUB_ACCESS_TOKEN` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_source_control", "test")
+	r := AppServiceSourceControlResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.windowsFunctionAppGitHubAction(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uses_github_action").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSourceControlResource_linuxFunctionAppGitHubAction(t *testing.T) {
+	if ok := os.Getenv("ARM_GITHUB_ACCESS_TOKEN"); ok == "" {
+		t.Skip("Skipping as `ARM_GITHUB_ACCESS_TOKEN` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_source_control", "test")
+	r := AppServiceSourceControlResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linuxFunctionAppGitHubAction(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("uses_github_action").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r AppServiceSourceControlResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.WebAppID(state.ID)
 	if err != nil {
@@ -386,6 +426,76 @@ resource "azurerm_app_service_source_control" "test" {
 `, r.baseLinuxAppTemplate(data), token)
 }
 
+func (r AppServiceSourceControlResource) windowsFunctionAppGitHubAction(data acceptance.TestData) string {
+	token := os.Getenv("ARM_GITHUB_ACCESS_TOKEN")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_source_control_token" "test" {
+  type  = "GitHub"
+  token = "%s"
+}
+
+resource "azurerm_app_service_source_control" "test" {
+  app_id   = azurerm_windows_function_app.test.id
+  repo_url = "https://github.com/jackofallops/app-service-web-dotnet-get-started.git"
+  branch   = "master"
+
+  github_action_configuration {
+    generate_workflow_file = true
+
+    code_configuration {
+      runtime_stack   = "dotnet"
+      runtime_version = "6.0.x"
+    }
+  }
+
+  depends_on = [
+    azurerm_source_control_token.test,
+  ]
+}
+`, r.baseWindowsFunctionAppTemplate(data), token)
+}
+
+func (r AppServiceSourceControlResource) linuxFunctionAppGitHubAction(data acceptance.TestData) string {
+	token := os.Getenv("ARM_GITHUB_ACCESS_TOKEN")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_source_control_token" "test" {
+  type  = "GitHub"
+  token = "%s"
+}
+
+resource "azurerm_app_service_source_control" "test" {
+  app_id   = azurerm_linux_function_app.test.id
+  repo_url = "https://github.com/jackofallops/app-service-web-dotnet-get-started.git"
+  branch   = "master"
+
+  github_action_configuration {
+    generate_workflow_file = true
+
+    code_configuration {
+      runtime_stack   = "dotnet"
+      runtime_version = "6.0.x"
+    }
+  }
+
+  depends_on = [
+    azurerm_source_control_token.test,
+  ]
+}
+`, r.baseLinuxFunctionAppTemplate(data), token)
+}
+
 func (r AppServiceSourceControlResource) baseWindowsAppTemplate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 
@@ -443,3 +553,85 @@ resource "azurerm_linux_web_app" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r AppServiceSourceControlResource) baseWindowsFunctionAppTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ASSC-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctest-SP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "S1"
+  os_type             = "Windows"
+}
+
+resource "azurerm_windows_function_app" "test" {
+  name                       = "acctestFA-%[1]d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  service_plan_id            = azurerm_service_plan.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {
+    application_stack {
+      dotnet_version = "6"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r AppServiceSourceControlResource) baseLinuxFunctionAppTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ASSC-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctest-SP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "S1"
+  os_type             = "Linux"
+}
+
+resource "azurerm_linux_function_app" "test" {
+  name                       = "acctestFA-%[1]d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  service_plan_id            = azurerm_service_plan.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {
+    application_stack {
+      dotnet_version = "6.0"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
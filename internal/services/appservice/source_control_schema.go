@@ -91,6 +91,9 @@ func githubActionConfigSchema() *pluginsdk.Schema {
 									"tomcat",     // Java on Tomcat
 									"node",       // Node, all versions
 									"python",     // Python, all versions
+									"dotnet",     // Function Apps - .Net, all versions
+									"java",       // Function Apps - Java, all versions
+									"powershell", // Function Apps - PowerShell Core, all versions
 								}, false),
 								Description: "The value to use for the Runtime Stack in the workflow file content for code base apps.",
 							},
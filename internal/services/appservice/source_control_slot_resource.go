@@ -36,11 +36,14 @@ var _ sdk.Resource = SourceControlSlotResource{}
 func (r SourceControlSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"slot_id": {
-			Type:         pluginsdk.TypeString,
-			Required:     true,
-			ForceNew:     true,
-			ValidateFunc: validate.WebAppSlotID,
-			Description:  "The ID of the Linux or Windows Web App Slot.",
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				validate.WebAppSlotID,
+				validate.FunctionAppSlotID,
+			),
+			Description: "The ID of the Linux or Windows Web App Slot or Function App Slot.",
 		},
 
 		"repo_url": {
@@ -0,0 +1,52 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+)
+
+func TestFunctionAppKeyName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "default",
+			Valid: true,
+		},
+		{
+			Input: "my-key_1.test",
+			Valid: true,
+		},
+		{
+			Input: "_master",
+			Valid: false,
+		},
+		{
+			Input: "has space",
+			Valid: false,
+		},
+		{
+			Input: "ThisNameIsTooLongThisNameIsTooLongThisNameIsTooLongThisNameIsTooLongThisNameIsTooLongThisNameIsTooLongThisNameIsTooLongThisNameIsTooLong",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errs := validate.FunctionAppKeyName(tc.Input, "test")
+		valid := len(errs) == 0
+
+		if valid != tc.Valid {
+			t.Fatalf("expected %s to be %t, got %t", tc.Input, tc.Valid, valid)
+		}
+	}
+}
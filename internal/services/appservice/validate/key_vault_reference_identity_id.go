@@ -0,0 +1,29 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// KeyVaultReferenceIdentitySystemAssigned is the value used to specify that the System Assigned Identity
+// should be used to access Key Vault References
+const KeyVaultReferenceIdentitySystemAssigned = "SystemAssigned"
+
+func KeyVaultReferenceIdentityID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if v == KeyVaultReferenceIdentitySystemAssigned {
+		return
+	}
+
+	if _, err := commonids.ParseUserAssignedIdentityID(v); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be either %q or a User Assigned Identity ID: %+v", key, KeyVaultReferenceIdentitySystemAssigned, err))
+	}
+
+	return
+}
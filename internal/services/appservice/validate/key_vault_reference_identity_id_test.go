@@ -0,0 +1,50 @@
+package validate
+
+import "testing"
+
+func TestKeyVaultReferenceIdentityID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// system assigned
+			Input: "SystemAssigned",
+			Valid: true,
+		},
+
+		{
+			// system assigned wrong casing
+			Input: "systemassigned",
+			Valid: false,
+		},
+
+		{
+			// user assigned
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+			Valid: true,
+		},
+
+		{
+			// other resource id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := KeyVaultReferenceIdentityID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -77,10 +77,10 @@ func (r WebAppActiveSlotResource) Create() sdk.ResourceFunc {
 
 			client := metadata.Client.AppService.WebAppsClient
 			id, err := parse.WebAppSlotID(activeSlot.SlotID)
-			appId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("parsing App ID: %+v", err)
 			}
+			appId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
 
 			app, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
@@ -127,15 +127,19 @@ func (r WebAppActiveSlotResource) Read() sdk.ResourceFunc {
 
 			app, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
+				if utils.ResponseWasNotFound(app.Response) {
+					return metadata.MarkAsGone(id)
+				}
 				return fmt.Errorf("reading active slot for %s: %+v", id.SiteName, err)
 			}
 
 			if app.SiteProperties == nil || app.SiteProperties.SlotSwapStatus == nil {
-				return fmt.Errorf("reading site properties to determine active slot status: %+v", err)
+				return fmt.Errorf("reading site properties to determine active slot status for %s", id)
 			}
 
-			activeSlot := WebAppActiveSlotModel{
-				LastSwap: app.SiteProperties.SlotSwapStatus.TimestampUtc.String(),
+			activeSlot := WebAppActiveSlotModel{}
+			if timestamp := app.SiteProperties.SlotSwapStatus.TimestampUtc; timestamp != nil {
+				activeSlot.LastSwap = timestamp.String()
 			}
 
 			if slotName := app.SiteProperties.SlotSwapStatus.SourceSlotName; slotName != nil {
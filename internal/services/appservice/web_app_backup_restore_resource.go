@@ -0,0 +1,237 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppBackupRestoreResource struct{}
+
+type WebAppBackupRestoreModel struct {
+	AppID                      string `tfschema:"app_id"`
+	StorageAccountUrl          string `tfschema:"storage_account_url"`
+	BlobName                   string `tfschema:"blob_name"`
+	Overwrite                  bool   `tfschema:"overwrite"`
+	IgnoreConflictingHostNames bool   `tfschema:"ignore_conflicting_host_names"`
+	IgnoreDatabases            bool   `tfschema:"ignore_databases"`
+}
+
+var _ sdk.ResourceWithCustomImporter = WebAppBackupRestoreResource{}
+
+func (r WebAppBackupRestoreResource) ModelObject() interface{} {
+	return &WebAppBackupRestoreModel{}
+}
+
+func (r WebAppBackupRestoreResource) ResourceType() string {
+	return "azurerm_web_app_backup_restore"
+}
+
+func (r WebAppBackupRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(validate.WebAppID, validate.WebAppSlotID)
+}
+
+func (r WebAppBackupRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(validate.WebAppID, validate.WebAppSlotID),
+			Description:  "The ID of the Web App, Function App or Slot to restore the Backup into.",
+		},
+
+		"storage_account_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+			Description:  "The SAS URL to the container which contains the Backup.",
+		},
+
+		"blob_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the Blob which contains the Backup.",
+		},
+
+		"overwrite": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+			Description: "Should the restore overwrite the existing content of the App? Defaults to `true`.",
+		},
+
+		"ignore_conflicting_host_names": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Should Custom Domains which conflict with other Apps be removed when restoring? Defaults to `false`.",
+		},
+
+		"ignore_databases": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Should the Databases within the Backup be ignored, so that only the App content is restored? Defaults to `false`.",
+		},
+	}
+}
+
+func (r WebAppBackupRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppBackupRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var restore WebAppBackupRestoreModel
+
+			if err := metadata.Decode(&restore); err != nil {
+				return err
+			}
+
+			client := metadata.Client.AppService.WebAppsClient
+
+			request := web.RestoreRequest{
+				RestoreRequestProperties: &web.RestoreRequestProperties{
+					StorageAccountURL:          utils.String(restore.StorageAccountUrl),
+					BlobName:                   utils.String(restore.BlobName),
+					Overwrite:                  utils.Bool(restore.Overwrite),
+					IgnoreConflictingHostNames: utils.Bool(restore.IgnoreConflictingHostNames),
+					IgnoreDatabases:            utils.Bool(restore.IgnoreDatabases),
+				},
+			}
+
+			if slotId, err := parse.WebAppSlotID(restore.AppID); err == nil {
+				existing, err := client.GetSlot(ctx, slotId.ResourceGroup, slotId.SiteName, slotId.SlotName)
+				if err != nil {
+					if utils.ResponseWasNotFound(existing.Response) {
+						return fmt.Errorf("%s was not found", slotId)
+					}
+					return fmt.Errorf("reading %s: %+v", slotId, err)
+				}
+
+				locks.ByID(slotId.ID())
+				defer locks.UnlockByID(slotId.ID())
+
+				future, err := client.RestoreFromBackupBlobSlot(ctx, slotId.ResourceGroup, slotId.SiteName, request, slotId.SlotName)
+				if err != nil {
+					return fmt.Errorf("restoring Backup %q into %s: %+v", restore.BlobName, slotId, err)
+				}
+				if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for restore of Backup %q into %s: %+v", restore.BlobName, slotId, err)
+				}
+
+				metadata.SetID(slotId)
+				return nil
+			}
+
+			id, err := parse.WebAppID(restore.AppID)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			future, err := client.RestoreFromBackupBlob(ctx, id.ResourceGroup, id.SiteName, request)
+			if err != nil {
+				return fmt.Errorf("restoring Backup %q into %s: %+v", restore.BlobName, id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for restore of Backup %q into %s: %+v", restore.BlobName, id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebAppBackupRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var state WebAppBackupRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			// the details of the restore are part of the request only and can't be read back, so only the App is checked
+			if slotId, err := parse.WebAppSlotID(metadata.ResourceData.Id()); err == nil {
+				existing, err := client.GetSlot(ctx, slotId.ResourceGroup, slotId.SiteName, slotId.SlotName)
+				if err != nil {
+					if utils.ResponseWasNotFound(existing.Response) {
+						return metadata.MarkAsGone(slotId)
+					}
+					return fmt.Errorf("reading %s: %+v", slotId, err)
+				}
+
+				state.AppID = slotId.ID()
+				return metadata.Encode(&state)
+			}
+
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			state.AppID = id.ID()
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppBackupRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Nothing to do here - there's no actual resource to delete
+			// Note: deleting does not revert the App to the state prior to the restore.
+			return nil
+		},
+	}
+}
+
+// CustomImporter prevents importing this resource, since the details of the restore can't be read back from the API and
+// importing it would result in the Backup being restored again during the next apply.
+func (r WebAppBackupRestoreResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("importing `%s` is not supported since the details of the restore cannot be retrieved", r.ResourceType())
+	}
+}
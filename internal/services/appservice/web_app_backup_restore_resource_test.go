@@ -0,0 +1,145 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppBackupRestoreResource struct{}
+
+func TestAccWebAppBackupRestore_linuxWebApp(t *testing.T) {
+	if os.Getenv("ARM_TEST_WEB_APP_BACKUP_STORAGE_URL") == "" || os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME") == "" {
+		t.Skip("Skipping as `ARM_TEST_WEB_APP_BACKUP_STORAGE_URL` and/or `ARM_TEST_WEB_APP_BACKUP_BLOB_NAME` are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_web_app_backup_restore", "test")
+	r := WebAppBackupRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linuxWebApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccWebAppBackupRestore_linuxWebAppSlot(t *testing.T) {
+	if os.Getenv("ARM_TEST_WEB_APP_BACKUP_STORAGE_URL") == "" || os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME") == "" {
+		t.Skip("Skipping as `ARM_TEST_WEB_APP_BACKUP_STORAGE_URL` and/or `ARM_TEST_WEB_APP_BACKUP_BLOB_NAME` are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_web_app_backup_restore", "test")
+	r := WebAppBackupRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linuxWebAppSlot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r WebAppBackupRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if id, err := parse.WebAppSlotID(state.ID); err == nil {
+		resp, err := client.AppService.WebAppsClient.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		return utils.Bool(true), nil
+	}
+
+	id, err := parse.WebAppID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r WebAppBackupRestoreResource) linuxWebApp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_backup_restore" "test" {
+  app_id              = azurerm_linux_web_app.test.id
+  storage_account_url = %q
+  blob_name           = %q
+}
+`, r.template(data), os.Getenv("ARM_TEST_WEB_APP_BACKUP_STORAGE_URL"), os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME"))
+}
+
+func (r WebAppBackupRestoreResource) linuxWebAppSlot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "acctestWAS-%d"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  site_config {}
+}
+
+resource "azurerm_web_app_backup_restore" "test" {
+  app_id              = azurerm_linux_web_app_slot.test.id
+  storage_account_url = %q
+  blob_name           = %q
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_WEB_APP_BACKUP_STORAGE_URL"), os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME"))
+}
+
+func (WebAppBackupRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -273,17 +273,17 @@ func (d WindowsFunctionAppDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
 			}
 
-			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("listing Site Publishing Credential information for Windows %s: %+v", id, err)
-			}
+			var siteCredentials web.User
+			if !metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+				scmBasicAuthPolicy, err := client.GetScmAllowed(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil && !utils.ResponseWasNotFound(scmBasicAuthPolicy.Response) {
+					return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
 
-			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for Site Publishing Credential information for Windows %s: %+v", id, err)
-			}
-			siteCredentials, err := siteCredentialsFuture.Result(*client)
-			if err != nil {
-				return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
+				siteCredentials, err = helpers.ReadSiteCredentials(ctx, client, id.ResourceGroup, id.SiteName, scmBasicAuthPolicy)
+				if err != nil {
+					return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
+				}
 			}
 
 			auth, err := client.GetAuthSettings(ctx, id.ResourceGroup, id.SiteName)
@@ -315,6 +315,13 @@ func (d WindowsFunctionAppDataSource) Read() sdk.ResourceFunc {
 
 			functionApp.SiteConfig = []helpers.SiteConfigWindowsFunctionApp{*siteConfig}
 
+			ipRestrictionDefaultAction, scmIpRestrictionDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading IP Restriction Default Actions for Windows %s: %+v", id, err)
+			}
+			functionApp.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+			functionApp.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+
 			functionApp.unpackWindowsFunctionAppSettings(appSettingsResp)
 
 			functionApp.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
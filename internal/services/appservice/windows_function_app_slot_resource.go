@@ -9,13 +9,14 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/google/uuid"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
 	kvValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -26,39 +27,48 @@ import (
 type WindowsFunctionAppSlotResource struct{}
 
 type WindowsFunctionAppSlotModel struct {
-	Name                          string                                     `tfschema:"name"`
-	FunctionAppID                 string                                     `tfschema:"function_app_id"`
-	StorageAccountName            string                                     `tfschema:"storage_account_name"`
-	StorageAccountKey             string                                     `tfschema:"storage_account_access_key"`
-	StorageUsesMSI                bool                                       `tfschema:"storage_uses_managed_identity"` // Storage uses MSI not account key
-	StorageKeyVaultSecretID       string                                     `tfschema:"storage_key_vault_secret_id"`
-	AppSettings                   map[string]string                          `tfschema:"app_settings"`
-	AuthSettings                  []helpers.AuthSettings                     `tfschema:"auth_settings"`
-	Backup                        []helpers.Backup                           `tfschema:"backup"` // Not supported on Dynamic or Basic plans
-	BuiltinLogging                bool                                       `tfschema:"builtin_logging_enabled"`
-	ClientCertEnabled             bool                                       `tfschema:"client_certificate_enabled"`
-	ClientCertMode                string                                     `tfschema:"client_certificate_mode"`
-	ConnectionStrings             []helpers.ConnectionString                 `tfschema:"connection_string"`
-	DailyMemoryTimeQuota          int                                        `tfschema:"daily_memory_time_quota"`
-	Enabled                       bool                                       `tfschema:"enabled"`
-	FunctionExtensionsVersion     string                                     `tfschema:"functions_extension_version"`
-	ForceDisableContentShare      bool                                       `tfschema:"content_share_force_disabled"`
-	HttpsOnly                     bool                                       `tfschema:"https_only"`
-	KeyVaultReferenceIdentityID   string                                     `tfschema:"key_vault_reference_identity_id"`
-	SiteConfig                    []helpers.SiteConfigWindowsFunctionAppSlot `tfschema:"site_config"`
-	Tags                          map[string]string                          `tfschema:"tags"`
-	CustomDomainVerificationId    string                                     `tfschema:"custom_domain_verification_id"`
-	DefaultHostname               string                                     `tfschema:"default_hostname"`
-	Kind                          string                                     `tfschema:"kind"`
-	OutboundIPAddresses           string                                     `tfschema:"outbound_ip_addresses"`
-	OutboundIPAddressList         []string                                   `tfschema:"outbound_ip_address_list"`
-	PossibleOutboundIPAddresses   string                                     `tfschema:"possible_outbound_ip_addresses"`
-	PossibleOutboundIPAddressList []string                                   `tfschema:"possible_outbound_ip_address_list"`
-	SiteCredentials               []helpers.SiteCredential                   `tfschema:"site_credential"`
+	Name                             string                                     `tfschema:"name"`
+	FunctionAppID                    string                                     `tfschema:"function_app_id"`
+	StorageAccountName               string                                     `tfschema:"storage_account_name"`
+	StorageAccountKey                string                                     `tfschema:"storage_account_access_key"`
+	StorageUsesMSI                   bool                                       `tfschema:"storage_uses_managed_identity"` // Storage uses MSI not account key
+	StorageKeyVaultSecretID          string                                     `tfschema:"storage_key_vault_secret_id"`
+	AppSettings                      map[string]string                          `tfschema:"app_settings"`
+	IgnoreAppSettings                []string                                   `tfschema:"ignore_app_settings"`
+	AuthSettings                     []helpers.AuthSettings                     `tfschema:"auth_settings"`
+	AuthV2Settings                   []helpers.AuthV2Settings                   `tfschema:"auth_settings_v2"`
+	Backup                           []helpers.Backup                           `tfschema:"backup"` // Not supported on Dynamic or Basic plans
+	BuiltinLogging                   bool                                       `tfschema:"builtin_logging_enabled"`
+	ClientCertEnabled                bool                                       `tfschema:"client_certificate_enabled"`
+	ClientCertMode                   string                                     `tfschema:"client_certificate_mode"`
+	ConnectionStrings                []helpers.ConnectionString                 `tfschema:"connection_string"`
+	DailyMemoryTimeQuota             int                                        `tfschema:"daily_memory_time_quota"`
+	Enabled                          bool                                       `tfschema:"enabled"`
+	PublishingFTPBasicAuthEnabled    bool                                       `tfschema:"ftp_publish_basic_authentication_enabled"`
+	PublishingDeployBasicAuthEnabled bool                                       `tfschema:"webdeploy_publish_basic_authentication_enabled"`
+	FunctionExtensionsVersion        string                                     `tfschema:"functions_extension_version"`
+	ForceDisableContentShare         bool                                       `tfschema:"content_share_force_disabled"`
+	VnetContentShareEnabled          bool                                       `tfschema:"vnet_content_share_enabled"`
+	HttpsOnly                        bool                                       `tfschema:"https_only"`
+	PublicNetworkAccess              bool                                       `tfschema:"public_network_access_enabled"`
+	KeyVaultReferenceIdentityID      string                                     `tfschema:"key_vault_reference_identity_id"`
+	SiteConfig                       []helpers.SiteConfigWindowsFunctionAppSlot `tfschema:"site_config"`
+	VirtualNetworkSubnetID           string                                     `tfschema:"virtual_network_subnet_id"`
+	Tags                             map[string]string                          `tfschema:"tags"`
+	CustomDomainVerificationId       string                                     `tfschema:"custom_domain_verification_id"`
+	DefaultHostname                  string                                     `tfschema:"default_hostname"`
+	Kind                             string                                     `tfschema:"kind"`
+	OutboundIPAddresses              string                                     `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList            []string                                   `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses      string                                     `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList    []string                                   `tfschema:"possible_outbound_ip_address_list"`
+	SiteCredentials                  []helpers.SiteCredential                   `tfschema:"site_credential"`
 }
 
 var _ sdk.ResourceWithUpdate = WindowsFunctionAppSlotResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = WindowsFunctionAppSlotResource{}
+
 func (r WindowsFunctionAppSlotResource) ModelObject() interface{} {
 	return &WindowsFunctionAppSlotModel{}
 }
@@ -101,10 +111,11 @@ func (r WindowsFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema
 		},
 
 		"storage_account_access_key": {
-			Type:         pluginsdk.TypeString,
-			Optional:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.NoZeroValues,
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			ValidateFunc:     validation.NoZeroValues,
+			DiffSuppressFunc: helpers.SuppressSensitiveValueHash,
 			ConflictsWith: []string{
 				"storage_uses_managed_identity",
 				"storage_key_vault_secret_id",
@@ -143,8 +154,12 @@ func (r WindowsFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema
 			Description: "A map of key-value pairs for [App Settings](https://docs.microsoft.com/en-us/azure/azure-functions/functions-app-settings) and custom values.",
 		},
 
+		"ignore_app_settings": helpers.IgnoreAppSettingsSchema(),
+
 		"auth_settings": helpers.AuthSettingsSchema(),
 
+		"auth_settings_v2": helpers.AuthV2SettingsSchema(),
+
 		"backup": helpers.BackupSchema(),
 
 		"builtin_logging_enabled": {
@@ -190,6 +205,20 @@ func (r WindowsFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema
 			Description: "Is the Windows Function App Slot enabled.",
 		},
 
+		"ftp_publish_basic_authentication_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should basic (username and password) authentication be enabled for FTP publishing to this Windows Function App Slot.",
+		},
+
+		"webdeploy_publish_basic_authentication_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should basic (username and password) authentication be enabled for Web Deploy and SCM publishing to this Windows Function App Slot.",
+		},
+
 		"content_share_force_disabled": {
 			Type:        pluginsdk.TypeBool,
 			Optional:    true,
@@ -197,6 +226,13 @@ func (r WindowsFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema
 			Description: "Force disable the content share settings.",
 		},
 
+		"vnet_content_share_enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should the traffic for the content share be routed over the Virtual Network? This sets the `WEBSITE_CONTENTOVERVNET` App Setting.",
+		},
+
 		"functions_extension_version": {
 			Type:        pluginsdk.TypeString,
 			Optional:    true,
@@ -211,19 +247,28 @@ func (r WindowsFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema
 			Description: "Can the Function App Slot only be accessed via HTTPS?",
 		},
 
+		"public_network_access_enabled": helpers.PublicNetworkAccessSchema(),
+
 		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 		"key_vault_reference_identity_id": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
-			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
-			Description:  "The User Assigned Identity to use for Key Vault access.",
+			ValidateFunc: validate.KeyVaultReferenceIdentityID,
+			Description:  "The Identity to use for Key Vault access, either `SystemAssigned` or the ID of a User Assigned Identity.",
 		},
 
 		"site_config": helpers.SiteConfigSchemaWindowsFunctionAppSlot(),
 
 		"tags": tags.Schema(),
+
+		"virtual_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: networkValidate.SubnetID,
+			Description:  "The Subnet ID used for the Regional Virtual Network Integration of this Windows Function App Slot.",
+		},
 	}
 }
 
@@ -324,6 +369,15 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s: %+v", servicePlanId, err)
 			}
 
+			// CustomizeDiff can't check this when the parent Function App is created in the same apply
+			var planSKU *string
+			if servicePlan.Sku != nil {
+				planSKU = servicePlan.Sku.Name
+			}
+			if err := helpers.CheckFunctionAppSlotScaleSettings(metadata.ResourceData, planSKU); err != nil {
+				return err
+			}
+
 			sendContentSettings := !functionAppSlot.ForceDisableContentShare
 			if planSku := servicePlan.Sku; planSku != nil && planSku.Tier != nil {
 				switch tier := *planSku.Tier; strings.ToLower(tier) {
@@ -355,26 +409,14 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			if ase := servicePlan.HostingEnvironmentProfile; ase != nil {
-				// Attempt to check the ASE for the appropriate suffix for the name availability request.
-				// This varies between internal and external ASE Types, and potentially has other names in other clouds
-				// We use the "internal" as the fallback here, if we can read the ASE, we'll get the full one
-				nameSuffix := "appserviceenvironment.net"
-				if ase.ID != nil {
-					aseId, err := parse.AppServiceEnvironmentID(*ase.ID)
-					nameSuffix = fmt.Sprintf("%s.%s", aseId.HostingEnvironmentName, nameSuffix)
-					if err != nil {
-						metadata.Logger.Warnf("could not parse App Service Environment ID determine FQDN for name availability check, defaulting to `%s.%s.appserviceenvironment.net`", functionAppSlot.Name, servicePlanId)
-					} else {
-						existingASE, err := aseClient.Get(ctx, aseId.ResourceGroup, aseId.HostingEnvironmentName)
-						if err != nil {
-							metadata.Logger.Warnf("could not read App Service Environment to determine FQDN for name availability check, defaulting to `%s.%s.appserviceenvironment.net`", functionAppSlot.Name, servicePlanId)
-						} else if props := existingASE.AppServiceEnvironment; props != nil && props.DNSSuffix != nil && *props.DNSSuffix != "" {
-							nameSuffix = *props.DNSSuffix
-						}
-					}
+				// Apps within an App Service Environment are checked using their FQDN, the suffix for which varies between
+				// internal and external ASE Types and between Clouds
+				nameSuffix, err := helpers.AppServiceEnvironmentDNSSuffix(ctx, aseClient, metadata.Client.Account.Environment, utils.NormalizeNilableString(ase.ID))
+				if err != nil {
+					metadata.Logger.Warnf("could not determine the DNS Suffix of the App Service Environment for the name availability check, defaulting to `%s`: %+v", nameSuffix, err)
 				}
 
-				availabilityRequest.Name = utils.String(fmt.Sprintf("%s.%s", functionAppSlot.Name, nameSuffix))
+				availabilityRequest.Name = utils.String(fmt.Sprintf("%s.%s", fmt.Sprintf("%s-%s", id.SiteName, id.SlotName), nameSuffix))
 				availabilityRequest.IsFqdn = utils.Bool(true)
 			}
 
@@ -382,8 +424,12 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 			if err != nil {
 				return fmt.Errorf("checking name availability for Windows %s: %+v", id, err)
 			}
-			if checkName.NameAvailable != nil && !*checkName.NameAvailable {
-				return fmt.Errorf("the Site Name %q failed the availability check: %+v", id.SiteName, *checkName.Message)
+			if err := azure.CheckNameAvailability("App Service", fmt.Sprintf("%s-%s", id.SiteName, id.SlotName), azure.NameAvailabilityResult{
+				NameAvailable: checkName.NameAvailable,
+				Reason:        string(checkName.Reason),
+				Message:       checkName.Message,
+			}); err != nil {
+				return err
 			}
 
 			storageString := functionAppSlot.StorageAccountName
@@ -424,6 +470,13 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			siteConfig.WindowsFxVersion = helpers.EncodeFunctionAppWindowsFxVersion(functionAppSlot.SiteConfig[0].ApplicationStack)
+			if functionAppSlot.VnetContentShareEnabled {
+				if functionAppSlot.AppSettings == nil {
+					functionAppSlot.AppSettings = make(map[string]string)
+				}
+				functionAppSlot.AppSettings["WEBSITE_CONTENTOVERVNET"] = "1"
+			}
+
 			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, functionAppSlot.AppSettings)
 
 			expandedIdentity, err := expandIdentity(metadata.ResourceData.Get("identity").([]interface{}))
@@ -431,6 +484,8 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
 
+			siteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(functionAppSlot.PublicNetworkAccess)
+
 			siteEnvelope := web.Site{
 				Location: functionApp.Location,
 				Tags:     tags.FromTypedObject(functionAppSlot.Tags),
@@ -468,6 +523,17 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("waiting for creation of Windows %s: %+v", id, err)
 			}
 
+			if functionAppSlot.VirtualNetworkSubnetID != "" {
+				swiftConnection := web.SwiftVirtualNetwork{
+					SwiftVirtualNetworkProperties: &web.SwiftVirtualNetworkProperties{
+						SubnetResourceID: utils.String(functionAppSlot.VirtualNetworkSubnetID),
+					},
+				}
+				if _, err := client.CreateOrUpdateSwiftVirtualNetworkConnectionWithCheckSlot(ctx, id.ResourceGroup, id.SiteName, swiftConnection, id.SlotName); err != nil {
+					return fmt.Errorf("setting Virtual Network Integration for Windows %s: %+v", id, err)
+				}
+			}
+
 			backupConfig := helpers.ExpandBackupConfig(functionAppSlot.Backup)
 			if backupConfig.BackupRequestProperties != nil {
 				if _, err := client.UpdateBackupConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, *backupConfig, id.SlotName); err != nil {
@@ -482,6 +548,13 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("auth_settings_v2") {
+				authV2 := helpers.ExpandAuthV2Settings(functionAppSlot.AuthV2Settings)
+				if _, err := client.UpdateAuthSettingsV2Slot(ctx, id.ResourceGroup, id.SiteName, *authV2, id.SlotName); err != nil {
+					return fmt.Errorf("setting AuthV2 Settings for Windows %s: %+v", id, err)
+				}
+			}
+
 			connectionStrings := helpers.ExpandConnectionStrings(functionAppSlot.ConnectionStrings)
 			if connectionStrings.Properties != nil {
 				if _, err := client.UpdateConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, *connectionStrings, id.SlotName); err != nil {
@@ -497,6 +570,25 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if !functionAppSlot.PublishingFTPBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(functionAppSlot.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			if !functionAppSlot.PublishingDeployBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(functionAppSlot.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, functionAppSlot.SiteConfig[0].IpRestrictionDefaultAction, functionAppSlot.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+				return fmt.Errorf("setting IP Restriction Default Actions for Windows %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
@@ -524,44 +616,127 @@ func (r WindowsFunctionAppSlotResource) Read() sdk.ResourceFunc {
 			}
 			props := *functionAppSlot.SiteProperties
 
-			appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
-			if err != nil {
-				return fmt.Errorf("reading App Settings for Windows %s: %+v", id, err)
-			}
-
-			connectionStrings, err := client.ListConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
-			if err != nil {
-				return fmt.Errorf("reading Connection String information for Windows %s: %+v", id, err)
-			}
-
-			siteCredentialsFuture, err := client.ListPublishingCredentialsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
-			if err != nil {
-				return fmt.Errorf("listing Site Publishing Credential information for Windows %s: %+v", id, err)
-			}
-
-			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for Site Publishing Credential information for Windows %s: %+v", id, err)
-			}
-			siteCredentials, err := siteCredentialsFuture.Result(*client)
-			if err != nil {
-				return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
-			}
+			var (
+				appSettingsResp               web.StringDictionary
+				connectionStrings             web.ConnectionStringDictionary
+				siteCredentials               web.User
+				ftpBasicAuthPolicy            web.CsmPublishingCredentialsPoliciesEntity
+				scmBasicAuthPolicy            web.CsmPublishingCredentialsPoliciesEntity
+				auth                          web.SiteAuthSettings
+				authV2                        web.SiteAuthSettingsV2
+				backup                        web.BackupRequest
+				logs                          web.SiteLogsConfig
+				swiftConnection               web.SwiftVirtualNetwork
+				configResp                    web.SiteConfigResource
+				ipRestrictionDefaultAction    string
+				scmIpRestrictionDefaultAction string
+			)
+
+			// the remaining information for the Function App Slot is independent, so is retrieved concurrently to speed up refreshes
+			err = utils.RunConcurrently(ctx, helpers.FunctionAppReadConcurrency,
+				func(ctx context.Context) error {
+					resp, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil {
+						return fmt.Errorf("reading App Settings for Windows %s: %+v", id, err)
+					}
+					appSettingsResp = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.ListConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil {
+						return fmt.Errorf("reading Connection String information for Windows %s: %+v", id, err)
+					}
+					connectionStrings = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetFtpAllowedSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("reading FTP Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+					}
+					ftpBasicAuthPolicy = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetScmAllowedSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+					}
+					scmBasicAuthPolicy = resp
 
-			auth, err := client.GetAuthSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
-			if err != nil {
-				return fmt.Errorf("reading Auth Settings for Windows %s: %+v", id, err)
-			}
+					// the Publishing Credentials are only retrieved when the SCM Basic Authentication Policy allows them
+					if metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+						return nil
+					}
 
-			backup, err := client.GetBackupConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
-			if err != nil {
-				if !utils.ResponseWasNotFound(backup.Response) {
-					return fmt.Errorf("reading Backup Settings for Windows %s: %+v", id, err)
-				}
-			}
+					credentials, err := helpers.ReadSiteCredentialsSlot(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, resp)
+					if err != nil {
+						return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
+					}
+					siteCredentials = credentials
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetAuthSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil {
+						return fmt.Errorf("reading Auth Settings for Windows %s: %+v", id, err)
+					}
+					auth = resp
 
-			logs, err := client.GetDiagnosticLogsConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if helpers.AuthV2ConfigVersionInUse(auth) {
+						resp, err := client.GetAuthSettingsV2Slot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+						if err != nil {
+							return fmt.Errorf("reading AuthV2 Settings for Windows %s: %+v", id, err)
+						}
+						authV2 = resp
+					}
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetBackupConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("reading Backup Settings for Windows %s: %+v", id, err)
+					}
+					backup = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetDiagnosticLogsConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil {
+						return fmt.Errorf("reading logs configuration for Windows %s: %+v", id, err)
+					}
+					logs = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetSwiftVirtualNetworkConnectionSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil && !utils.ResponseWasNotFound(resp.Response) {
+						return fmt.Errorf("reading Virtual Network Integration for Windows %s: %+v", id, err)
+					}
+					swiftConnection = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					resp, err := client.GetConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil {
+						return fmt.Errorf("making Read request on AzureRM Function App Configuration %q: %+v", id.SiteName, err)
+					}
+					configResp = resp
+					return nil
+				},
+				func(ctx context.Context) error {
+					defaultAction, scmDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName)
+					if err != nil {
+						return fmt.Errorf("reading IP Restriction Default Actions for Windows %s: %+v", id, err)
+					}
+					ipRestrictionDefaultAction = defaultAction
+					scmIpRestrictionDefaultAction = scmDefaultAction
+					return nil
+				},
+			)
 			if err != nil {
-				return fmt.Errorf("reading logs configuration for Windows %s: %+v", id, err)
+				return err
 			}
 
 			state := WindowsFunctionAppSlotModel{
@@ -577,9 +752,8 @@ func (r WindowsFunctionAppSlotResource) Read() sdk.ResourceFunc {
 				DefaultHostname:             utils.NormalizeNilableString(props.DefaultHostName),
 			}
 
-			configResp, err := client.GetConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
-			if err != nil {
-				return fmt.Errorf("making Read request on AzureRM Function App Configuration %q: %+v", id.SiteName, err)
+			if swiftProps := swiftConnection.SwiftVirtualNetworkProperties; swiftProps != nil {
+				state.VirtualNetworkSubnetID = utils.NormalizeNilableString(swiftProps.SubnetResourceID)
 			}
 
 			siteConfig, err := helpers.FlattenSiteConfigWindowsFunctionAppSlot(configResp.SiteConfig)
@@ -588,25 +762,40 @@ func (r WindowsFunctionAppSlotResource) Read() sdk.ResourceFunc {
 			}
 			state.SiteConfig = []helpers.SiteConfigWindowsFunctionAppSlot{*siteConfig}
 
+			state.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+			state.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+
 			state.unpackWindowsFunctionAppSettings(appSettingsResp, metadata)
 
+			state.IgnoreAppSettings = *utils.ExpandStringSlice(metadata.ResourceData.Get("ignore_app_settings").(*pluginsdk.Set).List())
+
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
 			state.AuthSettings = helpers.FlattenAuthSettings(auth)
 
+			state.AuthV2Settings = helpers.FlattenAuthV2Settings(authV2)
+
 			state.Backup = helpers.FlattenBackupConfig(backup)
 
 			state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs)
 
 			state.HttpsOnly = utils.NormaliseNilableBool(functionAppSlot.HTTPSOnly)
+			state.PublicNetworkAccess = helpers.FlattenPublicNetworkAccess(configResp.SiteConfig)
 			state.ClientCertEnabled = utils.NormaliseNilableBool(functionAppSlot.ClientCertEnabled)
 
+			state.PublishingFTPBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(ftpBasicAuthPolicy)
+			state.PublishingDeployBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(scmBasicAuthPolicy)
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
 
+			if err := helpers.RemoveSensitiveValuesFromState(metadata, helpers.WindowsFunctionAppWriteOnlyFields); err != nil {
+				return err
+			}
+
 			flattenedIdentity, err := flattenIdentity(functionAppSlot.Identity)
 			if err != nil {
 				return fmt.Errorf("flattening `identity`: %+v", err)
@@ -667,6 +856,7 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 			if err != nil {
 				return err
 			}
+
 			sendContentSettings := !helpers.PlanIsAppPlan(planSKU)
 
 			// Some service plan updates are allowed - see customiseDiff for exceptions
@@ -707,7 +897,7 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				if state.StorageKeyVaultSecretID != "" {
 					storageString = fmt.Sprintf(helpers.StorageStringFmtKV, state.StorageKeyVaultSecretID)
 				} else {
-					storageString = fmt.Sprintf(helpers.StorageStringFmt, state.StorageAccountName, state.StorageAccountKey, metadata.Client.Account.Environment.StorageEndpointSuffix)
+					storageString = fmt.Sprintf(helpers.StorageStringFmt, state.StorageAccountName, helpers.SensitiveValueFromConfig(metadata, "storage_account_access_key", state.StorageAccountKey), metadata.Client.Account.Environment.StorageEndpointSuffix)
 				}
 			}
 
@@ -746,8 +936,29 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				existing.SiteConfig.WindowsFxVersion = helpers.EncodeFunctionAppWindowsFxVersion(state.SiteConfig[0].ApplicationStack)
 			}
 
+			if state.VnetContentShareEnabled {
+				if state.AppSettings == nil {
+					state.AppSettings = make(map[string]string)
+				}
+				state.AppSettings["WEBSITE_CONTENTOVERVNET"] = "1"
+			}
+
+			if len(state.IgnoreAppSettings) > 0 {
+				appSettingsResp, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+				if err != nil {
+					return fmt.Errorf("reading App Settings for Windows %s: %+v", id, err)
+				}
+				state.AppSettings = helpers.PreserveIgnoredAppSettings(appSettingsResp, state.AppSettings, state.IgnoreAppSettings)
+			}
+
+			if existing.SiteConfig == nil {
+				existing.SiteConfig = &web.SiteConfig{}
+			}
 			existing.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
 
+			existing.SiteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(state.PublicNetworkAccess)
+			siteConfig.PublicNetworkAccess = existing.SiteConfig.PublicNetworkAccess
+
 			updateFuture, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, existing, id.SlotName)
 			if err != nil {
 				return fmt.Errorf("updating Windows %s: %+v", id, err)
@@ -760,6 +971,23 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating Site Config for Windows %s: %+v", id, err)
 			}
 
+			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
+				if state.VirtualNetworkSubnetID == "" {
+					if resp, err := client.DeleteSwiftVirtualNetworkSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName); err != nil && !utils.ResponseWasNotFound(resp) {
+						return fmt.Errorf("removing Virtual Network Integration for Windows %s: %+v", id, err)
+					}
+				} else {
+					swiftConnection := web.SwiftVirtualNetwork{
+						SwiftVirtualNetworkProperties: &web.SwiftVirtualNetworkProperties{
+							SubnetResourceID: utils.String(state.VirtualNetworkSubnetID),
+						},
+					}
+					if _, err := client.CreateOrUpdateSwiftVirtualNetworkConnectionWithCheckSlot(ctx, id.ResourceGroup, id.SiteName, swiftConnection, id.SlotName); err != nil {
+						return fmt.Errorf("updating Virtual Network Integration for Windows %s: %+v", id, err)
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("connection_string") {
 				connectionStringUpdate := helpers.ExpandConnectionStrings(state.ConnectionStrings)
 				if connectionStringUpdate.Properties == nil {
@@ -770,6 +998,19 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			// the V2 settings are updated first, so that removing them reverts to the V1 settings before these are updated
+			if metadata.ResourceData.HasChange("auth_settings_v2") {
+				authV2Update := helpers.ExpandAuthV2Settings(state.AuthV2Settings)
+				if _, err := client.UpdateAuthSettingsV2Slot(ctx, id.ResourceGroup, id.SiteName, *authV2Update, id.SlotName); err != nil {
+					return fmt.Errorf("updating AuthV2 Settings for Windows %s: %+v", id, err)
+				}
+				if len(state.AuthV2Settings) == 0 {
+					if err := helpers.RevertAuthV2ConfigVersionSlot(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName); err != nil {
+						return fmt.Errorf("reverting to the V1 Auth Settings for Windows %s: %+v", id, err)
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("auth_settings") {
 				authUpdate := helpers.ExpandAuthSettings(state.AuthSettings)
 				if _, err := client.UpdateAuthSettingsSlot(ctx, id.ResourceGroup, id.SiteName, *authUpdate, id.SlotName); err != nil {
@@ -797,6 +1038,27 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("ftp_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("webdeploy_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowedSlot(ctx, id.ResourceGroup, id.SiteName, sitePolicy, id.SlotName); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			// the IP Restriction Default Actions aren't part of the Site Config sent above, so these are set separately
+			if metadata.ResourceData.HasChange("site_config") {
+				if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, state.SiteConfig[0].IpRestrictionDefaultAction, state.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+					return fmt.Errorf("updating IP Restriction Default Actions for Windows %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -817,15 +1079,8 @@ func (m *WindowsFunctionAppSlotModel) unpackWindowsFunctionAppSettings(input web
 			m.FunctionExtensionsVersion = utils.NormalizeNilableString(v)
 
 		case "WEBSITE_NODE_DEFAULT_VERSION": // Note - This is only set if it's not the default of 12, but we collect it from WindowsFxVersion so can discard it here
-		case "WEBSITE_CONTENTAZUREFILECONNECTIONSTRING":
-			if _, ok := metadata.ResourceData.GetOk("app_settings.WEBSITE_CONTENTAZUREFILECONNECTIONSTRING"); ok {
-				appSettings[k] = utils.NormalizeNilableString(v)
-			}
-
-		case "WEBSITE_CONTENTSHARE":
-			if _, ok := metadata.ResourceData.GetOk("app_settings.WEBSITE_CONTENTSHARE"); ok {
-				appSettings[k] = utils.NormalizeNilableString(v)
-			}
+		case "WEBSITE_CONTENTOVERVNET":
+			m.VnetContentShareEnabled = utils.NormalizeNilableString(v) == "1"
 
 		case "WEBSITE_HTTPLOGGING_RETENTION_DAYS":
 		case "FUNCTIONS_WORKER_RUNTIME":
@@ -874,9 +1129,57 @@ func (m *WindowsFunctionAppSlotModel) unpackWindowsFunctionAppSettings(input web
 			m.BuiltinLogging = true
 
 		default:
-			appSettings[k] = utils.NormalizeNilableString(v)
+			// Note - managed app settings and those listed in `ignore_app_settings` are filtered here to prevent drift from changes made outside of Terraform
+			if value, ok := helpers.FlattenManagedAppSetting(k, v, metadata); ok {
+				appSettings[k] = value
+			}
 		}
 	}
 
 	m.AppSettings = appSettings
 }
+
+func (r WindowsFunctionAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if err := helpers.ValidateKeyVaultReferenceIdentity(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidatePublicNetworkAccess(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidateWindowsFunctionAppApplicationStack(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidateIgnoreAppSettings(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if metadata.Client.Features.AppService.ValidateKeyVaultReferences {
+				if err := helpers.ValidateKeyVaultReferences(metadata.ResourceDiff); err != nil {
+					return err
+				}
+			}
+
+			// the parent Function App may not exist yet, in which case the Service Plan can only be checked during apply
+			functionAppIdRaw := metadata.ResourceDiff.Get("function_app_id").(string)
+			if functionAppIdRaw == "" {
+				return nil
+			}
+			functionAppId, err := parse.FunctionAppID(functionAppIdRaw)
+			if err != nil {
+				return err
+			}
+			_, planSKU, err := helpers.ServicePlanInfoForApp(ctx, metadata, *functionAppId)
+			if err != nil {
+				return err
+			}
+
+			return helpers.CheckFunctionAppSlotScaleSettings(metadata.ResourceDiff, planSKU)
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -240,17 +241,17 @@ func (d WindowsWebAppDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Sticky Settings for Linux %s: %+v", id, err)
 			}
 
-			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("listing Site Publishing Credential information for Windows %s: %+v", id, err)
-			}
+			var siteCredentials web.User
+			if !metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+				scmBasicAuthPolicy, err := client.GetScmAllowed(ctx, id.ResourceGroup, id.SiteName)
+				if err != nil && !utils.ResponseWasNotFound(scmBasicAuthPolicy.Response) {
+					return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
 
-			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for Site Publishing Credential information for Windows %s: %+v", id, err)
-			}
-			siteCredentials, err := siteCredentialsFuture.Result(*client)
-			if err != nil {
-				return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
+				siteCredentials, err = helpers.ReadSiteCredentials(ctx, client, id.ResourceGroup, id.SiteName, scmBasicAuthPolicy)
+				if err != nil {
+					return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
+				}
 			}
 
 			siteMetadata, err := client.ListMetadata(ctx, id.ResourceGroup, id.SiteName)
@@ -300,6 +301,15 @@ func (d WindowsWebAppDataSource) Read() sdk.ResourceFunc {
 			}
 			webApp.SiteConfig = helpers.FlattenSiteConfigWindows(webAppSiteConfig.SiteConfig, currentStack, healthCheckCount)
 
+			ipRestrictionDefaultAction, scmIpRestrictionDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading IP Restriction Default Actions for Windows %s: %+v", id, err)
+			}
+			if len(webApp.SiteConfig) > 0 {
+				webApp.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+				webApp.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+			}
+
 			webApp.StickySettings = helpers.FlattenStickySettings(stickySettings.SlotConfigNames)
 
 			webApp.StorageAccounts = helpers.FlattenStorageAccounts(storageAccounts)
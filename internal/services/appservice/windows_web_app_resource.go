@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -24,38 +23,43 @@ import (
 type WindowsWebAppResource struct{}
 
 type WindowsWebAppModel struct {
-	Name                          string                      `tfschema:"name"`
-	ResourceGroup                 string                      `tfschema:"resource_group_name"`
-	Location                      string                      `tfschema:"location"`
-	ServicePlanId                 string                      `tfschema:"service_plan_id"`
-	AppSettings                   map[string]string           `tfschema:"app_settings"`
-	StickySettings                []helpers.StickySettings    `tfschema:"sticky_settings"`
-	AuthSettings                  []helpers.AuthSettings      `tfschema:"auth_settings"`
-	Backup                        []helpers.Backup            `tfschema:"backup"`
-	ClientAffinityEnabled         bool                        `tfschema:"client_affinity_enabled"`
-	ClientCertEnabled             bool                        `tfschema:"client_certificate_enabled"`
-	ClientCertMode                string                      `tfschema:"client_certificate_mode"`
-	Enabled                       bool                        `tfschema:"enabled"`
-	HttpsOnly                     bool                        `tfschema:"https_only"`
-	KeyVaultReferenceIdentityID   string                      `tfschema:"key_vault_reference_identity_id"`
-	LogsConfig                    []helpers.LogsConfig        `tfschema:"logs"`
-	SiteConfig                    []helpers.SiteConfigWindows `tfschema:"site_config"`
-	StorageAccounts               []helpers.StorageAccount    `tfschema:"storage_account"`
-	ConnectionStrings             []helpers.ConnectionString  `tfschema:"connection_string"`
-	CustomDomainVerificationId    string                      `tfschema:"custom_domain_verification_id"`
-	DefaultHostname               string                      `tfschema:"default_hostname"`
-	Kind                          string                      `tfschema:"kind"`
-	OutboundIPAddresses           string                      `tfschema:"outbound_ip_addresses"`
-	OutboundIPAddressList         []string                    `tfschema:"outbound_ip_address_list"`
-	PossibleOutboundIPAddresses   string                      `tfschema:"possible_outbound_ip_addresses"`
-	PossibleOutboundIPAddressList []string                    `tfschema:"possible_outbound_ip_address_list"`
-	SiteCredentials               []helpers.SiteCredential    `tfschema:"site_credential"`
-	ZipDeployFile                 string                      `tfschema:"zip_deploy_file"`
-	Tags                          map[string]string           `tfschema:"tags"`
+	Name                             string                      `tfschema:"name"`
+	ResourceGroup                    string                      `tfschema:"resource_group_name"`
+	Location                         string                      `tfschema:"location"`
+	ServicePlanId                    string                      `tfschema:"service_plan_id"`
+	AppSettings                      map[string]string           `tfschema:"app_settings"`
+	StickySettings                   []helpers.StickySettings    `tfschema:"sticky_settings"`
+	AuthSettings                     []helpers.AuthSettings      `tfschema:"auth_settings"`
+	Backup                           []helpers.Backup            `tfschema:"backup"`
+	ClientAffinityEnabled            bool                        `tfschema:"client_affinity_enabled"`
+	ClientCertEnabled                bool                        `tfschema:"client_certificate_enabled"`
+	ClientCertMode                   string                      `tfschema:"client_certificate_mode"`
+	Enabled                          bool                        `tfschema:"enabled"`
+	PublishingFTPBasicAuthEnabled    bool                        `tfschema:"ftp_publish_basic_authentication_enabled"`
+	PublishingDeployBasicAuthEnabled bool                        `tfschema:"webdeploy_publish_basic_authentication_enabled"`
+	HttpsOnly                        bool                        `tfschema:"https_only"`
+	PublicNetworkAccess              bool                        `tfschema:"public_network_access_enabled"`
+	KeyVaultReferenceIdentityID      string                      `tfschema:"key_vault_reference_identity_id"`
+	LogsConfig                       []helpers.LogsConfig        `tfschema:"logs"`
+	SiteConfig                       []helpers.SiteConfigWindows `tfschema:"site_config"`
+	StorageAccounts                  []helpers.StorageAccount    `tfschema:"storage_account"`
+	ConnectionStrings                []helpers.ConnectionString  `tfschema:"connection_string"`
+	CustomDomainVerificationId       string                      `tfschema:"custom_domain_verification_id"`
+	DefaultHostname                  string                      `tfschema:"default_hostname"`
+	Kind                             string                      `tfschema:"kind"`
+	OutboundIPAddresses              string                      `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList            []string                    `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses      string                      `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList    []string                    `tfschema:"possible_outbound_ip_address_list"`
+	SiteCredentials                  []helpers.SiteCredential    `tfschema:"site_credential"`
+	ZipDeployFile                    string                      `tfschema:"zip_deploy_file"`
+	Tags                             map[string]string           `tfschema:"tags"`
 }
 
 var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = WindowsWebAppResource{}
+
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -119,19 +123,33 @@ func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"ftp_publish_basic_authentication_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"webdeploy_publish_basic_authentication_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"https_only": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
 			Default:  false,
 		},
 
+		"public_network_access_enabled": helpers.PublicNetworkAccessSchema(),
+
 		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 		"key_vault_reference_identity_id": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
-			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			ValidateFunc: validate.KeyVaultReferenceIdentityID,
 		},
 
 		"logs": helpers.LogsConfigSchema(),
@@ -252,25 +270,14 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("reading App %s: %+v", servicePlanId, err)
 			}
 			if ase := servicePlan.HostingEnvironmentProfile; ase != nil {
-				// Attempt to check the ASE for the appropriate suffix for the name availability request. Not convinced
-				// the `DNSSuffix` field is still valid and possibly should have been deprecated / removed as is legacy
-				// setting from ASEv1? Hence the non-fatal approach here.
-				nameSuffix := "appserviceenvironment.net"
-				if ase.ID != nil {
-					aseId, err := parse.AppServiceEnvironmentID(*ase.ID)
-					nameSuffix = fmt.Sprintf("%s.%s", aseId.HostingEnvironmentName, nameSuffix)
-					if err != nil {
-						metadata.Logger.Warnf("could not parse App Service Environment ID determine FQDN for name availability check, defaulting to `%s.%s.appserviceenvironment.net`", webApp.Name, servicePlanId)
-					} else {
-						existingASE, err := aseClient.Get(ctx, aseId.ResourceGroup, aseId.HostingEnvironmentName)
-						if err != nil {
-							metadata.Logger.Warnf("could not read App Service Environment to determine FQDN for name availability check, defaulting to `%s.%s.appserviceenvironment.net`", webApp.Name, servicePlanId)
-						} else if props := existingASE.AppServiceEnvironment; props != nil && props.DNSSuffix != nil && *props.DNSSuffix != "" {
-							nameSuffix = *props.DNSSuffix
-						}
-					}
+				// Apps within an App Service Environment are checked using their FQDN, the suffix for which varies between
+				// internal and external ASE Types and between Clouds
+				nameSuffix, err := helpers.AppServiceEnvironmentDNSSuffix(ctx, aseClient, metadata.Client.Account.Environment, utils.NormalizeNilableString(ase.ID))
+				if err != nil {
+					metadata.Logger.Warnf("could not determine the DNS Suffix of the App Service Environment for the name availability check, defaulting to `%s`: %+v", nameSuffix, err)
 				}
-				availabilityRequest.Name = utils.String(fmt.Sprintf("%s.%s.%s", webApp.Name, servicePlanId.ServerfarmName, nameSuffix))
+
+				availabilityRequest.Name = utils.String(fmt.Sprintf("%s.%s", webApp.Name, nameSuffix))
 				availabilityRequest.IsFqdn = utils.Bool(true)
 			}
 
@@ -278,8 +285,12 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 			if err != nil {
 				return fmt.Errorf("checking name availability for %s: %+v", id, err)
 			}
-			if !*checkName.NameAvailable {
-				return fmt.Errorf("the Site Name %q failed the availability check: %+v", id.SiteName, *checkName.Message)
+			if err := azure.CheckNameAvailability("App Service", id.SiteName, azure.NameAvailabilityResult{
+				NameAvailable: checkName.NameAvailable,
+				Reason:        string(checkName.Reason),
+				Message:       checkName.Message,
+			}); err != nil {
+				return err
 			}
 
 			siteConfig, currentStack, err := helpers.ExpandSiteConfigWindows(webApp.SiteConfig, nil, metadata, servicePlan)
@@ -294,6 +305,8 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
 
+			siteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(webApp.PublicNetworkAccess)
+
 			siteEnvelope := web.Site{
 				Location: utils.String(webApp.Location),
 				Tags:     tags.FromTypedObject(webApp.Tags),
@@ -395,6 +408,24 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if !webApp.PublishingFTPBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(webApp.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			if !webApp.PublishingDeployBasicAuthEnabled {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(webApp.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "", webApp.SiteConfig[0].IpRestrictionDefaultAction, webApp.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+				return fmt.Errorf("setting IP Restriction Default Actions for Windows %s: %+v", id, err)
+			}
+
 			return nil
 		},
 
@@ -466,17 +497,22 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Windows %s: %+v", id, err)
 			}
 
-			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
-			if err != nil {
-				return fmt.Errorf("listing Site Publishing Credential information for Windows %s: %+v", id, err)
+			ftpBasicAuthPolicy, err := client.GetFtpAllowed(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil && !utils.ResponseWasNotFound(ftpBasicAuthPolicy.Response) {
+				return fmt.Errorf("reading FTP Publish Basic Authentication Policy for Windows %s: %+v", id, err)
 			}
 
-			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for Site Publishing Credential information for Windows %s: %+v", id, err)
+			scmBasicAuthPolicy, err := client.GetScmAllowed(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil && !utils.ResponseWasNotFound(scmBasicAuthPolicy.Response) {
+				return fmt.Errorf("reading Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
 			}
-			siteCredentials, err := siteCredentialsFuture.Result(*client)
-			if err != nil {
-				return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
+
+			var siteCredentials web.User
+			if !metadata.Client.Features.AppService.SkipReadingSiteCredentials {
+				siteCredentials, err = helpers.ReadSiteCredentials(ctx, client, id.ResourceGroup, id.SiteName, scmBasicAuthPolicy)
+				if err != nil {
+					return fmt.Errorf("reading Site Publishing Credential information for Windows %s: %+v", id, err)
+				}
 			}
 
 			siteMetadata, err := client.ListMetadata(ctx, id.ResourceGroup, id.SiteName)
@@ -531,11 +567,25 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 
 			state.SiteConfig = helpers.FlattenSiteConfigWindows(webAppSiteConfig.SiteConfig, currentStack, healthCheckCount)
 
+			ipRestrictionDefaultAction, scmIpRestrictionDefaultAction, err := helpers.GetIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "")
+			if err != nil {
+				return fmt.Errorf("reading IP Restriction Default Actions for Windows %s: %+v", id, err)
+			}
+			if len(state.SiteConfig) > 0 {
+				state.SiteConfig[0].IpRestrictionDefaultAction = ipRestrictionDefaultAction
+				state.SiteConfig[0].ScmIpRestrictionDefaultAction = scmIpRestrictionDefaultAction
+			}
+
+			state.PublicNetworkAccess = helpers.FlattenPublicNetworkAccess(webAppSiteConfig.SiteConfig)
+
 			// Zip Deploys are not retrievable, so attempt to get from config. This doesn't matter for imports as an unexpected value here could break the deployment.
 			if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
 				state.ZipDeployFile = deployFile
 			}
 
+			state.PublishingFTPBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(ftpBasicAuthPolicy)
+			state.PublishingDeployBasicAuthEnabled = helpers.FlattenBasicAuthPolicy(scmBasicAuthPolicy)
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
@@ -670,6 +720,11 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				existing.SiteConfig = siteConfig
 			}
 
+			if existing.SiteConfig == nil {
+				existing.SiteConfig = &web.SiteConfig{}
+			}
+			existing.SiteConfig.PublicNetworkAccess = helpers.ExpandPublicNetworkAccess(state.PublicNetworkAccess)
+
 			updateFuture, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, existing)
 			if err != nil {
 				return fmt.Errorf("updating Windows %s: %+v", id, err)
@@ -763,6 +818,27 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("ftp_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingFTPBasicAuthEnabled)
+				if _, err := client.UpdateFtpAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating FTP Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("webdeploy_publish_basic_authentication_enabled") {
+				sitePolicy := helpers.ExpandBasicAuthPolicy(state.PublishingDeployBasicAuthEnabled)
+				if _, err := client.UpdateScmAllowed(ctx, id.ResourceGroup, id.SiteName, sitePolicy); err != nil {
+					return fmt.Errorf("updating Web Deploy Publish Basic Authentication Policy for Windows %s: %+v", id, err)
+				}
+			}
+
+			// the IP Restriction Default Actions aren't part of the Site Config sent above, so these are set separately
+			if metadata.ResourceData.HasChange("site_config") {
+				if err := helpers.UpdateIpRestrictionDefaultActions(ctx, client, id.ResourceGroup, id.SiteName, "", state.SiteConfig[0].IpRestrictionDefaultAction, state.SiteConfig[0].ScmIpRestrictionDefaultAction); err != nil {
+					return fmt.Errorf("updating IP Restriction Default Actions for Windows %s: %+v", id, err)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("zip_deploy_file") || metadata.ResourceData.HasChange("zip_deploy_file") {
 				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile); err != nil {
 					return err
@@ -807,3 +883,24 @@ func (r WindowsWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 		return nil
 	}
 }
+
+func (r WindowsWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if err := helpers.ValidateKeyVaultReferenceIdentity(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if err := helpers.ValidatePublicNetworkAccess(metadata.ResourceDiff); err != nil {
+				return err
+			}
+
+			if metadata.Client.Features.AppService.ValidateKeyVaultReferences {
+				return helpers.ValidateKeyVaultReferences(metadata.ResourceDiff)
+			}
+
+			return nil
+		},
+	}
+}
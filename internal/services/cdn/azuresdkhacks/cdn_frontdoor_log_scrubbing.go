@@ -0,0 +1,152 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since `logScrubbing` is only available on Front Door Profiles from API Version
+// `2024-02-01`, whereas the Front Door resources are built against API Version `2021-06-01`
+
+const cdnFrontDoorLogScrubbingAPIVersion = "2024-02-01"
+
+type CdnFrontDoorLogScrubbingWorkaroundClient struct {
+	sdkClient *cdn.ProfilesClient
+}
+
+func NewCdnFrontDoorLogScrubbingWorkaroundClient(client *cdn.ProfilesClient) CdnFrontDoorLogScrubbingWorkaroundClient {
+	return CdnFrontDoorLogScrubbingWorkaroundClient{
+		sdkClient: client,
+	}
+}
+
+type ProfileLogScrubbingState string
+
+const (
+	ProfileLogScrubbingStateDisabled ProfileLogScrubbingState = "Disabled"
+	ProfileLogScrubbingStateEnabled  ProfileLogScrubbingState = "Enabled"
+)
+
+type ScrubbingRuleEntryMatchVariable string
+
+const (
+	ScrubbingRuleEntryMatchVariableQueryStringArgNames ScrubbingRuleEntryMatchVariable = "QueryStringArgNames"
+	ScrubbingRuleEntryMatchVariableRequestIPAddress    ScrubbingRuleEntryMatchVariable = "RequestIPAddress"
+	ScrubbingRuleEntryMatchVariableRequestURI          ScrubbingRuleEntryMatchVariable = "RequestUri"
+)
+
+type ScrubbingRuleEntryMatchOperator string
+
+const (
+	ScrubbingRuleEntryMatchOperatorEquals    ScrubbingRuleEntryMatchOperator = "Equals"
+	ScrubbingRuleEntryMatchOperatorEqualsAny ScrubbingRuleEntryMatchOperator = "EqualsAny"
+)
+
+type ScrubbingRuleEntryState string
+
+const (
+	ScrubbingRuleEntryStateDisabled ScrubbingRuleEntryState = "Disabled"
+	ScrubbingRuleEntryStateEnabled  ScrubbingRuleEntryState = "Enabled"
+)
+
+// ProfileLogScrubbingParameters is the subset of a Front Door Profile used to manage its Log Scrubbing configuration
+type ProfileLogScrubbingParameters struct {
+	autorest.Response `json:"-"`
+	Properties        *ProfileLogScrubbingProperties `json:"properties,omitempty"`
+}
+
+type ProfileLogScrubbingProperties struct {
+	LogScrubbing *ProfileLogScrubbing `json:"logScrubbing,omitempty"`
+}
+
+type ProfileLogScrubbing struct {
+	State          ProfileLogScrubbingState `json:"state,omitempty"`
+	ScrubbingRules *[]ProfileScrubbingRule  `json:"scrubbingRules,omitempty"`
+}
+
+type ProfileScrubbingRule struct {
+	MatchVariable         ScrubbingRuleEntryMatchVariable `json:"matchVariable"`
+	SelectorMatchOperator ScrubbingRuleEntryMatchOperator `json:"selectorMatchOperator"`
+	Selector              *string                         `json:"selector,omitempty"`
+	State                 ScrubbingRuleEntryState         `json:"state,omitempty"`
+}
+
+// Get retrieves the Log Scrubbing configuration for the specified Front Door Profile
+func (c CdnFrontDoorLogScrubbingWorkaroundClient) Get(ctx context.Context, resourceGroupName string, profileName string) (result ProfileLogScrubbingParameters, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, profileName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Update updates the Log Scrubbing configuration for the specified Front Door Profile, without changing any other
+// properties of the Front Door Profile
+func (c CdnFrontDoorLogScrubbingWorkaroundClient) Update(ctx context.Context, resourceGroupName string, profileName string, logScrubbing ProfileLogScrubbing) (result cdn.ProfilesUpdateFuture, err error) {
+	payload := ProfileLogScrubbingParameters{
+		Properties: &ProfileLogScrubbingProperties{
+			LogScrubbing: &logScrubbing,
+		},
+	}
+
+	req, err := c.preparer(ctx, resourceGroupName, profileName, autorest.AsPatch(), autorest.WithJSON(payload))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.sdkClient.UpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cdn.ProfilesClient", "Update", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+func (c CdnFrontDoorLogScrubbingWorkaroundClient) preparer(ctx context.Context, resourceGroupName string, profileName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"profileName":       autorest.Encode("path", profileName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": cdnFrontDoorLogScrubbingAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+	}, decorators...)
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Cdn/profiles/{profileName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,269 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorLogScrubbing() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorLogScrubbingCreateUpdate,
+		Read:   resourceCdnFrontDoorLogScrubbingRead,
+		Update: resourceCdnFrontDoorLogScrubbingCreateUpdate,
+		Delete: resourceCdnFrontDoorLogScrubbingDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorProfileID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"scrubbing_rule": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 3,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"match_variable": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(azuresdkhacks.ScrubbingRuleEntryMatchVariableQueryStringArgNames),
+								string(azuresdkhacks.ScrubbingRuleEntryMatchVariableRequestIPAddress),
+								string(azuresdkhacks.ScrubbingRuleEntryMatchVariableRequestURI),
+							}, false),
+						},
+
+						"operator": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(azuresdkhacks.ScrubbingRuleEntryMatchOperatorEqualsAny),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(azuresdkhacks.ScrubbingRuleEntryMatchOperatorEquals),
+								string(azuresdkhacks.ScrubbingRuleEntryMatchOperatorEqualsAny),
+							}, false),
+						},
+
+						"selector": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorLogScrubbingCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewCdnFrontDoorLogScrubbingWorkaroundClient(meta.(*clients.Client).Cdn.FrontDoorProfileClient)
+	profilesClient := meta.(*clients.Client).Cdn.FrontDoorProfileClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if props := existing.Properties; props != nil && props.LogScrubbing != nil && props.LogScrubbing.ScrubbingRules != nil && len(*props.LogScrubbing.ScrubbingRules) > 0 {
+			return tf.ImportAsExistsError("azurerm_cdn_frontdoor_log_scrubbing", id.ID())
+		}
+	}
+
+	rules, err := expandCdnFrontDoorLogScrubbingRules(d.Get("scrubbing_rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	state := azuresdkhacks.ProfileLogScrubbingStateDisabled
+	if d.Get("enabled").(bool) {
+		state = azuresdkhacks.ProfileLogScrubbingStateEnabled
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, azuresdkhacks.ProfileLogScrubbing{
+		State:          state,
+		ScrubbingRules: rules,
+	})
+	if err != nil {
+		return fmt.Errorf("updating the Log Scrubbing configuration for %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, profilesClient.Client); err != nil {
+		return fmt.Errorf("waiting for the Log Scrubbing configuration for %s to be updated: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorLogScrubbingRead(d, meta)
+}
+
+func resourceCdnFrontDoorLogScrubbingRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewCdnFrontDoorLogScrubbingWorkaroundClient(meta.(*clients.Client).Cdn.FrontDoorProfileClient)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	var logScrubbing *azuresdkhacks.ProfileLogScrubbing
+	if props := resp.Properties; props != nil {
+		logScrubbing = props.LogScrubbing
+	}
+
+	// the Log Scrubbing configuration is removed by clearing the rules, so treat this as gone
+	if logScrubbing == nil || logScrubbing.ScrubbingRules == nil || len(*logScrubbing.ScrubbingRules) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cdn_frontdoor_profile_id", id.ID())
+	d.Set("enabled", logScrubbing.State == azuresdkhacks.ProfileLogScrubbingStateEnabled)
+
+	if err := d.Set("scrubbing_rule", flattenCdnFrontDoorLogScrubbingRules(logScrubbing.ScrubbingRules)); err != nil {
+		return fmt.Errorf("setting `scrubbing_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorLogScrubbingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewCdnFrontDoorLogScrubbingWorkaroundClient(meta.(*clients.Client).Cdn.FrontDoorProfileClient)
+	profilesClient := meta.(*clients.Client).Cdn.FrontDoorProfileClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, azuresdkhacks.ProfileLogScrubbing{
+		State:          azuresdkhacks.ProfileLogScrubbingStateDisabled,
+		ScrubbingRules: &[]azuresdkhacks.ProfileScrubbingRule{},
+	})
+	if err != nil {
+		return fmt.Errorf("removing the Log Scrubbing configuration for %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, profilesClient.Client); err != nil {
+		return fmt.Errorf("waiting for the Log Scrubbing configuration for %s to be removed: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorLogScrubbingRules(input []interface{}) (*[]azuresdkhacks.ProfileScrubbingRule, error) {
+	results := make([]azuresdkhacks.ProfileScrubbingRule, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		matchVariable := azuresdkhacks.ScrubbingRuleEntryMatchVariable(v["match_variable"].(string))
+		operator := azuresdkhacks.ScrubbingRuleEntryMatchOperator(v["operator"].(string))
+		selector := v["selector"].(string)
+
+		// only the query string arguments can be scrubbed selectively, the IP Address and URI are always scrubbed in full
+		if matchVariable == azuresdkhacks.ScrubbingRuleEntryMatchVariableQueryStringArgNames {
+			if operator == azuresdkhacks.ScrubbingRuleEntryMatchOperatorEquals && selector == "" {
+				return nil, fmt.Errorf("`selector` must be specified when `match_variable` is `%s` and `operator` is `%s`", matchVariable, operator)
+			}
+		} else if operator != azuresdkhacks.ScrubbingRuleEntryMatchOperatorEqualsAny || selector != "" {
+			return nil, fmt.Errorf("`operator` must be `%s` and `selector` cannot be specified when `match_variable` is `%s`", azuresdkhacks.ScrubbingRuleEntryMatchOperatorEqualsAny, matchVariable)
+		}
+
+		state := azuresdkhacks.ScrubbingRuleEntryStateDisabled
+		if v["enabled"].(bool) {
+			state = azuresdkhacks.ScrubbingRuleEntryStateEnabled
+		}
+
+		rule := azuresdkhacks.ProfileScrubbingRule{
+			MatchVariable:         matchVariable,
+			SelectorMatchOperator: operator,
+			State:                 state,
+		}
+		if selector != "" {
+			rule.Selector = utils.String(selector)
+		}
+
+		results = append(results, rule)
+	}
+
+	return &results, nil
+}
+
+func flattenCdnFrontDoorLogScrubbingRules(input *[]azuresdkhacks.ProfileScrubbingRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		selector := ""
+		if item.Selector != nil {
+			selector = *item.Selector
+		}
+
+		results = append(results, map[string]interface{}{
+			"match_variable": string(item.MatchVariable),
+			"operator":       string(item.SelectorMatchOperator),
+			"selector":       selector,
+			"enabled":        item.State != azuresdkhacks.ScrubbingRuleEntryStateDisabled,
+		})
+	}
+
+	return results
+}
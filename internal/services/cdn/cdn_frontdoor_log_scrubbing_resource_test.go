@@ -0,0 +1,167 @@
+package cdn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorLogScrubbingResource struct{}
+
+func TestAccCdnFrontDoorLogScrubbing_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_log_scrubbing", "test")
+	r := CdnFrontDoorLogScrubbingResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCdnFrontDoorLogScrubbing_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_log_scrubbing", "test")
+	r := CdnFrontDoorLogScrubbingResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCdnFrontDoorLogScrubbing_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_log_scrubbing", "test")
+	r := CdnFrontDoorLogScrubbingResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CdnFrontDoorLogScrubbingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FrontDoorProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := azuresdkhacks.NewCdnFrontDoorLogScrubbingWorkaroundClient(clients.Cdn.FrontDoorProfileClient)
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if props := resp.Properties; props != nil && props.LogScrubbing != nil && props.LogScrubbing.ScrubbingRules != nil {
+		return utils.Bool(len(*props.LogScrubbing.ScrubbingRules) > 0), nil
+	}
+	return utils.Bool(false), nil
+}
+
+func (r CdnFrontDoorLogScrubbingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cdn-afdx-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_frontdoor_profile" "test" {
+  name                = "acctestprofile-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard_AzureFrontDoor"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r CdnFrontDoorLogScrubbingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_log_scrubbing" "test" {
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+
+  scrubbing_rule {
+    match_variable = "RequestIPAddress"
+  }
+}
+`, r.template(data))
+}
+
+func (r CdnFrontDoorLogScrubbingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_log_scrubbing" "import" {
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_log_scrubbing.test.cdn_frontdoor_profile_id
+
+  scrubbing_rule {
+    match_variable = "RequestIPAddress"
+  }
+}
+`, r.basic(data))
+}
+
+func (r CdnFrontDoorLogScrubbingResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_log_scrubbing" "test" {
+  cdn_frontdoor_profile_id = azurerm_cdn_frontdoor_profile.test.id
+  enabled                  = true
+
+  scrubbing_rule {
+    match_variable = "RequestIPAddress"
+    enabled        = false
+  }
+
+  scrubbing_rule {
+    match_variable = "RequestUri"
+  }
+
+  scrubbing_rule {
+    match_variable = "QueryStringArgNames"
+    operator       = "Equals"
+    selector       = "token"
+  }
+}
+`, r.template(data))
+}
@@ -47,8 +47,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cdn_profile":                resourceCdnProfile(),
 
 		// FrontDoor
-		"azurerm_cdn_frontdoor_endpoint": resourceCdnFrontDoorEndpoint(),
-		"azurerm_cdn_frontdoor_profile":  resourceCdnFrontDoorProfile(),
-		"azurerm_cdn_frontdoor_rule_set": resourceCdnFrontDoorRuleSet(),
+		"azurerm_cdn_frontdoor_endpoint":      resourceCdnFrontDoorEndpoint(),
+		"azurerm_cdn_frontdoor_log_scrubbing": resourceCdnFrontDoorLogScrubbing(),
+		"azurerm_cdn_frontdoor_profile":       resourceCdnFrontDoorProfile(),
+		"azurerm_cdn_frontdoor_rule_set":      resourceCdnFrontDoorRuleSet(),
 	}
 }
@@ -0,0 +1,106 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// NOTE: this workaround client exists since listing the Versions of a Community Gallery Image is only available
+// from API Version `2022-03-03` of the Compute Gallery API, whereas the Compute resources are built against API
+// Version `2021-11-01`. Once the resources have been migrated to a newer API Version this can be removed.
+
+const communityGalleryImageVersionsAPIVersion = "2022-03-03"
+
+type CommunityGalleryImageVersionsClient struct {
+	Client         autorest.Client
+	baseUri        string
+	subscriptionId string
+}
+
+func NewCommunityGalleryImageVersionsClientWithBaseURI(endpoint string, subscriptionId string) CommunityGalleryImageVersionsClient {
+	return CommunityGalleryImageVersionsClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		baseUri:        endpoint,
+		subscriptionId: subscriptionId,
+	}
+}
+
+type CommunityGalleryImageVersion struct {
+	Name       *string                                 `json:"name,omitempty"`
+	Location   *string                                 `json:"location,omitempty"`
+	Identifier *CommunityGalleryIdentifier             `json:"identifier,omitempty"`
+	Properties *CommunityGalleryImageVersionProperties `json:"properties,omitempty"`
+}
+
+type CommunityGalleryIdentifier struct {
+	UniqueID *string `json:"uniqueId,omitempty"`
+}
+
+type CommunityGalleryImageVersionProperties struct {
+	PublishedDate     *date.Time `json:"publishedDate,omitempty"`
+	EndOfLifeDate     *date.Time `json:"endOfLifeDate,omitempty"`
+	ExcludeFromLatest *bool      `json:"excludeFromLatest,omitempty"`
+}
+
+type communityGalleryImageVersionList struct {
+	Value    *[]CommunityGalleryImageVersion `json:"value,omitempty"`
+	NextLink *string                         `json:"nextLink,omitempty"`
+}
+
+// List returns all of the Versions of the specified Community Gallery Image within the specified location.
+func (c CommunityGalleryImageVersionsClient) List(ctx context.Context, location string, publicGalleryName string, galleryImageName string) (result []CommunityGalleryImageVersion, resp autorest.Response, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/Microsoft.Compute/locations/{location}/communityGalleries/{publicGalleryName}/images/{galleryImageName}/versions", map[string]interface{}{
+			"subscriptionId":    autorest.Encode("path", c.subscriptionId),
+			"location":          autorest.Encode("path", location),
+			"publicGalleryName": autorest.Encode("path", publicGalleryName),
+			"galleryImageName":  autorest.Encode("path", galleryImageName),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": communityGalleryImageVersionsAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, resp, autorest.NewErrorWithError(err, "compute.CommunityGalleryImageVersionsClient", "List", nil, "Failure preparing request")
+	}
+
+	result = make([]CommunityGalleryImageVersion, 0)
+	for req != nil {
+		httpResp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+		resp = autorest.Response{Response: httpResp}
+		if err != nil {
+			return nil, resp, autorest.NewErrorWithError(err, "compute.CommunityGalleryImageVersionsClient", "List", httpResp, "Failure sending request")
+		}
+
+		var page communityGalleryImageVersionList
+		err = autorest.Respond(
+			httpResp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, resp, autorest.NewErrorWithError(err, "compute.CommunityGalleryImageVersionsClient", "List", httpResp, "Failure responding to request")
+		}
+
+		if page.Value != nil {
+			result = append(result, *page.Value...)
+		}
+
+		req = nil
+		if page.NextLink != nil && *page.NextLink != "" {
+			req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+				autorest.AsGet(),
+				autorest.WithBaseURL(*page.NextLink))
+			if err != nil {
+				return nil, resp, autorest.NewErrorWithError(err, "compute.CommunityGalleryImageVersionsClient", "List", nil, "Failure preparing next results request")
+			}
+		}
+	}
+
+	return result, resp, nil
+}
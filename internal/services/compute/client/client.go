@@ -6,36 +6,39 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/availabilitysets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/sshpublickeys"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 )
 
 type Client struct {
-	AvailabilitySetsClient           *availabilitysets.AvailabilitySetsClient
-	CapacityReservationsClient       *compute.CapacityReservationsClient
-	CapacityReservationGroupsClient  *compute.CapacityReservationGroupsClient
-	DedicatedHostsClient             *compute.DedicatedHostsClient
-	DedicatedHostGroupsClient        *compute.DedicatedHostGroupsClient
-	DisksClient                      *compute.DisksClient
-	DiskAccessClient                 *compute.DiskAccessesClient
-	DiskEncryptionSetsClient         *compute.DiskEncryptionSetsClient
-	GalleriesClient                  *compute.GalleriesClient
-	GalleryApplicationsClient        *compute.GalleryApplicationsClient
-	GalleryApplicationVersionsClient *compute.GalleryApplicationVersionsClient
-	GalleryImagesClient              *compute.GalleryImagesClient
-	GalleryImageVersionsClient       *compute.GalleryImageVersionsClient
-	ImagesClient                     *compute.ImagesClient
-	MarketplaceAgreementsClient      *marketplaceordering.MarketplaceAgreementsClient
-	ProximityPlacementGroupsClient   *compute.ProximityPlacementGroupsClient
-	SSHPublicKeysClient              *sshpublickeys.SshPublicKeysClient
-	SnapshotsClient                  *compute.SnapshotsClient
-	UsageClient                      *compute.UsageClient
-	VMExtensionImageClient           *compute.VirtualMachineExtensionImagesClient
-	VMExtensionClient                *compute.VirtualMachineExtensionsClient
-	VMScaleSetClient                 *compute.VirtualMachineScaleSetsClient
-	VMScaleSetExtensionsClient       *compute.VirtualMachineScaleSetExtensionsClient
-	VMScaleSetRollingUpgradesClient  *compute.VirtualMachineScaleSetRollingUpgradesClient
-	VMScaleSetVMsClient              *compute.VirtualMachineScaleSetVMsClient
-	VMClient                         *compute.VirtualMachinesClient
-	VMImageClient                    *compute.VirtualMachineImagesClient
+	AvailabilitySetsClient              *availabilitysets.AvailabilitySetsClient
+	CapacityReservationsClient          *compute.CapacityReservationsClient
+	CapacityReservationGroupsClient     *compute.CapacityReservationGroupsClient
+	CommunityGalleryImagesClient        *compute.CommunityGalleryImagesClient
+	CommunityGalleryImageVersionsClient *azuresdkhacks.CommunityGalleryImageVersionsClient
+	DedicatedHostsClient                *compute.DedicatedHostsClient
+	DedicatedHostGroupsClient           *compute.DedicatedHostGroupsClient
+	DisksClient                         *compute.DisksClient
+	DiskAccessClient                    *compute.DiskAccessesClient
+	DiskEncryptionSetsClient            *compute.DiskEncryptionSetsClient
+	GalleriesClient                     *compute.GalleriesClient
+	GalleryApplicationsClient           *compute.GalleryApplicationsClient
+	GalleryApplicationVersionsClient    *compute.GalleryApplicationVersionsClient
+	GalleryImagesClient                 *compute.GalleryImagesClient
+	GalleryImageVersionsClient          *compute.GalleryImageVersionsClient
+	ImagesClient                        *compute.ImagesClient
+	MarketplaceAgreementsClient         *marketplaceordering.MarketplaceAgreementsClient
+	ProximityPlacementGroupsClient      *compute.ProximityPlacementGroupsClient
+	SSHPublicKeysClient                 *sshpublickeys.SshPublicKeysClient
+	SnapshotsClient                     *compute.SnapshotsClient
+	UsageClient                         *compute.UsageClient
+	VMExtensionImageClient              *compute.VirtualMachineExtensionImagesClient
+	VMExtensionClient                   *compute.VirtualMachineExtensionsClient
+	VMScaleSetClient                    *compute.VirtualMachineScaleSetsClient
+	VMScaleSetExtensionsClient          *compute.VirtualMachineScaleSetExtensionsClient
+	VMScaleSetRollingUpgradesClient     *compute.VirtualMachineScaleSetRollingUpgradesClient
+	VMScaleSetVMsClient                 *compute.VirtualMachineScaleSetVMsClient
+	VMClient                            *compute.VirtualMachinesClient
+	VMImageClient                       *compute.VirtualMachineImagesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -48,6 +51,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	capacityReservationGroupsClient := compute.NewCapacityReservationGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&capacityReservationGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	communityGalleryImagesClient := compute.NewCommunityGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&communityGalleryImagesClient.Client, o.ResourceManagerAuthorizer)
+
+	communityGalleryImageVersionsClient := azuresdkhacks.NewCommunityGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&communityGalleryImageVersionsClient.Client, o.ResourceManagerAuthorizer)
+
 	dedicatedHostsClient := compute.NewDedicatedHostsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dedicatedHostsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -121,32 +130,34 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AvailabilitySetsClient:           &availabilitySetsClient,
-		CapacityReservationsClient:       &capacityReservationsClient,
-		CapacityReservationGroupsClient:  &capacityReservationGroupsClient,
-		DedicatedHostsClient:             &dedicatedHostsClient,
-		DedicatedHostGroupsClient:        &dedicatedHostGroupsClient,
-		DisksClient:                      &disksClient,
-		DiskAccessClient:                 &diskAccessClient,
-		DiskEncryptionSetsClient:         &diskEncryptionSetsClient,
-		GalleriesClient:                  &galleriesClient,
-		GalleryApplicationsClient:        &galleryApplicationsClient,
-		GalleryApplicationVersionsClient: &galleryApplicationVersionsClient,
-		GalleryImagesClient:              &galleryImagesClient,
-		GalleryImageVersionsClient:       &galleryImageVersionsClient,
-		ImagesClient:                     &imagesClient,
-		MarketplaceAgreementsClient:      &marketplaceAgreementsClient,
-		ProximityPlacementGroupsClient:   &proximityPlacementGroupsClient,
-		SSHPublicKeysClient:              &sshPublicKeysClient,
-		SnapshotsClient:                  &snapshotsClient,
-		UsageClient:                      &usageClient,
-		VMExtensionImageClient:           &vmExtensionImageClient,
-		VMExtensionClient:                &vmExtensionClient,
-		VMScaleSetClient:                 &vmScaleSetClient,
-		VMScaleSetExtensionsClient:       &vmScaleSetExtensionsClient,
-		VMScaleSetRollingUpgradesClient:  &vmScaleSetRollingUpgradesClient,
-		VMScaleSetVMsClient:              &vmScaleSetVMsClient,
-		VMClient:                         &vmClient,
-		VMImageClient:                    &vmImageClient,
+		AvailabilitySetsClient:              &availabilitySetsClient,
+		CapacityReservationsClient:          &capacityReservationsClient,
+		CapacityReservationGroupsClient:     &capacityReservationGroupsClient,
+		CommunityGalleryImagesClient:        &communityGalleryImagesClient,
+		CommunityGalleryImageVersionsClient: &communityGalleryImageVersionsClient,
+		DedicatedHostsClient:                &dedicatedHostsClient,
+		DedicatedHostGroupsClient:           &dedicatedHostGroupsClient,
+		DisksClient:                         &disksClient,
+		DiskAccessClient:                    &diskAccessClient,
+		DiskEncryptionSetsClient:            &diskEncryptionSetsClient,
+		GalleriesClient:                     &galleriesClient,
+		GalleryApplicationsClient:           &galleryApplicationsClient,
+		GalleryApplicationVersionsClient:    &galleryApplicationVersionsClient,
+		GalleryImagesClient:                 &galleryImagesClient,
+		GalleryImageVersionsClient:          &galleryImageVersionsClient,
+		ImagesClient:                        &imagesClient,
+		MarketplaceAgreementsClient:         &marketplaceAgreementsClient,
+		ProximityPlacementGroupsClient:      &proximityPlacementGroupsClient,
+		SSHPublicKeysClient:                 &sshPublicKeysClient,
+		SnapshotsClient:                     &snapshotsClient,
+		UsageClient:                         &usageClient,
+		VMExtensionImageClient:              &vmExtensionImageClient,
+		VMExtensionClient:                   &vmExtensionClient,
+		VMScaleSetClient:                    &vmScaleSetClient,
+		VMScaleSetExtensionsClient:          &vmScaleSetExtensionsClient,
+		VMScaleSetRollingUpgradesClient:     &vmScaleSetRollingUpgradesClient,
+		VMScaleSetVMsClient:                 &vmScaleSetVMsClient,
+		VMClient:                            &vmClient,
+		VMImageClient:                       &vmImageClient,
 	}
 }
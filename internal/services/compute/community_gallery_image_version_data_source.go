@@ -0,0 +1,243 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceCommunityGalleryImageVersion() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCommunityGalleryImageVersionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"community_gallery_image_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CommunityGalleryImageID,
+			},
+
+			"location": commonschema.Location(),
+
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "latest",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"unique_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"published_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"end_of_life_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"exclude_from_latest": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"os_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"hyper_v_generation": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"purchase_plan": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"publisher": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"product": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCommunityGalleryImageVersionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	imagesClient := meta.(*clients.Client).Compute.CommunityGalleryImagesClient
+	versionsClient := meta.(*clients.Client).Compute.CommunityGalleryImageVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	imageId, err := parse.CommunityGalleryImageID(d.Get("community_gallery_image_id").(string))
+	if err != nil {
+		return err
+	}
+	loc := location.Normalize(d.Get("location").(string))
+
+	image, err := imagesClient.Get(ctx, loc, imageId.GalleryName, imageId.ImageName)
+	if err != nil {
+		if utils.ResponseWasNotFound(image.Response) {
+			return fmt.Errorf("%s was not found in %q", imageId, loc)
+		}
+		return fmt.Errorf("retrieving %s (Location %q): %+v", imageId, loc, err)
+	}
+
+	versions, resp, err := versionsClient.List(ctx, loc, imageId.GalleryName, imageId.ImageName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("no Versions were found for %s in %q", imageId, loc)
+		}
+		return fmt.Errorf("listing Versions for %s (Location %q): %+v", imageId, loc, err)
+	}
+
+	name := d.Get("name").(string)
+	imageVersion, err := findCommunityGalleryImageVersion(versions, name)
+	if err != nil {
+		return fmt.Errorf("finding Version %q for %s (Location %q): %+v", name, imageId, loc, err)
+	}
+
+	versionName := utils.NormalizeNilableString(imageVersion.Name)
+	id := parse.NewCommunityGalleryImageVersionID(imageId.GalleryName, imageId.ImageName, versionName)
+	uniqueId := id.ID()
+	if imageVersion.Identifier != nil && imageVersion.Identifier.UniqueID != nil {
+		uniqueId = *imageVersion.Identifier.UniqueID
+	}
+
+	d.SetId(uniqueId)
+	d.Set("name", versionName)
+	d.Set("location", loc)
+	d.Set("community_gallery_image_id", imageId.ID())
+	d.Set("unique_id", uniqueId)
+
+	publishedDate := ""
+	endOfLifeDate := ""
+	excludeFromLatest := false
+	if props := imageVersion.Properties; props != nil {
+		if props.PublishedDate != nil {
+			publishedDate = props.PublishedDate.Format(time.RFC3339)
+		}
+		if props.EndOfLifeDate != nil {
+			endOfLifeDate = props.EndOfLifeDate.Format(time.RFC3339)
+		}
+		if props.ExcludeFromLatest != nil {
+			excludeFromLatest = *props.ExcludeFromLatest
+		}
+	}
+	d.Set("published_date", publishedDate)
+	d.Set("end_of_life_date", endOfLifeDate)
+	d.Set("exclude_from_latest", excludeFromLatest)
+
+	if props := image.CommunityGalleryImageProperties; props != nil {
+		d.Set("os_type", string(props.OsType))
+		d.Set("hyper_v_generation", string(props.HyperVGeneration))
+
+		if err := d.Set("purchase_plan", flattenCommunityGalleryImagePurchasePlan(props.PurchasePlan)); err != nil {
+			return fmt.Errorf("setting `purchase_plan`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+// findCommunityGalleryImageVersion returns the specified Version of the Community Gallery Image, where `latest`
+// returns the most recent Version (by semantic version) which hasn't been excluded from latest.
+func findCommunityGalleryImageVersion(input []azuresdkhacks.CommunityGalleryImageVersion, name string) (*azuresdkhacks.CommunityGalleryImageVersion, error) {
+	if !strings.EqualFold(name, "latest") {
+		for _, item := range input {
+			if item.Name != nil && strings.EqualFold(*item.Name, name) {
+				return &item, nil
+			}
+		}
+
+		return nil, fmt.Errorf("the Version was not found")
+	}
+
+	candidates := make([]azuresdkhacks.CommunityGalleryImageVersion, 0)
+	for _, item := range input {
+		if item.Name == nil {
+			continue
+		}
+		if item.Properties != nil && item.Properties.ExcludeFromLatest != nil && *item.Properties.ExcludeFromLatest {
+			continue
+		}
+		candidates = append(candidates, item)
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no Versions are available which aren't excluded from latest")
+	}
+
+	var errs []error
+	sort.Slice(candidates, func(i, j int) bool {
+		verA, err := version.NewVersion(*candidates[i].Name)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+
+		verB, err := version.NewVersion(*candidates[j].Name)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+
+		return verA.LessThan(verB)
+	})
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("parsing version(s): %v", errs)
+	}
+
+	return &candidates[len(candidates)-1], nil
+}
+
+func flattenCommunityGalleryImagePurchasePlan(input *compute.ImagePurchasePlan) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":      utils.NormalizeNilableString(input.Name),
+			"publisher": utils.NormalizeNilableString(input.Publisher),
+			"product":   utils.NormalizeNilableString(input.Product),
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package compute_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CommunityGalleryImageVersionDataSource struct{}
+
+func TestAccDataSourceCommunityGalleryImageVersion_latest(t *testing.T) {
+	if os.Getenv("ARM_TEST_COMMUNITY_GALLERY_IMAGE_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_COMMUNITY_GALLERY_IMAGE_ID` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_community_gallery_image_version", "test")
+	r := CommunityGalleryImageVersionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.latest(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("unique_id").Exists(),
+				check.That(data.ResourceName).Key("published_date").Exists(),
+				check.That(data.ResourceName).Key("os_type").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceCommunityGalleryImageVersion_specificVersion(t *testing.T) {
+	if os.Getenv("ARM_TEST_COMMUNITY_GALLERY_IMAGE_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_COMMUNITY_GALLERY_IMAGE_ID` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_community_gallery_image_version", "test")
+	r := CommunityGalleryImageVersionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.specificVersion(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("unique_id").MatchesOtherKey(check.That("data.azurerm_community_gallery_image_version.latest").Key("unique_id")),
+			),
+		},
+	})
+}
+
+func (CommunityGalleryImageVersionDataSource) latest(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_community_gallery_image_version" "test" {
+  community_gallery_image_id = "%s"
+  location                   = "%s"
+}
+`, os.Getenv("ARM_TEST_COMMUNITY_GALLERY_IMAGE_ID"), data.Locations.Primary)
+}
+
+func (CommunityGalleryImageVersionDataSource) specificVersion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_community_gallery_image_version" "latest" {
+  community_gallery_image_id = "%[1]s"
+  location                   = "%[2]s"
+}
+
+data "azurerm_community_gallery_image_version" "test" {
+  community_gallery_image_id = "%[1]s"
+  location                   = "%[2]s"
+  name                       = data.azurerm_community_gallery_image_version.latest.name
+}
+`, os.Getenv("ARM_TEST_COMMUNITY_GALLERY_IMAGE_ID"), data.Locations.Primary)
+}
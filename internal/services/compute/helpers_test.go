@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -82,3 +83,50 @@ func TestSortVersions_invalid(t *testing.T) {
 		}
 	}
 }
+
+func TestFindCommunityGalleryImageVersion(t *testing.T) {
+	versions := []azuresdkhacks.CommunityGalleryImageVersion{
+		{Name: utils.String("1.0.1")},
+		{Name: utils.String("1.0.10")},
+		{Name: utils.String("1.2.0"), Properties: &azuresdkhacks.CommunityGalleryImageVersionProperties{ExcludeFromLatest: utils.Bool(true)}},
+		{Name: utils.String("1.0.9")},
+	}
+
+	testData := []struct {
+		name     string
+		expected string
+		error    bool
+	}{
+		{
+			name:     "latest",
+			expected: "1.0.10",
+		},
+		{
+			name:     "1.2.0",
+			expected: "1.2.0",
+		},
+		{
+			name:  "2.0.0",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing findCommunityGalleryImageVersion for %q..", v.name)
+
+		actual, err := findCommunityGalleryImageVersion(versions, v.name)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if *actual.Name != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, *actual.Name)
+		}
+	}
+}
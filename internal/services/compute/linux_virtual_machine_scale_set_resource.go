@@ -853,11 +853,7 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 				return fmt.Errorf("setting `source_image_reference`: %+v", err)
 			}
 
-			var storageImageId string
-			if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
-				storageImageId = *storageProfile.ImageReference.ID
-			}
-			d.Set("source_image_id", storageImageId)
+			d.Set("source_image_id", flattenSourceImageId(storageProfile.ImageReference))
 		}
 
 		if osProfile := profile.OsProfile; osProfile != nil {
@@ -1234,6 +1230,8 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 				validate.ImageID,
 				validate.SharedImageID,
 				validate.SharedImageVersionID,
+				validate.CommunityGalleryImageID,
+				validate.CommunityGalleryImageVersionID,
 			),
 		},
 
@@ -1341,6 +1341,12 @@ func expandOrchestratedSourceImageReference(referenceInput []interface{}, imageI
 	}
 
 	if imageId != "" {
+		if isCommunityGalleryImageId(imageId) {
+			return &compute.ImageReference{
+				CommunityGalleryImageID: utils.String(imageId),
+			}
+		}
+
 		return &compute.ImageReference{
 			ID: utils.String(imageId),
 		}
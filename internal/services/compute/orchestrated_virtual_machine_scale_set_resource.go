@@ -175,9 +175,13 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			// removing single_placement_group since it has been retired as of version 2019-12-01 for Flex VMSS
 			"source_image_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.Any(
+					azure.ValidateResourceID,
+					computeValidate.CommunityGalleryImageID,
+					computeValidate.CommunityGalleryImageVersionID,
+				),
 			},
 
 			"source_image_reference": sourceImageReferenceSchema(false),
@@ -1049,11 +1053,7 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 				return fmt.Errorf("setting `source_image_reference`: %+v", err)
 			}
 
-			var storageImageId string
-			if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
-				storageImageId = *storageProfile.ImageReference.ID
-			}
-			d.Set("source_image_id", storageImageId)
+			d.Set("source_image_id", flattenSourceImageId(storageProfile.ImageReference))
 		}
 
 		if osProfile := profile.OsProfile; osProfile != nil {
@@ -0,0 +1,120 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: Community Gallery Images are identified by a Unique ID which isn't an Azure Resource ID (since it's not
+// scoped to a Subscription), for example `/CommunityGalleries/{publicGalleryName}/Images/{imageName}` - as such
+// these can't be generated using the Resource ID generator.
+
+type CommunityGalleryImageId struct {
+	GalleryName string
+	ImageName   string
+}
+
+func NewCommunityGalleryImageID(galleryName, imageName string) CommunityGalleryImageId {
+	return CommunityGalleryImageId{
+		GalleryName: galleryName,
+		ImageName:   imageName,
+	}
+}
+
+func (id CommunityGalleryImageId) String() string {
+	segments := []string{
+		fmt.Sprintf("Image Name %q", id.ImageName),
+		fmt.Sprintf("Gallery Name %q", id.GalleryName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Community Gallery Image", segmentsStr)
+}
+
+func (id CommunityGalleryImageId) ID() string {
+	fmtString := "/CommunityGalleries/%s/Images/%s"
+	return fmt.Sprintf(fmtString, id.GalleryName, id.ImageName)
+}
+
+// CommunityGalleryImageID parses a CommunityGalleryImage ID into an CommunityGalleryImageId struct
+func CommunityGalleryImageID(input string) (*CommunityGalleryImageId, error) {
+	segments, err := parseCommunityGalleryUniqueId(input, "CommunityGalleries", "Images")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommunityGalleryImageId{
+		GalleryName: segments[0],
+		ImageName:   segments[1],
+	}, nil
+}
+
+type CommunityGalleryImageVersionId struct {
+	GalleryName string
+	ImageName   string
+	VersionName string
+}
+
+func NewCommunityGalleryImageVersionID(galleryName, imageName, versionName string) CommunityGalleryImageVersionId {
+	return CommunityGalleryImageVersionId{
+		GalleryName: galleryName,
+		ImageName:   imageName,
+		VersionName: versionName,
+	}
+}
+
+func (id CommunityGalleryImageVersionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Version Name %q", id.VersionName),
+		fmt.Sprintf("Image Name %q", id.ImageName),
+		fmt.Sprintf("Gallery Name %q", id.GalleryName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Community Gallery Image Version", segmentsStr)
+}
+
+func (id CommunityGalleryImageVersionId) ID() string {
+	fmtString := "/CommunityGalleries/%s/Images/%s/Versions/%s"
+	return fmt.Sprintf(fmtString, id.GalleryName, id.ImageName, id.VersionName)
+}
+
+// CommunityGalleryImageVersionID parses a CommunityGalleryImageVersion ID into an CommunityGalleryImageVersionId struct
+func CommunityGalleryImageVersionID(input string) (*CommunityGalleryImageVersionId, error) {
+	segments, err := parseCommunityGalleryUniqueId(input, "CommunityGalleries", "Images", "Versions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommunityGalleryImageVersionId{
+		GalleryName: segments[0],
+		ImageName:   segments[1],
+		VersionName: segments[2],
+	}, nil
+}
+
+// parseCommunityGalleryUniqueId parses the key/value pairs of a Community Gallery Unique ID, returning the values
+// for each of the keys (which are matched case-insensitively) in order.
+func parseCommunityGalleryUniqueId(input string, keys ...string) ([]string, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("expected the ID %q to start with a `/`", input)
+	}
+
+	components := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(components) != len(keys)*2 {
+		return nil, fmt.Errorf("expected the ID %q to be in the format `/%s/{value}`", input, strings.Join(keys, "/{value}/"))
+	}
+
+	values := make([]string, 0, len(keys))
+	for i, key := range keys {
+		if !strings.EqualFold(components[i*2], key) {
+			return nil, fmt.Errorf("expected the segment %q of the ID %q to be %q", components[i*2], input, key)
+		}
+
+		value := components[i*2+1]
+		if value == "" {
+			return nil, fmt.Errorf("ID was missing the value for the %q element", key)
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
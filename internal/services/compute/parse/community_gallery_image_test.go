@@ -0,0 +1,190 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestCommunityGalleryImageIDFormatter(t *testing.T) {
+	actual := NewCommunityGalleryImageID("gallery1-00000000-0000-0000-0000-000000000000", "image1").ID()
+	expected := "/CommunityGalleries/gallery1-00000000-0000-0000-0000-000000000000/Images/image1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCommunityGalleryImageID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CommunityGalleryImageId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing leading slash
+			Input: "CommunityGalleries/gallery1/Images/image1",
+			Error: true,
+		},
+
+		{
+			// missing GalleryName
+			Input: "/CommunityGalleries/",
+			Error: true,
+		},
+
+		{
+			// missing ImageName
+			Input: "/CommunityGalleries/gallery1/",
+			Error: true,
+		},
+
+		{
+			// missing value for ImageName
+			Input: "/CommunityGalleries/gallery1/Images/",
+			Error: true,
+		},
+
+		{
+			// resource manager id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1",
+			Error: true,
+		},
+
+		{
+			// version id
+			Input: "/CommunityGalleries/gallery1/Images/image1/Versions/1.0.0",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/CommunityGalleries/gallery1/Images/image1",
+			Expected: &CommunityGalleryImageId{
+				GalleryName: "gallery1",
+				ImageName:   "image1",
+			},
+		},
+
+		{
+			// lower-cased
+			Input: "/communitygalleries/gallery1/images/image1",
+			Expected: &CommunityGalleryImageId{
+				GalleryName: "gallery1",
+				ImageName:   "image1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := CommunityGalleryImageID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.GalleryName != v.Expected.GalleryName {
+			t.Fatalf("Expected %q but got %q for GalleryName", v.Expected.GalleryName, actual.GalleryName)
+		}
+		if actual.ImageName != v.Expected.ImageName {
+			t.Fatalf("Expected %q but got %q for ImageName", v.Expected.ImageName, actual.ImageName)
+		}
+	}
+}
+
+func TestCommunityGalleryImageVersionIDFormatter(t *testing.T) {
+	actual := NewCommunityGalleryImageVersionID("gallery1", "image1", "1.0.0").ID()
+	expected := "/CommunityGalleries/gallery1/Images/image1/Versions/1.0.0"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCommunityGalleryImageVersionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CommunityGalleryImageVersionId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// image id
+			Input: "/CommunityGalleries/gallery1/Images/image1",
+			Error: true,
+		},
+
+		{
+			// missing value for VersionName
+			Input: "/CommunityGalleries/gallery1/Images/image1/Versions/",
+			Error: true,
+		},
+
+		{
+			// incorrect segment
+			Input: "/CommunityGalleries/gallery1/Images/image1/Foo/1.0.0",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/CommunityGalleries/gallery1/Images/image1/Versions/1.0.0",
+			Expected: &CommunityGalleryImageVersionId{
+				GalleryName: "gallery1",
+				ImageName:   "image1",
+				VersionName: "1.0.0",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/COMMUNITYGALLERIES/gallery1/IMAGES/image1/VERSIONS/1.0.0",
+			Expected: &CommunityGalleryImageVersionId{
+				GalleryName: "gallery1",
+				ImageName:   "image1",
+				VersionName: "1.0.0",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := CommunityGalleryImageVersionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.GalleryName != v.Expected.GalleryName {
+			t.Fatalf("Expected %q but got %q for GalleryName", v.Expected.GalleryName, actual.GalleryName)
+		}
+		if actual.ImageName != v.Expected.ImageName {
+			t.Fatalf("Expected %q but got %q for ImageName", v.Expected.ImageName, actual.ImageName)
+		}
+		if actual.VersionName != v.Expected.VersionName {
+			t.Fatalf("Expected %q but got %q for VersionName", v.Expected.VersionName, actual.VersionName)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type VirtualMachineScaleSetStandbyOverrideId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	VirtualMachineScaleSetName string
+	StandbyOverrideName        string
+}
+
+func NewVirtualMachineScaleSetStandbyOverrideID(subscriptionId, resourceGroup, virtualMachineScaleSetName, standbyOverrideName string) VirtualMachineScaleSetStandbyOverrideId {
+	return VirtualMachineScaleSetStandbyOverrideId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		VirtualMachineScaleSetName: virtualMachineScaleSetName,
+		StandbyOverrideName:        standbyOverrideName,
+	}
+}
+
+func (id VirtualMachineScaleSetStandbyOverrideId) String() string {
+	segments := []string{
+		fmt.Sprintf("Standby Override Name %q", id.StandbyOverrideName),
+		fmt.Sprintf("Virtual Machine Scale Set Name %q", id.VirtualMachineScaleSetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Virtual Machine Scale Set Standby Override", segmentsStr)
+}
+
+func (id VirtualMachineScaleSetStandbyOverrideId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s/standbyOverride/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.StandbyOverrideName)
+}
+
+// VirtualMachineScaleSetStandbyOverrideID parses a VirtualMachineScaleSetStandbyOverride ID into an VirtualMachineScaleSetStandbyOverrideId struct
+func VirtualMachineScaleSetStandbyOverrideID(input string) (*VirtualMachineScaleSetStandbyOverrideId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineScaleSetStandbyOverrideId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+		return nil, err
+	}
+	if resourceId.StandbyOverrideName, err = id.PopSegment("standbyOverride"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
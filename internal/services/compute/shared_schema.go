@@ -5,6 +5,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -400,6 +401,13 @@ func isValidHotPatchSourceImageReference(referenceInput []interface{}, imageId s
 
 func expandSourceImageReference(referenceInput []interface{}, imageId string) (*compute.ImageReference, error) {
 	if imageId != "" {
+		// Community Gallery Images are referenced using their Unique ID rather than a Resource ID
+		if isCommunityGalleryImageId(imageId) {
+			return &compute.ImageReference{
+				CommunityGalleryImageID: utils.String(imageId),
+			}, nil
+		}
+
 		return &compute.ImageReference{
 			ID: utils.String(imageId),
 		}, nil
@@ -420,7 +428,7 @@ func expandSourceImageReference(referenceInput []interface{}, imageId string) (*
 
 func flattenSourceImageReference(input *compute.ImageReference) []interface{} {
 	// since the image id is pulled out as a separate field, if that's set we should return an empty block here
-	if input == nil || input.ID != nil {
+	if input == nil || input.ID != nil || input.CommunityGalleryImageID != nil {
 		return []interface{}{}
 	}
 
@@ -449,6 +457,33 @@ func flattenSourceImageReference(input *compute.ImageReference) []interface{} {
 	}
 }
 
+// flattenSourceImageId returns the ID of the Image used as the source for this Virtual Machine (Scale Set), which
+// is either the Resource ID of an Image/Shared Image (Version) or the Unique ID of a Community Gallery Image (Version)
+func flattenSourceImageId(input *compute.ImageReference) string {
+	if input == nil {
+		return ""
+	}
+
+	if input.ID != nil {
+		return *input.ID
+	}
+
+	if input.CommunityGalleryImageID != nil {
+		return *input.CommunityGalleryImageID
+	}
+
+	return ""
+}
+
+func isCommunityGalleryImageId(input string) bool {
+	if _, err := parse.CommunityGalleryImageID(input); err == nil {
+		return true
+	}
+
+	_, err := parse.CommunityGalleryImageVersionID(input)
+	return err == nil
+}
+
 func winRmListenerSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeSet,
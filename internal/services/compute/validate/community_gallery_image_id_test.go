@@ -0,0 +1,83 @@
+package validate
+
+import "testing"
+
+func TestCommunityGalleryImageID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// shared image id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1",
+			Valid: false,
+		},
+
+		{
+			// version id
+			Input: "/CommunityGalleries/gallery1/Images/image1/Versions/1.0.0",
+			Valid: false,
+		},
+
+		{
+			// valid
+			Input: "/CommunityGalleries/gallery1/Images/image1",
+			Valid: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := CommunityGalleryImageID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestCommunityGalleryImageVersionID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+
+		{
+			// image id
+			Input: "/CommunityGalleries/gallery1/Images/image1",
+			Valid: false,
+		},
+
+		{
+			// valid
+			Input: "/CommunityGalleries/gallery1/Images/image1/Versions/1.0.0",
+			Valid: true,
+		},
+
+		{
+			// latest
+			Input: "/CommunityGalleries/gallery1/Images/image1/Versions/latest",
+			Valid: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := CommunityGalleryImageVersionID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// NOTE: this resource manages the Protection Policy of the individual instances within a Virtual Machine Scale Set,
+// as such only one can exist per Scale Set - and the ID of this resource is the ID of the Scale Set with a fixed
+// `standbyOverride/default` suffix.
+
+const virtualMachineScaleSetStandbyOverrideName = "default"
+
+func resourceVirtualMachineScaleSetStandbyOverride() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineScaleSetStandbyOverrideCreateUpdate,
+		Read:   resourceVirtualMachineScaleSetStandbyOverrideRead,
+		Update: resourceVirtualMachineScaleSetStandbyOverrideCreateUpdate,
+		Delete: resourceVirtualMachineScaleSetStandbyOverrideDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineScaleSetStandbyOverrideID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"instance_ids": {
+				Type:         pluginsdk.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"instance_ids", "instance_tags"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"instance_tags": {
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ExactlyOneOf: []string{"instance_ids", "instance_tags"},
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"protect_from_scale_in": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"protect_from_scale_set_actions": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"protected_instance_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineScaleSetStandbyOverrideCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	vmssClient := meta.(*clients.Client).Compute.VMScaleSetClient
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	vmssId, err := parse.VirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewVirtualMachineScaleSetStandbyOverrideID(vmssId.SubscriptionId, vmssId.ResourceGroup, vmssId.Name, virtualMachineScaleSetStandbyOverrideName)
+
+	if _, err := vmssClient.Get(ctx, vmssId.ResourceGroup, vmssId.Name, ""); err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *vmssId, err)
+	}
+
+	instances, err := listVirtualMachineScaleSetInstances(ctx, client, *vmssId)
+	if err != nil {
+		return err
+	}
+
+	// the Standby Override is determined from the instances which have a Protection Policy, so any existing
+	// protection means one already exists for this Scale Set
+	if d.IsNewResource() {
+		for _, instance := range instances {
+			if policy := instance.protectionPolicy(); policy.scaleIn || policy.scaleSetActions {
+				return tf.ImportAsExistsError("azurerm_virtual_machine_scale_set_standby_override", id.ID())
+			}
+		}
+	}
+
+	matched := filterVirtualMachineScaleSetInstances(instances, d.Get("instance_ids").(*pluginsdk.Set).List(), d.Get("instance_tags").(map[string]interface{}))
+
+	protection := compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(d.Get("protect_from_scale_in").(bool)),
+		ProtectFromScaleSetActions: utils.Bool(d.Get("protect_from_scale_set_actions").(bool)),
+	}
+	for _, instanceId := range matched {
+		if err := updateVirtualMachineScaleSetInstanceProtection(ctx, client, *vmssId, instanceId, protection); err != nil {
+			return err
+		}
+	}
+
+	// any instances which were previously protected by this resource but are no longer selected need to be released
+	if !d.IsNewResource() {
+		old, _ := d.GetChange("protected_instance_ids")
+		for _, instanceId := range *utils.ExpandStringSlice(old.([]interface{})) {
+			if utils.SliceContainsValue(matched, instanceId) {
+				continue
+			}
+			if _, ok := instances[instanceId]; !ok {
+				continue
+			}
+			if err := updateVirtualMachineScaleSetInstanceProtection(ctx, client, *vmssId, instanceId, compute.VirtualMachineScaleSetVMProtectionPolicy{
+				ProtectFromScaleIn:         utils.Bool(false),
+				ProtectFromScaleSetActions: utils.Bool(false),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineScaleSetStandbyOverrideRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetStandbyOverrideRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	vmssClient := meta.(*clients.Client).Compute.VMScaleSetClient
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetStandbyOverrideID(d.Id())
+	if err != nil {
+		return err
+	}
+	vmssId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+	resp, err := vmssClient.Get(ctx, vmssId.ResourceGroup, vmssId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing Standby Override from state", vmssId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", vmssId, err)
+	}
+
+	instances, err := listVirtualMachineScaleSetInstances(ctx, client, vmssId)
+	if err != nil {
+		return err
+	}
+
+	instanceIds := d.Get("instance_ids").(*pluginsdk.Set).List()
+	instanceTags := d.Get("instance_tags").(map[string]interface{})
+	if len(instanceIds) == 0 && len(instanceTags) == 0 {
+		// when importing there's no selector available, so use the instances which currently have a Protection Policy
+		for instanceId, instance := range instances {
+			if policy := instance.protectionPolicy(); policy.scaleIn || policy.scaleSetActions {
+				instanceIds = append(instanceIds, instanceId)
+			}
+		}
+		if err := d.Set("instance_ids", instanceIds); err != nil {
+			return fmt.Errorf("setting `instance_ids`: %+v", err)
+		}
+	}
+
+	matched := filterVirtualMachineScaleSetInstances(instances, instanceIds, instanceTags)
+	if len(matched) > 0 {
+		protectFromScaleIn := true
+		protectFromScaleSetActions := true
+		for _, instanceId := range matched {
+			policy := instances[instanceId].protectionPolicy()
+			protectFromScaleIn = protectFromScaleIn && policy.scaleIn
+			protectFromScaleSetActions = protectFromScaleSetActions && policy.scaleSetActions
+		}
+		d.Set("protect_from_scale_in", protectFromScaleIn)
+		d.Set("protect_from_scale_set_actions", protectFromScaleSetActions)
+	}
+
+	d.Set("virtual_machine_scale_set_id", vmssId.ID())
+
+	if err := d.Set("protected_instance_ids", matched); err != nil {
+		return fmt.Errorf("setting `protected_instance_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceVirtualMachineScaleSetStandbyOverrideDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetStandbyOverrideID(d.Id())
+	if err != nil {
+		return err
+	}
+	vmssId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+
+	instances, err := listVirtualMachineScaleSetInstances(ctx, client, vmssId)
+	if err != nil {
+		return err
+	}
+
+	for _, instanceId := range *utils.ExpandStringSlice(d.Get("protected_instance_ids").([]interface{})) {
+		// the instance may have since been removed from the Scale Set
+		if _, ok := instances[instanceId]; !ok {
+			continue
+		}
+
+		if err := updateVirtualMachineScaleSetInstanceProtection(ctx, client, vmssId, instanceId, compute.VirtualMachineScaleSetVMProtectionPolicy{
+			ProtectFromScaleIn:         utils.Bool(false),
+			ProtectFromScaleSetActions: utils.Bool(false),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type virtualMachineScaleSetInstance struct {
+	tags   map[string]*string
+	policy *compute.VirtualMachineScaleSetVMProtectionPolicy
+}
+
+type virtualMachineScaleSetInstanceProtection struct {
+	scaleIn         bool
+	scaleSetActions bool
+}
+
+func (i virtualMachineScaleSetInstance) protectionPolicy() virtualMachineScaleSetInstanceProtection {
+	output := virtualMachineScaleSetInstanceProtection{}
+	if i.policy != nil {
+		if i.policy.ProtectFromScaleIn != nil {
+			output.scaleIn = *i.policy.ProtectFromScaleIn
+		}
+		if i.policy.ProtectFromScaleSetActions != nil {
+			output.scaleSetActions = *i.policy.ProtectFromScaleSetActions
+		}
+	}
+	return output
+}
+
+func listVirtualMachineScaleSetInstances(ctx context.Context, client *compute.VirtualMachineScaleSetVMsClient, id parse.VirtualMachineScaleSetId) (map[string]virtualMachineScaleSetInstance, error) {
+	instances := make(map[string]virtualMachineScaleSetInstance)
+
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("listing instances for %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		instance := iterator.Value()
+		if instance.InstanceID != nil {
+			item := virtualMachineScaleSetInstance{
+				tags: instance.Tags,
+			}
+			if props := instance.VirtualMachineScaleSetVMProperties; props != nil {
+				item.policy = props.ProtectionPolicy
+			}
+			instances[*instance.InstanceID] = item
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("enumerating instances for %s: %+v", id, err)
+		}
+	}
+
+	return instances, nil
+}
+
+// filterVirtualMachineScaleSetInstances returns the sorted Instance IDs which match either the specified
+// Instance IDs or all of the specified Tags
+func filterVirtualMachineScaleSetInstances(instances map[string]virtualMachineScaleSetInstance, instanceIds []interface{}, instanceTags map[string]interface{}) []string {
+	output := make([]string, 0)
+
+	for instanceId, instance := range instances {
+		if len(instanceIds) > 0 {
+			for _, v := range instanceIds {
+				if v.(string) == instanceId {
+					output = append(output, instanceId)
+					break
+				}
+			}
+			continue
+		}
+
+		if len(instanceTags) == 0 {
+			continue
+		}
+
+		matches := true
+		for k, v := range instanceTags {
+			value, ok := instance.tags[k]
+			if !ok || value == nil || *value != v.(string) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			output = append(output, instanceId)
+		}
+	}
+
+	sort.Strings(output)
+	return output
+}
+
+func updateVirtualMachineScaleSetInstanceProtection(ctx context.Context, client *compute.VirtualMachineScaleSetVMsClient, id parse.VirtualMachineScaleSetId, instanceId string, protection compute.VirtualMachineScaleSetVMProtectionPolicy) error {
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, instanceId, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Instance %q (%s): %+v", instanceId, id, err)
+	}
+	if existing.VirtualMachineScaleSetVMProperties == nil {
+		return fmt.Errorf("retrieving Instance %q (%s): `properties` was nil", instanceId, id)
+	}
+
+	existing.VirtualMachineScaleSetVMProperties.ProtectionPolicy = &protection
+
+	log.Printf("[DEBUG] Updating the Protection Policy for Instance %q (%s)..", instanceId, id)
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, instanceId, existing)
+	if err != nil {
+		return fmt.Errorf("updating the Protection Policy for Instance %q (%s): %+v", instanceId, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Protection Policy for Instance %q (%s) to be updated: %+v", instanceId, id, err)
+	}
+
+	return nil
+}
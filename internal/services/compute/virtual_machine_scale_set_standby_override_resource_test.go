@@ -0,0 +1,227 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineScaleSetStandbyOverrideResource struct{}
+
+func TestAccVirtualMachineScaleSetStandbyOverride_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_standby_override", "test")
+	r := VirtualMachineScaleSetStandbyOverrideResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protected_instance_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineScaleSetStandbyOverride_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_standby_override", "test")
+	r := VirtualMachineScaleSetStandbyOverrideResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineScaleSetStandbyOverride_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_standby_override", "test")
+	r := VirtualMachineScaleSetStandbyOverrideResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protected_instance_ids.#").HasValue("2"),
+			),
+		},
+		// NOTE: there's no ImportStep here since a Tag-based selector can't be determined when importing, in which
+		// case the protected instances are imported as `instance_ids`
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protected_instance_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineScaleSetStandbyOverrideResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineScaleSetStandbyOverrideID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(state.Attributes["protected_instance_ids.#"])
+	if err != nil || count == 0 {
+		return utils.Bool(false), nil
+	}
+
+	protectFromScaleIn := state.Attributes["protect_from_scale_in"] == "true"
+	protectFromScaleSetActions := state.Attributes["protect_from_scale_set_actions"] == "true"
+
+	// each of the instances selected by the Standby Override must have the Protection Policy defined in the State
+	for i := 0; i < count; i++ {
+		instanceId := state.Attributes[fmt.Sprintf("protected_instance_ids.%d", i)]
+		instance, err := clients.Compute.VMScaleSetVMsClient.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, instanceId, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(instance.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Instance %q (%s): %+v", instanceId, *id, err)
+		}
+
+		props := instance.VirtualMachineScaleSetVMProperties
+		if props == nil || props.ProtectionPolicy == nil {
+			return utils.Bool(false), nil
+		}
+		policy := props.ProtectionPolicy
+		if (policy.ProtectFromScaleIn != nil && *policy.ProtectFromScaleIn) != protectFromScaleIn {
+			return utils.Bool(false), nil
+		}
+		if (policy.ProtectFromScaleSetActions != nil && *policy.ProtectFromScaleSetActions) != protectFromScaleSetActions {
+			return utils.Bool(false), nil
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r VirtualMachineScaleSetStandbyOverrideResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_standby_override" "test" {
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  instance_ids                 = ["0"]
+  protect_from_scale_in        = true
+}
+`, r.template(data))
+}
+
+func (r VirtualMachineScaleSetStandbyOverrideResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_standby_override" "import" {
+  virtual_machine_scale_set_id = azurerm_virtual_machine_scale_set_standby_override.test.virtual_machine_scale_set_id
+  instance_ids                 = azurerm_virtual_machine_scale_set_standby_override.test.instance_ids
+  protect_from_scale_in        = azurerm_virtual_machine_scale_set_standby_override.test.protect_from_scale_in
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineScaleSetStandbyOverrideResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_standby_override" "test" {
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+
+  instance_tags = {
+    workload = "stateful"
+  }
+
+  protect_from_scale_in          = true
+  protect_from_scale_set_actions = true
+}
+`, r.template(data))
+}
+
+func (VirtualMachineScaleSetStandbyOverrideResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestnw-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_linux_virtual_machine_scale_set" "test" {
+  name                = "acctestvmss-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard_F2"
+  instances           = 2
+  admin_username      = "adminuser"
+
+  admin_ssh_key {
+    username   = "adminuser"
+    public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN you@me.com"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+
+  tags = {
+    workload = "stateful"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -296,6 +296,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 					computeValidate.ImageID,
 					computeValidate.SharedImageID,
 					computeValidate.SharedImageVersionID,
+					computeValidate.CommunityGalleryImageID,
+					computeValidate.CommunityGalleryImageVersionID,
 				),
 			},
 
@@ -878,11 +880,7 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 			return fmt.Errorf("settings `os_disk`: %+v", err)
 		}
 
-		var storageImageId string
-		if profile.ImageReference != nil && profile.ImageReference.ID != nil {
-			storageImageId = *profile.ImageReference.ID
-		}
-		d.Set("source_image_id", storageImageId)
+		d.Set("source_image_id", flattenSourceImageId(profile.ImageReference))
 
 		if err := d.Set("source_image_reference", flattenSourceImageReference(profile.ImageReference)); err != nil {
 			return fmt.Errorf("setting `source_image_reference`: %+v", err)
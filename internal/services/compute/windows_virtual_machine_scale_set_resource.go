@@ -887,11 +887,7 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 				return fmt.Errorf("setting `source_image_reference`: %+v", err)
 			}
 
-			var storageImageId string
-			if storageProfile.ImageReference != nil && storageProfile.ImageReference.ID != nil {
-				storageImageId = *storageProfile.ImageReference.ID
-			}
-			d.Set("source_image_id", storageImageId)
+			d.Set("source_image_id", flattenSourceImageId(storageProfile.ImageReference))
 		}
 
 		if osProfile := profile.OsProfile; osProfile != nil {
@@ -1278,9 +1274,13 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 		},
 
 		"source_image_id": {
-			Type:         pluginsdk.TypeString,
-			Optional:     true,
-			ValidateFunc: azure.ValidateResourceID,
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				azure.ValidateResourceID,
+				computeValidate.CommunityGalleryImageID,
+				computeValidate.CommunityGalleryImageVersionID,
+			),
 		},
 
 		"source_image_reference": sourceImageReferenceSchema(false),
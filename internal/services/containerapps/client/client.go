@@ -0,0 +1,43 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
+)
+
+type Client struct {
+	CertificatesClient        *certificates.CertificatesClient
+	ContainerAppsClient       *containerapps.ContainerAppsClient
+	DaprComponentsClient      *daprcomponents.DaprComponentsClient
+	JobsClient                *jobs.JobsClient
+	ManagedEnvironmentsClient *managedenvironments.ManagedEnvironmentsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	certificatesClient := certificates.NewCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&certificatesClient.Client, o.ResourceManagerAuthorizer)
+
+	containerAppsClient := containerapps.NewContainerAppsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&containerAppsClient.Client, o.ResourceManagerAuthorizer)
+
+	daprComponentsClient := daprcomponents.NewDaprComponentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&daprComponentsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobsClient := jobs.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
+	managedEnvironmentsClient := managedenvironments.NewManagedEnvironmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedEnvironmentsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CertificatesClient:        &certificatesClient,
+		ContainerAppsClient:       &containerAppsClient,
+		DaprComponentsClient:      &daprComponentsClient,
+		JobsClient:                &jobsClient,
+		ManagedEnvironmentsClient: &managedEnvironmentsClient,
+	}
+}
@@ -0,0 +1,278 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppCustomDomainResource struct{}
+
+type ContainerAppCustomDomainModel struct {
+	Name                                 string `tfschema:"name"`
+	ContainerAppId                       string `tfschema:"container_app_id"`
+	ContainerAppEnvironmentCertificateId string `tfschema:"container_app_environment_certificate_id"`
+	CertificateBindingType               string `tfschema:"certificate_binding_type"`
+}
+
+var _ sdk.Resource = ContainerAppCustomDomainResource{}
+
+func (r ContainerAppCustomDomainResource) ModelObject() interface{} {
+	return &ContainerAppCustomDomainModel{}
+}
+
+func (r ContainerAppCustomDomainResource) ResourceType() string {
+	return "azurerm_container_app_custom_domain"
+}
+
+func (r ContainerAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerAppCustomDomainID
+}
+
+func (r ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerapps.ValidateContainerAppID,
+		},
+
+		"container_app_environment_certificate_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: certificates.ValidateCertificateID,
+		},
+
+		"certificate_binding_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForBindingType(), false),
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppsClient
+
+			var model ContainerAppCustomDomainModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			appId, err := containerapps.ParseContainerAppID(model.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			certificateId, err := certificates.ParseCertificateID(model.ContainerAppEnvironmentCertificateId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerAppCustomDomainID(appId.SubscriptionId, appId.ResourceGroupName, appId.ContainerAppName, model.Name)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			app, err := retrieveContainerAppForCustomDomain(ctx, client, *appId)
+			if err != nil {
+				return err
+			}
+
+			ingress := app.Properties.Configuration.Ingress
+			if ingress == nil {
+				return fmt.Errorf("%s must have `ingress` configured before adding a Custom Domain", *appId)
+			}
+
+			customDomains := make([]containerapps.CustomDomain, 0)
+			if ingress.CustomDomains != nil {
+				customDomains = *ingress.CustomDomains
+			}
+			for _, v := range customDomains {
+				if strings.EqualFold(v.Name, id.CustomDomainName) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			bindingType := containerapps.BindingType(model.CertificateBindingType)
+			customDomains = append(customDomains, containerapps.CustomDomain{
+				BindingType:   &bindingType,
+				CertificateId: certificateId.ID(),
+				Name:          id.CustomDomainName,
+			})
+			ingress.CustomDomains = &customDomains
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *appId, *app); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppsClient
+
+			id, err := parse.ContainerAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			appId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroup, id.ContainerAppName)
+			existing, err := client.Get(ctx, appId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", appId, err)
+			}
+
+			var customDomain *containerapps.CustomDomain
+			if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Configuration != nil {
+				if ingress := model.Properties.Configuration.Ingress; ingress != nil && ingress.CustomDomains != nil {
+					for _, v := range *ingress.CustomDomains {
+						if strings.EqualFold(v.Name, id.CustomDomainName) {
+							domain := v
+							customDomain = &domain
+							break
+						}
+					}
+				}
+			}
+			if customDomain == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			certificateId, err := certificates.ParseCertificateIDInsensitively(customDomain.CertificateId)
+			if err != nil {
+				return err
+			}
+
+			state := ContainerAppCustomDomainModel{
+				Name:                                 id.CustomDomainName,
+				ContainerAppId:                       appId.ID(),
+				ContainerAppEnvironmentCertificateId: certificateId.ID(),
+			}
+			if customDomain.BindingType != nil {
+				state.CertificateBindingType = string(*customDomain.BindingType)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppsClient
+
+			id, err := parse.ContainerAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			appId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroup, id.ContainerAppName)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			app, err := retrieveContainerAppForCustomDomain(ctx, client, appId)
+			if err != nil {
+				return err
+			}
+
+			ingress := app.Properties.Configuration.Ingress
+			if ingress == nil || ingress.CustomDomains == nil {
+				return nil
+			}
+
+			customDomains := make([]containerapps.CustomDomain, 0)
+			for _, v := range *ingress.CustomDomains {
+				if !strings.EqualFold(v.Name, id.CustomDomainName) {
+					customDomains = append(customDomains, v)
+				}
+			}
+			ingress.CustomDomains = &customDomains
+
+			if err := client.CreateOrUpdateThenPoll(ctx, appId, *app); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// retrieveContainerAppForCustomDomain returns the Container App with the secret values populated, since these
+// aren't returned from the GET and are required when updating the Custom Domains via a PUT
+func retrieveContainerAppForCustomDomain(ctx context.Context, client *containerapps.ContainerAppsClient, id containerapps.ContainerAppId) (*containerapps.ContainerApp, error) {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.Configuration == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.configuration` was nil", id)
+	}
+
+	secrets, err := client.ListSecrets(ctx, id)
+	if err != nil || secrets.Model == nil {
+		return nil, fmt.Errorf("listing secrets for %s: %+v", id, err)
+	}
+
+	appSecrets := make([]containerapps.Secret, 0)
+	for _, v := range secrets.Model.Value {
+		secret := containerapps.Secret{
+			Name:        v.Name,
+			Identity:    v.Identity,
+			KeyVaultUrl: v.KeyVaultUrl,
+		}
+		// the value of a Key Vault Secret is resolved by the service and must not be sent back alongside the reference
+		if v.KeyVaultUrl == nil || *v.KeyVaultUrl == "" {
+			secret.Value = v.Value
+		}
+		appSecrets = append(appSecrets, secret)
+	}
+
+	app := *existing.Model
+	app.Properties.Configuration.Secrets = &appSecrets
+	// these are read-only and rejected by the API when sent back
+	app.SystemData = nil
+	app.Properties.ProvisioningState = nil
+
+	return &app, nil
+}
@@ -0,0 +1,275 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppCustomDomainResource struct{}
+
+func TestAccContainerAppCustomDomain_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+	data := acceptance.BuildTestData(t, "azurerm_container_app_custom_domain", "test")
+	r := ContainerAppCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppCustomDomain_keyVaultSecret(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+	data := acceptance.BuildTestData(t, "azurerm_container_app_custom_domain", "test")
+	r := ContainerAppCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVaultSecret(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppCustomDomain_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+	data := acceptance.BuildTestData(t, "azurerm_container_app_custom_domain", "test")
+	r := ContainerAppCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ContainerAppCustomDomainResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerAppCustomDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	appId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroup, id.ContainerAppName)
+	resp, err := client.ContainerApps.ContainerAppsClient.Get(ctx, appId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", appId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Configuration != nil {
+		if ingress := model.Properties.Configuration.Ingress; ingress != nil && ingress.CustomDomains != nil {
+			for _, v := range *ingress.CustomDomains {
+				if strings.EqualFold(v.Name, id.CustomDomainName) {
+					return utils.Bool(true), nil
+				}
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ContainerAppCustomDomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name                                     = trimsuffix(azurerm_dns_cname_record.test.fqdn, ".")
+  container_app_id                         = azurerm_container_app.test.id
+  container_app_environment_certificate_id = azurerm_container_app_environment_certificate.test.id
+  certificate_binding_type                 = "SniEnabled"
+
+  depends_on = [azurerm_dns_txt_record.test]
+}
+`, r.template(data))
+}
+
+func (r ContainerAppCustomDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_custom_domain" "import" {
+  name                                     = azurerm_container_app_custom_domain.test.name
+  container_app_id                         = azurerm_container_app_custom_domain.test.container_app_id
+  container_app_environment_certificate_id = azurerm_container_app_custom_domain.test.container_app_environment_certificate_id
+  certificate_binding_type                 = azurerm_container_app_custom_domain.test.certificate_binding_type
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppCustomDomainResource) keyVaultSecret(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_custom_domain" "test" {
+  name                                     = trimsuffix(azurerm_dns_cname_record.test.fqdn, ".")
+  container_app_id                         = azurerm_container_app.test.id
+  container_app_environment_certificate_id = azurerm_container_app_environment_certificate.test.id
+  certificate_binding_type                 = "SniEnabled"
+
+  depends_on = [azurerm_dns_txt_record.test]
+}
+`, r.templateWithContainerApp(data, r.keyVaultSecretContainerApp(data)))
+}
+
+func (r ContainerAppCustomDomainResource) keyVaultSecretContainerApp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = data.azurerm_client_config.current.object_id
+    secret_permissions = ["Delete", "Get", "Purge", "Set"]
+  }
+
+  access_policy {
+    tenant_id          = azurerm_user_assigned_identity.test.tenant_id
+    object_id          = azurerm_user_assigned_identity.test.principal_id
+    secret_permissions = ["Get"]
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "queue-auth-secret"
+  value        = "VGhpcyBJcyBOb3QgQSBHb29kIFBhc3N3b3JkCg=="
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_container_app" "test" {
+  name                         = "acctest-capp-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  revision_mode                = "Single"
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "mcr.microsoft.com/azuredocs/containerapps-helloworld:latest"
+      cpu    = 0.25
+      memory = "0.5Gi"
+
+      env {
+        name        = "KEY_VAULT_SECRET"
+        secret_name = "key-vault-secret"
+      }
+    }
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 80
+
+    traffic_weight {
+      latest_revision = true
+      percentage      = 100
+    }
+  }
+
+  secret {
+    name                = "key-vault-secret"
+    identity            = azurerm_user_assigned_identity.test.id
+    key_vault_secret_id = azurerm_key_vault_secret.test.versionless_id
+  }
+
+  secret {
+    name  = "plain-secret"
+    value = "c2VjcmV0LXZhbHVl"
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger, data.RandomString)
+}
+
+func (r ContainerAppCustomDomainResource) template(data acceptance.TestData) string {
+	return r.templateWithContainerApp(data, ContainerAppResource{}.complete(data, "rev1"))
+}
+
+func (r ContainerAppCustomDomainResource) templateWithContainerApp(data acceptance.TestData, containerApp string) string {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_environment_certificate" "test" {
+  name                         = "acctest-cacert%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  certificate_blob_base64      = filebase64("testdata/testacc.pfx")
+  certificate_password         = "terraform"
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = "%[3]s"
+  resource_group_name = "%[4]s"
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = "asuid.containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    value = azurerm_container_app.test.custom_domain_verification_id
+  }
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "containerapp%[2]d"
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  zone_name           = data.azurerm_dns_zone.test.name
+  ttl                 = 300
+  record              = azurerm_container_app.test.ingress[0].fqdn
+}
+`, containerApp, data.RandomInteger, dnsZone, dataResourceGroup)
+}
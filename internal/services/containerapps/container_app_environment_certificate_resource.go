@@ -0,0 +1,265 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/certificates"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppEnvironmentCertificateResource struct{}
+
+type ContainerAppEnvironmentCertificateModel struct {
+	Name                      string            `tfschema:"name"`
+	ContainerAppEnvironmentId string            `tfschema:"container_app_environment_id"`
+	CertificateBlobBase64     string            `tfschema:"certificate_blob_base64"`
+	CertificatePassword       string            `tfschema:"certificate_password"`
+	Tags                      map[string]string `tfschema:"tags"`
+
+	SubjectName    string `tfschema:"subject_name"`
+	Issuer         string `tfschema:"issuer"`
+	IssueDate      string `tfschema:"issue_date"`
+	ExpirationDate string `tfschema:"expiration_date"`
+	Thumbprint     string `tfschema:"thumbprint"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCertificateResource{}
+
+func (r ContainerAppEnvironmentCertificateResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentCertificateModel{}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) ResourceType() string {
+	return "azurerm_container_app_environment_certificate"
+}
+
+func (r ContainerAppEnvironmentCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return certificates.ValidateCertificateID
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: certificates.ValidateManagedEnvironmentID,
+		},
+
+		"certificate_blob_base64": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsBase64,
+		},
+
+		"certificate_password": {
+			Type:      pluginsdk.TypeString,
+			Required:  true,
+			ForceNew:  true,
+			Sensitive: true,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"subject_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issuer": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"issue_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expiration_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			var model ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := certificates.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := certificates.NewCertificateID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// certificates must be created in the same location as the Container App Environment
+			envId := managedenvironments.NewManagedEnvironmentID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName)
+			environment, err := environmentsClient.Get(ctx, envId)
+			if err != nil || environment.Model == nil {
+				return fmt.Errorf("retrieving %s: %+v", envId, err)
+			}
+
+			certificate := certificates.Certificate{
+				Location: environment.Model.Location,
+				Properties: &certificates.CertificateProperties{
+					Password: utils.String(model.CertificatePassword),
+					Value:    utils.String(model.CertificateBlobBase64),
+				},
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, certificate); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the certificate blob and password aren't returned by the API so we pull them from the existing state
+			var config ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ContainerAppEnvironmentCertificateModel{
+				Name:                      id.CertificateName,
+				ContainerAppEnvironmentId: certificates.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+				CertificateBlobBase64:     config.CertificateBlobBase64,
+				CertificatePassword:       config.CertificatePassword,
+			}
+
+			if model := existing.Model; model != nil {
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.SubjectName = utils.NormalizeNilableString(props.SubjectName)
+					state.Issuer = utils.NormalizeNilableString(props.Issuer)
+					state.IssueDate = utils.NormalizeNilableString(props.IssueDate)
+					state.ExpirationDate = utils.NormalizeNilableString(props.ExpirationDate)
+					state.Thumbprint = utils.NormalizeNilableString(props.Thumbprint)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				patch := certificates.CertificatePatch{
+					Tags: &model.Tags,
+				}
+				if _, err := client.Update(ctx, *id, patch); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.CertificatesClient
+
+			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,341 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppEnvironmentDaprComponentResource struct{}
+
+type ContainerAppEnvironmentDaprComponentModel struct {
+	Name                      string                     `tfschema:"name"`
+	ContainerAppEnvironmentId string                     `tfschema:"container_app_environment_id"`
+	ComponentType             string                     `tfschema:"component_type"`
+	Version                   string                     `tfschema:"version"`
+	IgnoreErrors              bool                       `tfschema:"ignore_errors"`
+	InitTimeout               string                     `tfschema:"init_timeout"`
+	Scopes                    []string                   `tfschema:"scopes"`
+	Metadata                  []DaprComponentMetadata    `tfschema:"metadata"`
+	Secrets                   []ContainerAppSecretsModel `tfschema:"secret"`
+}
+
+type DaprComponentMetadata struct {
+	Name       string `tfschema:"name"`
+	SecretName string `tfschema:"secret_name"`
+	Value      string `tfschema:"value"`
+}
+
+type ContainerAppSecretsModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentDaprComponentResource{}
+
+func (r ContainerAppEnvironmentDaprComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentDaprComponentModel{}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_dapr_component"
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return daprcomponents.ValidateDaprComponentID
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DaprComponentName,
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: daprcomponents.ValidateManagedEnvironmentID,
+		},
+
+		"component_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ignore_errors": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"init_timeout": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "5s",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secret_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.SecretName,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"secret": secretsSchema(),
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			var model ContainerAppEnvironmentDaprComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := daprcomponents.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := daprcomponents.NewDaprComponentID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandDaprComponent(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentDaprComponentModel{
+				Name:                      id.ComponentName,
+				ContainerAppEnvironmentId: daprcomponents.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ComponentType = utils.NormalizeNilableString(props.ComponentType)
+					state.Version = utils.NormalizeNilableString(props.Version)
+					state.IgnoreErrors = utils.NormaliseNilableBool(props.IgnoreErrors)
+					state.InitTimeout = utils.NormalizeNilableString(props.InitTimeout)
+					if props.Scopes != nil {
+						state.Scopes = *props.Scopes
+					}
+					state.Metadata = flattenDaprComponentMetadata(props.Metadata)
+				}
+			}
+
+			// the secret values are only available from the listSecrets API
+			secrets, err := client.ListSecrets(ctx, *id)
+			if err != nil || secrets.Model == nil {
+				return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+			}
+			state.Secrets = flattenDaprComponentSecrets(secrets.Model.Value)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentDaprComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API replaces the whole component, so the full payload is sent on every update
+			if _, err := client.CreateOrUpdate(ctx, *id, expandDaprComponent(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.DaprComponentsClient
+
+			id, err := daprcomponents.ParseDaprComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDaprComponent(input ContainerAppEnvironmentDaprComponentModel) daprcomponents.DaprComponent {
+	metadata := make([]daprcomponents.DaprMetadata, 0)
+	for _, v := range input.Metadata {
+		item := daprcomponents.DaprMetadata{
+			Name: utils.String(v.Name),
+		}
+		if v.SecretName != "" {
+			item.SecretRef = utils.String(v.SecretName)
+		}
+		if v.Value != "" {
+			item.Value = utils.String(v.Value)
+		}
+		metadata = append(metadata, item)
+	}
+
+	secrets := make([]daprcomponents.Secret, 0)
+	for _, v := range input.Secrets {
+		secrets = append(secrets, daprcomponents.Secret{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	scopes := input.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	return daprcomponents.DaprComponent{
+		Properties: &daprcomponents.DaprComponentProperties{
+			ComponentType: utils.String(input.ComponentType),
+			IgnoreErrors:  utils.Bool(input.IgnoreErrors),
+			InitTimeout:   utils.String(input.InitTimeout),
+			Metadata:      &metadata,
+			Scopes:        &scopes,
+			Secrets:       &secrets,
+			Version:       utils.String(input.Version),
+		},
+	}
+}
+
+func flattenDaprComponentMetadata(input *[]daprcomponents.DaprMetadata) []DaprComponentMetadata {
+	if input == nil {
+		return []DaprComponentMetadata{}
+	}
+
+	output := make([]DaprComponentMetadata, 0)
+	for _, v := range *input {
+		output = append(output, DaprComponentMetadata{
+			Name:       utils.NormalizeNilableString(v.Name),
+			SecretName: utils.NormalizeNilableString(v.SecretRef),
+			Value:      utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return output
+}
+
+func flattenDaprComponentSecrets(input []daprcomponents.Secret) []ContainerAppSecretsModel {
+	output := make([]ContainerAppSecretsModel, 0)
+	for _, v := range input {
+		output = append(output, ContainerAppSecretsModel{
+			Name:  utils.NormalizeNilableString(v.Name),
+			Value: utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return output
+}
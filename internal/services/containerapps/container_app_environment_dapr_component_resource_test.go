@@ -0,0 +1,184 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/daprcomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppEnvironmentDaprComponentResource struct{}
+
+func TestAccContainerAppEnvironmentDaprComponent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_dapr_component", "test")
+	r := ContainerAppEnvironmentDaprComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentDaprComponent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_dapr_component", "test")
+	r := ContainerAppEnvironmentDaprComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppEnvironmentDaprComponent_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_dapr_component", "test")
+	r := ContainerAppEnvironmentDaprComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentDaprComponent_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_dapr_component", "test")
+	r := ContainerAppEnvironmentDaprComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := daprcomponents.ParseDaprComponentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.DaprComponentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_dapr_component" "test" {
+  name                         = "acctest-dapr%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "state.azure.blobstorage"
+  version                      = "v1"
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_dapr_component" "import" {
+  name                         = azurerm_container_app_environment_dapr_component.test.name
+  container_app_environment_id = azurerm_container_app_environment_dapr_component.test.container_app_environment_id
+  component_type               = azurerm_container_app_environment_dapr_component.test.component_type
+  version                      = azurerm_container_app_environment_dapr_component.test.version
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppEnvironmentDaprComponentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctest-dapr"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_container_app_environment_dapr_component" "test" {
+  name                         = "acctest-dapr%[3]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "state.azure.blobstorage"
+  version                      = "v1"
+  ignore_errors                = true
+  init_timeout                 = "10s"
+  scopes                       = ["testapp"]
+
+  secret {
+    name  = "storage-account-access-key"
+    value = azurerm_storage_account.test.primary_access_key
+  }
+
+  metadata {
+    name  = "accountName"
+    value = azurerm_storage_account.test.name
+  }
+
+  metadata {
+    name  = "containerName"
+    value = azurerm_storage_container.test.name
+  }
+
+  metadata {
+    name        = "accountKey"
+    secret_name = "storage-account-access-key"
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomString, data.RandomInteger)
+}
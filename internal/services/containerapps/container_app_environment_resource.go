@@ -0,0 +1,358 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	loganalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	loganalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppEnvironmentResource struct{}
+
+type ContainerAppEnvironmentModel struct {
+	Name                                    string            `tfschema:"name"`
+	ResourceGroupName                       string            `tfschema:"resource_group_name"`
+	Location                                string            `tfschema:"location"`
+	LogAnalyticsWorkspaceId                 string            `tfschema:"log_analytics_workspace_id"`
+	InfrastructureSubnetId                  string            `tfschema:"infrastructure_subnet_id"`
+	InternalLoadBalancerEnabled             bool              `tfschema:"internal_load_balancer_enabled"`
+	ZoneRedundancyEnabled                   bool              `tfschema:"zone_redundancy_enabled"`
+	DaprApplicationInsightsConnectionString string            `tfschema:"dapr_application_insights_connection_string"`
+	Tags                                    map[string]string `tfschema:"tags"`
+
+	DefaultDomain                string `tfschema:"default_domain"`
+	DockerBridgeCidr             string `tfschema:"docker_bridge_cidr"`
+	PlatformReservedCidr         string `tfschema:"platform_reserved_cidr"`
+	PlatformReservedDnsIPAddress string `tfschema:"platform_reserved_dns_ip_address"`
+	StaticIPAddress              string `tfschema:"static_ip_address"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
+
+func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentModel{}
+}
+
+func (r ContainerAppEnvironmentResource) ResourceType() string {
+	return "azurerm_container_app_environment"
+}
+
+func (r ContainerAppEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managedenvironments.ValidateManagedEnvironmentID
+}
+
+func (r ContainerAppEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedEnvironmentName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: loganalyticsValidate.LogAnalyticsWorkspaceID,
+		},
+
+		"infrastructure_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"internal_load_balancer_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      false,
+			RequiredWith: []string{"infrastructure_subnet_id"},
+		},
+
+		"zone_redundancy_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      false,
+			RequiredWith: []string{"infrastructure_subnet_id"},
+		},
+
+		"dapr_application_insights_connection_string": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"docker_bridge_cidr": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"platform_reserved_cidr": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"platform_reserved_dns_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"static_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppEnvironmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := managedenvironments.NewManagedEnvironmentID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			appLogsConfiguration, err := expandContainerAppEnvironmentLogsConfiguration(ctx, metadata, model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			environment := managedenvironments.ManagedEnvironment{
+				Location: location.Normalize(model.Location),
+				Properties: &managedenvironments.ManagedEnvironmentProperties{
+					AppLogsConfiguration: appLogsConfiguration,
+					ZoneRedundant:        utils.Bool(model.ZoneRedundancyEnabled),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.DaprApplicationInsightsConnectionString != "" {
+				environment.Properties.DaprAIConnectionString = utils.String(model.DaprApplicationInsightsConnectionString)
+			}
+
+			if model.InfrastructureSubnetId != "" {
+				environment.Properties.VnetConfiguration = &managedenvironments.VnetConfiguration{
+					InfrastructureSubnetId: utils.String(model.InfrastructureSubnetId),
+					Internal:               utils.Bool(model.InternalLoadBalancerEnabled),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, environment); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentModel{
+				Name:              id.ManagedEnvironmentName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			// the Log Analytics Workspace ID and the Dapr connection string aren't returned by the API
+			// so we pull them from the existing state/config
+			var config ContainerAppEnvironmentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.LogAnalyticsWorkspaceId = config.LogAnalyticsWorkspaceId
+			state.DaprApplicationInsightsConnectionString = config.DaprApplicationInsightsConnectionString
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.DefaultDomain = utils.NormalizeNilableString(props.DefaultDomain)
+					state.StaticIPAddress = utils.NormalizeNilableString(props.StaticIP)
+					state.ZoneRedundancyEnabled = utils.NormaliseNilableBool(props.ZoneRedundant)
+
+					if vnet := props.VnetConfiguration; vnet != nil {
+						state.InfrastructureSubnetId = utils.NormalizeNilableString(vnet.InfrastructureSubnetId)
+						state.InternalLoadBalancerEnabled = utils.NormaliseNilableBool(vnet.Internal)
+						state.DockerBridgeCidr = utils.NormalizeNilableString(vnet.DockerBridgeCidr)
+						state.PlatformReservedCidr = utils.NormalizeNilableString(vnet.PlatformReservedCidr)
+						state.PlatformReservedDnsIPAddress = utils.NormalizeNilableString(vnet.PlatformReservedDnsIP)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			environment := *existing.Model
+			// the Dapr connection string is write-only, so it needs to be resent on every update
+			environment.Properties.DaprAIConnectionString = nil
+			if model.DaprApplicationInsightsConnectionString != "" {
+				environment.Properties.DaprAIConnectionString = utils.String(model.DaprApplicationInsightsConnectionString)
+			}
+
+			// as with the Dapr connection string the shared key isn't returned by the API, so the logs configuration is always resent
+			appLogsConfiguration, err := expandContainerAppEnvironmentLogsConfiguration(ctx, metadata, model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+			environment.Properties.AppLogsConfiguration = appLogsConfiguration
+
+			if metadata.ResourceData.HasChange("tags") {
+				environment.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, environment); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+
+			id, err := managedenvironments.ParseManagedEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppEnvironmentLogsConfiguration(ctx context.Context, metadata sdk.ResourceMetaData, input string) (*managedenvironments.AppLogsConfiguration, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	workspacesClient := metadata.Client.LogAnalytics.WorkspacesClient
+	sharedKeysClient := metadata.Client.LogAnalytics.SharedKeysClient
+
+	workspaceId, err := loganalyticsParse.LogAnalyticsWorkspaceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := workspacesClient.Get(ctx, workspaceId.ResourceGroup, workspaceId.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+	}
+	if workspace.WorkspaceProperties == nil || workspace.WorkspaceProperties.CustomerID == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.customerId` was nil", *workspaceId)
+	}
+
+	sharedKeys, err := sharedKeysClient.GetSharedKeys(ctx, workspaceId.ResourceGroup, workspaceId.WorkspaceName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Shared Keys for %s: %+v", *workspaceId, err)
+	}
+
+	return &managedenvironments.AppLogsConfiguration{
+		Destination: utils.String("log-analytics"),
+		LogAnalyticsConfiguration: &managedenvironments.LogAnalyticsConfiguration{
+			CustomerId: workspace.WorkspaceProperties.CustomerID,
+			SharedKey:  sharedKeys.PrimarySharedKey,
+		},
+	}, nil
+}
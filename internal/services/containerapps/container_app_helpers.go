@@ -0,0 +1,1138 @@
+package containerapps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppIngress struct {
+	AllowInsecureConnections bool                               `tfschema:"allow_insecure_connections"`
+	ExternalEnabled          bool                               `tfschema:"external_enabled"`
+	Fqdn                     string                             `tfschema:"fqdn"`
+	TargetPort               int64                              `tfschema:"target_port"`
+	Transport                string                             `tfschema:"transport"`
+	TrafficWeights           []ContainerAppIngressTrafficWeight `tfschema:"traffic_weight"`
+}
+
+type ContainerAppIngressTrafficWeight struct {
+	Label          string `tfschema:"label"`
+	LatestRevision bool   `tfschema:"latest_revision"`
+	RevisionSuffix string `tfschema:"revision_suffix"`
+	Percentage     int64  `tfschema:"percentage"`
+}
+
+type ContainerAppDapr struct {
+	AppId       string `tfschema:"app_id"`
+	AppPort     int64  `tfschema:"app_port"`
+	AppProtocol string `tfschema:"app_protocol"`
+}
+
+type ContainerAppRegistry struct {
+	Server             string `tfschema:"server"`
+	Username           string `tfschema:"username"`
+	PasswordSecretName string `tfschema:"password_secret_name"`
+	Identity           string `tfschema:"identity"`
+}
+
+type ContainerAppSecret struct {
+	Name             string `tfschema:"name"`
+	Identity         string `tfschema:"identity"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+	Value            string `tfschema:"value"`
+}
+
+type ContainerAppTemplate struct {
+	Containers     []ContainerAppContainer `tfschema:"container"`
+	MinReplicas    int64                   `tfschema:"min_replicas"`
+	MaxReplicas    int64                   `tfschema:"max_replicas"`
+	RevisionSuffix string                  `tfschema:"revision_suffix"`
+	Volumes        []ContainerAppVolume    `tfschema:"volume"`
+}
+
+type ContainerAppContainer struct {
+	Name             string                       `tfschema:"name"`
+	Image            string                       `tfschema:"image"`
+	CPU              float64                      `tfschema:"cpu"`
+	Memory           string                       `tfschema:"memory"`
+	EphemeralStorage string                       `tfschema:"ephemeral_storage"`
+	Args             []string                     `tfschema:"args"`
+	Command          []string                     `tfschema:"command"`
+	Env              []ContainerAppEnvironmentVar `tfschema:"env"`
+	LivenessProbe    []ContainerAppLivenessProbe  `tfschema:"liveness_probe"`
+	ReadinessProbe   []ContainerAppReadinessProbe `tfschema:"readiness_probe"`
+	StartupProbe     []ContainerAppStartupProbe   `tfschema:"startup_probe"`
+	VolumeMounts     []ContainerAppVolumeMount    `tfschema:"volume_mounts"`
+}
+
+type ContainerAppEnvironmentVar struct {
+	Name       string `tfschema:"name"`
+	SecretName string `tfschema:"secret_name"`
+	Value      string `tfschema:"value"`
+}
+
+type ContainerAppProbeHeader struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type ContainerAppLivenessProbe struct {
+	Transport                     string                    `tfschema:"transport"`
+	Host                          string                    `tfschema:"host"`
+	Port                          int64                     `tfschema:"port"`
+	Path                          string                    `tfschema:"path"`
+	Headers                       []ContainerAppProbeHeader `tfschema:"header"`
+	InitialDelay                  int64                     `tfschema:"initial_delay"`
+	Interval                      int64                     `tfschema:"interval_seconds"`
+	Timeout                       int64                     `tfschema:"timeout"`
+	FailureThreshold              int64                     `tfschema:"failure_count_threshold"`
+	TerminationGracePeriodSeconds int64                     `tfschema:"termination_grace_period_seconds"`
+}
+
+type ContainerAppReadinessProbe struct {
+	Transport        string                    `tfschema:"transport"`
+	Host             string                    `tfschema:"host"`
+	Port             int64                     `tfschema:"port"`
+	Path             string                    `tfschema:"path"`
+	Headers          []ContainerAppProbeHeader `tfschema:"header"`
+	Interval         int64                     `tfschema:"interval_seconds"`
+	Timeout          int64                     `tfschema:"timeout"`
+	FailureThreshold int64                     `tfschema:"failure_count_threshold"`
+	SuccessThreshold int64                     `tfschema:"success_count_threshold"`
+}
+
+type ContainerAppStartupProbe struct {
+	Transport                     string                    `tfschema:"transport"`
+	Host                          string                    `tfschema:"host"`
+	Port                          int64                     `tfschema:"port"`
+	Path                          string                    `tfschema:"path"`
+	Headers                       []ContainerAppProbeHeader `tfschema:"header"`
+	Interval                      int64                     `tfschema:"interval_seconds"`
+	Timeout                       int64                     `tfschema:"timeout"`
+	FailureThreshold              int64                     `tfschema:"failure_count_threshold"`
+	TerminationGracePeriodSeconds int64                     `tfschema:"termination_grace_period_seconds"`
+}
+
+type ContainerAppVolume struct {
+	Name        string `tfschema:"name"`
+	StorageName string `tfschema:"storage_name"`
+	StorageType string `tfschema:"storage_type"`
+}
+
+type ContainerAppVolumeMount struct {
+	Name string `tfschema:"name"`
+	Path string `tfschema:"path"`
+}
+
+const (
+	// secretIdentitySystemAssigned is the value used to reference a Key Vault Secret using the System Assigned Identity
+	secretIdentitySystemAssigned = "System"
+
+	probeTransportHTTP  = "HTTP"
+	probeTransportHTTPS = "HTTPS"
+	probeTransportTCP   = "TCP"
+)
+
+func secretsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeSet,
+		Optional:  true,
+		Sensitive: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.SecretName,
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+// containerAppSecretsSchema returns the schema for the secrets of a Container App or a Container App Job, which
+// unlike Dapr Component secrets can also reference a Key Vault Secret using a Managed Identity
+func containerAppSecretsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.SecretName,
+				},
+
+				"identity": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.Any(
+						validation.StringInSlice([]string{secretIdentitySystemAssigned}, false),
+						commonids.ValidateUserAssignedIdentityID,
+					),
+				},
+
+				"key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func containerAppIngressSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"allow_insecure_connections": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"external_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"fqdn": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"target_port": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+
+				"transport": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(containerapps.IngressTransportMethodAuto),
+					ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForIngressTransportMethod(), false),
+				},
+
+				"traffic_weight": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"label": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"latest_revision": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"revision_suffix": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"percentage": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func containerAppDaprSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"app_port": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+
+				"app_protocol": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(containerapps.AppProtocolHttp),
+					ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForAppProtocol(), false),
+				},
+			},
+		},
+	}
+}
+
+func containerAppRegistrySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"server": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"username": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"password_secret_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.SecretName,
+				},
+
+				"identity": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func containerAppTemplateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container": containerAppContainerSchema(),
+
+				"min_replicas": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 30),
+				},
+
+				"max_replicas": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntBetween(1, 30),
+				},
+
+				"revision_suffix": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"volume": containerAppVolumeSchema(),
+			},
+		},
+	}
+}
+
+func containerAppVolumeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"storage_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(containerapps.StorageTypeEmptyDir),
+					ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForStorageType(), false),
+				},
+
+				"storage_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func containerAppContainerSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"image": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"cpu": {
+					Type:         pluginsdk.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatAtLeast(0.25),
+				},
+
+				"memory": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"ephemeral_storage": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"args": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"command": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"env": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"secret_name": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.SecretName,
+							},
+
+							"value": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+
+				"liveness_probe": containerAppProbeSchema(containerapps.TypeLiveness),
+
+				"readiness_probe": containerAppProbeSchema(containerapps.TypeReadiness),
+
+				"startup_probe": containerAppProbeSchema(containerapps.TypeStartup),
+
+				"volume_mounts": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func containerAppProbeSchema(probeType containerapps.Type) *pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"transport": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				probeTransportHTTP,
+				probeTransportHTTPS,
+				probeTransportTCP,
+			}, false),
+		},
+
+		"host": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"header": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"interval_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 240),
+		},
+
+		"timeout": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 240),
+		},
+
+		"failure_count_threshold": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validation.IntBetween(1, 10),
+		},
+	}
+
+	switch probeType {
+	case containerapps.TypeLiveness:
+		s["initial_delay"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(0, 60),
+		}
+		s["termination_grace_period_seconds"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		}
+
+	case containerapps.TypeReadiness:
+		s["success_count_threshold"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validation.IntBetween(1, 10),
+		}
+
+	case containerapps.TypeStartup:
+		s["termination_grace_period_seconds"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func expandContainerAppIngress(input []ContainerAppIngress, appName string) *containerapps.Ingress {
+	if len(input) == 0 {
+		return nil
+	}
+
+	ingress := input[0]
+	transport := containerapps.IngressTransportMethod(ingress.Transport)
+	traffic := make([]containerapps.TrafficWeight, 0)
+	for _, v := range ingress.TrafficWeights {
+		weight := containerapps.TrafficWeight{
+			LatestRevision: utils.Bool(v.LatestRevision),
+			Weight:         utils.Int64(v.Percentage),
+		}
+		if v.Label != "" {
+			weight.Label = utils.String(v.Label)
+		}
+		if !v.LatestRevision && v.RevisionSuffix != "" {
+			weight.RevisionName = utils.String(fmt.Sprintf("%s--%s", appName, v.RevisionSuffix))
+		}
+		traffic = append(traffic, weight)
+	}
+
+	return &containerapps.Ingress{
+		AllowInsecure: utils.Bool(ingress.AllowInsecureConnections),
+		External:      utils.Bool(ingress.ExternalEnabled),
+		TargetPort:    utils.Int64(ingress.TargetPort),
+		Traffic:       &traffic,
+		Transport:     &transport,
+	}
+}
+
+func flattenContainerAppIngress(input *containerapps.Ingress, appName string) []ContainerAppIngress {
+	if input == nil {
+		return []ContainerAppIngress{}
+	}
+
+	ingress := ContainerAppIngress{
+		AllowInsecureConnections: utils.NormaliseNilableBool(input.AllowInsecure),
+		ExternalEnabled:          utils.NormaliseNilableBool(input.External),
+		Fqdn:                     utils.NormalizeNilableString(input.Fqdn),
+		TargetPort:               utils.NormaliseNilableInt64(input.TargetPort),
+		TrafficWeights:           []ContainerAppIngressTrafficWeight{},
+	}
+	if input.Transport != nil {
+		ingress.Transport = strings.ToLower(string(*input.Transport))
+	}
+
+	if input.Traffic != nil {
+		for _, v := range *input.Traffic {
+			ingress.TrafficWeights = append(ingress.TrafficWeights, ContainerAppIngressTrafficWeight{
+				Label:          utils.NormalizeNilableString(v.Label),
+				LatestRevision: utils.NormaliseNilableBool(v.LatestRevision),
+				RevisionSuffix: strings.TrimPrefix(utils.NormalizeNilableString(v.RevisionName), fmt.Sprintf("%s--", appName)),
+				Percentage:     utils.NormaliseNilableInt64(v.Weight),
+			})
+		}
+	}
+
+	return []ContainerAppIngress{ingress}
+}
+
+func expandContainerAppDapr(input []ContainerAppDapr) *containerapps.Dapr {
+	if len(input) == 0 {
+		return &containerapps.Dapr{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	dapr := input[0]
+	protocol := containerapps.AppProtocol(dapr.AppProtocol)
+	output := &containerapps.Dapr{
+		AppId:       utils.String(dapr.AppId),
+		AppProtocol: &protocol,
+		Enabled:     utils.Bool(true),
+	}
+	if dapr.AppPort != 0 {
+		output.AppPort = utils.Int64(dapr.AppPort)
+	}
+
+	return output
+}
+
+func flattenContainerAppDapr(input *containerapps.Dapr) []ContainerAppDapr {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []ContainerAppDapr{}
+	}
+
+	dapr := ContainerAppDapr{
+		AppId:   utils.NormalizeNilableString(input.AppId),
+		AppPort: utils.NormaliseNilableInt64(input.AppPort),
+	}
+	if input.AppProtocol != nil {
+		dapr.AppProtocol = string(*input.AppProtocol)
+	}
+
+	return []ContainerAppDapr{dapr}
+}
+
+func validateContainerAppRegistries(input []ContainerAppRegistry) error {
+	for _, v := range input {
+		if v.Identity != "" && (v.Username != "" || v.PasswordSecretName != "") {
+			return fmt.Errorf("`identity` cannot be specified with `username` or `password_secret_name` for the registry %q", v.Server)
+		}
+		if (v.Username == "") != (v.PasswordSecretName == "") {
+			return fmt.Errorf("`username` and `password_secret_name` must be specified together for the registry %q", v.Server)
+		}
+	}
+
+	return nil
+}
+
+func expandContainerAppRegistries(input []ContainerAppRegistry) *[]containerapps.RegistryCredentials {
+	registries := make([]containerapps.RegistryCredentials, 0)
+	for _, v := range input {
+		registry := containerapps.RegistryCredentials{
+			Server: utils.String(v.Server),
+		}
+		if v.Username != "" {
+			registry.Username = utils.String(v.Username)
+		}
+		if v.PasswordSecretName != "" {
+			registry.PasswordSecretRef = utils.String(v.PasswordSecretName)
+		}
+		if v.Identity != "" {
+			registry.Identity = utils.String(v.Identity)
+		}
+		registries = append(registries, registry)
+	}
+
+	return &registries
+}
+
+func flattenContainerAppRegistries(input *[]containerapps.RegistryCredentials) []ContainerAppRegistry {
+	output := make([]ContainerAppRegistry, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ContainerAppRegistry{
+			Server:             utils.NormalizeNilableString(v.Server),
+			Username:           utils.NormalizeNilableString(v.Username),
+			PasswordSecretName: utils.NormalizeNilableString(v.PasswordSecretRef),
+			Identity:           utils.NormalizeNilableString(v.Identity),
+		})
+	}
+
+	return output
+}
+
+func validateContainerAppSecrets(input []ContainerAppSecret) error {
+	for _, v := range input {
+		if (v.Value == "") == (v.KeyVaultSecretId == "") {
+			return fmt.Errorf("exactly one of `value` or `key_vault_secret_id` must be specified for the secret %q", v.Name)
+		}
+		if (v.Identity == "") != (v.KeyVaultSecretId == "") {
+			return fmt.Errorf("`identity` must be specified together with `key_vault_secret_id` for the secret %q", v.Name)
+		}
+	}
+
+	return nil
+}
+
+func expandContainerAppSecrets(input []ContainerAppSecret) *[]containerapps.Secret {
+	secrets := make([]containerapps.Secret, 0)
+	for _, v := range input {
+		secret := containerapps.Secret{
+			Name: utils.String(v.Name),
+		}
+		if v.KeyVaultSecretId != "" {
+			secret.Identity = utils.String(v.Identity)
+			secret.KeyVaultUrl = utils.String(v.KeyVaultSecretId)
+		} else {
+			secret.Value = utils.String(v.Value)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return &secrets
+}
+
+func flattenContainerAppSecrets(input []containerapps.ContainerAppSecret) []ContainerAppSecret {
+	output := make([]ContainerAppSecret, 0)
+	for _, v := range input {
+		secret := ContainerAppSecret{
+			Name: utils.NormalizeNilableString(v.Name),
+		}
+		// the value of a Key Vault reference is resolved by the service and must not be persisted into the state
+		if keyVaultUrl := utils.NormalizeNilableString(v.KeyVaultUrl); keyVaultUrl != "" {
+			secret.Identity = utils.NormalizeNilableString(v.Identity)
+			secret.KeyVaultSecretId = keyVaultUrl
+		} else {
+			secret.Value = utils.NormalizeNilableString(v.Value)
+		}
+		output = append(output, secret)
+	}
+
+	return output
+}
+
+func expandContainerAppTemplate(input []ContainerAppTemplate) *containerapps.Template {
+	if len(input) == 0 {
+		return nil
+	}
+
+	template := input[0]
+	containers := make([]containerapps.Container, 0)
+	for _, v := range template.Containers {
+		containers = append(containers, expandContainerAppContainer(v))
+	}
+
+	volumes := make([]containerapps.Volume, 0)
+	for _, v := range template.Volumes {
+		storageType := containerapps.StorageType(v.StorageType)
+		volume := containerapps.Volume{
+			Name:        utils.String(v.Name),
+			StorageType: &storageType,
+		}
+		if v.StorageName != "" {
+			volume.StorageName = utils.String(v.StorageName)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	output := &containerapps.Template{
+		Containers: &containers,
+		Scale: &containerapps.Scale{
+			MaxReplicas: utils.Int64(template.MaxReplicas),
+			MinReplicas: utils.Int64(template.MinReplicas),
+		},
+		Volumes: &volumes,
+	}
+	if template.RevisionSuffix != "" {
+		output.RevisionSuffix = utils.String(template.RevisionSuffix)
+	}
+
+	return output
+}
+
+func expandContainerAppContainer(input ContainerAppContainer) containerapps.Container {
+	env := make([]containerapps.EnvironmentVar, 0)
+	for _, v := range input.Env {
+		item := containerapps.EnvironmentVar{
+			Name: utils.String(v.Name),
+		}
+		if v.SecretName != "" {
+			item.SecretRef = utils.String(v.SecretName)
+		}
+		if v.Value != "" {
+			item.Value = utils.String(v.Value)
+		}
+		env = append(env, item)
+	}
+
+	probes := make([]containerapps.ContainerAppProbe, 0)
+	for _, v := range input.LivenessProbe {
+		probe := expandContainerAppProbe(containerapps.TypeLiveness, v.Transport, v.Host, v.Port, v.Path, v.Headers)
+		probe.InitialDelaySeconds = utils.Int64(v.InitialDelay)
+		probe.PeriodSeconds = utils.Int64(v.Interval)
+		probe.TimeoutSeconds = utils.Int64(v.Timeout)
+		probe.FailureThreshold = utils.Int64(v.FailureThreshold)
+		probes = append(probes, probe)
+	}
+	for _, v := range input.ReadinessProbe {
+		probe := expandContainerAppProbe(containerapps.TypeReadiness, v.Transport, v.Host, v.Port, v.Path, v.Headers)
+		probe.PeriodSeconds = utils.Int64(v.Interval)
+		probe.TimeoutSeconds = utils.Int64(v.Timeout)
+		probe.FailureThreshold = utils.Int64(v.FailureThreshold)
+		probe.SuccessThreshold = utils.Int64(v.SuccessThreshold)
+		probes = append(probes, probe)
+	}
+	for _, v := range input.StartupProbe {
+		probe := expandContainerAppProbe(containerapps.TypeStartup, v.Transport, v.Host, v.Port, v.Path, v.Headers)
+		probe.PeriodSeconds = utils.Int64(v.Interval)
+		probe.TimeoutSeconds = utils.Int64(v.Timeout)
+		probe.FailureThreshold = utils.Int64(v.FailureThreshold)
+		probes = append(probes, probe)
+	}
+
+	volumeMounts := make([]containerapps.VolumeMount, 0)
+	for _, v := range input.VolumeMounts {
+		volumeMounts = append(volumeMounts, containerapps.VolumeMount{
+			MountPath:  utils.String(v.Path),
+			VolumeName: utils.String(v.Name),
+		})
+	}
+
+	args := input.Args
+	if args == nil {
+		args = []string{}
+	}
+	command := input.Command
+	if command == nil {
+		command = []string{}
+	}
+
+	return containerapps.Container{
+		Args:    &args,
+		Command: &command,
+		Env:     &env,
+		Image:   utils.String(input.Image),
+		Name:    utils.String(input.Name),
+		Probes:  &probes,
+		Resources: &containerapps.ContainerResources{
+			Cpu:    utils.Float(input.CPU),
+			Memory: utils.String(input.Memory),
+		},
+		VolumeMounts: &volumeMounts,
+	}
+}
+
+func expandContainerAppProbe(probeType containerapps.Type, transport, host string, port int64, path string, headers []ContainerAppProbeHeader) containerapps.ContainerAppProbe {
+	probe := containerapps.ContainerAppProbe{
+		Type: &probeType,
+	}
+
+	if transport == probeTransportTCP {
+		probe.TcpSocket = &containerapps.ContainerAppProbeTcpSocket{
+			Port: port,
+		}
+		if host != "" {
+			probe.TcpSocket.Host = utils.String(host)
+		}
+		return probe
+	}
+
+	scheme := containerapps.Scheme(transport)
+	httpHeaders := make([]containerapps.ContainerAppProbeHttpGetHttpHeadersInlined, 0)
+	for _, v := range headers {
+		httpHeaders = append(httpHeaders, containerapps.ContainerAppProbeHttpGetHttpHeadersInlined{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+	probe.HttpGet = &containerapps.ContainerAppProbeHttpGet{
+		HttpHeaders: &httpHeaders,
+		Port:        port,
+		Scheme:      &scheme,
+	}
+	if host != "" {
+		probe.HttpGet.Host = utils.String(host)
+	}
+	if path != "" {
+		probe.HttpGet.Path = utils.String(path)
+	}
+
+	return probe
+}
+
+func flattenContainerAppTemplate(input *containerapps.Template) []ContainerAppTemplate {
+	if input == nil {
+		return []ContainerAppTemplate{}
+	}
+
+	template := ContainerAppTemplate{
+		Containers:     []ContainerAppContainer{},
+		RevisionSuffix: utils.NormalizeNilableString(input.RevisionSuffix),
+		Volumes:        []ContainerAppVolume{},
+	}
+
+	if input.Containers != nil {
+		for _, v := range *input.Containers {
+			template.Containers = append(template.Containers, flattenContainerAppContainer(v))
+		}
+	}
+
+	if scale := input.Scale; scale != nil {
+		template.MinReplicas = utils.NormaliseNilableInt64(scale.MinReplicas)
+		template.MaxReplicas = utils.NormaliseNilableInt64(scale.MaxReplicas)
+	}
+
+	if input.Volumes != nil {
+		for _, v := range *input.Volumes {
+			volume := ContainerAppVolume{
+				Name:        utils.NormalizeNilableString(v.Name),
+				StorageName: utils.NormalizeNilableString(v.StorageName),
+			}
+			if v.StorageType != nil {
+				volume.StorageType = string(*v.StorageType)
+			}
+			template.Volumes = append(template.Volumes, volume)
+		}
+	}
+
+	return []ContainerAppTemplate{template}
+}
+
+func flattenContainerAppContainer(input containerapps.Container) ContainerAppContainer {
+	container := ContainerAppContainer{
+		Name:           utils.NormalizeNilableString(input.Name),
+		Image:          utils.NormalizeNilableString(input.Image),
+		Env:            []ContainerAppEnvironmentVar{},
+		LivenessProbe:  []ContainerAppLivenessProbe{},
+		ReadinessProbe: []ContainerAppReadinessProbe{},
+		StartupProbe:   []ContainerAppStartupProbe{},
+		VolumeMounts:   []ContainerAppVolumeMount{},
+	}
+
+	if input.Args != nil {
+		container.Args = *input.Args
+	}
+	if input.Command != nil {
+		container.Command = *input.Command
+	}
+
+	if resources := input.Resources; resources != nil {
+		if resources.Cpu != nil {
+			container.CPU = *resources.Cpu
+		}
+		container.Memory = utils.NormalizeNilableString(resources.Memory)
+		container.EphemeralStorage = utils.NormalizeNilableString(resources.EphemeralStorage)
+	}
+
+	if input.Env != nil {
+		for _, v := range *input.Env {
+			container.Env = append(container.Env, ContainerAppEnvironmentVar{
+				Name:       utils.NormalizeNilableString(v.Name),
+				SecretName: utils.NormalizeNilableString(v.SecretRef),
+				Value:      utils.NormalizeNilableString(v.Value),
+			})
+		}
+	}
+
+	if input.Probes != nil {
+		for _, v := range *input.Probes {
+			if v.Type == nil {
+				continue
+			}
+
+			transport, host, port, path, headers := flattenContainerAppProbe(v)
+			switch *v.Type {
+			case containerapps.TypeLiveness:
+				container.LivenessProbe = append(container.LivenessProbe, ContainerAppLivenessProbe{
+					Transport:                     transport,
+					Host:                          host,
+					Port:                          port,
+					Path:                          path,
+					Headers:                       headers,
+					InitialDelay:                  utils.NormaliseNilableInt64(v.InitialDelaySeconds),
+					Interval:                      utils.NormaliseNilableInt64(v.PeriodSeconds),
+					Timeout:                       utils.NormaliseNilableInt64(v.TimeoutSeconds),
+					FailureThreshold:              utils.NormaliseNilableInt64(v.FailureThreshold),
+					TerminationGracePeriodSeconds: utils.NormaliseNilableInt64(v.TerminationGracePeriodSeconds),
+				})
+
+			case containerapps.TypeReadiness:
+				container.ReadinessProbe = append(container.ReadinessProbe, ContainerAppReadinessProbe{
+					Transport:        transport,
+					Host:             host,
+					Port:             port,
+					Path:             path,
+					Headers:          headers,
+					Interval:         utils.NormaliseNilableInt64(v.PeriodSeconds),
+					Timeout:          utils.NormaliseNilableInt64(v.TimeoutSeconds),
+					FailureThreshold: utils.NormaliseNilableInt64(v.FailureThreshold),
+					SuccessThreshold: utils.NormaliseNilableInt64(v.SuccessThreshold),
+				})
+
+			case containerapps.TypeStartup:
+				container.StartupProbe = append(container.StartupProbe, ContainerAppStartupProbe{
+					Transport:                     transport,
+					Host:                          host,
+					Port:                          port,
+					Path:                          path,
+					Headers:                       headers,
+					Interval:                      utils.NormaliseNilableInt64(v.PeriodSeconds),
+					Timeout:                       utils.NormaliseNilableInt64(v.TimeoutSeconds),
+					FailureThreshold:              utils.NormaliseNilableInt64(v.FailureThreshold),
+					TerminationGracePeriodSeconds: utils.NormaliseNilableInt64(v.TerminationGracePeriodSeconds),
+				})
+			}
+		}
+	}
+
+	if input.VolumeMounts != nil {
+		for _, v := range *input.VolumeMounts {
+			container.VolumeMounts = append(container.VolumeMounts, ContainerAppVolumeMount{
+				Name: utils.NormalizeNilableString(v.VolumeName),
+				Path: utils.NormalizeNilableString(v.MountPath),
+			})
+		}
+	}
+
+	return container
+}
+
+func flattenContainerAppProbe(input containerapps.ContainerAppProbe) (transport string, host string, port int64, path string, headers []ContainerAppProbeHeader) {
+	headers = []ContainerAppProbeHeader{}
+
+	if tcp := input.TcpSocket; tcp != nil {
+		return probeTransportTCP, utils.NormalizeNilableString(tcp.Host), tcp.Port, "", headers
+	}
+
+	if httpGet := input.HttpGet; httpGet != nil {
+		transport = probeTransportHTTP
+		if httpGet.Scheme != nil {
+			transport = strings.ToUpper(string(*httpGet.Scheme))
+		}
+		if httpGet.HttpHeaders != nil {
+			for _, v := range *httpGet.HttpHeaders {
+				headers = append(headers, ContainerAppProbeHeader{
+					Name:  v.Name,
+					Value: v.Value,
+				})
+			}
+		}
+		return transport, utils.NormalizeNilableString(httpGet.Host), httpGet.Port, utils.NormalizeNilableString(httpGet.Path), headers
+	}
+
+	return "", "", 0, "", headers
+}
@@ -0,0 +1,930 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppJobResource struct{}
+
+type ContainerAppJobModel struct {
+	Name                      string                               `tfschema:"name"`
+	ResourceGroupName         string                               `tfschema:"resource_group_name"`
+	Location                  string                               `tfschema:"location"`
+	ContainerAppEnvironmentId string                               `tfschema:"container_app_environment_id"`
+	ReplicaTimeoutInSeconds   int64                                `tfschema:"replica_timeout_in_seconds"`
+	ReplicaRetryLimit         int64                                `tfschema:"replica_retry_limit"`
+	ManualTriggerConfig       []ContainerAppJobManualTriggerConfig `tfschema:"manual_trigger_config"`
+	ScheduleTriggerConfig     []ContainerAppJobScheduleTrigger     `tfschema:"schedule_trigger_config"`
+	EventTriggerConfig        []ContainerAppJobEventTriggerConfig  `tfschema:"event_trigger_config"`
+	Secrets                   []ContainerAppSecret                 `tfschema:"secret"`
+	Registries                []ContainerAppRegistry               `tfschema:"registry"`
+	Template                  []ContainerAppJobTemplate            `tfschema:"template"`
+	Tags                      map[string]string                    `tfschema:"tags"`
+
+	EventStreamEndpoint string   `tfschema:"event_stream_endpoint"`
+	OutboundIPAddresses []string `tfschema:"outbound_ip_addresses"`
+}
+
+type ContainerAppJobManualTriggerConfig struct {
+	Parallelism            int64 `tfschema:"parallelism"`
+	ReplicaCompletionCount int64 `tfschema:"replica_completion_count"`
+}
+
+type ContainerAppJobScheduleTrigger struct {
+	CronExpression         string `tfschema:"cron_expression"`
+	Parallelism            int64  `tfschema:"parallelism"`
+	ReplicaCompletionCount int64  `tfschema:"replica_completion_count"`
+}
+
+type ContainerAppJobEventTriggerConfig struct {
+	Parallelism            int64                  `tfschema:"parallelism"`
+	ReplicaCompletionCount int64                  `tfschema:"replica_completion_count"`
+	Scale                  []ContainerAppJobScale `tfschema:"scale"`
+}
+
+type ContainerAppJobScale struct {
+	MaxExecutions            int64                      `tfschema:"max_executions"`
+	MinExecutions            int64                      `tfschema:"min_executions"`
+	PollingIntervalInSeconds int64                      `tfschema:"polling_interval_in_seconds"`
+	Rules                    []ContainerAppJobScaleRule `tfschema:"rules"`
+}
+
+type ContainerAppJobScaleRule struct {
+	Name           string                                   `tfschema:"name"`
+	CustomRuleType string                                   `tfschema:"custom_rule_type"`
+	Metadata       map[string]string                        `tfschema:"metadata"`
+	Authentication []ContainerAppJobScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+type ContainerAppJobScaleRuleAuthentication struct {
+	SecretName       string `tfschema:"secret_name"`
+	TriggerParameter string `tfschema:"trigger_parameter"`
+}
+
+type ContainerAppJobTemplate struct {
+	Containers []ContainerAppJobContainer `tfschema:"container"`
+	Volumes    []ContainerAppVolume       `tfschema:"volume"`
+}
+
+type ContainerAppJobContainer struct {
+	Name             string                       `tfschema:"name"`
+	Image            string                       `tfschema:"image"`
+	CPU              float64                      `tfschema:"cpu"`
+	Memory           string                       `tfschema:"memory"`
+	EphemeralStorage string                       `tfschema:"ephemeral_storage"`
+	Args             []string                     `tfschema:"args"`
+	Command          []string                     `tfschema:"command"`
+	Env              []ContainerAppEnvironmentVar `tfschema:"env"`
+	VolumeMounts     []ContainerAppVolumeMount    `tfschema:"volume_mounts"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppJobResource{}
+
+func (r ContainerAppJobResource) ModelObject() interface{} {
+	return &ContainerAppJobModel{}
+}
+
+func (r ContainerAppJobResource) ResourceType() string {
+	return "azurerm_container_app_job"
+}
+
+func (r ContainerAppJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobs.ValidateJobID
+}
+
+func (r ContainerAppJobResource) Arguments() map[string]*pluginsdk.Schema {
+	triggers := []string{
+		"manual_trigger_config",
+		"schedule_trigger_config",
+		"event_trigger_config",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+		},
+
+		"replica_timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"replica_retry_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"manual_trigger_config": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: triggers,
+			Elem: &pluginsdk.Resource{
+				Schema: containerAppJobParallelismSchema(),
+			},
+		},
+
+		"schedule_trigger_config": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: triggers,
+			Elem: &pluginsdk.Resource{
+				Schema: func() map[string]*pluginsdk.Schema {
+					s := containerAppJobParallelismSchema()
+					s["cron_expression"] = &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					}
+					return s
+				}(),
+			},
+		},
+
+		"event_trigger_config": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: triggers,
+			Elem: &pluginsdk.Resource{
+				Schema: func() map[string]*pluginsdk.Schema {
+					s := containerAppJobParallelismSchema()
+					s["scale"] = containerAppJobScaleSchema()
+					return s
+				}(),
+			},
+		},
+
+		"secret": containerAppSecretsSchema(),
+
+		"registry": containerAppRegistrySchema(),
+
+		"template": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"container": containerAppJobContainerSchema(),
+
+					"volume": containerAppVolumeSchema(),
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerAppJobResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"event_stream_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := validateContainerAppRegistries(model.Registries); err != nil {
+				return err
+			}
+
+			if err := validateContainerAppSecrets(model.Secrets); err != nil {
+				return err
+			}
+
+			id := jobs.NewJobID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			job := jobs.Job{
+				Identity: identityValue,
+				Location: location.Normalize(model.Location),
+				Properties: &jobs.JobProperties{
+					Configuration: expandContainerAppJobConfiguration(model),
+					EnvironmentId: utils.String(environmentId.ID()),
+					Template:      expandContainerAppJobTemplate(model.Template),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, job); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppJobModel{
+				Name:              id.JobName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			var identityValue *[]interface{}
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				identityValue, err = identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					if props.EnvironmentId != nil {
+						environmentId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(*props.EnvironmentId)
+						if err != nil {
+							return err
+						}
+						state.ContainerAppEnvironmentId = environmentId.ID()
+					}
+					state.EventStreamEndpoint = utils.NormalizeNilableString(props.EventStreamEndpoint)
+					if props.OutboundIPAddresses != nil {
+						state.OutboundIPAddresses = *props.OutboundIPAddresses
+					}
+
+					if config := props.Configuration; config != nil {
+						state.ReplicaTimeoutInSeconds = config.ReplicaTimeout
+						state.ReplicaRetryLimit = utils.NormaliseNilableInt64(config.ReplicaRetryLimit)
+						state.ManualTriggerConfig = flattenContainerAppJobManualTriggerConfig(config.ManualTriggerConfig)
+						state.ScheduleTriggerConfig = flattenContainerAppJobScheduleTriggerConfig(config.ScheduleTriggerConfig)
+						state.EventTriggerConfig = flattenContainerAppJobEventTriggerConfig(config.EventTriggerConfig)
+						state.Registries = flattenContainerAppJobRegistries(config.Registries)
+					}
+
+					state.Template = flattenContainerAppJobTemplate(props.Template)
+				}
+			}
+
+			// the secret values are only available from the listSecrets API
+			secrets, err := client.ListSecrets(ctx, *id)
+			if err != nil || secrets.Model == nil {
+				return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+			}
+			state.Secrets = flattenContainerAppJobSecrets(secrets.Model.Value)
+
+			if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := validateContainerAppRegistries(model.Registries); err != nil {
+				return err
+			}
+
+			if err := validateContainerAppSecrets(model.Secrets); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			// the API replaces the whole Job, so the full payload is sent on every update
+			job := jobs.Job{
+				Identity: identityValue,
+				Location: existing.Model.Location,
+				Properties: &jobs.JobProperties{
+					Configuration: expandContainerAppJobConfiguration(model),
+					EnvironmentId: existing.Model.Properties.EnvironmentId,
+					Template:      expandContainerAppJobTemplate(model.Template),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, job); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobsClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func containerAppJobParallelismSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"replica_completion_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func containerAppJobScaleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_executions": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      100,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"min_executions": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"polling_interval_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      30,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"rules": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"custom_rule_type": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"metadata": {
+								Type:     pluginsdk.TypeMap,
+								Required: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+
+							"authentication": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"secret_name": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validate.SecretName,
+										},
+
+										"trigger_parameter": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// containerAppJobContainerSchema returns the schema for the containers of a Job, which run to completion and
+// therefore don't expose the health probes available for the containers of a Container App
+func containerAppJobContainerSchema() *pluginsdk.Schema {
+	s := containerAppContainerSchema()
+	schema := s.Elem.(*pluginsdk.Resource).Schema
+	delete(schema, "liveness_probe")
+	delete(schema, "readiness_probe")
+	delete(schema, "startup_probe")
+	return s
+}
+
+func expandContainerAppJobConfiguration(input ContainerAppJobModel) *jobs.JobConfiguration {
+	config := &jobs.JobConfiguration{
+		Registries:        expandContainerAppJobRegistries(input.Registries),
+		ReplicaRetryLimit: utils.Int64(input.ReplicaRetryLimit),
+		ReplicaTimeout:    input.ReplicaTimeoutInSeconds,
+		Secrets:           expandContainerAppJobSecrets(input.Secrets),
+	}
+
+	if len(input.ManualTriggerConfig) > 0 {
+		trigger := input.ManualTriggerConfig[0]
+		config.TriggerType = jobs.TriggerTypeManual
+		config.ManualTriggerConfig = &jobs.JobConfigurationManualTriggerConfig{
+			Parallelism:            utils.Int64(trigger.Parallelism),
+			ReplicaCompletionCount: utils.Int64(trigger.ReplicaCompletionCount),
+		}
+	}
+
+	if len(input.ScheduleTriggerConfig) > 0 {
+		trigger := input.ScheduleTriggerConfig[0]
+		config.TriggerType = jobs.TriggerTypeSchedule
+		config.ScheduleTriggerConfig = &jobs.JobConfigurationScheduleTriggerConfig{
+			CronExpression:         trigger.CronExpression,
+			Parallelism:            utils.Int64(trigger.Parallelism),
+			ReplicaCompletionCount: utils.Int64(trigger.ReplicaCompletionCount),
+		}
+	}
+
+	if len(input.EventTriggerConfig) > 0 {
+		trigger := input.EventTriggerConfig[0]
+		config.TriggerType = jobs.TriggerTypeEvent
+		config.EventTriggerConfig = &jobs.JobConfigurationEventTriggerConfig{
+			Parallelism:            utils.Int64(trigger.Parallelism),
+			ReplicaCompletionCount: utils.Int64(trigger.ReplicaCompletionCount),
+			Scale:                  expandContainerAppJobScale(trigger.Scale),
+		}
+	}
+
+	return config
+}
+
+func expandContainerAppJobScale(input []ContainerAppJobScale) *jobs.JobScale {
+	if len(input) == 0 {
+		return nil
+	}
+
+	scale := input[0]
+	rules := make([]jobs.JobScaleRule, 0)
+	for _, v := range scale.Rules {
+		auth := make([]jobs.ScaleRuleAuth, 0)
+		for _, a := range v.Authentication {
+			auth = append(auth, jobs.ScaleRuleAuth{
+				SecretRef:        utils.String(a.SecretName),
+				TriggerParameter: utils.String(a.TriggerParameter),
+			})
+		}
+
+		var metadata interface{} = v.Metadata
+		rules = append(rules, jobs.JobScaleRule{
+			Auth:     &auth,
+			Metadata: &metadata,
+			Name:     utils.String(v.Name),
+			Type:     utils.String(v.CustomRuleType),
+		})
+	}
+
+	return &jobs.JobScale{
+		MaxExecutions:   utils.Int64(scale.MaxExecutions),
+		MinExecutions:   utils.Int64(scale.MinExecutions),
+		PollingInterval: utils.Int64(scale.PollingIntervalInSeconds),
+		Rules:           &rules,
+	}
+}
+
+func flattenContainerAppJobManualTriggerConfig(input *jobs.JobConfigurationManualTriggerConfig) []ContainerAppJobManualTriggerConfig {
+	if input == nil {
+		return []ContainerAppJobManualTriggerConfig{}
+	}
+
+	return []ContainerAppJobManualTriggerConfig{
+		{
+			Parallelism:            utils.NormaliseNilableInt64(input.Parallelism),
+			ReplicaCompletionCount: utils.NormaliseNilableInt64(input.ReplicaCompletionCount),
+		},
+	}
+}
+
+func flattenContainerAppJobScheduleTriggerConfig(input *jobs.JobConfigurationScheduleTriggerConfig) []ContainerAppJobScheduleTrigger {
+	if input == nil {
+		return []ContainerAppJobScheduleTrigger{}
+	}
+
+	return []ContainerAppJobScheduleTrigger{
+		{
+			CronExpression:         input.CronExpression,
+			Parallelism:            utils.NormaliseNilableInt64(input.Parallelism),
+			ReplicaCompletionCount: utils.NormaliseNilableInt64(input.ReplicaCompletionCount),
+		},
+	}
+}
+
+func flattenContainerAppJobEventTriggerConfig(input *jobs.JobConfigurationEventTriggerConfig) []ContainerAppJobEventTriggerConfig {
+	if input == nil {
+		return []ContainerAppJobEventTriggerConfig{}
+	}
+
+	trigger := ContainerAppJobEventTriggerConfig{
+		Parallelism:            utils.NormaliseNilableInt64(input.Parallelism),
+		ReplicaCompletionCount: utils.NormaliseNilableInt64(input.ReplicaCompletionCount),
+		Scale:                  []ContainerAppJobScale{},
+	}
+
+	if scale := input.Scale; scale != nil {
+		rules := make([]ContainerAppJobScaleRule, 0)
+		if scale.Rules != nil {
+			for _, v := range *scale.Rules {
+				rule := ContainerAppJobScaleRule{
+					Name:           utils.NormalizeNilableString(v.Name),
+					CustomRuleType: utils.NormalizeNilableString(v.Type),
+					Metadata:       map[string]string{},
+					Authentication: []ContainerAppJobScaleRuleAuthentication{},
+				}
+
+				if v.Metadata != nil {
+					if metadata, ok := (*v.Metadata).(map[string]interface{}); ok {
+						for key, value := range metadata {
+							rule.Metadata[key] = fmt.Sprintf("%v", value)
+						}
+					}
+				}
+
+				if v.Auth != nil {
+					for _, a := range *v.Auth {
+						rule.Authentication = append(rule.Authentication, ContainerAppJobScaleRuleAuthentication{
+							SecretName:       utils.NormalizeNilableString(a.SecretRef),
+							TriggerParameter: utils.NormalizeNilableString(a.TriggerParameter),
+						})
+					}
+				}
+
+				rules = append(rules, rule)
+			}
+		}
+
+		trigger.Scale = []ContainerAppJobScale{
+			{
+				MaxExecutions:            utils.NormaliseNilableInt64(scale.MaxExecutions),
+				MinExecutions:            utils.NormaliseNilableInt64(scale.MinExecutions),
+				PollingIntervalInSeconds: utils.NormaliseNilableInt64(scale.PollingInterval),
+				Rules:                    rules,
+			},
+		}
+	}
+
+	return []ContainerAppJobEventTriggerConfig{trigger}
+}
+
+func expandContainerAppJobRegistries(input []ContainerAppRegistry) *[]jobs.RegistryCredentials {
+	registries := make([]jobs.RegistryCredentials, 0)
+	for _, v := range input {
+		registry := jobs.RegistryCredentials{
+			Server: utils.String(v.Server),
+		}
+		if v.Username != "" {
+			registry.Username = utils.String(v.Username)
+		}
+		if v.PasswordSecretName != "" {
+			registry.PasswordSecretRef = utils.String(v.PasswordSecretName)
+		}
+		if v.Identity != "" {
+			registry.Identity = utils.String(v.Identity)
+		}
+		registries = append(registries, registry)
+	}
+
+	return &registries
+}
+
+func flattenContainerAppJobRegistries(input *[]jobs.RegistryCredentials) []ContainerAppRegistry {
+	output := make([]ContainerAppRegistry, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ContainerAppRegistry{
+			Server:             utils.NormalizeNilableString(v.Server),
+			Username:           utils.NormalizeNilableString(v.Username),
+			PasswordSecretName: utils.NormalizeNilableString(v.PasswordSecretRef),
+			Identity:           utils.NormalizeNilableString(v.Identity),
+		})
+	}
+
+	return output
+}
+
+func expandContainerAppJobSecrets(input []ContainerAppSecret) *[]jobs.Secret {
+	secrets := make([]jobs.Secret, 0)
+	for _, v := range input {
+		secret := jobs.Secret{
+			Name: utils.String(v.Name),
+		}
+		if v.KeyVaultSecretId != "" {
+			secret.Identity = utils.String(v.Identity)
+			secret.KeyVaultUrl = utils.String(v.KeyVaultSecretId)
+		} else {
+			secret.Value = utils.String(v.Value)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	return &secrets
+}
+
+func flattenContainerAppJobSecrets(input []jobs.Secret) []ContainerAppSecret {
+	output := make([]ContainerAppSecret, 0)
+	for _, v := range input {
+		secret := ContainerAppSecret{
+			Name: utils.NormalizeNilableString(v.Name),
+		}
+		// the value of a Key Vault reference is resolved by the service and must not be persisted into the state
+		if keyVaultUrl := utils.NormalizeNilableString(v.KeyVaultUrl); keyVaultUrl != "" {
+			secret.Identity = utils.NormalizeNilableString(v.Identity)
+			secret.KeyVaultSecretId = keyVaultUrl
+		} else {
+			secret.Value = utils.NormalizeNilableString(v.Value)
+		}
+		output = append(output, secret)
+	}
+
+	return output
+}
+
+func expandContainerAppJobTemplate(input []ContainerAppJobTemplate) *jobs.JobTemplate {
+	if len(input) == 0 {
+		return nil
+	}
+
+	template := input[0]
+	containers := make([]jobs.Container, 0)
+	for _, v := range template.Containers {
+		env := make([]jobs.EnvironmentVar, 0)
+		for _, e := range v.Env {
+			item := jobs.EnvironmentVar{
+				Name: utils.String(e.Name),
+			}
+			if e.SecretName != "" {
+				item.SecretRef = utils.String(e.SecretName)
+			}
+			if e.Value != "" {
+				item.Value = utils.String(e.Value)
+			}
+			env = append(env, item)
+		}
+
+		volumeMounts := make([]jobs.VolumeMount, 0)
+		for _, m := range v.VolumeMounts {
+			volumeMounts = append(volumeMounts, jobs.VolumeMount{
+				MountPath:  utils.String(m.Path),
+				VolumeName: utils.String(m.Name),
+			})
+		}
+
+		args := v.Args
+		if args == nil {
+			args = []string{}
+		}
+		command := v.Command
+		if command == nil {
+			command = []string{}
+		}
+
+		containers = append(containers, jobs.Container{
+			Args:    &args,
+			Command: &command,
+			Env:     &env,
+			Image:   utils.String(v.Image),
+			Name:    utils.String(v.Name),
+			Resources: &jobs.ContainerResources{
+				Cpu:    utils.Float(v.CPU),
+				Memory: utils.String(v.Memory),
+			},
+			VolumeMounts: &volumeMounts,
+		})
+	}
+
+	volumes := make([]jobs.Volume, 0)
+	for _, v := range template.Volumes {
+		storageType := jobs.StorageType(v.StorageType)
+		volume := jobs.Volume{
+			Name:        utils.String(v.Name),
+			StorageType: &storageType,
+		}
+		if v.StorageName != "" {
+			volume.StorageName = utils.String(v.StorageName)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	return &jobs.JobTemplate{
+		Containers: &containers,
+		Volumes:    &volumes,
+	}
+}
+
+func flattenContainerAppJobTemplate(input *jobs.JobTemplate) []ContainerAppJobTemplate {
+	if input == nil {
+		return []ContainerAppJobTemplate{}
+	}
+
+	template := ContainerAppJobTemplate{
+		Containers: []ContainerAppJobContainer{},
+		Volumes:    []ContainerAppVolume{},
+	}
+
+	if input.Containers != nil {
+		for _, v := range *input.Containers {
+			container := ContainerAppJobContainer{
+				Name:         utils.NormalizeNilableString(v.Name),
+				Image:        utils.NormalizeNilableString(v.Image),
+				Env:          []ContainerAppEnvironmentVar{},
+				VolumeMounts: []ContainerAppVolumeMount{},
+			}
+
+			if v.Args != nil {
+				container.Args = *v.Args
+			}
+			if v.Command != nil {
+				container.Command = *v.Command
+			}
+
+			if resources := v.Resources; resources != nil {
+				if resources.Cpu != nil {
+					container.CPU = *resources.Cpu
+				}
+				container.Memory = utils.NormalizeNilableString(resources.Memory)
+				container.EphemeralStorage = utils.NormalizeNilableString(resources.EphemeralStorage)
+			}
+
+			if v.Env != nil {
+				for _, e := range *v.Env {
+					container.Env = append(container.Env, ContainerAppEnvironmentVar{
+						Name:       utils.NormalizeNilableString(e.Name),
+						SecretName: utils.NormalizeNilableString(e.SecretRef),
+						Value:      utils.NormalizeNilableString(e.Value),
+					})
+				}
+			}
+
+			if v.VolumeMounts != nil {
+				for _, m := range *v.VolumeMounts {
+					container.VolumeMounts = append(container.VolumeMounts, ContainerAppVolumeMount{
+						Name: utils.NormalizeNilableString(m.VolumeName),
+						Path: utils.NormalizeNilableString(m.MountPath),
+					})
+				}
+			}
+
+			template.Containers = append(template.Containers, container)
+		}
+	}
+
+	if input.Volumes != nil {
+		for _, v := range *input.Volumes {
+			volume := ContainerAppVolume{
+				Name:        utils.NormalizeNilableString(v.Name),
+				StorageName: utils.NormalizeNilableString(v.StorageName),
+			}
+			if v.StorageType != nil {
+				volume.StorageType = string(*v.StorageType)
+			}
+			template.Volumes = append(template.Volumes, volume)
+		}
+	}
+
+	return []ContainerAppJobTemplate{template}
+}
@@ -0,0 +1,310 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppJobResource struct{}
+
+func TestAccContainerAppJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("event_stream_endpoint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJob_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppJob_scheduleTrigger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.scheduleTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJob_eventTrigger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.eventTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppJob_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_job", "test")
+	r := ContainerAppJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scheduleTrigger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppJobResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobs.ParseJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.JobsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerAppJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-cajob%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  replica_timeout_in_seconds   = 10
+
+  manual_trigger_config {}
+
+  template {
+    container {
+      name    = "testcontainerappsjob0"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["/bin/bash", "-c", "echo hello; sleep 5"]
+    }
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_job" "import" {
+  name                         = azurerm_container_app_job.test.name
+  resource_group_name          = azurerm_container_app_job.test.resource_group_name
+  location                     = azurerm_container_app_job.test.location
+  container_app_environment_id = azurerm_container_app_job.test.container_app_environment_id
+  replica_timeout_in_seconds   = 10
+
+  manual_trigger_config {}
+
+  template {
+    container {
+      name    = "testcontainerappsjob0"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["/bin/bash", "-c", "echo hello; sleep 5"]
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppJobResource) scheduleTrigger(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-cajob%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  replica_timeout_in_seconds   = 20
+  replica_retry_limit          = 2
+
+  schedule_trigger_config {
+    cron_expression          = "*/5 * * * *"
+    parallelism              = 2
+    replica_completion_count = 2
+  }
+
+  secret {
+    name  = "registry-password"
+    value = "c2VjcmV0LXZhbHVl"
+  }
+
+  template {
+    container {
+      name    = "testcontainerappsjob0"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["/bin/bash", "-c", "echo hello; sleep 5"]
+
+      env {
+        name        = "PASSWORD"
+        secret_name = "registry-password"
+      }
+
+      volume_mounts {
+        name = "scratch"
+        path = "/tmp/scratch"
+      }
+    }
+
+    volume {
+      name         = "scratch"
+      storage_type = "EmptyDir"
+    }
+  }
+
+  identity {
+    type         = "SystemAssigned, UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  tags = {
+    Foo = "Bar"
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContainerAppJobResource) eventTrigger(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "acctestqueue"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+resource "azurerm_container_app_job" "test" {
+  name                         = "acctest-cajob%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  replica_timeout_in_seconds   = 10
+
+  event_trigger_config {
+    parallelism              = 1
+    replica_completion_count = 1
+
+    scale {
+      max_executions              = 10
+      min_executions              = 0
+      polling_interval_in_seconds = 60
+
+      rules {
+        name             = "azure-queue"
+        custom_rule_type = "azure-queue"
+        metadata = {
+          accountName = azurerm_storage_account.test.name
+          queueName   = azurerm_storage_queue.test.name
+          queueLength = "1"
+        }
+
+        authentication {
+          secret_name       = "queue-connection-string"
+          trigger_parameter = "connection"
+        }
+      }
+    }
+  }
+
+  secret {
+    name  = "queue-connection-string"
+    value = azurerm_storage_account.test.primary_connection_string
+  }
+
+  template {
+    container {
+      name    = "testcontainerappsjob0"
+      image   = "mcr.microsoft.com/k8se/quickstart-jobs:latest"
+      cpu     = 0.5
+      memory  = "1Gi"
+      command = ["/bin/bash", "-c", "echo hello; sleep 5"]
+    }
+  }
+}
+`, ContainerAppEnvironmentResource{}.basic(data), data.RandomInteger, data.RandomString)
+}
@@ -0,0 +1,377 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2023-05-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppResource struct{}
+
+type ContainerAppModel struct {
+	Name                      string                 `tfschema:"name"`
+	ResourceGroupName         string                 `tfschema:"resource_group_name"`
+	ContainerAppEnvironmentId string                 `tfschema:"container_app_environment_id"`
+	RevisionMode              string                 `tfschema:"revision_mode"`
+	Template                  []ContainerAppTemplate `tfschema:"template"`
+	Ingress                   []ContainerAppIngress  `tfschema:"ingress"`
+	Dapr                      []ContainerAppDapr     `tfschema:"dapr"`
+	Secrets                   []ContainerAppSecret   `tfschema:"secret"`
+	Registries                []ContainerAppRegistry `tfschema:"registry"`
+	Tags                      map[string]string      `tfschema:"tags"`
+
+	Location                   string   `tfschema:"location"`
+	LatestRevisionName         string   `tfschema:"latest_revision_name"`
+	LatestRevisionFqdn         string   `tfschema:"latest_revision_fqdn"`
+	OutboundIPAddresses        []string `tfschema:"outbound_ip_addresses"`
+	CustomDomainVerificationId string   `tfschema:"custom_domain_verification_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppResource{}
+
+func (r ContainerAppResource) ModelObject() interface{} {
+	return &ContainerAppModel{}
+}
+
+func (r ContainerAppResource) ResourceType() string {
+	return "azurerm_container_app"
+}
+
+func (r ContainerAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerapps.ValidateContainerAppID
+}
+
+func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+		},
+
+		"revision_mode": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForActiveRevisionsMode(), false),
+		},
+
+		"template": containerAppTemplateSchema(),
+
+		"ingress": containerAppIngressSchema(),
+
+		"dapr": containerAppDaprSchema(),
+
+		"secret": containerAppSecretsSchema(),
+
+		"registry": containerAppRegistrySchema(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ContainerAppResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"latest_revision_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_revision_fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ContainerAppResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppsClient
+			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerAppModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := validateContainerAppRegistries(model.Registries); err != nil {
+				return err
+			}
+
+			if err := validateContainerAppSecrets(model.Secrets); err != nil {
+				return err
+			}
+
+			id := containerapps.NewContainerAppID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			environmentId, err := managedenvironments.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			// Container Apps must be created in the same location as the Container App Environment
+			environment, err := environmentsClient.Get(ctx, *environmentId)
+			if err != nil || environment.Model == nil {
+				return fmt.Errorf("retrieving %s: %+v", *environmentId, err)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			revisionMode := containerapps.ActiveRevisionsMode(model.RevisionMode)
+			app := containerapps.ContainerApp{
+				Identity: identityValue,
+				Location: environment.Model.Location,
+				Properties: &containerapps.ContainerAppProperties{
+					Configuration: &containerapps.Configuration{
+						ActiveRevisionsMode: &revisionMode,
+						Dapr:                expandContainerAppDapr(model.Dapr),
+						Ingress:             expandContainerAppIngress(model.Ingress, id.ContainerAppName),
+						Registries:          expandContainerAppRegistries(model.Registries),
+						Secrets:             expandContainerAppSecrets(model.Secrets),
+					},
+					ManagedEnvironmentId: utils.String(environmentId.ID()),
+					Template:             expandContainerAppTemplate(model.Template),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, app); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppsClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppModel{
+				Name:              id.ContainerAppName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			var identityValue *[]interface{}
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				identityValue, err = identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					if props.ManagedEnvironmentId != nil {
+						environmentId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(*props.ManagedEnvironmentId)
+						if err != nil {
+							return err
+						}
+						state.ContainerAppEnvironmentId = environmentId.ID()
+					}
+
+					state.LatestRevisionName = utils.NormalizeNilableString(props.LatestRevisionName)
+					state.LatestRevisionFqdn = utils.NormalizeNilableString(props.LatestRevisionFqdn)
+					state.CustomDomainVerificationId = utils.NormalizeNilableString(props.CustomDomainVerificationId)
+					if props.OutboundIPAddresses != nil {
+						state.OutboundIPAddresses = *props.OutboundIPAddresses
+					}
+
+					if config := props.Configuration; config != nil {
+						if config.ActiveRevisionsMode != nil {
+							state.RevisionMode = string(*config.ActiveRevisionsMode)
+						}
+						state.Dapr = flattenContainerAppDapr(config.Dapr)
+						state.Ingress = flattenContainerAppIngress(config.Ingress, id.ContainerAppName)
+						state.Registries = flattenContainerAppRegistries(config.Registries)
+					}
+
+					state.Template = flattenContainerAppTemplate(props.Template)
+				}
+			}
+
+			// the secret values are only available from the listSecrets API
+			secrets, err := client.ListSecrets(ctx, *id)
+			if err != nil || secrets.Model == nil {
+				return fmt.Errorf("listing secrets for %s: %+v", *id, err)
+			}
+			state.Secrets = flattenContainerAppSecrets(secrets.Model.Value)
+
+			if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppsClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := validateContainerAppRegistries(model.Registries); err != nil {
+				return err
+			}
+
+			if err := validateContainerAppSecrets(model.Secrets); err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			// the API replaces the whole Container App, so the full payload is sent on every update
+			revisionMode := containerapps.ActiveRevisionsMode(model.RevisionMode)
+			ingress := expandContainerAppIngress(model.Ingress, id.ContainerAppName)
+			if ingress != nil {
+				// custom domains are managed by the `azurerm_container_app_custom_domain` resource, so we retain these
+				if config := existing.Model.Properties.Configuration; config != nil && config.Ingress != nil {
+					ingress.CustomDomains = config.Ingress.CustomDomains
+				}
+			}
+
+			app := containerapps.ContainerApp{
+				Identity: identityValue,
+				Location: existing.Model.Location,
+				Properties: &containerapps.ContainerAppProperties{
+					Configuration: &containerapps.Configuration{
+						ActiveRevisionsMode: &revisionMode,
+						Dapr:                expandContainerAppDapr(model.Dapr),
+						Ingress:             ingress,
+						Registries:          expandContainerAppRegistries(model.Registries),
+						Secrets:             expandContainerAppSecrets(model.Secrets),
+					},
+					ManagedEnvironmentId: existing.Model.Properties.ManagedEnvironmentId,
+					Template:             expandContainerAppTemplate(model.Template),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, app); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppsClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
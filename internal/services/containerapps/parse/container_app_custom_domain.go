@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerAppCustomDomainId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	ContainerAppName string
+	CustomDomainName string
+}
+
+func NewContainerAppCustomDomainID(subscriptionId, resourceGroup, containerAppName, customDomainName string) ContainerAppCustomDomainId {
+	return ContainerAppCustomDomainId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		ContainerAppName: containerAppName,
+		CustomDomainName: customDomainName,
+	}
+}
+
+func (id ContainerAppCustomDomainId) String() string {
+	segments := []string{
+		fmt.Sprintf("Custom Domain Name %q", id.CustomDomainName),
+		fmt.Sprintf("Container App Name %q", id.ContainerAppName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container App Custom Domain", segmentsStr)
+}
+
+func (id ContainerAppCustomDomainId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.App/containerApps/%s/customDomains/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ContainerAppName, id.CustomDomainName)
+}
+
+// ContainerAppCustomDomainID parses a ContainerAppCustomDomain ID into an ContainerAppCustomDomainId struct
+func ContainerAppCustomDomainID(input string) (*ContainerAppCustomDomainId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerAppCustomDomainId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ContainerAppName, err = id.PopSegment("containerApps"); err != nil {
+		return nil, err
+	}
+	if resourceId.CustomDomainName, err = id.PopSegment("customDomains"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
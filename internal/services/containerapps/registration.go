@@ -0,0 +1,38 @@
+package containerapps
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+type Registration struct{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/container-apps"
+}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Container Apps",
+	}
+}
+
+func (r Registration) Name() string {
+	return "Container Apps"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ContainerAppEnvironmentResource{},
+		ContainerAppEnvironmentCertificateResource{},
+		ContainerAppEnvironmentDaprComponentResource{},
+		ContainerAppResource{},
+		ContainerAppCustomDomainResource{},
+		ContainerAppJobResource{},
+	}
+}
@@ -0,0 +1,15 @@
+package certificates
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type Certificate struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *CertificateProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
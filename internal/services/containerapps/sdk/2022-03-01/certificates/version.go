@@ -0,0 +1,9 @@
+package certificates
+
+import "fmt"
+
+const defaultApiVersion = "2022-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/certificates/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package daprcomponents
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DaprComponentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDaprComponentsClientWithBaseURI(endpoint string) DaprComponentsClient {
+	return DaprComponentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,13 @@
+package daprcomponents
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type DaprComponent struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *DaprComponentProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData   `json:"systemData,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package daprcomponents
+
+type DaprSecretsCollection struct {
+	Value []Secret `json:"value"`
+}
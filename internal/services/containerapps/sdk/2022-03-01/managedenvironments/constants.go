@@ -0,0 +1,55 @@
+package managedenvironments
+
+import "strings"
+
+type EnvironmentProvisioningState string
+
+const (
+	EnvironmentProvisioningStateCanceled                      EnvironmentProvisioningState = "Canceled"
+	EnvironmentProvisioningStateFailed                        EnvironmentProvisioningState = "Failed"
+	EnvironmentProvisioningStateInfrastructureSetupComplete   EnvironmentProvisioningState = "InfrastructureSetupComplete"
+	EnvironmentProvisioningStateInfrastructureSetupInProgress EnvironmentProvisioningState = "InfrastructureSetupInProgress"
+	EnvironmentProvisioningStateInitializationInProgress      EnvironmentProvisioningState = "InitializationInProgress"
+	EnvironmentProvisioningStateScheduledForDelete            EnvironmentProvisioningState = "ScheduledForDelete"
+	EnvironmentProvisioningStateSucceeded                     EnvironmentProvisioningState = "Succeeded"
+	EnvironmentProvisioningStateUpgradeFailed                 EnvironmentProvisioningState = "UpgradeFailed"
+	EnvironmentProvisioningStateUpgradeRequested              EnvironmentProvisioningState = "UpgradeRequested"
+	EnvironmentProvisioningStateWaiting                       EnvironmentProvisioningState = "Waiting"
+)
+
+func PossibleValuesForEnvironmentProvisioningState() []string {
+	return []string{
+		string(EnvironmentProvisioningStateCanceled),
+		string(EnvironmentProvisioningStateFailed),
+		string(EnvironmentProvisioningStateInfrastructureSetupComplete),
+		string(EnvironmentProvisioningStateInfrastructureSetupInProgress),
+		string(EnvironmentProvisioningStateInitializationInProgress),
+		string(EnvironmentProvisioningStateScheduledForDelete),
+		string(EnvironmentProvisioningStateSucceeded),
+		string(EnvironmentProvisioningStateUpgradeFailed),
+		string(EnvironmentProvisioningStateUpgradeRequested),
+		string(EnvironmentProvisioningStateWaiting),
+	}
+}
+
+func parseEnvironmentProvisioningState(input string) (*EnvironmentProvisioningState, error) {
+	vals := map[string]EnvironmentProvisioningState{
+		"canceled":                      EnvironmentProvisioningStateCanceled,
+		"failed":                        EnvironmentProvisioningStateFailed,
+		"infrastructuresetupcomplete":   EnvironmentProvisioningStateInfrastructureSetupComplete,
+		"infrastructuresetupinprogress": EnvironmentProvisioningStateInfrastructureSetupInProgress,
+		"initializationinprogress":      EnvironmentProvisioningStateInitializationInProgress,
+		"scheduledfordelete":            EnvironmentProvisioningStateScheduledForDelete,
+		"succeeded":                     EnvironmentProvisioningStateSucceeded,
+		"upgradefailed":                 EnvironmentProvisioningStateUpgradeFailed,
+		"upgraderequested":              EnvironmentProvisioningStateUpgradeRequested,
+		"waiting":                       EnvironmentProvisioningStateWaiting,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EnvironmentProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package managedenvironments
+
+type LogAnalyticsConfiguration struct {
+	CustomerId *string `json:"customerId,omitempty"`
+	SharedKey  *string `json:"sharedKey,omitempty"`
+}
@@ -0,0 +1,15 @@
+package managedenvironments
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type ManagedEnvironment struct {
+	Id         *string                       `json:"id,omitempty"`
+	Location   string                        `json:"location"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *ManagedEnvironmentProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData        `json:"systemData,omitempty"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package managedenvironments
+
+import "fmt"
+
+const defaultApiVersion = "2022-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/managedenvironments/%s", defaultApiVersion)
+}
@@ -0,0 +1,9 @@
+package containerapps
+
+type ContainerAppProbeHttpGet struct {
+	Host        *string                                       `json:"host,omitempty"`
+	HttpHeaders *[]ContainerAppProbeHttpGetHttpHeadersInlined `json:"httpHeaders,omitempty"`
+	Path        *string                                       `json:"path,omitempty"`
+	Port        int64                                         `json:"port"`
+	Scheme      *Scheme                                       `json:"scheme,omitempty"`
+}
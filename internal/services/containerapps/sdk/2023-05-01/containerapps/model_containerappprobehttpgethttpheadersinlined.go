@@ -0,0 +1,6 @@
+package containerapps
+
+type ContainerAppProbeHttpGetHttpHeadersInlined struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,6 @@
+package containerapps
+
+type ContainerAppProbeTcpSocket struct {
+	Host *string `json:"host,omitempty"`
+	Port int64   `json:"port"`
+}
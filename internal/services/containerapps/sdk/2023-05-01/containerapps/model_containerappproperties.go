@@ -0,0 +1,12 @@
+package containerapps
+
+type ContainerAppProperties struct {
+	Configuration              *Configuration                 `json:"configuration,omitempty"`
+	CustomDomainVerificationId *string                        `json:"customDomainVerificationId,omitempty"`
+	LatestRevisionFqdn         *string                        `json:"latestRevisionFqdn,omitempty"`
+	LatestRevisionName         *string                        `json:"latestRevisionName,omitempty"`
+	ManagedEnvironmentId       *string                        `json:"managedEnvironmentId,omitempty"`
+	OutboundIPAddresses        *[]string                      `json:"outboundIPAddresses,omitempty"`
+	ProvisioningState          *ContainerAppProvisioningState `json:"provisioningState,omitempty"`
+	Template                   *Template                      `json:"template,omitempty"`
+}
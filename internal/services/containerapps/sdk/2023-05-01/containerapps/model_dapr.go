@@ -0,0 +1,8 @@
+package containerapps
+
+type Dapr struct {
+	AppId       *string      `json:"appId,omitempty"`
+	AppPort     *int64       `json:"appPort,omitempty"`
+	AppProtocol *AppProtocol `json:"appProtocol,omitempty"`
+	Enabled     *bool        `json:"enabled,omitempty"`
+}
@@ -0,0 +1,7 @@
+package containerapps
+
+type EnvironmentVar struct {
+	Name      *string `json:"name,omitempty"`
+	SecretRef *string `json:"secretRef,omitempty"`
+	Value     *string `json:"value,omitempty"`
+}
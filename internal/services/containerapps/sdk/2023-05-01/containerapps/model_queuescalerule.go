@@ -0,0 +1,7 @@
+package containerapps
+
+type QueueScaleRule struct {
+	Auth        *[]ScaleRuleAuth `json:"auth,omitempty"`
+	QueueLength *int64           `json:"queueLength,omitempty"`
+	QueueName   *string          `json:"queueName,omitempty"`
+}
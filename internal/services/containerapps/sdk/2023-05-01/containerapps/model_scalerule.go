@@ -0,0 +1,9 @@
+package containerapps
+
+type ScaleRule struct {
+	AzureQueue *QueueScaleRule  `json:"azureQueue,omitempty"`
+	Custom     *CustomScaleRule `json:"custom,omitempty"`
+	HTTP       *HTTPScaleRule   `json:"http,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Tcp        *TcpScaleRule    `json:"tcp,omitempty"`
+}
@@ -0,0 +1,8 @@
+package containerapps
+
+type Secret struct {
+	Identity    *string `json:"identity,omitempty"`
+	KeyVaultUrl *string `json:"keyVaultUrl,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Value       *string `json:"value,omitempty"`
+}
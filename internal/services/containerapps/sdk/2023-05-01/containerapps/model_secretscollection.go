@@ -0,0 +1,5 @@
+package containerapps
+
+type SecretsCollection struct {
+	Value []ContainerAppSecret `json:"value"`
+}
@@ -0,0 +1,8 @@
+package containerapps
+
+type TrafficWeight struct {
+	Label          *string `json:"label,omitempty"`
+	LatestRevision *bool   `json:"latestRevision,omitempty"`
+	RevisionName   *string `json:"revisionName,omitempty"`
+	Weight         *int64  `json:"weight,omitempty"`
+}
@@ -0,0 +1,102 @@
+package jobs
+
+import "strings"
+
+type JobProvisioningState string
+
+const (
+	JobProvisioningStateCanceled   JobProvisioningState = "Canceled"
+	JobProvisioningStateDeleting   JobProvisioningState = "Deleting"
+	JobProvisioningStateFailed     JobProvisioningState = "Failed"
+	JobProvisioningStateInProgress JobProvisioningState = "InProgress"
+	JobProvisioningStateSucceeded  JobProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForJobProvisioningState() []string {
+	return []string{
+		string(JobProvisioningStateCanceled),
+		string(JobProvisioningStateDeleting),
+		string(JobProvisioningStateFailed),
+		string(JobProvisioningStateInProgress),
+		string(JobProvisioningStateSucceeded),
+	}
+}
+
+func parseJobProvisioningState(input string) (*JobProvisioningState, error) {
+	vals := map[string]JobProvisioningState{
+		"canceled":   JobProvisioningStateCanceled,
+		"deleting":   JobProvisioningStateDeleting,
+		"failed":     JobProvisioningStateFailed,
+		"inprogress": JobProvisioningStateInProgress,
+		"succeeded":  JobProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := JobProvisioningState(input)
+	return &out, nil
+}
+
+type StorageType string
+
+const (
+	StorageTypeAzureFile StorageType = "AzureFile"
+	StorageTypeEmptyDir  StorageType = "EmptyDir"
+	StorageTypeSecret    StorageType = "Secret"
+)
+
+func PossibleValuesForStorageType() []string {
+	return []string{
+		string(StorageTypeAzureFile),
+		string(StorageTypeEmptyDir),
+		string(StorageTypeSecret),
+	}
+}
+
+func parseStorageType(input string) (*StorageType, error) {
+	vals := map[string]StorageType{
+		"azurefile": StorageTypeAzureFile,
+		"emptydir":  StorageTypeEmptyDir,
+		"secret":    StorageTypeSecret,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageType(input)
+	return &out, nil
+}
+
+type TriggerType string
+
+const (
+	TriggerTypeEvent    TriggerType = "Event"
+	TriggerTypeManual   TriggerType = "Manual"
+	TriggerTypeSchedule TriggerType = "Schedule"
+)
+
+func PossibleValuesForTriggerType() []string {
+	return []string{
+		string(TriggerTypeEvent),
+		string(TriggerTypeManual),
+		string(TriggerTypeSchedule),
+	}
+}
+
+func parseTriggerType(input string) (*TriggerType, error) {
+	vals := map[string]TriggerType{
+		"event":    TriggerTypeEvent,
+		"manual":   TriggerTypeManual,
+		"schedule": TriggerTypeSchedule,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TriggerType(input)
+	return &out, nil
+}
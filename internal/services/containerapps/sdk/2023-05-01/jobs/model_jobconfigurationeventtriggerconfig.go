@@ -0,0 +1,7 @@
+package jobs
+
+type JobConfigurationEventTriggerConfig struct {
+	Parallelism            *int64    `json:"parallelism,omitempty"`
+	ReplicaCompletionCount *int64    `json:"replicaCompletionCount,omitempty"`
+	Scale                  *JobScale `json:"scale,omitempty"`
+}
@@ -0,0 +1,6 @@
+package jobs
+
+type JobConfigurationManualTriggerConfig struct {
+	Parallelism            *int64 `json:"parallelism,omitempty"`
+	ReplicaCompletionCount *int64 `json:"replicaCompletionCount,omitempty"`
+}
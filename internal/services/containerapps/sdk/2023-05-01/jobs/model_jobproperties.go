@@ -0,0 +1,11 @@
+package jobs
+
+type JobProperties struct {
+	Configuration       *JobConfiguration     `json:"configuration,omitempty"`
+	EnvironmentId       *string               `json:"environmentId,omitempty"`
+	EventStreamEndpoint *string               `json:"eventStreamEndpoint,omitempty"`
+	OutboundIPAddresses *[]string             `json:"outboundIpAddresses,omitempty"`
+	ProvisioningState   *JobProvisioningState `json:"provisioningState,omitempty"`
+	Template            *JobTemplate          `json:"template,omitempty"`
+	WorkloadProfileName *string               `json:"workloadProfileName,omitempty"`
+}
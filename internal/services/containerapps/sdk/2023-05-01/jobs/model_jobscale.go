@@ -0,0 +1,8 @@
+package jobs
+
+type JobScale struct {
+	MaxExecutions   *int64          `json:"maxExecutions,omitempty"`
+	MinExecutions   *int64          `json:"minExecutions,omitempty"`
+	PollingInterval *int64          `json:"pollingInterval,omitempty"`
+	Rules           *[]JobScaleRule `json:"rules,omitempty"`
+}
@@ -0,0 +1,8 @@
+package jobs
+
+type JobScaleRule struct {
+	Auth     *[]ScaleRuleAuth `json:"auth,omitempty"`
+	Metadata *interface{}     `json:"metadata,omitempty"`
+	Name     *string          `json:"name,omitempty"`
+	Type     *string          `json:"type,omitempty"`
+}
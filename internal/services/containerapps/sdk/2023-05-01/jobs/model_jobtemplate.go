@@ -0,0 +1,7 @@
+package jobs
+
+type JobTemplate struct {
+	Containers     *[]Container     `json:"containers,omitempty"`
+	InitContainers *[]BaseContainer `json:"initContainers,omitempty"`
+	Volumes        *[]Volume        `json:"volumes,omitempty"`
+}
@@ -0,0 +1,7 @@
+package jobs
+
+type Volume struct {
+	Name        *string      `json:"name,omitempty"`
+	StorageName *string      `json:"storageName,omitempty"`
+	StorageType *StorageType `json:"storageType,omitempty"`
+}
@@ -0,0 +1,6 @@
+package jobs
+
+type VolumeMount struct {
+	MountPath  *string `json:"mountPath,omitempty"`
+	VolumeName *string `json:"volumeName,omitempty"`
+}
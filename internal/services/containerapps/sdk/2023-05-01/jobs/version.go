@@ -0,0 +1,9 @@
+package jobs
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/jobs/%s", defaultApiVersion)
+}
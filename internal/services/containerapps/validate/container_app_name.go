@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func ContainerAppName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if matched := regexp.MustCompile(`^[a-z][a-z0-9-]{0,30}[a-z0-9]$`).MatchString(v); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 2 and 32 characters, can only contain lowercase letters, numbers and hyphens, must start with a letter and end with a letter or number", k))
+		return warnings, errors
+	}
+
+	if regexp.MustCompile(`--`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must not contain consecutive hyphens", k))
+	}
+
+	return warnings, errors
+}
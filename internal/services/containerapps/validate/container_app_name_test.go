@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContainerAppName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "a",
+			expected: false,
+		},
+		{
+			input:    "ab",
+			expected: true,
+		},
+		{
+			input:    "my-app1",
+			expected: true,
+		},
+		{
+			input:    "1app",
+			expected: false,
+		},
+		{
+			input:    "app-",
+			expected: false,
+		},
+		{
+			input:    "app--1",
+			expected: false,
+		},
+		{
+			input:    "App",
+			expected: false,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 31),
+			expected: true,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 32),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ContainerAppName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.input)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDaprComponentName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "statestore",
+			expected: true,
+		},
+		{
+			input:    "state.store-1",
+			expected: true,
+		},
+		{
+			input:    "1state",
+			expected: false,
+		},
+		{
+			input:    "state.",
+			expected: false,
+		},
+		{
+			input:    "State",
+			expected: false,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 59),
+			expected: true,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 60),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DaprComponentName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.input)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestManagedEnvironmentName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "a",
+			expected: false,
+		},
+		{
+			input:    "ab",
+			expected: true,
+		},
+		{
+			input:    "acctest-env",
+			expected: true,
+		},
+		{
+			input:    "1env",
+			expected: false,
+		},
+		{
+			input:    "env-",
+			expected: false,
+		},
+		{
+			input:    "env--1",
+			expected: false,
+		},
+		{
+			input:    "Env1",
+			expected: false,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 59),
+			expected: true,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 60),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ManagedEnvironmentName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.input)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func SecretName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if matched := regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]{0,251}[a-z0-9])?$`).MatchString(v); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 253 characters, can only contain lowercase letters, numbers, periods and hyphens and must start and end with a letter or number", k))
+	}
+
+	return warnings, errors
+}
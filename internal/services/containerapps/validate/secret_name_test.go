@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSecretName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "a",
+			expected: true,
+		},
+		{
+			input:    "1",
+			expected: true,
+		},
+		{
+			input:    "my-secret.1",
+			expected: true,
+		},
+		{
+			input:    "-secret",
+			expected: false,
+		},
+		{
+			input:    "secret-",
+			expected: false,
+		},
+		{
+			input:    "Secret",
+			expected: false,
+		},
+		{
+			input:    "my_secret",
+			expected: false,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 252),
+			expected: true,
+		},
+		{
+			input:    "a" + strings.Repeat("b", 253),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := SecretName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.input)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+)
+
+// NOTE: this workaround client exists since Kubernetes Fleet Manager is only available from API Version `2023-10-15`
+// of the Container Service Fleet API, which isn't available in the vendored SDKs. Once a newer SDK containing the
+// Fleet API is vendored this can be removed.
+
+const fleetAPIVersion = "2023-10-15"
+
+type FleetClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFleetClientWithBaseURI(endpoint string) FleetClient {
+	return FleetClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		baseUri: endpoint,
+	}
+}
+
+type Fleet struct {
+	autorest.Response `json:"-"`
+	ID                *string            `json:"id,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	Location          *string            `json:"location,omitempty"`
+	Tags              map[string]*string `json:"tags,omitempty"`
+	Properties        *FleetProperties   `json:"properties,omitempty"`
+}
+
+type FleetProperties struct {
+	ProvisioningState *string          `json:"provisioningState,omitempty"`
+	HubProfile        *FleetHubProfile `json:"hubProfile,omitempty"`
+}
+
+type FleetHubProfile struct {
+	DNSPrefix         *string `json:"dnsPrefix,omitempty"`
+	Fqdn              *string `json:"fqdn,omitempty"`
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+}
+
+type FleetMember struct {
+	autorest.Response `json:"-"`
+	ID                *string                `json:"id,omitempty"`
+	Name              *string                `json:"name,omitempty"`
+	Properties        *FleetMemberProperties `json:"properties,omitempty"`
+}
+
+type FleetMemberProperties struct {
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	ClusterResourceID *string `json:"clusterResourceId,omitempty"`
+	Group             *string `json:"group,omitempty"`
+}
+
+type FleetUpdateRun struct {
+	autorest.Response `json:"-"`
+	ID                *string                   `json:"id,omitempty"`
+	Name              *string                   `json:"name,omitempty"`
+	Properties        *FleetUpdateRunProperties `json:"properties,omitempty"`
+}
+
+type FleetUpdateRunProperties struct {
+	ProvisioningState    *string                    `json:"provisioningState,omitempty"`
+	UpdateStrategyID     *string                    `json:"updateStrategyId,omitempty"`
+	Strategy             *FleetUpdateRunStrategy    `json:"strategy,omitempty"`
+	ManagedClusterUpdate *FleetManagedClusterUpdate `json:"managedClusterUpdate,omitempty"`
+}
+
+type FleetUpdateRunStrategy struct {
+	Stages []FleetUpdateStage `json:"stages"`
+}
+
+type FleetUpdateStage struct {
+	Name                    string              `json:"name"`
+	Groups                  *[]FleetUpdateGroup `json:"groups,omitempty"`
+	AfterStageWaitInSeconds *int64              `json:"afterStageWaitInSeconds,omitempty"`
+}
+
+type FleetUpdateGroup struct {
+	Name string `json:"name"`
+}
+
+type FleetManagedClusterUpdate struct {
+	Upgrade            FleetManagedClusterUpgradeSpec `json:"upgrade"`
+	NodeImageSelection *FleetNodeImageSelection       `json:"nodeImageSelection,omitempty"`
+}
+
+type FleetManagedClusterUpgradeSpec struct {
+	Type              string  `json:"type"`
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+}
+
+type FleetNodeImageSelection struct {
+	Type string `json:"type"`
+}
+
+// GetFleet retrieves the specified Kubernetes Fleet Manager.
+func (c FleetClient) GetFleet(ctx context.Context, id parse.KubernetesFleetManagerId) (result Fleet, err error) {
+	result.Response, err = c.get(ctx, id.ID(), "FleetsClient", &result)
+	return result, err
+}
+
+// CreateOrUpdateFleetThenPoll creates or updates the specified Kubernetes Fleet Manager and waits for it to be provisioned.
+func (c FleetClient) CreateOrUpdateFleetThenPoll(ctx context.Context, id parse.KubernetesFleetManagerId, input Fleet) error {
+	return c.sendThenPoll(ctx, id.ID(), "FleetsClient", "CreateOrUpdate", autorest.AsPut(), input)
+}
+
+// DeleteFleetThenPoll deletes the specified Kubernetes Fleet Manager and waits for it to be removed.
+func (c FleetClient) DeleteFleetThenPoll(ctx context.Context, id parse.KubernetesFleetManagerId) error {
+	return c.sendThenPoll(ctx, id.ID(), "FleetsClient", "Delete", autorest.AsDelete(), nil)
+}
+
+// GetMember retrieves the specified Kubernetes Fleet Member.
+func (c FleetClient) GetMember(ctx context.Context, id parse.KubernetesFleetMemberId) (result FleetMember, err error) {
+	result.Response, err = c.get(ctx, id.ID(), "FleetMembersClient", &result)
+	return result, err
+}
+
+// CreateMemberThenPoll creates the specified Kubernetes Fleet Member and waits for it to be provisioned.
+func (c FleetClient) CreateMemberThenPoll(ctx context.Context, id parse.KubernetesFleetMemberId, input FleetMember) error {
+	return c.sendThenPoll(ctx, id.ID(), "FleetMembersClient", "Create", autorest.AsPut(), input)
+}
+
+// UpdateMemberThenPoll updates the specified Kubernetes Fleet Member and waits for it to be provisioned.
+func (c FleetClient) UpdateMemberThenPoll(ctx context.Context, id parse.KubernetesFleetMemberId, input FleetMember) error {
+	return c.sendThenPoll(ctx, id.ID(), "FleetMembersClient", "Update", autorest.AsPatch(), input)
+}
+
+// DeleteMemberThenPoll deletes the specified Kubernetes Fleet Member and waits for it to be removed.
+func (c FleetClient) DeleteMemberThenPoll(ctx context.Context, id parse.KubernetesFleetMemberId) error {
+	return c.sendThenPoll(ctx, id.ID(), "FleetMembersClient", "Delete", autorest.AsDelete(), nil)
+}
+
+// GetUpdateRun retrieves the specified Kubernetes Fleet Update Run.
+func (c FleetClient) GetUpdateRun(ctx context.Context, id parse.KubernetesFleetUpdateRunId) (result FleetUpdateRun, err error) {
+	result.Response, err = c.get(ctx, id.ID(), "UpdateRunsClient", &result)
+	return result, err
+}
+
+// CreateOrUpdateUpdateRunThenPoll creates or updates the specified Kubernetes Fleet Update Run and waits for it to be provisioned.
+func (c FleetClient) CreateOrUpdateUpdateRunThenPoll(ctx context.Context, id parse.KubernetesFleetUpdateRunId, input FleetUpdateRun) error {
+	return c.sendThenPoll(ctx, id.ID(), "UpdateRunsClient", "CreateOrUpdate", autorest.AsPut(), input)
+}
+
+// DeleteUpdateRunThenPoll deletes the specified Kubernetes Fleet Update Run and waits for it to be removed.
+func (c FleetClient) DeleteUpdateRunThenPoll(ctx context.Context, id parse.KubernetesFleetUpdateRunId) error {
+	return c.sendThenPoll(ctx, id.ID(), "UpdateRunsClient", "Delete", autorest.AsDelete(), nil)
+}
+
+func (c FleetClient) get(ctx context.Context, path string, clientName string, result interface{}) (autorest.Response, error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": fleetAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.Response{}, autorest.NewErrorWithError(err, "containerservice."+clientName, "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "containerservice."+clientName, "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "containerservice."+clientName, "Get", resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, nil
+}
+
+func (c FleetClient) sendThenPoll(ctx context.Context, path string, clientName string, operation string, method autorest.PrepareDecorator, input interface{}) error {
+	decorators := []autorest.PrepareDecorator{
+		method,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": fleetAPIVersion,
+		}),
+	}
+	if input != nil {
+		decorators = append(decorators, autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(input))
+	}
+
+	req, err := autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice."+clientName, operation, nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice."+clientName, operation, resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return fmt.Errorf("polling after %s: %+v", operation, err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after %s: %+v", operation, err)
+	}
+
+	return nil
+}
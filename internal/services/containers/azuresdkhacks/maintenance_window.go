@@ -0,0 +1,175 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+)
+
+// NOTE: this workaround client exists since the `maintenanceWindow` used by the AKS-managed Maintenance Configurations
+// (`aksManagedAutoUpgradeSchedule` and `aksManagedNodeOSUpgradeSchedule`) is only available from API Version `2023-09-01`
+// of the Container Service API, whereas the Kubernetes Cluster resources are built against API Version
+// `2022-03-02-preview`. Once the resources have been migrated to a newer API Version this can be removed.
+
+const maintenanceWindowAPIVersion = "2023-09-01"
+
+const (
+	// MaintenanceConfigurationNameAutoUpgrade is the name of the Maintenance Configuration used for the Cluster Auto-Upgrade Channel
+	MaintenanceConfigurationNameAutoUpgrade = "aksManagedAutoUpgradeSchedule"
+
+	// MaintenanceConfigurationNameNodeOS is the name of the Maintenance Configuration used for the Node OS Upgrade Channel
+	MaintenanceConfigurationNameNodeOS = "aksManagedNodeOSUpgradeSchedule"
+)
+
+type MaintenanceWindowClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMaintenanceWindowClientWithBaseURI(endpoint string) MaintenanceWindowClient {
+	return MaintenanceWindowClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		baseUri: endpoint,
+	}
+}
+
+type MaintenanceWindowConfiguration struct {
+	autorest.Response `json:"-"`
+	ID                *string                                   `json:"id,omitempty"`
+	Name              *string                                   `json:"name,omitempty"`
+	Properties        *MaintenanceWindowConfigurationProperties `json:"properties,omitempty"`
+}
+
+type MaintenanceWindowConfigurationProperties struct {
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
+
+type MaintenanceWindow struct {
+	Schedule        *MaintenanceSchedule `json:"schedule,omitempty"`
+	DurationHours   int64                `json:"durationHours"`
+	UtcOffset       *string              `json:"utcOffset,omitempty"`
+	StartDate       *string              `json:"startDate,omitempty"`
+	StartTime       *string              `json:"startTime,omitempty"`
+	NotAllowedDates *[]DateSpan          `json:"notAllowedDates,omitempty"`
+}
+
+type MaintenanceSchedule struct {
+	Daily           *DailySchedule           `json:"daily,omitempty"`
+	Weekly          *WeeklySchedule          `json:"weekly,omitempty"`
+	AbsoluteMonthly *AbsoluteMonthlySchedule `json:"absoluteMonthly,omitempty"`
+	RelativeMonthly *RelativeMonthlySchedule `json:"relativeMonthly,omitempty"`
+}
+
+type DailySchedule struct {
+	IntervalDays int64 `json:"intervalDays"`
+}
+
+type WeeklySchedule struct {
+	IntervalWeeks int64  `json:"intervalWeeks"`
+	DayOfWeek     string `json:"dayOfWeek"`
+}
+
+type AbsoluteMonthlySchedule struct {
+	IntervalMonths int64 `json:"intervalMonths"`
+	DayOfMonth     int64 `json:"dayOfMonth"`
+}
+
+type RelativeMonthlySchedule struct {
+	IntervalMonths int64  `json:"intervalMonths"`
+	WeekIndex      string `json:"weekIndex"`
+	DayOfWeek      string `json:"dayOfWeek"`
+}
+
+type DateSpan struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Get retrieves the specified Maintenance Configuration for the Kubernetes Cluster.
+func (c MaintenanceWindowClient) Get(ctx context.Context, id parse.ClusterId, name string) (result MaintenanceWindowConfiguration, err error) {
+	req, err := c.preparer(ctx, id, name, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// CreateOrUpdate creates or updates the specified Maintenance Configuration for the Kubernetes Cluster.
+func (c MaintenanceWindowClient) CreateOrUpdate(ctx context.Context, id parse.ClusterId, name string, input MaintenanceWindowConfiguration) error {
+	req, err := c.preparer(ctx, id, name,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// Delete deletes the specified Maintenance Configuration for the Kubernetes Cluster.
+func (c MaintenanceWindowClient) Delete(ctx context.Context, id parse.ClusterId, name string) error {
+	req, err := c.preparer(ctx, id, name, autorest.AsDelete())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.MaintenanceConfigurationsClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func (c MaintenanceWindowClient) preparer(ctx context.Context, id parse.ClusterId, name string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/maintenanceConfigurations/%s", id.ID(), name)),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": maintenanceWindowAPIVersion,
+		}))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
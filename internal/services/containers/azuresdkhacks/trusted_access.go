@@ -0,0 +1,207 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+)
+
+// NOTE: this workaround client exists since Trusted Access is only available from API Version `2023-09-01`
+// of the Container Service API, whereas the Kubernetes Cluster resources are built against API Version
+// `2022-03-02-preview`. Once the resources have been migrated to a newer API Version this can be removed.
+
+const trustedAccessAPIVersion = "2023-09-01"
+
+type TrustedAccessClient struct {
+	Client         autorest.Client
+	baseUri        string
+	subscriptionId string
+}
+
+func NewTrustedAccessClientWithBaseURI(endpoint string, subscriptionId string) TrustedAccessClient {
+	return TrustedAccessClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		baseUri:        endpoint,
+		subscriptionId: subscriptionId,
+	}
+}
+
+type TrustedAccessRole struct {
+	SourceResourceType *string                  `json:"sourceResourceType,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	Rules              *[]TrustedAccessRoleRule `json:"rules,omitempty"`
+}
+
+type TrustedAccessRoleRule struct {
+	Verbs           *[]string `json:"verbs,omitempty"`
+	APIGroups       *[]string `json:"apiGroups,omitempty"`
+	Resources       *[]string `json:"resources,omitempty"`
+	ResourceNames   *[]string `json:"resourceNames,omitempty"`
+	NonResourceURLs *[]string `json:"nonResourceURLs,omitempty"`
+}
+
+type trustedAccessRoleListResult struct {
+	Value    *[]TrustedAccessRole `json:"value,omitempty"`
+	NextLink *string              `json:"nextLink,omitempty"`
+}
+
+type TrustedAccessRoleBinding struct {
+	autorest.Response `json:"-"`
+	ID                *string                             `json:"id,omitempty"`
+	Name              *string                             `json:"name,omitempty"`
+	Properties        *TrustedAccessRoleBindingProperties `json:"properties,omitempty"`
+}
+
+type TrustedAccessRoleBindingProperties struct {
+	ProvisioningState *string  `json:"provisioningState,omitempty"`
+	SourceResourceID  *string  `json:"sourceResourceId,omitempty"`
+	Roles             []string `json:"roles"`
+}
+
+// ListRoles returns the Trusted Access Roles which are available in the specified location.
+func (c TrustedAccessClient) ListRoles(ctx context.Context, location string) ([]TrustedAccessRole, error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/Microsoft.ContainerService/locations/{location}/trustedAccessRoles", map[string]interface{}{
+			"subscriptionId": autorest.Encode("path", c.subscriptionId),
+			"location":       autorest.Encode("path", location),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": trustedAccessAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "containerservice.TrustedAccessRolesClient", "List", nil, "Failure preparing request")
+	}
+
+	roles := make([]TrustedAccessRole, 0)
+	for req != nil {
+		resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "containerservice.TrustedAccessRolesClient", "List", resp, "Failure sending request")
+		}
+
+		var page trustedAccessRoleListResult
+		err = autorest.Respond(
+			resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "containerservice.TrustedAccessRolesClient", "List", resp, "Failure responding to request")
+		}
+
+		if page.Value != nil {
+			roles = append(roles, *page.Value...)
+		}
+
+		req = nil
+		if page.NextLink != nil && *page.NextLink != "" {
+			req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+				autorest.AsGet(),
+				autorest.WithBaseURL(*page.NextLink))
+			if err != nil {
+				return nil, autorest.NewErrorWithError(err, "containerservice.TrustedAccessRolesClient", "List", nil, "Failure preparing next results request")
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+// GetRoleBinding retrieves the specified Trusted Access Role Binding.
+func (c TrustedAccessClient) GetRoleBinding(ctx context.Context, id parse.TrustedAccessRoleBindingId) (result TrustedAccessRoleBinding, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": trustedAccessAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "containerservice.TrustedAccessRoleBindingsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "containerservice.TrustedAccessRoleBindingsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "containerservice.TrustedAccessRoleBindingsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// CreateOrUpdateRoleBindingThenPoll creates or updates the specified Trusted Access Role Binding and waits for it to be provisioned.
+func (c TrustedAccessClient) CreateOrUpdateRoleBindingThenPoll(ctx context.Context, id parse.TrustedAccessRoleBindingId, input TrustedAccessRoleBinding) error {
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": trustedAccessAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.TrustedAccessRoleBindingsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.TrustedAccessRoleBindingsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteRoleBindingThenPoll deletes the specified Trusted Access Role Binding and waits for it to be removed.
+func (c TrustedAccessClient) DeleteRoleBindingThenPoll(ctx context.Context, id parse.TrustedAccessRoleBindingId) error {
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": trustedAccessAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.TrustedAccessRoleBindingsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "containerservice.TrustedAccessRoleBindingsClient", "Delete", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
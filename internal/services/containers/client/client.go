@@ -8,22 +8,27 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2021-03-01/containerinstance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
 )
 
 type Client struct {
 	AgentPoolsClient                  *containerservice.AgentPoolsClient
 	ContainerRegistryAgentPoolsClient *containerregistry.AgentPoolsClient
 	ContainerInstanceClient           *containerinstance.ContainerInstanceClient
+	FleetClient                       *azuresdkhacks.FleetClient
 	KubernetesClustersClient          *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient   *containerservice.MaintenanceConfigurationsClient
+	MaintenanceWindowClient           *azuresdkhacks.MaintenanceWindowClient
 	RegistriesClient                  *containerregistry.RegistriesClient
 	ReplicationsClient                *containerregistry.ReplicationsClient
 	ServicesClient                    *legacy.ContainerServicesClient
+	SnapshotClient                    *containerservice.SnapshotsClient
 	WebhooksClient                    *containerregistry.WebhooksClient
 	TokensClient                      *containerregistry.TokensClient
 	ScopeMapsClient                   *containerregistry.ScopeMapsClient
 	TasksClient                       *legacyacr.TasksClient
 	ConnectedRegistriesClient         *containerregistry.ConnectedRegistriesClient
+	TrustedAccessClient               *azuresdkhacks.TrustedAccessClient
 
 	Environment azure.Environment
 }
@@ -63,6 +68,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	maintenanceWindowClient := azuresdkhacks.NewMaintenanceWindowClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&maintenanceWindowClient.Client, o.ResourceManagerAuthorizer)
+
+	fleetClient := azuresdkhacks.NewFleetClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetClient.Client, o.ResourceManagerAuthorizer)
+
+	trustedAccessClient := azuresdkhacks.NewTrustedAccessClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&trustedAccessClient.Client, o.ResourceManagerAuthorizer)
+
+	snapshotClient := containerservice.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&snapshotClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -74,15 +91,19 @@ func NewClient(o *common.ClientOptions) *Client {
 		ContainerRegistryAgentPoolsClient: &registryAgentPoolsClient,
 		KubernetesClustersClient:          &kubernetesClustersClient,
 		ContainerInstanceClient:           &containerInstanceClient,
+		FleetClient:                       &fleetClient,
 		MaintenanceConfigurationsClient:   &maintenanceConfigurationsClient,
+		MaintenanceWindowClient:           &maintenanceWindowClient,
 		RegistriesClient:                  &registriesClient,
 		WebhooksClient:                    &webhooksClient,
 		ReplicationsClient:                &replicationsClient,
 		ServicesClient:                    &servicesClient,
+		SnapshotClient:                    &snapshotClient,
 		Environment:                       o.Environment,
 		TokensClient:                      &tokensClient,
 		ScopeMapsClient:                   &scopeMapsClient,
 		TasksClient:                       &tasksClient,
 		ConnectedRegistriesClient:         &connectedRegistriesClient,
+		TrustedAccessClient:               &trustedAccessClient,
 	}
 }
@@ -167,8 +167,12 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("checking if the name %q was available: %+v", id.Name, err)
 	}
 
-	if !*available.NameAvailable {
-		return fmt.Errorf("the name %q used for the Container Registry needs to be globally unique and isn't available: %s", id.Name, *available.Message)
+	if err := azure.CheckNameAvailability("Container Registry", id.Name, azure.NameAvailabilityResult{
+		NameAvailable: available.NameAvailable,
+		Reason:        utils.NormalizeNilableString(available.Reason),
+		Message:       available.Message,
+	}); err != nil {
+		return err
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
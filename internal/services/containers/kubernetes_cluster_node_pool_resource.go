@@ -290,6 +290,13 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"snapshot_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.NodePoolSnapshotID,
+			},
+
 			"ultra_ssd_enabled": {
 				Type:     pluginsdk.TypeBool,
 				ForceNew: true,
@@ -402,6 +409,12 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 		profile.OsSKU = containerservice.OSSKU(osSku)
 	}
 
+	if snapshotId := d.Get("snapshot_id").(string); snapshotId != "" {
+		profile.CreationData = &containerservice.CreationData{
+			SourceResourceID: utils.String(snapshotId),
+		}
+	}
+
 	if scaleDownMode := d.Get("scale_down_mode").(string); scaleDownMode != "" {
 		profile.ScaleDownMode = containerservice.ScaleDownMode(scaleDownMode)
 	}
@@ -841,6 +854,16 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 
 		d.Set("proximity_placement_group_id", props.ProximityPlacementGroupID)
 
+		snapshotId := ""
+		if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+			id, err := parse.NodePoolSnapshotID(*props.CreationData.SourceResourceID)
+			if err != nil {
+				return err
+			}
+			snapshotId = id.ID()
+		}
+		d.Set("snapshot_id", snapshotId)
+
 		spotMaxPrice := -1.0
 		if props.SpotMaxPrice != nil {
 			spotMaxPrice = *props.SpotMaxPrice
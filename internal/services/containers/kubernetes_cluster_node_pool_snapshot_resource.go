@@ -0,0 +1,257 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterNodePoolSnapshotResource struct{}
+
+type KubernetesClusterNodePoolSnapshotModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	NodePoolId        string            `tfschema:"node_pool_id"`
+	Tags              map[string]string `tfschema:"tags"`
+
+	FipsEnabled       bool   `tfschema:"fips_enabled"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+	NodeImageVersion  string `tfschema:"node_image_version"`
+	OsSku             string `tfschema:"os_sku"`
+	OsType            string `tfschema:"os_type"`
+	VmSize            string `tfschema:"vm_size"`
+}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterNodePoolSnapshotResource{}
+
+func (r KubernetesClusterNodePoolSnapshotResource) ModelObject() interface{} {
+	return &KubernetesClusterNodePoolSnapshotModel{}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_node_pool_snapshot"
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NodePoolSnapshotID
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([-_.a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$`),
+				"`name` must be between 1 and 63 characters, can only contain alphanumeric characters, hyphens, underscores and periods and must start and end with an alphanumeric character",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"node_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NodePoolID,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fips_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"kubernetes_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"node_image_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_sku": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"vm_size": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model KubernetesClusterNodePoolSnapshotModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			nodePoolId, err := parse.NodePoolID(model.NodePoolId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNodePoolSnapshotID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SnapshotName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			snapshot := containerservice.Snapshot{
+				Location: utils.String(location.Normalize(model.Location)),
+				SnapshotProperties: &containerservice.SnapshotProperties{
+					CreationData: &containerservice.CreationData{
+						SourceResourceID: utils.String(nodePoolId.ID()),
+					},
+					SnapshotType: containerservice.SnapshotTypeNodePool,
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SnapshotName, snapshot); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+
+			id, err := parse.NodePoolSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SnapshotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := KubernetesClusterNodePoolSnapshotModel{
+				Name:              id.SnapshotName,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.NormalizeNilable(existing.Location),
+				Tags:              tags.ToTypedObject(existing.Tags),
+			}
+
+			if props := existing.SnapshotProperties; props != nil {
+				if props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+					nodePoolId, err := parse.NodePoolID(*props.CreationData.SourceResourceID)
+					if err != nil {
+						return err
+					}
+					model.NodePoolId = nodePoolId.ID()
+				}
+
+				model.FipsEnabled = utils.NormaliseNilableBool(props.EnableFIPS)
+				model.KubernetesVersion = utils.NormalizeNilableString(props.KubernetesVersion)
+				model.NodeImageVersion = utils.NormalizeNilableString(props.NodeImageVersion)
+				model.OsSku = string(props.OsSku)
+				model.OsType = string(props.OsType)
+				model.VmSize = utils.NormalizeNilableString(props.VMSize)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+
+			id, err := parse.NodePoolSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterNodePoolSnapshotModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters := containerservice.TagsObject{
+					Tags: tags.FromTypedObject(model.Tags),
+				}
+				if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.SnapshotName, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterNodePoolSnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.SnapshotClient
+
+			id, err := parse.NodePoolSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.SnapshotName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -20,10 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -92,6 +94,21 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				ExactlyOneOf: []string{"dns_prefix", "dns_prefix_private_cluster"},
 			},
 
+			"key_management_service": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+					},
+				},
+			},
+
 			"kubernetes_version": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -387,6 +404,10 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"maintenance_window_auto_upgrade": schemaKubernetesClusterMaintenanceWindowSchedule(),
+
+			"maintenance_window_node_os": schemaKubernetesClusterMaintenanceWindowSchedule(),
+
 			"microsoft_defender": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -628,6 +649,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"workload_identity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"private_fqdn": { // privateFqdn
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -1104,7 +1131,26 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	microsoftDefenderRaw := d.Get("microsoft_defender").([]interface{})
-	microsoftDefender := expandKubernetesClusterMicrosoftDefender(d, microsoftDefenderRaw)
+	securityProfile := expandKubernetesClusterMicrosoftDefender(d, microsoftDefenderRaw)
+
+	if azureKeyVaultKms := expandKubernetesClusterAzureKeyVaultKms(d, d.Get("key_management_service").([]interface{})); azureKeyVaultKms != nil {
+		if securityProfile == nil {
+			securityProfile = &containerservice.ManagedClusterSecurityProfile{}
+		}
+		securityProfile.AzureKeyVaultKms = azureKeyVaultKms
+	}
+
+	if d.Get("workload_identity_enabled").(bool) {
+		if !enableOidcIssuer {
+			return fmt.Errorf("`oidc_issuer_enabled` must be set to `true` to enable `workload_identity_enabled`")
+		}
+		if securityProfile == nil {
+			securityProfile = &containerservice.ManagedClusterSecurityProfile{}
+		}
+		securityProfile.WorkloadIdentity = &containerservice.ManagedClusterSecurityProfileWorkloadIdentity{
+			Enabled: utils.Bool(true),
+		}
+	}
 
 	parameters := containerservice.ManagedCluster{
 		Name:     utils.String(id.ManagedClusterName),
@@ -1130,7 +1176,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 			DisableLocalAccounts:   utils.Bool(d.Get("local_account_disabled").(bool)),
 			HTTPProxyConfig:        httpProxyConfig,
 			OidcIssuerProfile:      oidcIssuerProfile,
-			SecurityProfile:        microsoftDefender,
+			SecurityProfile:        securityProfile,
 		},
 		Tags: tags.Expand(t),
 	}
@@ -1195,6 +1241,18 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.ManagedClusterProperties.DiskEncryptionSetID = utils.String(v.(string))
 	}
 
+	// these are expanded prior to creating the cluster, since they're validated during expansion
+	maintenanceWindows := make(map[string]azuresdkhacks.MaintenanceWindowConfiguration)
+	for fieldName, configurationName := range kubernetesClusterMaintenanceWindows {
+		configuration, err := expandKubernetesClusterMaintenanceWindowSchedule(d.Get(fieldName).([]interface{}), fieldName)
+		if err != nil {
+			return err
+		}
+		if configuration != nil {
+			maintenanceWindows[configurationName] = *configuration
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, parameters)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -1214,6 +1272,12 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	for configurationName, configuration := range maintenanceWindows {
+		if err := meta.(*clients.Client).Containers.MaintenanceWindowClient.CreateOrUpdate(ctx, id, configurationName, configuration); err != nil {
+			return fmt.Errorf("creating Maintenance Configuration %q for %s: %+v", configurationName, id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceKubernetesClusterRead(d, meta)
 }
@@ -1571,18 +1635,40 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.OidcIssuerProfile = oidcIssuerProfile
 	}
 
+	if d.HasChanges("microsoft_defender", "key_management_service", "workload_identity_enabled") && existing.ManagedClusterProperties.SecurityProfile == nil {
+		existing.ManagedClusterProperties.SecurityProfile = &containerservice.ManagedClusterSecurityProfile{}
+	}
+
 	if d.HasChanges("microsoft_defender") {
 		updateCluster = true
 		microsoftDefenderRaw := d.Get("microsoft_defender").([]interface{})
-		microsoftDefender := expandKubernetesClusterMicrosoftDefender(d, microsoftDefenderRaw)
-		existing.ManagedClusterProperties.SecurityProfile = microsoftDefender
+		existing.ManagedClusterProperties.SecurityProfile.AzureDefender = nil
+		if microsoftDefender := expandKubernetesClusterMicrosoftDefender(d, microsoftDefenderRaw); microsoftDefender != nil {
+			existing.ManagedClusterProperties.SecurityProfile.AzureDefender = microsoftDefender.AzureDefender
+		}
+	}
+
+	if d.HasChange("key_management_service") {
+		updateCluster = true
+		existing.ManagedClusterProperties.SecurityProfile.AzureKeyVaultKms = expandKubernetesClusterAzureKeyVaultKms(d, d.Get("key_management_service").([]interface{}))
+	}
+
+	if d.HasChange("workload_identity_enabled") {
+		updateCluster = true
+		workloadIdentityEnabled := d.Get("workload_identity_enabled").(bool)
+		if workloadIdentityEnabled && !d.Get("oidc_issuer_enabled").(bool) {
+			return fmt.Errorf("`oidc_issuer_enabled` must be set to `true` to enable `workload_identity_enabled`")
+		}
+		existing.ManagedClusterProperties.SecurityProfile.WorkloadIdentity = &containerservice.ManagedClusterSecurityProfileWorkloadIdentity{
+			Enabled: utils.Bool(workloadIdentityEnabled),
+		}
 	}
 
 	if updateCluster {
-		// If Defender was explicitly disabled in a prior update then we should strip security profile from the request
-		// body to prevent errors in cases where Defender is disabled for the entire subscription
-		if !d.HasChanges("microsoft_defender") && len(d.Get("microsoft_defender").([]interface{})) == 0 {
-			existing.ManagedClusterProperties.SecurityProfile = nil
+		// If Defender was explicitly disabled in a prior update then we should strip it from the security profile in the
+		// request body to prevent errors in cases where Defender is disabled for the entire subscription
+		if !d.HasChanges("microsoft_defender") && len(d.Get("microsoft_defender").([]interface{})) == 0 && existing.ManagedClusterProperties.SecurityProfile != nil {
+			existing.ManagedClusterProperties.SecurityProfile.AzureDefender = nil
 		}
 
 		log.Printf("[DEBUG] Updating %s..", *id)
@@ -1672,6 +1758,28 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	for fieldName, configurationName := range kubernetesClusterMaintenanceWindows {
+		if !d.HasChange(fieldName) {
+			continue
+		}
+
+		parameters, err := expandKubernetesClusterMaintenanceWindowSchedule(d.Get(fieldName).([]interface{}), fieldName)
+		if err != nil {
+			return err
+		}
+
+		if parameters == nil {
+			if err := containersClient.MaintenanceWindowClient.Delete(ctx, *id, configurationName); err != nil {
+				return fmt.Errorf("deleting Maintenance Configuration %q for %s: %+v", configurationName, *id, err)
+			}
+			continue
+		}
+
+		if err := containersClient.MaintenanceWindowClient.CreateOrUpdate(ctx, *id, configurationName, *parameters); err != nil {
+			return fmt.Errorf("updating Maintenance Configuration %q for %s: %+v", configurationName, *id, err)
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceKubernetesClusterRead(d, meta)
@@ -1846,6 +1954,19 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `microsoft_defender`: %+v", err)
 		}
 
+		workloadIdentityEnabled := false
+		var azureKeyVaultKms *containerservice.AzureKeyVaultKms
+		if securityProfile := props.SecurityProfile; securityProfile != nil {
+			azureKeyVaultKms = securityProfile.AzureKeyVaultKms
+			if securityProfile.WorkloadIdentity != nil && securityProfile.WorkloadIdentity.Enabled != nil {
+				workloadIdentityEnabled = *securityProfile.WorkloadIdentity.Enabled
+			}
+		}
+		if err := d.Set("key_management_service", flattenKubernetesClusterAzureKeyVaultKms(azureKeyVaultKms)); err != nil {
+			return fmt.Errorf("setting `key_management_service`: %+v", err)
+		}
+		d.Set("workload_identity_enabled", workloadIdentityEnabled)
+
 		// adminProfile is only available for RBAC enabled clusters with AAD and local account is not disabled
 		if props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) {
 			adminProfile, err := client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterAdmin")
@@ -1885,6 +2006,16 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("maintenance_window", flattenKubernetesClusterMaintenanceConfiguration(props))
 	}
 
+	for fieldName, configurationName := range kubernetesClusterMaintenanceWindows {
+		configuration, err := meta.(*clients.Client).Containers.MaintenanceWindowClient.Get(ctx, *id, configurationName)
+		if err != nil && !utils.ResponseWasNotFound(configuration.Response) {
+			return fmt.Errorf("retrieving Maintenance Configuration %q for %s: %+v", configurationName, *id, err)
+		}
+		if err := d.Set(fieldName, flattenKubernetesClusterMaintenanceWindowSchedule(configuration.Properties)); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", fieldName, err)
+		}
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -2992,3 +3123,36 @@ func flattenKubernetesClusterMicrosoftDefender(input *containerservice.ManagedCl
 		},
 	}
 }
+
+func expandKubernetesClusterAzureKeyVaultKms(d *pluginsdk.ResourceData, input []interface{}) *containerservice.AzureKeyVaultKms {
+	if (len(input) == 0 || input[0] == nil) && d.HasChange("key_management_service") {
+		return &containerservice.AzureKeyVaultKms{
+			Enabled: utils.Bool(false),
+		}
+	} else if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &containerservice.AzureKeyVaultKms{
+		Enabled: utils.Bool(true),
+		KeyID:   utils.String(raw["key_vault_key_id"].(string)),
+	}
+}
+
+func flattenKubernetesClusterAzureKeyVaultKms(input *containerservice.AzureKeyVaultKms) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled {
+		return []interface{}{}
+	}
+
+	keyVaultKeyId := ""
+	if input.KeyID != nil {
+		keyVaultKeyId = *input.KeyID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id": keyVaultKeyId,
+		},
+	}
+}
@@ -0,0 +1,281 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterTrustedAccessRoleBindingResource struct{}
+
+type KubernetesClusterTrustedAccessRoleBindingModel struct {
+	Name                string   `tfschema:"name"`
+	KubernetesClusterId string   `tfschema:"kubernetes_cluster_id"`
+	SourceResourceId    string   `tfschema:"source_resource_id"`
+	Roles               []string `tfschema:"roles"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = KubernetesClusterTrustedAccessRoleBindingResource{}
+	_ sdk.ResourceWithCustomizeDiff = KubernetesClusterTrustedAccessRoleBindingResource{}
+)
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) ModelObject() interface{} {
+	return &KubernetesClusterTrustedAccessRoleBindingModel{}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_trusted_access_role_binding"
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.TrustedAccessRoleBindingID
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9-]{1,24}$`),
+				"`name` must be between 1 and 24 characters and can only contain alphanumeric characters and hyphens",
+			),
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"source_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"roles": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			var model KubernetesClusterTrustedAccessRoleBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := parse.ClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewTrustedAccessRoleBindingID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ManagedClusterName, model.Name)
+			existing, err := client.GetRoleBinding(ctx, id)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			binding := azuresdkhacks.TrustedAccessRoleBinding{
+				Properties: &azuresdkhacks.TrustedAccessRoleBindingProperties{
+					SourceResourceID: utils.String(model.SourceResourceId),
+					Roles:            model.Roles,
+				},
+			}
+			if err := client.CreateOrUpdateRoleBindingThenPoll(ctx, id, binding); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			id, err := parse.TrustedAccessRoleBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetRoleBinding(ctx, *id)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := KubernetesClusterTrustedAccessRoleBindingModel{
+				Name:                id.Name,
+				KubernetesClusterId: parse.NewClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName).ID(),
+			}
+
+			if props := existing.Properties; props != nil {
+				model.SourceResourceId = utils.NormalizeNilableString(props.SourceResourceID)
+				model.Roles = props.Roles
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			id, err := parse.TrustedAccessRoleBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterTrustedAccessRoleBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.GetRoleBinding(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			if metadata.ResourceData.HasChange("roles") {
+				existing.Properties.Roles = model.Roles
+			}
+			existing.Properties.ProvisioningState = nil
+
+			if err := client.CreateOrUpdateRoleBindingThenPoll(ctx, *id, existing); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			id, err := parse.TrustedAccessRoleBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteRoleBindingThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("source_resource_id") || !rd.NewValueKnown("roles") {
+				return nil
+			}
+
+			sourceResourceType := trustedAccessSourceResourceType(rd.Get("source_resource_id").(string))
+			if sourceResourceType == "" {
+				return nil
+			}
+
+			// roles are in the format `{sourceResourceType}/{roleName}`, e.g. `Microsoft.MachineLearningServices/workspaces/mlworkload`,
+			// the available roles can be found using the `azurerm_kubernetes_cluster_trusted_access_roles` Data Source
+			seen := make(map[string]struct{})
+			for _, raw := range rd.Get("roles").([]interface{}) {
+				role, _ := raw.(string)
+				if role == "" {
+					continue
+				}
+
+				if _, exists := seen[strings.ToLower(role)]; exists {
+					return fmt.Errorf("`roles` must be unique but %q was specified multiple times", role)
+				}
+				seen[strings.ToLower(role)] = struct{}{}
+
+				prefix := sourceResourceType + "/"
+				if !strings.HasPrefix(strings.ToLower(role), strings.ToLower(prefix)) || strings.Contains(role[len(prefix):], "/") {
+					return fmt.Errorf("role %q is not valid for a `source_resource_id` of type %q - roles must be in the format `%s{roleName}`, the available roles can be found using the `azurerm_kubernetes_cluster_trusted_access_roles` Data Source", role, sourceResourceType, prefix)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// trustedAccessSourceResourceType returns the Resource Type (e.g. `Microsoft.MachineLearningServices/workspaces`) of the
+// specified Resource ID, or an empty string if this can't be determined.
+func trustedAccessSourceResourceType(input string) string {
+	index := strings.LastIndex(strings.ToLower(input), "/providers/")
+	if index == -1 {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(input[index+len("/providers/"):], "/"), "/")
+	if len(segments) < 3 || len(segments)%2 == 0 {
+		return ""
+	}
+
+	resourceType := []string{segments[0]}
+	for i := 1; i < len(segments); i += 2 {
+		resourceType = append(resourceType, segments[i])
+	}
+	return strings.Join(resourceType, "/")
+}
@@ -0,0 +1,118 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterTrustedAccessRolesDataSource struct{}
+
+type KubernetesClusterTrustedAccessRolesDataSourceModel struct {
+	Location           string                                    `tfschema:"location"`
+	SourceResourceType string                                    `tfschema:"source_resource_type"`
+	Roles              []KubernetesClusterTrustedAccessRoleModel `tfschema:"role"`
+}
+
+type KubernetesClusterTrustedAccessRoleModel struct {
+	Name               string `tfschema:"name"`
+	SourceResourceType string `tfschema:"source_resource_type"`
+	BindingRole        string `tfschema:"binding_role"`
+}
+
+var _ sdk.DataSource = KubernetesClusterTrustedAccessRolesDataSource{}
+
+func (d KubernetesClusterTrustedAccessRolesDataSource) ModelObject() interface{} {
+	return &KubernetesClusterTrustedAccessRolesDataSourceModel{}
+}
+
+func (d KubernetesClusterTrustedAccessRolesDataSource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_trusted_access_roles"
+}
+
+func (d KubernetesClusterTrustedAccessRolesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"source_resource_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d KubernetesClusterTrustedAccessRolesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"role": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source_resource_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"binding_role": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d KubernetesClusterTrustedAccessRolesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state KubernetesClusterTrustedAccessRolesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			loc := location.Normalize(state.Location)
+			roles, err := client.ListRoles(ctx, loc)
+			if err != nil {
+				return fmt.Errorf("listing Trusted Access Roles (Subscription %q / Location %q): %+v", subscriptionId, loc, err)
+			}
+
+			state.Location = loc
+			state.Roles = make([]KubernetesClusterTrustedAccessRoleModel, 0)
+			for _, role := range roles {
+				sourceResourceType := utils.NormalizeNilableString(role.SourceResourceType)
+				if state.SourceResourceType != "" && !strings.EqualFold(state.SourceResourceType, sourceResourceType) {
+					continue
+				}
+
+				name := utils.NormalizeNilableString(role.Name)
+				state.Roles = append(state.Roles, KubernetesClusterTrustedAccessRoleModel{
+					Name:               name,
+					SourceResourceType: sourceResourceType,
+					BindingRole:        fmt.Sprintf("%s/%s", sourceResourceType, name),
+				})
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.ContainerService/locations/%s/trustedAccessRoles", subscriptionId, loc))
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesClusterTrustedAccessRolesDataSource struct{}
+
+func TestAccKubernetesClusterTrustedAccessRolesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_trusted_access_roles", "test")
+	r := KubernetesClusterTrustedAccessRolesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccKubernetesClusterTrustedAccessRolesDataSource_sourceResourceType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_trusted_access_roles", "test")
+	r := KubernetesClusterTrustedAccessRolesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.sourceResourceType(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role.0.source_resource_type").HasValue("Microsoft.MachineLearningServices/workspaces"),
+				check.That(data.ResourceName).Key("role.0.binding_role").Exists(),
+			),
+		},
+	})
+}
+
+func (KubernetesClusterTrustedAccessRolesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_kubernetes_cluster_trusted_access_roles" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (KubernetesClusterTrustedAccessRolesDataSource) sourceResourceType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_kubernetes_cluster_trusted_access_roles" "test" {
+  location             = "%s"
+  source_resource_type = "Microsoft.MachineLearningServices/workspaces"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,255 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetManagerResource struct{}
+
+type KubernetesFleetManagerModel struct {
+	Name              string                             `tfschema:"name"`
+	ResourceGroupName string                             `tfschema:"resource_group_name"`
+	Location          string                             `tfschema:"location"`
+	HubProfile        []KubernetesFleetManagerHubProfile `tfschema:"hub_profile"`
+	Tags              map[string]string                  `tfschema:"tags"`
+}
+
+type KubernetesFleetManagerHubProfile struct {
+	DnsPrefix         string `tfschema:"dns_prefix"`
+	Fqdn              string `tfschema:"fqdn"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+}
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetManagerResource{}
+
+func (r KubernetesFleetManagerResource) ModelObject() interface{} {
+	return &KubernetesFleetManagerModel{}
+}
+
+func (r KubernetesFleetManagerResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_manager"
+}
+
+func (r KubernetesFleetManagerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.KubernetesFleetManagerID
+}
+
+func (r KubernetesFleetManagerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`),
+				"`name` must be between 1 and 63 characters, can only contain lowercase alphanumeric characters and hyphens and must start and end with an alphanumeric character",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"hub_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dns_prefix": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]{0,52}[a-zA-Z0-9])?$`),
+							"`dns_prefix` must be between 1 and 54 characters, can only contain alphanumeric characters and hyphens and must start and end with an alphanumeric character",
+						),
+					},
+
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"kubernetes_version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r KubernetesFleetManagerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetManagerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model KubernetesFleetManagerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewKubernetesFleetManagerID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.GetFleet(ctx, id)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			fleet := azuresdkhacks.Fleet{
+				Location: utils.String(location.Normalize(model.Location)),
+				Properties: &azuresdkhacks.FleetProperties{
+					HubProfile: expandKubernetesFleetManagerHubProfile(model.HubProfile),
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+			if err := client.CreateOrUpdateFleetThenPoll(ctx, id, fleet); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetManagerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetFleet(ctx, *id)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := KubernetesFleetManagerModel{
+				Name:              id.FleetName,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.NormalizeNilable(existing.Location),
+				Tags:              tags.ToTypedObject(existing.Tags),
+			}
+			if props := existing.Properties; props != nil {
+				model.HubProfile = flattenKubernetesFleetManagerHubProfile(props.HubProfile)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesFleetManagerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetManagerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				fleet := azuresdkhacks.Fleet{
+					Location: utils.String(location.Normalize(model.Location)),
+					Properties: &azuresdkhacks.FleetProperties{
+						HubProfile: expandKubernetesFleetManagerHubProfile(model.HubProfile),
+					},
+					Tags: tags.FromTypedObject(model.Tags),
+				}
+				if err := client.CreateOrUpdateFleetThenPoll(ctx, *id, fleet); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetManagerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteFleetThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetManagerHubProfile(input []KubernetesFleetManagerHubProfile) *azuresdkhacks.FleetHubProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &azuresdkhacks.FleetHubProfile{
+		DNSPrefix: utils.String(input[0].DnsPrefix),
+	}
+}
+
+func flattenKubernetesFleetManagerHubProfile(input *azuresdkhacks.FleetHubProfile) []KubernetesFleetManagerHubProfile {
+	if input == nil {
+		return []KubernetesFleetManagerHubProfile{}
+	}
+
+	return []KubernetesFleetManagerHubProfile{
+		{
+			DnsPrefix:         utils.NormalizeNilableString(input.DNSPrefix),
+			Fqdn:              utils.NormalizeNilableString(input.Fqdn),
+			KubernetesVersion: utils.NormalizeNilableString(input.KubernetesVersion),
+		},
+	}
+}
@@ -0,0 +1,218 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetMemberResource struct{}
+
+type KubernetesFleetMemberModel struct {
+	Name                string `tfschema:"name"`
+	KubernetesFleetId   string `tfschema:"kubernetes_fleet_id"`
+	KubernetesClusterId string `tfschema:"kubernetes_cluster_id"`
+	Group               string `tfschema:"group"`
+}
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetMemberResource{}
+
+func (r KubernetesFleetMemberResource) ModelObject() interface{} {
+	return &KubernetesFleetMemberModel{}
+}
+
+func (r KubernetesFleetMemberResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_member"
+}
+
+func (r KubernetesFleetMemberResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.KubernetesFleetMemberID
+}
+
+func (r KubernetesFleetMemberResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,48}[a-z0-9])?$`),
+				"`name` must be between 1 and 50 characters, can only contain lowercase alphanumeric characters and hyphens and must start and end with an alphanumeric character",
+			),
+		},
+
+		"kubernetes_fleet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesFleetManagerID,
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		"group": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetMemberResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			var model KubernetesFleetMemberModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := parse.KubernetesFleetManagerID(model.KubernetesFleetId)
+			if err != nil {
+				return err
+			}
+
+			clusterId, err := parse.ClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewKubernetesFleetMemberID(fleetId.SubscriptionId, fleetId.ResourceGroup, fleetId.FleetName, model.Name)
+			existing, err := client.GetMember(ctx, id)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			member := azuresdkhacks.FleetMember{
+				Properties: &azuresdkhacks.FleetMemberProperties{
+					ClusterResourceID: utils.String(clusterId.ID()),
+				},
+			}
+			if model.Group != "" {
+				member.Properties.Group = utils.String(model.Group)
+			}
+			if err := client.CreateMemberThenPoll(ctx, id, member); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetMember(ctx, *id)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := KubernetesFleetMemberModel{
+				Name:              id.MemberName,
+				KubernetesFleetId: parse.NewKubernetesFleetManagerID(id.SubscriptionId, id.ResourceGroup, id.FleetName).ID(),
+			}
+			if props := existing.Properties; props != nil {
+				if props.ClusterResourceID != nil {
+					clusterId, err := parse.ClusterID(*props.ClusterResourceID)
+					if err != nil {
+						return err
+					}
+					model.KubernetesClusterId = clusterId.ID()
+				}
+				model.Group = utils.NormalizeNilableString(props.Group)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetMemberModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("group") {
+				member := azuresdkhacks.FleetMember{
+					Properties: &azuresdkhacks.FleetMemberProperties{
+						Group: utils.String(model.Group),
+					},
+				}
+				if err := client.UpdateMemberThenPoll(ctx, *id, member); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetMemberResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteMemberThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,143 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetMemberResource struct{}
+
+func TestAccKubernetesFleetMember_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_member", "test")
+	r := KubernetesFleetMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFleetMember_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_member", "test")
+	r := KubernetesFleetMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesFleetMember_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_member", "test")
+	r := KubernetesFleetMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.group(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.group(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group").HasValue("second"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KubernetesFleetMemberResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KubernetesFleetMemberID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.FleetClient.GetMember(ctx, *id)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KubernetesFleetMemberResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_fleet_member" "test" {
+  name                  = "acctestmember%d"
+  kubernetes_fleet_id   = azurerm_kubernetes_fleet_manager.test.id
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetMemberResource) group(data acceptance.TestData, group string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_fleet_member" "test" {
+  name                  = "acctestmember%d"
+  kubernetes_fleet_id   = azurerm_kubernetes_fleet_manager.test.id
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  group                 = %q
+}
+`, r.template(data), data.RandomInteger, group)
+}
+
+func (r KubernetesFleetMemberResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_member" "import" {
+  name                  = azurerm_kubernetes_fleet_member.test.name
+  kubernetes_fleet_id   = azurerm_kubernetes_fleet_member.test.kubernetes_fleet_id
+  kubernetes_cluster_id = azurerm_kubernetes_fleet_member.test.kubernetes_cluster_id
+}
+`, r.basic(data))
+}
+
+func (KubernetesFleetMemberResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_manager" "test" {
+  name                = "acctestfleet%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, KubernetesClusterNodePoolResource{}.templateConfig(data), data.RandomInteger)
+}
@@ -0,0 +1,430 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	fleetUpgradeTypeFull          = "Full"
+	fleetUpgradeTypeNodeImageOnly = "NodeImageOnly"
+
+	fleetNodeImageSelectionTypeConsistent = "Consistent"
+	fleetNodeImageSelectionTypeLatest     = "Latest"
+)
+
+type KubernetesFleetUpdateRunResource struct{}
+
+type KubernetesFleetUpdateRunModel struct {
+	Name                     string                                         `tfschema:"name"`
+	KubernetesFleetManagerId string                                         `tfschema:"kubernetes_fleet_manager_id"`
+	ManagedClusterUpdate     []KubernetesFleetUpdateRunManagedClusterUpdate `tfschema:"managed_cluster_update"`
+	Stage                    []KubernetesFleetUpdateRunStage                `tfschema:"stage"`
+	FleetUpdateStrategyId    string                                         `tfschema:"fleet_update_strategy_id"`
+}
+
+type KubernetesFleetUpdateRunManagedClusterUpdate struct {
+	Upgrade            []KubernetesFleetUpdateRunUpgrade            `tfschema:"upgrade"`
+	NodeImageSelection []KubernetesFleetUpdateRunNodeImageSelection `tfschema:"node_image_selection"`
+}
+
+type KubernetesFleetUpdateRunUpgrade struct {
+	Type              string `tfschema:"type"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+}
+
+type KubernetesFleetUpdateRunNodeImageSelection struct {
+	Type string `tfschema:"type"`
+}
+
+type KubernetesFleetUpdateRunStage struct {
+	Name                    string                               `tfschema:"name"`
+	Group                   []KubernetesFleetUpdateRunStageGroup `tfschema:"group"`
+	AfterStageWaitInSeconds int                                  `tfschema:"after_stage_wait_in_seconds"`
+}
+
+type KubernetesFleetUpdateRunStageGroup struct {
+	Name string `tfschema:"name"`
+}
+
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateRunResource{}
+
+func (r KubernetesFleetUpdateRunResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateRunModel{}
+}
+
+func (r KubernetesFleetUpdateRunResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_run"
+}
+
+func (r KubernetesFleetUpdateRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.KubernetesFleetUpdateRunID
+}
+
+func (r KubernetesFleetUpdateRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,48}[a-z0-9])?$`),
+				"`name` must be between 1 and 50 characters, can only contain lowercase alphanumeric characters and hyphens and must start and end with an alphanumeric character",
+			),
+		},
+
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesFleetManagerID,
+		},
+
+		"managed_cluster_update": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"upgrade": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										fleetUpgradeTypeFull,
+										fleetUpgradeTypeNodeImageOnly,
+									}, false),
+								},
+
+								"kubernetes_version": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"node_image_selection": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										fleetNodeImageSelectionTypeConsistent,
+										fleetNodeImageSelectionTypeLatest,
+									}, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"stage": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"fleet_update_strategy_id"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"group": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"after_stage_wait_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 3600),
+					},
+				},
+			},
+		},
+
+		"fleet_update_strategy_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"stage"},
+			ValidateFunc:  validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			var model KubernetesFleetUpdateRunModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := parse.KubernetesFleetManagerID(model.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewKubernetesFleetUpdateRunID(fleetId.SubscriptionId, fleetId.ResourceGroup, fleetId.FleetName, model.Name)
+			existing, err := client.GetUpdateRun(ctx, id)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			updateRun := azuresdkhacks.FleetUpdateRun{
+				Properties: expandKubernetesFleetUpdateRunProperties(model),
+			}
+			if err := client.CreateOrUpdateUpdateRunThenPoll(ctx, id, updateRun); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetUpdateRun(ctx, *id)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := KubernetesFleetUpdateRunModel{
+				Name:                     id.UpdateRunName,
+				KubernetesFleetManagerId: parse.NewKubernetesFleetManagerID(id.SubscriptionId, id.ResourceGroup, id.FleetName).ID(),
+			}
+			if props := existing.Properties; props != nil {
+				model.ManagedClusterUpdate = flattenKubernetesFleetUpdateRunManagedClusterUpdate(props.ManagedClusterUpdate)
+				model.FleetUpdateStrategyId = utils.NormalizeNilableString(props.UpdateStrategyID)
+
+				// the stages are returned from the Update Strategy when one is used, so are only set when they've been specified
+				if props.UpdateStrategyID == nil && props.Strategy != nil {
+					model.Stage = flattenKubernetesFleetUpdateRunStages(props.Strategy.Stages)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFleetUpdateRunModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// an Update Run can only be updated before it's been started, which is validated by the API
+			updateRun := azuresdkhacks.FleetUpdateRun{
+				Properties: expandKubernetesFleetUpdateRunProperties(model),
+			}
+			if err := client.CreateOrUpdateUpdateRunThenPoll(ctx, *id, updateRun); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetClient
+
+			id, err := parse.KubernetesFleetUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteUpdateRunThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateRunProperties(input KubernetesFleetUpdateRunModel) *azuresdkhacks.FleetUpdateRunProperties {
+	props := azuresdkhacks.FleetUpdateRunProperties{
+		ManagedClusterUpdate: expandKubernetesFleetUpdateRunManagedClusterUpdate(input.ManagedClusterUpdate),
+	}
+
+	if input.FleetUpdateStrategyId != "" {
+		props.UpdateStrategyID = utils.String(input.FleetUpdateStrategyId)
+	}
+
+	if len(input.Stage) > 0 {
+		props.Strategy = &azuresdkhacks.FleetUpdateRunStrategy{
+			Stages: expandKubernetesFleetUpdateRunStages(input.Stage),
+		}
+	}
+
+	return &props
+}
+
+func expandKubernetesFleetUpdateRunManagedClusterUpdate(input []KubernetesFleetUpdateRunManagedClusterUpdate) *azuresdkhacks.FleetManagedClusterUpdate {
+	if len(input) == 0 {
+		return nil
+	}
+	update := input[0]
+
+	result := azuresdkhacks.FleetManagedClusterUpdate{}
+	if len(update.Upgrade) > 0 {
+		result.Upgrade = azuresdkhacks.FleetManagedClusterUpgradeSpec{
+			Type: update.Upgrade[0].Type,
+		}
+		if v := update.Upgrade[0].KubernetesVersion; v != "" {
+			result.Upgrade.KubernetesVersion = utils.String(v)
+		}
+	}
+
+	if len(update.NodeImageSelection) > 0 {
+		result.NodeImageSelection = &azuresdkhacks.FleetNodeImageSelection{
+			Type: update.NodeImageSelection[0].Type,
+		}
+	}
+
+	return &result
+}
+
+func expandKubernetesFleetUpdateRunStages(input []KubernetesFleetUpdateRunStage) []azuresdkhacks.FleetUpdateStage {
+	results := make([]azuresdkhacks.FleetUpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]azuresdkhacks.FleetUpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, azuresdkhacks.FleetUpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		results = append(results, azuresdkhacks.FleetUpdateStage{
+			Name:                    stage.Name,
+			Groups:                  &groups,
+			AfterStageWaitInSeconds: utils.Int64(int64(stage.AfterStageWaitInSeconds)),
+		})
+	}
+	return results
+}
+
+func flattenKubernetesFleetUpdateRunManagedClusterUpdate(input *azuresdkhacks.FleetManagedClusterUpdate) []KubernetesFleetUpdateRunManagedClusterUpdate {
+	if input == nil {
+		return []KubernetesFleetUpdateRunManagedClusterUpdate{}
+	}
+
+	result := KubernetesFleetUpdateRunManagedClusterUpdate{
+		Upgrade: []KubernetesFleetUpdateRunUpgrade{
+			{
+				Type:              input.Upgrade.Type,
+				KubernetesVersion: utils.NormalizeNilableString(input.Upgrade.KubernetesVersion),
+			},
+		},
+	}
+
+	if v := input.NodeImageSelection; v != nil {
+		result.NodeImageSelection = []KubernetesFleetUpdateRunNodeImageSelection{
+			{
+				Type: v.Type,
+			},
+		}
+	}
+
+	return []KubernetesFleetUpdateRunManagedClusterUpdate{result}
+}
+
+func flattenKubernetesFleetUpdateRunStages(input []azuresdkhacks.FleetUpdateStage) []KubernetesFleetUpdateRunStage {
+	results := make([]KubernetesFleetUpdateRunStage, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetUpdateRunStageGroup, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetUpdateRunStageGroup{
+					Name: group.Name,
+				})
+			}
+		}
+
+		waitInSeconds := 0
+		if stage.AfterStageWaitInSeconds != nil {
+			waitInSeconds = int(*stage.AfterStageWaitInSeconds)
+		}
+
+		results = append(results, KubernetesFleetUpdateRunStage{
+			Name:                    stage.Name,
+			Group:                   groups,
+			AfterStageWaitInSeconds: waitInSeconds,
+		})
+	}
+	return results
+}
@@ -0,0 +1,205 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFleetUpdateRunResource struct{}
+
+func TestAccKubernetesFleetUpdateRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFleetUpdateRun_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesFleetUpdateRun_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("stage.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFleetUpdateRun_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_update_run", "test")
+	r := KubernetesFleetUpdateRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KubernetesFleetUpdateRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KubernetesFleetUpdateRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.FleetClient.GetUpdateRun(ctx, *id)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KubernetesFleetUpdateRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_fleet_update_run" "test" {
+  name                        = "acctestrun%d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+
+  managed_cluster_update {
+    upgrade {
+      type = "NodeImageOnly"
+    }
+  }
+
+  depends_on = [azurerm_kubernetes_fleet_member.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetUpdateRunResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_fleet_update_run" "test" {
+  name                        = "acctestrun%d"
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+
+  managed_cluster_update {
+    upgrade {
+      type = "NodeImageOnly"
+    }
+
+    node_image_selection {
+      type = "Latest"
+    }
+  }
+
+  stage {
+    name = "first"
+
+    group {
+      name = "canary"
+    }
+
+    after_stage_wait_in_seconds = 60
+  }
+
+  stage {
+    name = "second"
+
+    group {
+      name = "production"
+    }
+  }
+
+  depends_on = [azurerm_kubernetes_fleet_member.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KubernetesFleetUpdateRunResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_update_run" "import" {
+  name                        = azurerm_kubernetes_fleet_update_run.test.name
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_update_run.test.kubernetes_fleet_manager_id
+
+  managed_cluster_update {
+    upgrade {
+      type = "NodeImageOnly"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (KubernetesFleetUpdateRunResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_fleet_manager" "test" {
+  name                = "acctestfleet%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_kubernetes_fleet_member" "test" {
+  name                  = "acctestmember%d"
+  kubernetes_fleet_id   = azurerm_kubernetes_fleet_manager.test.id
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  group                 = "canary"
+}
+`, KubernetesClusterNodePoolResource{}.templateConfig(data), data.RandomInteger, data.RandomInteger)
+}
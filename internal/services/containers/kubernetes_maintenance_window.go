@@ -0,0 +1,321 @@
+package containers
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	maintenanceFrequencyDaily           = "Daily"
+	maintenanceFrequencyWeekly          = "Weekly"
+	maintenanceFrequencyAbsoluteMonthly = "AbsoluteMonthly"
+	maintenanceFrequencyRelativeMonthly = "RelativeMonthly"
+)
+
+// kubernetesClusterMaintenanceWindows maps the fields within the Kubernetes Cluster resource to the names of the
+// AKS-managed Maintenance Configurations which they're mapped to
+var kubernetesClusterMaintenanceWindows = map[string]string{
+	"maintenance_window_auto_upgrade": azuresdkhacks.MaintenanceConfigurationNameAutoUpgrade,
+	"maintenance_window_node_os":      azuresdkhacks.MaintenanceConfigurationNameNodeOS,
+}
+
+// maintenanceDateFormat is the format used by the API for the `startDate` and `notAllowedDates` of a Maintenance Window
+const maintenanceDateFormat = "2006-01-02"
+
+func schemaKubernetesClusterMaintenanceWindowSchedule() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"frequency": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						maintenanceFrequencyDaily,
+						maintenanceFrequencyWeekly,
+						maintenanceFrequencyAbsoluteMonthly,
+						maintenanceFrequencyRelativeMonthly,
+					}, false),
+				},
+
+				"interval": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"duration": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(4, 24),
+				},
+
+				"day_of_week": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(containerservice.WeekDaySunday),
+						string(containerservice.WeekDayMonday),
+						string(containerservice.WeekDayTuesday),
+						string(containerservice.WeekDayWednesday),
+						string(containerservice.WeekDayThursday),
+						string(containerservice.WeekDayFriday),
+						string(containerservice.WeekDaySaturday),
+					}, false),
+				},
+
+				"day_of_month": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 31),
+				},
+
+				"week_index": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"First",
+						"Second",
+						"Third",
+						"Fourth",
+						"Last",
+					}, false),
+				},
+
+				"start_date": {
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					Computed:         true,
+					DiffSuppressFunc: suppressMaintenanceWindowDate,
+					ValidateFunc:     validation.IsRFC3339Time,
+				},
+
+				"start_time": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+						"`start_time` must be in the format `HH:mm`, for example `03:00`",
+					),
+				},
+
+				"utc_offset": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^(-|\+)[0-9]{2}:[0-9]{2}$`),
+						"`utc_offset` must be in the format `+/-HH:mm`, for example `+05:30`",
+					),
+				},
+
+				"not_allowed": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"end": {
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppressMaintenanceWindowDate,
+								ValidateFunc:     validation.IsRFC3339Time,
+							},
+
+							"start": {
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppressMaintenanceWindowDate,
+								ValidateFunc:     validation.IsRFC3339Time,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandKubernetesClusterMaintenanceWindowSchedule(input []interface{}, fieldName string) (*azuresdkhacks.MaintenanceWindowConfiguration, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	value := input[0].(map[string]interface{})
+
+	frequency := value["frequency"].(string)
+	interval := int64(value["interval"].(int))
+	dayOfWeek := value["day_of_week"].(string)
+	dayOfMonth := int64(value["day_of_month"].(int))
+	weekIndex := value["week_index"].(string)
+
+	schedule := azuresdkhacks.MaintenanceSchedule{}
+	switch frequency {
+	case maintenanceFrequencyDaily:
+		schedule.Daily = &azuresdkhacks.DailySchedule{
+			IntervalDays: interval,
+		}
+	case maintenanceFrequencyWeekly:
+		if dayOfWeek == "" {
+			return nil, fmt.Errorf("`%s.0.day_of_week` must be specified when `frequency` is `Weekly`", fieldName)
+		}
+		schedule.Weekly = &azuresdkhacks.WeeklySchedule{
+			IntervalWeeks: interval,
+			DayOfWeek:     dayOfWeek,
+		}
+	case maintenanceFrequencyAbsoluteMonthly:
+		if dayOfMonth == 0 {
+			return nil, fmt.Errorf("`%s.0.day_of_month` must be specified when `frequency` is `AbsoluteMonthly`", fieldName)
+		}
+		schedule.AbsoluteMonthly = &azuresdkhacks.AbsoluteMonthlySchedule{
+			IntervalMonths: interval,
+			DayOfMonth:     dayOfMonth,
+		}
+	case maintenanceFrequencyRelativeMonthly:
+		if dayOfWeek == "" || weekIndex == "" {
+			return nil, fmt.Errorf("`%[1]s.0.day_of_week` and `%[1]s.0.week_index` must be specified when `frequency` is `RelativeMonthly`", fieldName)
+		}
+		schedule.RelativeMonthly = &azuresdkhacks.RelativeMonthlySchedule{
+			IntervalMonths: interval,
+			WeekIndex:      weekIndex,
+			DayOfWeek:      dayOfWeek,
+		}
+	}
+
+	window := azuresdkhacks.MaintenanceWindow{
+		Schedule:        &schedule,
+		DurationHours:   int64(value["duration"].(int)),
+		NotAllowedDates: expandKubernetesClusterMaintenanceWindowNotAllowed(value["not_allowed"].(*pluginsdk.Set).List()),
+	}
+
+	if v := value["start_date"].(string); v != "" {
+		startDate, _ := time.Parse(time.RFC3339, v)
+		window.StartDate = utils.String(startDate.Format(maintenanceDateFormat))
+	}
+	if v := value["start_time"].(string); v != "" {
+		window.StartTime = utils.String(v)
+	}
+	if v := value["utc_offset"].(string); v != "" {
+		window.UtcOffset = utils.String(v)
+	}
+
+	return &azuresdkhacks.MaintenanceWindowConfiguration{
+		Properties: &azuresdkhacks.MaintenanceWindowConfigurationProperties{
+			MaintenanceWindow: &window,
+		},
+	}, nil
+}
+
+func expandKubernetesClusterMaintenanceWindowNotAllowed(input []interface{}) *[]azuresdkhacks.DateSpan {
+	results := make([]azuresdkhacks.DateSpan, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		start, _ := time.Parse(time.RFC3339, v["start"].(string))
+		end, _ := time.Parse(time.RFC3339, v["end"].(string))
+		results = append(results, azuresdkhacks.DateSpan{
+			Start: start.Format(maintenanceDateFormat),
+			End:   end.Format(maintenanceDateFormat),
+		})
+	}
+	return &results
+}
+
+func flattenKubernetesClusterMaintenanceWindowSchedule(input *azuresdkhacks.MaintenanceWindowConfigurationProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.MaintenanceWindow == nil {
+		return results
+	}
+	window := input.MaintenanceWindow
+
+	frequency := ""
+	interval := int64(0)
+	dayOfWeek := ""
+	dayOfMonth := int64(0)
+	weekIndex := ""
+	if schedule := window.Schedule; schedule != nil {
+		if v := schedule.Daily; v != nil {
+			frequency = maintenanceFrequencyDaily
+			interval = v.IntervalDays
+		}
+		if v := schedule.Weekly; v != nil {
+			frequency = maintenanceFrequencyWeekly
+			interval = v.IntervalWeeks
+			dayOfWeek = v.DayOfWeek
+		}
+		if v := schedule.AbsoluteMonthly; v != nil {
+			frequency = maintenanceFrequencyAbsoluteMonthly
+			interval = v.IntervalMonths
+			dayOfMonth = v.DayOfMonth
+		}
+		if v := schedule.RelativeMonthly; v != nil {
+			frequency = maintenanceFrequencyRelativeMonthly
+			interval = v.IntervalMonths
+			dayOfWeek = v.DayOfWeek
+			weekIndex = v.WeekIndex
+		}
+	}
+
+	startDate := ""
+	if window.StartDate != nil {
+		if v, err := time.Parse(maintenanceDateFormat, *window.StartDate); err == nil {
+			startDate = v.Format(time.RFC3339)
+		}
+	}
+
+	return append(results, map[string]interface{}{
+		"frequency":    frequency,
+		"interval":     int(interval),
+		"duration":     int(window.DurationHours),
+		"day_of_week":  dayOfWeek,
+		"day_of_month": int(dayOfMonth),
+		"week_index":   weekIndex,
+		"start_date":   startDate,
+		"start_time":   utils.NormalizeNilableString(window.StartTime),
+		"utc_offset":   utils.NormalizeNilableString(window.UtcOffset),
+		"not_allowed":  flattenKubernetesClusterMaintenanceWindowNotAllowed(window.NotAllowedDates),
+	})
+}
+
+func flattenKubernetesClusterMaintenanceWindowNotAllowed(input *[]azuresdkhacks.DateSpan) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var start, end string
+		if v, err := time.Parse(maintenanceDateFormat, item.Start); err == nil {
+			start = v.Format(time.RFC3339)
+		}
+		if v, err := time.Parse(maintenanceDateFormat, item.End); err == nil {
+			end = v.Format(time.RFC3339)
+		}
+		results = append(results, map[string]interface{}{
+			"end":   end,
+			"start": start,
+		})
+	}
+	return results
+}
+
+// suppressMaintenanceWindowDate suppresses the difference between two RFC3339 times on the same date, since only the
+// date is used by the API for the `start_date` and `not_allowed` fields of a Maintenance Window
+func suppressMaintenanceWindowDate(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Format(maintenanceDateFormat) == newTime.Format(maintenanceDateFormat)
+}
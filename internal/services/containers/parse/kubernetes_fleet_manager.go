@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type KubernetesFleetManagerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FleetName      string
+}
+
+func NewKubernetesFleetManagerID(subscriptionId, resourceGroup, fleetName string) KubernetesFleetManagerId {
+	return KubernetesFleetManagerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FleetName:      fleetName,
+	}
+}
+
+func (id KubernetesFleetManagerId) String() string {
+	segments := []string{
+		fmt.Sprintf("Fleet Name %q", id.FleetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Kubernetes Fleet Manager", segmentsStr)
+}
+
+func (id KubernetesFleetManagerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FleetName)
+}
+
+// KubernetesFleetManagerID parses a KubernetesFleetManager ID into an KubernetesFleetManagerId struct
+func KubernetesFleetManagerID(input string) (*KubernetesFleetManagerId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := KubernetesFleetManagerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FleetName, err = id.PopSegment("fleets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
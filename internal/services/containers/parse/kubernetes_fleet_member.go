@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type KubernetesFleetMemberId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FleetName      string
+	MemberName     string
+}
+
+func NewKubernetesFleetMemberID(subscriptionId, resourceGroup, fleetName, memberName string) KubernetesFleetMemberId {
+	return KubernetesFleetMemberId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FleetName:      fleetName,
+		MemberName:     memberName,
+	}
+}
+
+func (id KubernetesFleetMemberId) String() string {
+	segments := []string{
+		fmt.Sprintf("Member Name %q", id.MemberName),
+		fmt.Sprintf("Fleet Name %q", id.FleetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Kubernetes Fleet Member", segmentsStr)
+}
+
+func (id KubernetesFleetMemberId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s/members/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FleetName, id.MemberName)
+}
+
+// KubernetesFleetMemberID parses a KubernetesFleetMember ID into an KubernetesFleetMemberId struct
+func KubernetesFleetMemberID(input string) (*KubernetesFleetMemberId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := KubernetesFleetMemberId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FleetName, err = id.PopSegment("fleets"); err != nil {
+		return nil, err
+	}
+	if resourceId.MemberName, err = id.PopSegment("members"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type KubernetesFleetUpdateRunId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	FleetName      string
+	UpdateRunName  string
+}
+
+func NewKubernetesFleetUpdateRunID(subscriptionId, resourceGroup, fleetName, updateRunName string) KubernetesFleetUpdateRunId {
+	return KubernetesFleetUpdateRunId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		FleetName:      fleetName,
+		UpdateRunName:  updateRunName,
+	}
+}
+
+func (id KubernetesFleetUpdateRunId) String() string {
+	segments := []string{
+		fmt.Sprintf("Update Run Name %q", id.UpdateRunName),
+		fmt.Sprintf("Fleet Name %q", id.FleetName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Kubernetes Fleet Update Run", segmentsStr)
+}
+
+func (id KubernetesFleetUpdateRunId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s/updateRuns/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FleetName, id.UpdateRunName)
+}
+
+// KubernetesFleetUpdateRunID parses a KubernetesFleetUpdateRun ID into an KubernetesFleetUpdateRunId struct
+func KubernetesFleetUpdateRunID(input string) (*KubernetesFleetUpdateRunId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := KubernetesFleetUpdateRunId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FleetName, err = id.PopSegment("fleets"); err != nil {
+		return nil, err
+	}
+	if resourceId.UpdateRunName, err = id.PopSegment("updateRuns"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type TrustedAccessRoleBindingId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ManagedClusterName string
+	Name               string
+}
+
+func NewTrustedAccessRoleBindingID(subscriptionId, resourceGroup, managedClusterName, name string) TrustedAccessRoleBindingId {
+	return TrustedAccessRoleBindingId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ManagedClusterName: managedClusterName,
+		Name:               name,
+	}
+}
+
+func (id TrustedAccessRoleBindingId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Managed Cluster Name %q", id.ManagedClusterName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Trusted Access Role Binding", segmentsStr)
+}
+
+func (id TrustedAccessRoleBindingId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s/trustedAccessRoleBindings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.Name)
+}
+
+// TrustedAccessRoleBindingID parses a TrustedAccessRoleBinding ID into an TrustedAccessRoleBindingId struct
+func TrustedAccessRoleBindingID(input string) (*TrustedAccessRoleBindingId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TrustedAccessRoleBindingId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("trustedAccessRoleBindings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -47,12 +47,19 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		KubernetesClusterTrustedAccessRolesDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ContainerRegistryTaskResource{},
 		ContainerConnectedRegistryResource{},
+		KubernetesClusterTrustedAccessRoleBindingResource{},
+		KubernetesClusterNodePoolSnapshotResource{},
+		KubernetesFleetManagerResource{},
+		KubernetesFleetMemberResource{},
+		KubernetesFleetUpdateRunResource{},
 	}
 }
@@ -9,3 +9,8 @@ package containers
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Registry -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Webhook -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1/webhooks/webhook1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ContainerConnectedRegistry -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1/connectedRegistries/registry1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=TrustedAccessRoleBinding -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/trustedAccessRoleBindings/binding1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NodePoolSnapshot -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/snapshots/snapshot1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=KubernetesFleetManager -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=KubernetesFleetMember -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/members/member1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=KubernetesFleetUpdateRun -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/fleets/fleet1/updateRuns/run1
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-05-15/services"
 )
 
 type Client struct {
@@ -14,6 +15,7 @@ type Client struct {
 	MongoDbClient                    *documentdb.MongoDBResourcesClient
 	NotebookWorkspaceClient          *documentdb.NotebookWorkspacesClient
 	RestorableDatabaseAccountsClient *documentdb.RestorableDatabaseAccountsClient
+	ServicesClient                   *services.ServicesClient
 	SqlClient                        *documentdb.SQLResourcesClient
 	SqlResourceClient                *documentdb.SQLResourcesClient
 	TableClient                      *documentdb.TableResourcesClient
@@ -44,6 +46,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	restorableDatabaseAccountsClient := documentdb.NewRestorableDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&restorableDatabaseAccountsClient.Client, o.ResourceManagerAuthorizer)
 
+	servicesClient := services.NewServicesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
+
 	sqlClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlClient.Client, o.ResourceManagerAuthorizer)
 
@@ -62,6 +67,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		MongoDbClient:                    &mongoDbClient,
 		NotebookWorkspaceClient:          &notebookWorkspaceClient,
 		RestorableDatabaseAccountsClient: &restorableDatabaseAccountsClient,
+		ServicesClient:                   &servicesClient,
 		SqlClient:                        &sqlClient,
 		SqlResourceClient:                &sqlResourceClient,
 		TableClient:                      &tableClient,
@@ -682,7 +682,12 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if v, ok := d.GetOk("restore"); ok {
+		if createMode != string(documentdb.CreateModeRestore) {
+			return fmt.Errorf("`create_mode` must be `Restore` when `restore` is specified")
+		}
 		account.DatabaseAccountCreateUpdateProperties.RestoreParameters = expandCosmosdbAccountRestoreParameters(v.([]interface{}))
+	} else if createMode == string(documentdb.CreateModeRestore) {
+		return fmt.Errorf("`restore` must be specified when `create_mode` is `Restore`")
 	}
 
 	if v, ok := d.GetOk("mongo_server_version"); ok {
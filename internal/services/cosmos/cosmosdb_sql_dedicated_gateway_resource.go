@@ -0,0 +1,241 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-05-15/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbSqlDedicatedGatewayModel struct {
+	CosmosDbAccountId         string `tfschema:"cosmosdb_account_id"`
+	InstanceCount             int64  `tfschema:"instance_count"`
+	InstanceSize              string `tfschema:"instance_size"`
+	Endpoint                  string `tfschema:"endpoint"`
+	PrimaryConnectionString   string `tfschema:"primary_connection_string"`
+	SecondaryConnectionString string `tfschema:"secondary_connection_string"`
+}
+
+var _ sdk.Resource = CosmosDbSqlDedicatedGatewayResource{}
+var _ sdk.ResourceWithUpdate = CosmosDbSqlDedicatedGatewayResource{}
+
+type CosmosDbSqlDedicatedGatewayResource struct{}
+
+// the name of the Service must match the type of the Service, so there can only be a single Dedicated Gateway per account
+const cosmosDbSqlDedicatedGatewayServiceName = "SqlDedicatedGateway"
+
+func (r CosmosDbSqlDedicatedGatewayResource) ResourceType() string {
+	return "azurerm_cosmosdb_sql_dedicated_gateway"
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) ModelObject() interface{} {
+	return &CosmosDbSqlDedicatedGatewayModel{}
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return services.ValidateServiceID
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cosmosdb_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DatabaseAccountID,
+		},
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 5),
+		},
+
+		"instance_size": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(services.PossibleValuesForServiceSize(), false),
+		},
+	}
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_connection_string": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.ServicesClient
+
+			var model CosmosDbSqlDedicatedGatewayModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := parse.DatabaseAccountID(model.CosmosDbAccountId)
+			if err != nil {
+				return fmt.Errorf("parsing `cosmosdb_account_id`: %v", err)
+			}
+
+			id := services.NewServiceID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, cosmosDbSqlDedicatedGatewayServiceName)
+
+			metadata.Logger.Infof("Import check for %s", id)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+			if err := client.CreateThenPoll(ctx, id, expandCosmosDbSqlDedicatedGateway(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.ServicesClient
+
+			id, err := services.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbSqlDedicatedGatewayModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+			if err := client.CreateThenPoll(ctx, *id, expandCosmosDbSqlDedicatedGateway(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.ServicesClient
+			accountsClient := metadata.Client.Cosmos.DatabaseClient
+
+			id, err := services.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbSqlDedicatedGatewayModel{
+				CosmosDbAccountId: parse.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroupName, id.DatabaseAccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.InstanceCount = utils.NormaliseNilableInt64(props.InstanceCount)
+				if props.InstanceSize != nil {
+					state.InstanceSize = string(*props.InstanceSize)
+				}
+				state.Endpoint = utils.NormalizeNilableString(props.SqlDedicatedGatewayEndpoint)
+			}
+
+			// the Dedicated Gateway is authenticated using the keys of the CosmosDB Account
+			if state.Endpoint != "" {
+				keys, err := accountsClient.ListKeys(ctx, id.ResourceGroupName, id.DatabaseAccountName)
+				if err != nil {
+					return fmt.Errorf("listing keys for the CosmosDB Account for %s: %+v", *id, err)
+				}
+
+				if keys.PrimaryMasterKey != nil {
+					state.PrimaryConnectionString = fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s;", state.Endpoint, *keys.PrimaryMasterKey)
+				}
+				if keys.SecondaryMasterKey != nil {
+					state.SecondaryConnectionString = fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s;", state.Endpoint, *keys.SecondaryMasterKey)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbSqlDedicatedGatewayResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.ServicesClient
+
+			id, err := services.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("Deleting %s", id)
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCosmosDbSqlDedicatedGateway(model CosmosDbSqlDedicatedGatewayModel) services.ServiceResourceCreateUpdateParameters {
+	instanceSize := services.ServiceSize(model.InstanceSize)
+	serviceType := services.ServiceTypeSqlDedicatedGateway
+
+	return services.ServiceResourceCreateUpdateParameters{
+		Properties: &services.ServiceResourceCreateUpdateProperties{
+			InstanceCount: utils.Int64(model.InstanceCount),
+			InstanceSize:  &instanceSize,
+			ServiceType:   &serviceType,
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package services
+
+import "strings"
+
+type ServiceSize string
+
+const (
+	ServiceSizeCosmosD16s ServiceSize = "Cosmos.D16s"
+	ServiceSizeCosmosD4s  ServiceSize = "Cosmos.D4s"
+	ServiceSizeCosmosD8s  ServiceSize = "Cosmos.D8s"
+)
+
+func PossibleValuesForServiceSize() []string {
+	return []string{
+		string(ServiceSizeCosmosD16s),
+		string(ServiceSizeCosmosD4s),
+		string(ServiceSizeCosmosD8s),
+	}
+}
+
+func parseServiceSize(input string) (*ServiceSize, error) {
+	vals := map[string]ServiceSize{
+		"cosmos.d16s": ServiceSizeCosmosD16s,
+		"cosmos.d4s":  ServiceSizeCosmosD4s,
+		"cosmos.d8s":  ServiceSizeCosmosD8s,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ServiceSize(input)
+	return &out, nil
+}
+
+type ServiceStatus string
+
+const (
+	ServiceStatusCreating ServiceStatus = "Creating"
+	ServiceStatusDeleting ServiceStatus = "Deleting"
+	ServiceStatusError    ServiceStatus = "Error"
+	ServiceStatusRunning  ServiceStatus = "Running"
+	ServiceStatusStopped  ServiceStatus = "Stopped"
+	ServiceStatusUpdating ServiceStatus = "Updating"
+)
+
+func PossibleValuesForServiceStatus() []string {
+	return []string{
+		string(ServiceStatusCreating),
+		string(ServiceStatusDeleting),
+		string(ServiceStatusError),
+		string(ServiceStatusRunning),
+		string(ServiceStatusStopped),
+		string(ServiceStatusUpdating),
+	}
+}
+
+func parseServiceStatus(input string) (*ServiceStatus, error) {
+	vals := map[string]ServiceStatus{
+		"creating": ServiceStatusCreating,
+		"deleting": ServiceStatusDeleting,
+		"error":    ServiceStatusError,
+		"running":  ServiceStatusRunning,
+		"stopped":  ServiceStatusStopped,
+		"updating": ServiceStatusUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ServiceStatus(input)
+	return &out, nil
+}
+
+type ServiceType string
+
+const (
+	ServiceTypeDataTransfer             ServiceType = "DataTransfer"
+	ServiceTypeGraphAPICompute          ServiceType = "GraphAPICompute"
+	ServiceTypeMaterializedViewsBuilder ServiceType = "MaterializedViewsBuilder"
+	ServiceTypeSqlDedicatedGateway      ServiceType = "SqlDedicatedGateway"
+)
+
+func PossibleValuesForServiceType() []string {
+	return []string{
+		string(ServiceTypeDataTransfer),
+		string(ServiceTypeGraphAPICompute),
+		string(ServiceTypeMaterializedViewsBuilder),
+		string(ServiceTypeSqlDedicatedGateway),
+	}
+}
+
+func parseServiceType(input string) (*ServiceType, error) {
+	vals := map[string]ServiceType{
+		"datatransfer":             ServiceTypeDataTransfer,
+		"graphapicompute":          ServiceTypeGraphAPICompute,
+		"materializedviewsbuilder": ServiceTypeMaterializedViewsBuilder,
+		"sqldedicatedgateway":      ServiceTypeSqlDedicatedGateway,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ServiceType(input)
+	return &out, nil
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ServiceId{}
+
+// ServiceId is a struct representing the Resource ID for a Service
+type ServiceId struct {
+	SubscriptionId      string
+	ResourceGroupName   string
+	DatabaseAccountName string
+	ServiceName         string
+}
+
+// NewServiceID returns a new ServiceId struct
+func NewServiceID(subscriptionId string, resourceGroupName string, databaseAccountName string, serviceName string) ServiceId {
+	return ServiceId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroupName:   resourceGroupName,
+		DatabaseAccountName: databaseAccountName,
+		ServiceName:         serviceName,
+	}
+}
+
+// ParseServiceID parses 'input' into a ServiceId
+func ParseServiceID(input string) (*ServiceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ServiceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ServiceId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DatabaseAccountName, ok = parsed.Parsed["databaseAccountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'databaseAccountName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseServiceIDInsensitively parses 'input' case-insensitively into a ServiceId
+// note: this method should only be used for API response data and not user input
+func ParseServiceIDInsensitively(input string) (*ServiceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ServiceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ServiceId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DatabaseAccountName, ok = parsed.Parsed["databaseAccountName"]; !ok {
+		return nil, fmt.Errorf("the segment 'databaseAccountName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateServiceID checks that 'input' can be parsed as a Service ID
+func ValidateServiceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseServiceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Service ID
+func (id ServiceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/services/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DatabaseAccountName, id.ServiceName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Service ID
+func (id ServiceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticDatabaseAccounts", "databaseAccounts", "databaseAccounts"),
+		resourceids.UserSpecifiedSegment("databaseAccountName", "databaseAccountValue"),
+		resourceids.StaticSegment("staticServices", "services", "services"),
+		resourceids.UserSpecifiedSegment("serviceName", "serviceValue"),
+	}
+}
+
+// String returns a human-readable description of this Service ID
+func (id ServiceId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Database Account Name: %q", id.DatabaseAccountName),
+		fmt.Sprintf("Service Name: %q", id.ServiceName),
+	}
+	return fmt.Sprintf("Service (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package services
+
+type RegionalServiceResource struct {
+	Location                    *string        `json:"location,omitempty"`
+	Name                        *string        `json:"name,omitempty"`
+	SqlDedicatedGatewayEndpoint *string        `json:"sqlDedicatedGatewayEndpoint,omitempty"`
+	Status                      *ServiceStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,8 @@
+package services
+
+type ServiceResource struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *ServiceResourceProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package services
+
+type ServiceResourceCreateUpdateParameters struct {
+	Properties *ServiceResourceCreateUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,7 @@
+package services
+
+type ServiceResourceCreateUpdateProperties struct {
+	InstanceCount *int64       `json:"instanceCount,omitempty"`
+	InstanceSize  *ServiceSize `json:"instanceSize,omitempty"`
+	ServiceType   *ServiceType `json:"serviceType,omitempty"`
+}
@@ -0,0 +1,11 @@
+package services
+
+type ServiceResourceProperties struct {
+	CreationTime                *string                    `json:"creationTime,omitempty"`
+	InstanceCount               *int64                     `json:"instanceCount,omitempty"`
+	InstanceSize                *ServiceSize               `json:"instanceSize,omitempty"`
+	Locations                   *[]RegionalServiceResource `json:"locations,omitempty"`
+	ServiceType                 ServiceType                `json:"serviceType"`
+	SqlDedicatedGatewayEndpoint *string                    `json:"sqlDedicatedGatewayEndpoint,omitempty"`
+	Status                      *ServiceStatus             `json:"status,omitempty"`
+}
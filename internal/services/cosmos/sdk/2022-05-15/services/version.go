@@ -0,0 +1,9 @@
+package services
+
+import "fmt"
+
+const defaultApiVersion = "2022-05-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/services/%s", defaultApiVersion)
+}
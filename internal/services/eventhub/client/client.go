@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2018-01-01-preview/networkrulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-01-01-preview/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-11-01/schemaregistry"
 )
 
 type Client struct {
@@ -23,6 +24,7 @@ type Client struct {
 	NamespacesClient                       *namespaces.NamespacesClient
 	NamespaceAuthorizationRulesClient      *authorizationrulesnamespaces.AuthorizationRulesNamespacesClient
 	NetworkRuleSetsClient                  *networkrulesets.NetworkRuleSetsClient
+	SchemaRegistryClient                   *schemaregistry.SchemaRegistryClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -53,6 +55,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	networkRuleSetsClient := networkrulesets.NewNetworkRuleSetsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&networkRuleSetsClient.Client, o.ResourceManagerAuthorizer)
 
+	schemaRegistryClient := schemaregistry.NewSchemaRegistryClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&schemaRegistryClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ClusterClient:                          &clustersClient,
 		ConsumerGroupClient:                    &consumerGroupsClient,
@@ -63,5 +68,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		NamespacesClient:                       &namespacesClient,
 		NamespaceAuthorizationRulesClient:      &namespaceAuthorizationRulesClient,
 		NetworkRuleSetsClient:                  &networkRuleSetsClient,
+		SchemaRegistryClient:                   &schemaRegistryClient,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2018-01-01-preview/eventhubsclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -35,6 +36,13 @@ func dataSourceEventHubCluster() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"capacity": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"tags": commonschema.TagsDataSource(),
 		},
 	}
 }
@@ -64,6 +72,14 @@ func dataSourceEventHubClusterRead(d *pluginsdk.ResourceData, meta interface{})
 	if model := resp.Model; model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		d.Set("sku_name", flattenEventHubClusterSkuName(model.Sku))
+
+		capacity := 0
+		if sku := model.Sku; sku != nil && sku.Capacity != nil {
+			capacity = int(*sku.Capacity)
+		}
+		d.Set("capacity", capacity)
+
+		return tags.FlattenAndSet(d, model.Tags)
 	}
 
 	return nil
@@ -19,6 +19,7 @@ func TestAccEventHubClusterDataSource_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("sku_name").HasValue("Dedicated_1"),
+				check.That(data.ResourceName).Key("capacity").HasValue("1"),
 			),
 		},
 	})
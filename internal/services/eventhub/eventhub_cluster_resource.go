@@ -1,6 +1,7 @@
 package eventhub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -23,9 +24,9 @@ import (
 
 func resourceEventHubCluster() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceEventHubClusterCreateUpdate,
+		Create: resourceEventHubClusterCreate,
 		Read:   resourceEventHubClusterRead,
-		Update: resourceEventHubClusterCreateUpdate,
+		Update: resourceEventHubClusterUpdate,
 		Delete: resourceEventHubClusterDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := eventhubsclusters.ParseClusterID(id)
@@ -58,7 +59,6 @@ func resourceEventHubCluster() *pluginsdk.Resource {
 			"sku_name": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringMatch(
 					regexp.MustCompile(`^Dedicated_[1-9][0-9]*$`),
 					"SKU name must match /^Dedicated_[1-9][0-9]*$/.",
@@ -67,30 +67,37 @@ func resourceEventHubCluster() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the capacity of a Dedicated Cluster can be scaled in-place, however changing the SKU itself requires a new Cluster
+			pluginsdk.ForceNewIfChange("sku_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				oldSku := strings.Split(old.(string), "_")
+				newSku := strings.Split(new.(string), "_")
+				return oldSku[0] != newSku[0]
+			}),
+		),
 	}
 }
 
-func resourceEventHubClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceEventHubClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Eventhub.ClusterClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM EventHub Cluster creation.")
 
 	id := eventhubsclusters.NewClusterID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
-	if d.IsNewResource() {
-		existing, err := client.ClustersGet(ctx, id)
-		if err != nil {
-			if !response.WasNotFound(existing.HttpResponse) {
-				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
-			}
-		}
-
+	existing, err := client.ClustersGet(ctx, id)
+	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
-			return tf.ImportAsExistsError("azurerm_eventhub_cluster", id.ID())
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
 
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_eventhub_cluster", id.ID())
+	}
+
 	cluster := eventhubsclusters.Cluster{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
@@ -101,8 +108,33 @@ func resourceEventHubClusterCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if d.IsNewResource() {
-		d.SetId(id.ID())
+	d.SetId(id.ID())
+
+	return resourceEventHubClusterRead(d, meta)
+}
+
+func resourceEventHubClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Eventhub.ClusterClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := eventhubsclusters.ParseClusterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	cluster := eventhubsclusters.Cluster{}
+
+	if d.HasChange("sku_name") {
+		cluster.Sku = expandEventHubClusterSkuName(d.Get("sku_name").(string))
+	}
+
+	if d.HasChange("tags") {
+		cluster.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.ClustersUpdateThenPoll(ctx, *id, cluster); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
 	return resourceEventHubClusterRead(d, meta)
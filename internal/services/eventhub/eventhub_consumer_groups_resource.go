@@ -0,0 +1,351 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2017-04-01/consumergroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// consumerGroupsConcurrency is the number of Consumer Groups which are created/deleted at once, this is limited to avoid
+// being throttled by the API when managing a large number of Consumer Groups
+const consumerGroupsConcurrency = 10
+
+// defaultConsumerGroupName is the name of the Consumer Group which is created (and managed) by Azure for each Event Hub
+const defaultConsumerGroupName = "$Default"
+
+// consumerGroupsResourceName is the name used in the ID of this resource, since there's only one per Event Hub
+const consumerGroupsResourceName = "default"
+
+type ConsumerGroupsObject struct {
+	EventHubId     string                `tfschema:"eventhub_id"`
+	ConsumerGroups []ConsumerGroupsEntry `tfschema:"consumer_group"`
+}
+
+type ConsumerGroupsEntry struct {
+	Name         string `tfschema:"name"`
+	UserMetadata string `tfschema:"user_metadata"`
+}
+
+var (
+	_ sdk.Resource           = ConsumerGroupsResource{}
+	_ sdk.ResourceWithUpdate = ConsumerGroupsResource{}
+)
+
+type ConsumerGroupsResource struct{}
+
+func (r ConsumerGroupsResource) ResourceType() string {
+	return "azurerm_eventhub_consumer_groups"
+}
+
+func (r ConsumerGroupsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"eventhub_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: consumergroups.ValidateEventhubID,
+		},
+
+		"consumer_group": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ValidateEventHubConsumerName(),
+					},
+
+					"user_metadata": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1024),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ConsumerGroupsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ConsumerGroupsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state ConsumerGroupsObject
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Eventhub.ConsumerGroupClient
+
+			id, err := consumergroups.ParseEventhubID(state.EventHubId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListByEventHubComplete(ctx, *id, consumergroups.DefaultListByEventHubOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Consumer Groups for %s: %+v", *id, err)
+			}
+
+			configured := make(map[string]struct{})
+			for _, v := range state.ConsumerGroups {
+				configured[v.Name] = struct{}{}
+			}
+			alreadyExists := make([]string, 0)
+			for _, v := range existing.Items {
+				if v.Name == nil {
+					continue
+				}
+				if _, ok := configured[*v.Name]; ok {
+					alreadyExists = append(alreadyExists, *v.Name)
+				}
+			}
+			if len(alreadyExists) > 0 {
+				sort.Strings(alreadyExists)
+				return fmt.Errorf("the Consumer Groups %q already exist within %s - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information.", strings.Join(alreadyExists, ", "), *id, r.ResourceType())
+			}
+
+			// the ID is set ahead of creating the Consumer Groups so that any which are created before a failure are
+			// tracked in the State (and cleaned up/recreated on the next apply), rather than being orphaned
+			resourceId := parse.NewConsumerGroupsID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.EventHubName, consumerGroupsResourceName)
+			metadata.SetID(resourceId)
+
+			metadata.Logger.Infof("creating %d Consumer Groups within %s..", len(state.ConsumerGroups), *id)
+			if err := createOrUpdateConsumerGroups(ctx, client, *id, state.ConsumerGroups); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func (r ConsumerGroupsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ConsumerGroupClient
+			resourceId, err := parse.ConsumerGroupsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := consumergroups.NewEventhubID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.EventhubName)
+
+			var state ConsumerGroupsObject
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("consumer_group") {
+				oldRaw, _ := metadata.ResourceData.GetChange("consumer_group")
+
+				existing := make(map[string]string)
+				for _, v := range oldRaw.(*pluginsdk.Set).List() {
+					raw := v.(map[string]interface{})
+					existing[raw["name"].(string)] = raw["user_metadata"].(string)
+				}
+
+				toCreateOrUpdate := make([]ConsumerGroupsEntry, 0)
+				for _, v := range state.ConsumerGroups {
+					if userMetadata, ok := existing[v.Name]; !ok || userMetadata != v.UserMetadata {
+						toCreateOrUpdate = append(toCreateOrUpdate, v)
+					}
+					delete(existing, v.Name)
+				}
+
+				// anything left over has been removed from the configuration
+				toDelete := make([]string, 0)
+				for name := range existing {
+					toDelete = append(toDelete, name)
+				}
+
+				metadata.Logger.Infof("deleting %d Consumer Groups within %s..", len(toDelete), id)
+				if err := deleteConsumerGroups(ctx, client, id, toDelete); err != nil {
+					return err
+				}
+
+				metadata.Logger.Infof("creating/updating %d Consumer Groups within %s..", len(toCreateOrUpdate), id)
+				if err := createOrUpdateConsumerGroups(ctx, client, id, toCreateOrUpdate); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func (r ConsumerGroupsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ConsumerGroupClient
+			resourceId, err := parse.ConsumerGroupsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := consumergroups.NewEventhubID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.EventhubName)
+
+			var state ConsumerGroupsObject
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			// only the Consumer Groups managed by this resource are tracked, unless this is an import in which case
+			// everything other than the Consumer Group created by Azure is
+			managed := make(map[string]struct{})
+			for _, v := range state.ConsumerGroups {
+				managed[v.Name] = struct{}{}
+			}
+			isImport := len(managed) == 0
+
+			// the first page is retrieved separately so that we can detect when the Event Hub has been removed
+			page, err := client.ListByEventHub(ctx, id, consumergroups.DefaultListByEventHubOperationOptions())
+			if err != nil {
+				if response.WasNotFound(page.HttpResponse) {
+					return metadata.MarkAsGone(resourceId)
+				}
+				return fmt.Errorf("listing Consumer Groups for %s: %+v", id, err)
+			}
+			items := make([]consumergroups.ConsumerGroup, 0)
+			if page.Model != nil {
+				items = append(items, *page.Model...)
+			}
+			for page.HasMore() {
+				if page, err = page.LoadMore(ctx); err != nil {
+					return fmt.Errorf("listing Consumer Groups for %s: %+v", id, err)
+				}
+				if page.Model != nil {
+					items = append(items, *page.Model...)
+				}
+			}
+
+			consumerGroups := make([]ConsumerGroupsEntry, 0)
+			for _, v := range items {
+				if v.Name == nil {
+					continue
+				}
+				name := *v.Name
+
+				if isImport {
+					if name == defaultConsumerGroupName {
+						continue
+					}
+				} else if _, ok := managed[name]; !ok {
+					continue
+				}
+
+				entry := ConsumerGroupsEntry{
+					Name: name,
+				}
+				if props := v.Properties; props != nil {
+					entry.UserMetadata = utils.NormalizeNilableString(props.UserMetadata)
+				}
+				consumerGroups = append(consumerGroups, entry)
+			}
+
+			if len(consumerGroups) == 0 {
+				return metadata.MarkAsGone(resourceId)
+			}
+
+			return metadata.Encode(&ConsumerGroupsObject{
+				EventHubId:     id.ID(),
+				ConsumerGroups: consumerGroups,
+			})
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ConsumerGroupsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ConsumerGroupClient
+			resourceId, err := parse.ConsumerGroupsID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := consumergroups.NewEventhubID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.EventhubName)
+
+			var state ConsumerGroupsObject
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			names := make([]string, 0, len(state.ConsumerGroups))
+			for _, v := range state.ConsumerGroups {
+				names = append(names, v.Name)
+			}
+
+			metadata.Logger.Infof("deleting %d Consumer Groups within %s..", len(names), id)
+			return deleteConsumerGroups(ctx, client, id, names)
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func (r ConsumerGroupsResource) ModelObject() interface{} {
+	return &ConsumerGroupsObject{}
+}
+
+func (r ConsumerGroupsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ConsumerGroupsID
+}
+
+func createOrUpdateConsumerGroups(ctx context.Context, client *consumergroups.ConsumerGroupsClient, eventHubId consumergroups.EventhubId, input []ConsumerGroupsEntry) error {
+	funcs := make([]func(ctx context.Context) error, 0, len(input))
+	for _, v := range input {
+		v := v
+		funcs = append(funcs, func(ctx context.Context) error {
+			id := consumergroups.NewConsumerGroupID(eventHubId.SubscriptionId, eventHubId.ResourceGroupName, eventHubId.NamespaceName, eventHubId.EventHubName, v.Name)
+			parameters := consumergroups.ConsumerGroup{
+				Name: utils.String(v.Name),
+				Properties: &consumergroups.ConsumerGroupProperties{
+					UserMetadata: utils.String(v.UserMetadata),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating/updating %s: %+v", id, err)
+			}
+			return nil
+		})
+	}
+
+	return utils.RunConcurrently(ctx, consumerGroupsConcurrency, funcs...)
+}
+
+func deleteConsumerGroups(ctx context.Context, client *consumergroups.ConsumerGroupsClient, eventHubId consumergroups.EventhubId, names []string) error {
+	funcs := make([]func(ctx context.Context) error, 0, len(names))
+	for _, name := range names {
+		name := name
+		funcs = append(funcs, func(ctx context.Context) error {
+			id := consumergroups.NewConsumerGroupID(eventHubId.SubscriptionId, eventHubId.ResourceGroupName, eventHubId.NamespaceName, eventHubId.EventHubName, name)
+			if resp, err := client.Delete(ctx, id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+			return nil
+		})
+	}
+
+	return utils.RunConcurrently(ctx, consumerGroupsConcurrency, funcs...)
+}
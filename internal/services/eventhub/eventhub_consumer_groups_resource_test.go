@@ -0,0 +1,185 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2017-04-01/consumergroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventHubConsumerGroupsResource struct{}
+
+func TestAccEventHubConsumerGroups_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_consumer_groups", "test")
+	r := EventHubConsumerGroupsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consumer_group.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubConsumerGroups_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_consumer_groups", "test")
+	r := EventHubConsumerGroupsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consumer_group.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubConsumerGroups_alreadyExists(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_consumer_groups", "test")
+	r := EventHubConsumerGroupsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.alreadyExists(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_eventhub_consumer_groups"),
+		},
+	})
+}
+
+func (EventHubConsumerGroupsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	resourceId, err := parse.ConsumerGroupsID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := consumergroups.NewEventhubID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.NamespaceName, resourceId.EventhubName)
+	resp, err := clients.Eventhub.ConsumerGroupClient.ListByEventHubComplete(ctx, id, consumergroups.DefaultListByEventHubOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Consumer Groups for %s: %+v", id, err)
+	}
+
+	// the `$Default` Consumer Group always exists, so check there's at least one more
+	return utils.Bool(len(resp.Items) > 1), nil
+}
+
+func (EventHubConsumerGroupsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eh-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 7
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r EventHubConsumerGroupsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_consumer_groups" "test" {
+  eventhub_id = azurerm_eventhub.test.id
+
+  consumer_group {
+    name = "acctestcg-first"
+  }
+
+  consumer_group {
+    name          = "acctestcg-second"
+    user_metadata = "some-meta-data"
+  }
+
+  consumer_group {
+    name = "acctestcg-third"
+  }
+}
+`, r.template(data))
+}
+
+func (r EventHubConsumerGroupsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_consumer_groups" "test" {
+  eventhub_id = azurerm_eventhub.test.id
+
+  consumer_group {
+    name          = "acctestcg-first"
+    user_metadata = "updated-meta-data"
+  }
+
+  consumer_group {
+    name = "acctestcg-second"
+  }
+
+  consumer_group {
+    name = "acctestcg-fourth"
+  }
+}
+`, r.template(data))
+}
+
+func (r EventHubConsumerGroupsResource) alreadyExists(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_consumer_groups" "import" {
+  eventhub_id = azurerm_eventhub_consumer_groups.test.eventhub_id
+
+  consumer_group {
+    name = "acctestcg-first"
+  }
+}
+`, r.basic(data))
+}
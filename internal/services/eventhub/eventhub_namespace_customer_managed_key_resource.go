@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-01-01-preview/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -74,6 +75,19 @@ func resourceEventHubNamespaceCustomerManagedKey() *pluginsdk.Resource {
 					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 				},
 			},
+
+			"infrastructure_encryption_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
 		},
 	}
 }
@@ -109,10 +123,11 @@ func resourceEventHubNamespaceCustomerManagedKeyCreateUpdate(d *pluginsdk.Resour
 
 	keySource := namespaces.KeySourceMicrosoftPointKeyVault
 	namespace.Properties.Encryption = &namespaces.Encryption{
-		KeySource: &keySource,
+		KeySource:                       &keySource,
+		RequireInfrastructureEncryption: utils.Bool(d.Get("infrastructure_encryption_enabled").(bool)),
 	}
 
-	keyVaultProps, err := expandEventHubNamespaceKeyVaultKeyIds(d.Get("key_vault_key_ids").(*pluginsdk.Set).List())
+	keyVaultProps, err := expandEventHubNamespaceKeyVaultKeyIds(d.Get("key_vault_key_ids").(*pluginsdk.Set).List(), d.Get("user_assigned_identity_id").(string))
 	if err != nil {
 		return err
 	}
@@ -148,7 +163,7 @@ func resourceEventHubNamespaceCustomerManagedKeyRead(d *pluginsdk.ResourceData,
 	if resp.Model == nil {
 		return fmt.Errorf("retrieving %s: `model` was nil", *id)
 	}
-	if resp.Model.Properties == nil && resp.Model.Properties.Encryption == nil {
+	if resp.Model.Properties == nil || resp.Model.Properties.Encryption == nil {
 		d.SetId("")
 		return nil
 	}
@@ -162,6 +177,14 @@ func resourceEventHubNamespaceCustomerManagedKeyRead(d *pluginsdk.ResourceData,
 		}
 
 		d.Set("key_vault_key_ids", keyVaultKeyIds)
+
+		infrastructureEncryption := false
+		if props.Encryption.RequireInfrastructureEncryption != nil {
+			infrastructureEncryption = *props.Encryption.RequireInfrastructureEncryption
+		}
+		d.Set("infrastructure_encryption_enabled", infrastructureEncryption)
+
+		d.Set("user_assigned_identity_id", flattenEventHubNamespaceUserAssignedIdentityId(props.Encryption))
 	}
 
 	return nil
@@ -173,7 +196,7 @@ func resourceEventHubNamespaceCustomerManagedKeyDelete(d *pluginsdk.ResourceData
 	return nil
 }
 
-func expandEventHubNamespaceKeyVaultKeyIds(input []interface{}) (*[]namespaces.KeyVaultProperties, error) {
+func expandEventHubNamespaceKeyVaultKeyIds(input []interface{}, userAssignedIdentityId string) (*[]namespaces.KeyVaultProperties, error) {
 	if len(input) == 0 {
 		return nil, nil
 	}
@@ -186,11 +209,23 @@ func expandEventHubNamespaceKeyVaultKeyIds(input []interface{}) (*[]namespaces.K
 			return nil, err
 		}
 
-		results = append(results, namespaces.KeyVaultProperties{
+		props := namespaces.KeyVaultProperties{
 			KeyName:     utils.String(keyId.Name),
 			KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
-			KeyVersion:  utils.String(keyId.Version),
-		})
+		}
+
+		// omitting the Key Version means the latest version of the Key is used, allowing it to be rotated automatically
+		if keyId.Version != "" {
+			props.KeyVersion = utils.String(keyId.Version)
+		}
+
+		if userAssignedIdentityId != "" {
+			props.Identity = &namespaces.UserAssignedIdentityProperties{
+				UserAssignedIdentity: utils.String(userAssignedIdentityId),
+			}
+		}
+
+		results = append(results, props)
 	}
 
 	return &results, nil
@@ -228,3 +263,18 @@ func flattenEventHubNamespaceKeyVaultKeyIds(input *namespaces.Encryption) ([]int
 
 	return results, nil
 }
+
+func flattenEventHubNamespaceUserAssignedIdentityId(input *namespaces.Encryption) string {
+	if input == nil || input.KeyVaultProperties == nil {
+		return ""
+	}
+
+	// the same identity is used for each of the keys, so it's sufficient to return the first
+	for _, item := range *input.KeyVaultProperties {
+		if item.Identity != nil && item.Identity.UserAssignedIdentity != nil {
+			return *item.Identity.UserAssignedIdentity
+		}
+	}
+
+	return ""
+}
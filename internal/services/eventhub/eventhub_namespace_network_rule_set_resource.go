@@ -0,0 +1,317 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2018-01-01-preview/networkrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceEventHubNamespaceNetworkRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceEventHubNamespaceNetworkRuleSetCreateUpdate,
+		Read:   resourceEventHubNamespaceNetworkRuleSetRead,
+		Update: resourceEventHubNamespaceNetworkRuleSetCreateUpdate,
+		Delete: resourceEventHubNamespaceNetworkRuleSetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := networkrulesets.ParseNamespaceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceEventHubNamespaceNetworkRuleSetCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			//lintignore: S013
+			"namespace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkrulesets.ValidateNamespaceID,
+			},
+
+			"default_action": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(networkrulesets.DefaultActionAllow),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(networkrulesets.DefaultActionAllow),
+					string(networkrulesets.DefaultActionDeny),
+				}, false),
+			},
+
+			"trusted_service_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// 128 limit per https://docs.microsoft.com/azure/event-hubs/event-hubs-quotas
+			"virtual_network_rule": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				MaxItems: 128,
+				Set:      resourceVnetRuleHash,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// the API returns the subnet ID's resource group name in lowercase
+						"subnet_id": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"ignore_missing_virtual_network_service_endpoint": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			// 128 limit per https://docs.microsoft.com/azure/event-hubs/event-hubs-quotas
+			"ip_rule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 128,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"ip_mask": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(networkrulesets.NetworkRuleIPActionAllow),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(networkrulesets.NetworkRuleIPActionAllow),
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceEventHubNamespaceNetworkRuleSetCustomizeDiff surfaces the conflict with the `network_rulesets` block of the
+// `azurerm_eventhub_namespace` resource during plan, rather than once the Namespace has been partially applied
+func resourceEventHubNamespaceNetworkRuleSetCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	// the Namespace ID isn't known until apply when the Namespace is created in the same plan
+	namespaceIdRaw := d.Get("namespace_id").(string)
+	if namespaceIdRaw == "" {
+		return nil
+	}
+
+	id, err := networkrulesets.ParseNamespaceID(namespaceIdRaw)
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Eventhub.NetworkRuleSetsClient
+	existing, err := client.NamespacesGetNetworkRuleSet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("checking for the presence of existing Network Rule Set for %s: %+v", id, err)
+	}
+
+	if model := existing.Model; model != nil && !checkEventHubNamespaceNetworkRuleSetNullified(*model) {
+		return eventHubNamespaceNetworkRuleSetExistsError(*id)
+	}
+
+	return nil
+}
+
+func resourceEventHubNamespaceNetworkRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Eventhub.NetworkRuleSetsClient
+	namespacesClient := meta.(*clients.Client).Eventhub.NamespacesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkrulesets.ParseNamespaceID(d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.NamespacesGetNetworkRuleSet(ctx, *id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of existing Network Rule Set for %s: %+v", id, err)
+			}
+		}
+
+		// The Network Rule Set is created along with the Event Hub Namespace and can't be removed, so it only counts
+		// as existing when rules have been configured - either by another instance of this resource or by the
+		// `network_rulesets` block within the `azurerm_eventhub_namespace` resource
+		if model := existing.Model; model != nil {
+			if !checkEventHubNamespaceNetworkRuleSetNullified(*model) {
+				return eventHubNamespaceNetworkRuleSetExistsError(*id)
+			}
+		}
+	}
+
+	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+	namespace, err := namespacesClient.Get(ctx, namespaceId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", namespaceId, err)
+	}
+	if model := namespace.Model; model != nil && model.Sku != nil && model.Sku.Name == namespaces.SkuNameBasic {
+		return fmt.Errorf("a Network Rule Set cannot be used with %s since it uses the `Basic` SKU", namespaceId)
+	}
+
+	props := expandEventHubNamespaceNetworkRuleset([]interface{}{
+		map[string]interface{}{
+			"default_action":                 d.Get("default_action").(string),
+			"trusted_service_access_enabled": d.Get("trusted_service_access_enabled").(bool),
+			"virtual_network_rule":           d.Get("virtual_network_rule").(*pluginsdk.Set),
+			"ip_rule":                        d.Get("ip_rule").([]interface{}),
+		},
+	})
+
+	// API doesn't accept "Deny" to be set for "default_action" if no "ip_rule" or "virtual_network_rule" is defined and returns no error message to the user
+	if *props.DefaultAction == networkrulesets.DefaultActionDeny && props.IpRules == nil && props.VirtualNetworkRules == nil {
+		return fmt.Errorf("the default action of the Network Rule Set for %s can only be set to `Deny` when at least one `ip_rule` or `virtual_network_rule` is set", id)
+	}
+
+	parameters := networkrulesets.NetworkRuleSet{
+		Properties: props,
+	}
+
+	if _, err := client.NamespacesCreateOrUpdateNetworkRuleSet(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("creating/updating Network Rule Set for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceEventHubNamespaceNetworkRuleSetRead(d, meta)
+}
+
+func resourceEventHubNamespaceNetworkRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Eventhub.NetworkRuleSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkrulesets.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.NamespacesGetNetworkRuleSet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Network Rule Set for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Network Rule Set for %s: %+v", *id, err)
+	}
+
+	d.Set("namespace_id", id.ID())
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		defaultAction := ""
+		if v := model.Properties.DefaultAction; v != nil {
+			defaultAction = string(*v)
+		}
+		d.Set("default_action", defaultAction)
+
+		trustedServiceAccessEnabled := false
+		if v := model.Properties.TrustedServiceAccessEnabled; v != nil {
+			trustedServiceAccessEnabled = *v
+		}
+		d.Set("trusted_service_access_enabled", trustedServiceAccessEnabled)
+
+		ruleset := flattenEventHubNamespaceNetworkRuleset(resp)
+		block := ruleset[0].(map[string]interface{})
+
+		if err := d.Set("virtual_network_rule", pluginsdk.NewSet(resourceVnetRuleHash, block["virtual_network_rule"].([]interface{}))); err != nil {
+			return fmt.Errorf("setting `virtual_network_rule`: %+v", err)
+		}
+
+		if err := d.Set("ip_rule", block["ip_rule"].([]interface{})); err != nil {
+			return fmt.Errorf("setting `ip_rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceEventHubNamespaceNetworkRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Eventhub.NetworkRuleSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networkrulesets.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// A Network Rule Set is unique to a namespace and cannot be deleted.
+	// Therefore we're just resetting it by setting the default_action to allow and removing all of its rules
+	defaultAction := networkrulesets.DefaultActionAllow
+	parameters := networkrulesets.NetworkRuleSet{
+		Properties: &networkrulesets.NetworkRuleSetProperties{
+			DefaultAction: &defaultAction,
+		},
+	}
+
+	if _, err := client.NamespacesCreateOrUpdateNetworkRuleSet(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("deleting Network Rule Set for %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func eventHubNamespaceNetworkRuleSetExistsError(id networkrulesets.NamespaceId) error {
+	return fmt.Errorf("%+v\n\nWhen these rules are managed by the `network_rulesets` block within the `azurerm_eventhub_namespace` resource, that block must be removed instead since both can't be used for the same Namespace", tf.ImportAsExistsError("azurerm_eventhub_namespace_network_rule_set", id.ID()))
+}
+
+func checkEventHubNamespaceNetworkRuleSetNullified(input networkrulesets.NetworkRuleSet) bool {
+	if input.Id == nil || *input.Id == "" {
+		return true
+	}
+
+	if props := input.Properties; props != nil {
+		if props.VirtualNetworkRules != nil && len(*props.VirtualNetworkRules) > 0 {
+			return false
+		}
+
+		if props.IpRules != nil && len(*props.IpRules) > 0 {
+			return false
+		}
+
+		if props.TrustedServiceAccessEnabled != nil && *props.TrustedServiceAccessEnabled {
+			return false
+		}
+	}
+
+	return true
+}
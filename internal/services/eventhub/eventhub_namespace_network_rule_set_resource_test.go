@@ -0,0 +1,253 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2018-01-01-preview/networkrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventHubNamespaceNetworkRuleSetResource struct{}
+
+func TestAccEventHubNamespaceNetworkRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_network_rule_set", "test")
+	r := EventHubNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceNetworkRuleSet_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_network_rule_set", "test")
+	r := EventHubNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("trusted_service_access_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceNetworkRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_network_rule_set", "test")
+	r := EventHubNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespaceNetworkRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_network_rule_set", "test")
+	r := EventHubNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventHubNamespaceNetworkRuleSet_conflictsWithInlineRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace_network_rule_set", "test")
+	r := EventHubNamespaceNetworkRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.inlineRulesTemplate(data),
+		},
+		{
+			Config:      r.inlineRules(data),
+			ExpectError: regexp.MustCompile("`network_rulesets` block within the `azurerm_eventhub_namespace` resource"),
+		},
+	})
+}
+
+func (EventHubNamespaceNetworkRuleSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkrulesets.ParseNamespaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Eventhub.NetworkRuleSetsClient.NamespacesGetNetworkRuleSet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Network Rule Set for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EventHubNamespaceNetworkRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_network_rule_set" "test" {
+  namespace_id   = azurerm_eventhub_namespace.test.id
+  default_action = "Deny"
+
+  virtual_network_rule {
+    subnet_id = azurerm_subnet.test.id
+  }
+}
+`, r.template(data))
+}
+
+func (r EventHubNamespaceNetworkRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_network_rule_set" "test" {
+  namespace_id                   = azurerm_eventhub_namespace.test.id
+  default_action                 = "Deny"
+  trusted_service_access_enabled = true
+
+  virtual_network_rule {
+    subnet_id                                       = azurerm_subnet.test.id
+    ignore_missing_virtual_network_service_endpoint = true
+  }
+
+  ip_rule {
+    ip_mask = "10.0.0.0/16"
+    action  = "Allow"
+  }
+}
+`, r.template(data))
+}
+
+func (r EventHubNamespaceNetworkRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_network_rule_set" "import" {
+  namespace_id   = azurerm_eventhub_namespace_network_rule_set.test.namespace_id
+  default_action = azurerm_eventhub_namespace_network_rule_set.test.default_action
+
+  virtual_network_rule {
+    subnet_id = azurerm_subnet.test.id
+  }
+}
+`, r.basic(data))
+}
+
+func (r EventHubNamespaceNetworkRuleSetResource) inlineRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_network_rule_set" "test" {
+  namespace_id   = azurerm_eventhub_namespace.test.id
+  default_action = "Deny"
+
+  ip_rule {
+    ip_mask = "10.1.0.0/16"
+  }
+}
+`, r.inlineRulesTemplate(data))
+}
+
+func (EventHubNamespaceNetworkRuleSetResource) inlineRulesTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+  capacity            = 1
+
+  network_rulesets {
+    default_action = "Deny"
+
+    ip_rule {
+      ip_mask = "10.0.0.0/16"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (EventHubNamespaceNetworkRuleSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+  capacity            = 1
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+  service_endpoints    = ["Microsoft.EventHub"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
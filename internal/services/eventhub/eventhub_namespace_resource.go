@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpoint"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -43,9 +44,24 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 		Update: resourceEventHubNamespaceUpdate,
 		Delete: resourceEventHubNamespaceDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := namespaces.ParseNamespaceID(id)
 			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			id, err := namespaces.ParseNamespaceID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			privateEndpoints, err := privateendpoint.Import(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroupName, id.ID())
+			if err != nil {
+				return nil, fmt.Errorf("retrieving Private Endpoints for %s: %+v", *id, err)
+			}
+			if err := d.Set("private_endpoint", privateEndpoints); err != nil {
+				return nil, fmt.Errorf("setting `private_endpoint`: %+v", err)
+			}
+
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -190,6 +206,8 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 				},
 			},
 
+			"private_endpoint": privateendpoint.Schema([]string{"namespace"}),
+
 			"default_primary_connection_string_alias": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -334,6 +352,12 @@ func resourceEventHubNamespaceCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if v, ok := d.GetOk("private_endpoint"); ok {
+		if err := privateendpoint.CreateOrUpdate(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroupName, location, id.ID(), privateendpoint.Expand(v.([]interface{})), t); err != nil {
+			return fmt.Errorf("creating Private Endpoints for %s: %+v", id, err)
+		}
+	}
+
 	return resourceEventHubNamespaceRead(d, meta)
 }
 
@@ -399,10 +423,11 @@ func resourceEventHubNamespaceUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	d.SetId(id.ID())
 
-	ruleSets, hasRuleSets := d.GetOk("network_rulesets")
-	if hasRuleSets {
+	// only update the Network Rule Set when the `network_rulesets` block has changed, so that rules managed by
+	// the `azurerm_eventhub_namespace_network_rule_set` resource aren't overwritten by the computed values in state
+	if d.HasChange("network_rulesets") {
 		rulesets := networkrulesets.NetworkRuleSet{
-			Properties: expandEventHubNamespaceNetworkRuleset(ruleSets.([]interface{})),
+			Properties: expandEventHubNamespaceNetworkRuleset(d.Get("network_rulesets").([]interface{})),
 		}
 
 		// cannot use network rulesets with the basic SKU
@@ -423,6 +448,13 @@ func resourceEventHubNamespaceUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("private_endpoint") {
+		oldRaw, newRaw := d.GetChange("private_endpoint")
+		if err := privateendpoint.Update(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroupName, location, id.ID(), privateendpoint.Expand(oldRaw.([]interface{})), privateendpoint.Expand(newRaw.([]interface{})), t); err != nil {
+			return fmt.Errorf("updating Private Endpoints for %s: %+v", id, err)
+		}
+	}
+
 	return resourceEventHubNamespaceRead(d, meta)
 }
 
@@ -489,6 +521,14 @@ func resourceEventHubNamespaceRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("setting `network_ruleset` for Evenhub Namespace %s: %v", id.NamespaceName, err)
 	}
 
+	privateEndpoints, err := privateendpoint.Flatten(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroupName, privateendpoint.Expand(d.Get("private_endpoint").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("retrieving Private Endpoints for %s: %+v", *id, err)
+	}
+	if err := d.Set("private_endpoint", privateEndpoints); err != nil {
+		return fmt.Errorf("setting `private_endpoint`: %+v", err)
+	}
+
 	authorizationRuleId := authorizationrulesnamespaces.NewAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, eventHubNamespaceDefaultAuthorizationRule)
 	keys, err := authorizationKeysClient.NamespacesListKeys(ctx, authorizationRuleId)
 	if err != nil {
@@ -517,6 +557,10 @@ func resourceEventHubNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	if err := privateendpoint.Delete(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroupName, privateendpoint.Expand(d.Get("private_endpoint").([]interface{}))); err != nil {
+		return fmt.Errorf("deleting Private Endpoints for %s: %+v", *id, err)
+	}
+
 	future, err := client.Delete(ctx, *id)
 	if err != nil {
 		if response.WasNotFound(future.HttpResponse) {
@@ -0,0 +1,95 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-11-01/schemaregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = SchemaGroupDataSource{}
+
+type SchemaGroupDataSource struct{}
+
+func (d SchemaGroupDataSource) ResourceType() string {
+	return "azurerm_eventhub_schema_group"
+}
+
+func (d SchemaGroupDataSource) ModelObject() interface{} {
+	return &SchemaGroupObject{}
+}
+
+func (d SchemaGroupDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ValidateEventHubSchemaGroupName(),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: schemaregistry.ValidateNamespaceID,
+		},
+	}
+}
+
+func (d SchemaGroupDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"schema_compatibility": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"schema_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d SchemaGroupDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.SchemaRegistryClient
+
+			var state SchemaGroupObject
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			namespaceId, err := schemaregistry.ParseNamespaceID(state.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := schemaregistry.NewSchemaGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, state.Name)
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if v := model.Properties.SchemaCompatibility; v != nil {
+					state.SchemaCompatibility = string(*v)
+				}
+				if v := model.Properties.SchemaType; v != nil {
+					state.SchemaType = string(*v)
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
@@ -0,0 +1,37 @@
+package eventhub_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type EventHubSchemaGroupDataSource struct{}
+
+func TestAccEventHubSchemaGroupDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_eventhub_schema_group", "test")
+	r := EventHubSchemaGroupDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("schema_compatibility").HasValue("Forward"),
+				check.That(data.ResourceName).Key("schema_type").HasValue("Avro"),
+			),
+		},
+	})
+}
+
+func (EventHubSchemaGroupDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_eventhub_schema_group" "test" {
+  name         = azurerm_eventhub_schema_group.test.name
+  namespace_id = azurerm_eventhub_schema_group.test.namespace_id
+}
+`, EventHubSchemaGroupResource{}.basic(data))
+}
@@ -0,0 +1,102 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-11-01/schemaregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventHubSchemaGroupResource struct{}
+
+func TestAccEventHubSchemaGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_schema_group", "test")
+	r := EventHubSchemaGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schema_compatibility").HasValue("Forward"),
+				check.That(data.ResourceName).Key("schema_type").HasValue("Avro"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubSchemaGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_schema_group", "test")
+	r := EventHubSchemaGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (EventHubSchemaGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := schemaregistry.ParseSchemaGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Eventhub.SchemaRegistryClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (EventHubSchemaGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eh-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_schema_group" "test" {
+  name                 = "acctestsg-%d"
+  namespace_id         = azurerm_eventhub_namespace.test.id
+  schema_compatibility = "Forward"
+  schema_type          = "Avro"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r EventHubSchemaGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_schema_group" "import" {
+  name                 = azurerm_eventhub_schema_group.test.name
+  namespace_id         = azurerm_eventhub_schema_group.test.namespace_id
+  schema_compatibility = azurerm_eventhub_schema_group.test.schema_compatibility
+  schema_type          = azurerm_eventhub_schema_group.test.schema_type
+}
+`, r.basic(data))
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ConsumerGroupsId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	NamespaceName            string
+	EventhubName             string
+	ManagedConsumerGroupName string
+}
+
+func NewConsumerGroupsID(subscriptionId, resourceGroup, namespaceName, eventhubName, managedConsumerGroupName string) ConsumerGroupsId {
+	return ConsumerGroupsId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		NamespaceName:            namespaceName,
+		EventhubName:             eventhubName,
+		ManagedConsumerGroupName: managedConsumerGroupName,
+	}
+}
+
+func (id ConsumerGroupsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Managed Consumer Group Name %q", id.ManagedConsumerGroupName),
+		fmt.Sprintf("Eventhub Name %q", id.EventhubName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Consumer Groups", segmentsStr)
+}
+
+func (id ConsumerGroupsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/managedConsumerGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.ManagedConsumerGroupName)
+}
+
+// ConsumerGroupsID parses a ConsumerGroups ID into an ConsumerGroupsId struct
+func ConsumerGroupsID(input string) (*ConsumerGroupsId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ConsumerGroupsId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.EventhubName, err = id.PopSegment("eventhubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.ManagedConsumerGroupName, err = id.PopSegment("managedConsumerGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -45,6 +45,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_eventhub_namespace_authorization_rule":       resourceEventHubNamespaceAuthorizationRule(),
 		"azurerm_eventhub_namespace_customer_managed_key":     resourceEventHubNamespaceCustomerManagedKey(),
 		"azurerm_eventhub_namespace_disaster_recovery_config": resourceEventHubNamespaceDisasterRecoveryConfig(),
+		"azurerm_eventhub_namespace_network_rule_set":         resourceEventHubNamespaceNetworkRuleSet(),
 		"azurerm_eventhub_namespace":                          resourceEventHubNamespace(),
 		"azurerm_eventhub":                                    resourceEventHub(),
 	}
@@ -52,12 +53,16 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		SchemaGroupDataSource{},
+	}
 }
 
 // Resources returns a list of Resources supported by this Service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ConsumerGroupResource{},
+		ConsumerGroupsResource{},
+		SchemaGroupResource{},
 	}
 }
@@ -0,0 +1,10 @@
+package schemaregistry
+
+type SchemaGroup struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SchemaGroupProperties `json:"properties,omitempty"`
+	SystemData *SystemData            `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
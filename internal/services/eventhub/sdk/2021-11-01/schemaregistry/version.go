@@ -0,0 +1,9 @@
+package schemaregistry
+
+import "fmt"
+
+const defaultApiVersion = "2021-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/schemaregistry/%s", defaultApiVersion)
+}
@@ -35,3 +35,10 @@ func ValidateEventHubAuthorizationRuleName() pluginsdk.SchemaValidateFunc {
 		"The authorization rule name can contain only letters, numbers, periods, hyphens and underscores. The name must start and end with a letter or number and be up to 60 characters long.",
 	)
 }
+
+func ValidateEventHubSchemaGroupName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^[a-zA-Z0-9]([-._a-zA-Z0-9]{0,254}[a-zA-Z0-9])?$"),
+		"The schema group name can contain only letters, numbers, periods (.), hyphens (-),and underscores (_), up to 256 characters, and it must begin and end with a letter or number.",
+	)
+}
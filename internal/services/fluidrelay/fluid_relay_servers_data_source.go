@@ -0,0 +1,163 @@
+package fluidrelay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/fluidrelay/2022-05-26/fluidrelayservers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fluidrelay/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServerDataSourceModel struct {
+	Name             string                                     `tfschema:"name"`
+	ResourceGroup    string                                     `tfschema:"resource_group_name"`
+	Location         string                                     `tfschema:"location"`
+	StorageSKU       string                                     `tfschema:"storage_sku"`
+	FrsTenantId      string                                     `tfschema:"frs_tenant_id"`
+	OrdererEndpoints []string                                   `tfschema:"orderer_endpoints"`
+	StorageEndpoints []string                                   `tfschema:"storage_endpoints"`
+	PrimaryKey       string                                     `tfschema:"primary_key"`
+	SecondaryKey     string                                     `tfschema:"secondary_key"`
+	Tags             map[string]string                          `tfschema:"tags"`
+	Identity         []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+}
+
+type ServerDataSource struct{}
+
+var _ sdk.DataSource = ServerDataSource{}
+
+func (s ServerDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.FluidRelayServerName,
+		},
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+	}
+}
+
+func (s ServerDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+		"tags":     commonschema.TagsDataSource(),
+		"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
+		"storage_sku": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"frs_tenant_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"orderer_endpoints": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"storage_endpoints": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (s ServerDataSource) ModelObject() interface{} {
+	return &ServerDataSourceModel{}
+}
+
+func (s ServerDataSource) ResourceType() string {
+	return "azurerm_fluid_relay_server"
+}
+
+func (s ServerDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			client := meta.Client.FluidRelay.ServerClient
+
+			var state ServerDataSourceModel
+			if err := meta.Decode(&state); err != nil {
+				return err
+			}
+
+			id := fluidrelayservers.NewFluidRelayServerID(meta.Client.Account.SubscriptionId, state.ResourceGroup, state.Name)
+
+			server, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(server.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			output := ServerDataSourceModel{
+				Name:          id.FluidRelayServerName,
+				ResourceGroup: id.ResourceGroup,
+			}
+
+			if model := server.Model; model != nil {
+				output.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					output.Tags = *model.Tags
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %v", err)
+				}
+				output.Identity = *flattenedIdentity
+
+				if prop := model.Properties; prop != nil {
+					output.FrsTenantId = utils.NormalizeNilableString(prop.FrsTenantId)
+					if prop.Storagesku != nil {
+						output.StorageSKU = string(*prop.Storagesku)
+					}
+					if points := prop.FluidRelayEndpoints; points != nil {
+						if points.OrdererEndpoints != nil {
+							output.OrdererEndpoints = *points.OrdererEndpoints
+						}
+						if points.StorageEndpoints != nil {
+							output.StorageEndpoints = *points.StorageEndpoints
+						}
+					}
+				}
+			}
+
+			keys, err := client.ListKeys(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing keys for %s: %v", id, err)
+			}
+			if model := keys.Model; model != nil {
+				output.PrimaryKey = utils.NormalizeNilableString(model.Key1)
+				output.SecondaryKey = utils.NormalizeNilableString(model.Key2)
+			}
+
+			meta.SetID(id)
+			return meta.Encode(&output)
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package fluidrelay_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type FluidRelayServerDataSource struct{}
+
+func TestAccFluidRelayServerDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_fluid_relay_server", "test")
+	d := FluidRelayServerDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("frs_tenant_id").IsUUID(),
+				check.That(data.ResourceName).Key("orderer_endpoints.0").Exists(),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+				check.That(data.ResourceName).Key("secondary_key").Exists(),
+				check.That(data.ResourceName).Key("tags.foo").HasValue("bar"),
+			),
+		},
+	})
+}
+
+func (d FluidRelayServerDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_fluid_relay_server" "test" {
+  name                = azurerm_fluid_relay_server.test.name
+  resource_group_name = azurerm_fluid_relay_server.test.resource_group_name
+}
+`, FluidRelayResource{}.basic(data))
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -13,21 +14,31 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/fluidrelay/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type ServerModel struct {
-	Name             string                                     `tfschema:"name"`
-	ResourceGroup    string                                     `tfschema:"resource_group_name"`
-	Location         string                                     `tfschema:"location"`
-	StorageSKU       string                                     `tfschema:"storage_sku"`
-	FrsTenantId      string                                     `tfschema:"frs_tenant_id"`
-	OrdererEndpoints []string                                   `tfschema:"orderer_endpoints"`
-	StorageEndpoints []string                                   `tfschema:"storage_endpoints"`
-	Tags             map[string]string                          `tfschema:"tags"`
-	Identity         []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Name               string                                     `tfschema:"name"`
+	ResourceGroup      string                                     `tfschema:"resource_group_name"`
+	Location           string                                     `tfschema:"location"`
+	StorageSKU         string                                     `tfschema:"storage_sku"`
+	FrsTenantId        string                                     `tfschema:"frs_tenant_id"`
+	OrdererEndpoints   []string                                   `tfschema:"orderer_endpoints"`
+	StorageEndpoints   []string                                   `tfschema:"storage_endpoints"`
+	Tags               map[string]string                          `tfschema:"tags"`
+	Identity           []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	CustomerManagedKey []CustomerManagedKeyModel                  `tfschema:"customer_managed_key"`
+	RotateKeysOn       string                                     `tfschema:"rotate_keys_on"`
+	PrimaryKey         string                                     `tfschema:"primary_key"`
+	SecondaryKey       string                                     `tfschema:"secondary_key"`
+}
+
+type CustomerManagedKeyModel struct {
+	KeyVaultKeyId          string `tfschema:"key_vault_key_id"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
 }
 
 func (s *ServerModel) flattenIdentity(input *identity.SystemAndUserAssignedMap) error {
@@ -78,6 +89,31 @@ func (s Server) Arguments() map[string]*pluginsdk.Schema {
 			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice(fluidrelayservers.PossibleValuesForStorageSKU(), false),
 		},
+		"customer_managed_key": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_vault_key_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+				},
+			},
+		},
+		// an arbitrary value which, when changed, regenerates both the primary and secondary keys
+		"rotate_keys_on": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
 	}
 }
 
@@ -101,6 +137,16 @@ func (s Server) Attributes() map[string]*pluginsdk.Schema {
 				Type: pluginsdk.TypeString,
 			},
 		},
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
 	}
 }
 
@@ -151,6 +197,7 @@ func (s Server) Create() sdk.ResourceFunc {
 			if model.StorageSKU != "" {
 				serverReq.Properties.Storagesku = (*fluidrelayservers.StorageSKU)(&model.StorageSKU)
 			}
+			serverReq.Properties.Encryption = expandFluidRelayServerCustomerManagedKey(model.CustomerManagedKey)
 			_, err = client.CreateOrUpdate(ctx, id, serverReq)
 			if err != nil {
 				return fmt.Errorf("creating %v err: %+v", id, err)
@@ -187,10 +234,23 @@ func (s Server) Update() sdk.ResourceFunc {
 					return fmt.Errorf("expanding user identities: %+v", err)
 				}
 			}
+			if meta.ResourceData.HasChange("customer_managed_key") {
+				upd.Properties = &fluidrelayservers.FluidRelayServerUpdateProperties{
+					Encryption: expandFluidRelayServerCustomerManagedKey(model.CustomerManagedKey),
+				}
+			}
 			if _, err = client.Update(ctx, *id, upd); err != nil {
 				return fmt.Errorf("updating %s: %v", id, err)
 			}
 
+			if meta.ResourceData.HasChange("rotate_keys_on") {
+				for _, keyName := range []fluidrelayservers.KeyName{fluidrelayservers.KeyNameKeyOne, fluidrelayservers.KeyNameKeyTwo} {
+					if _, err = client.RegenerateKey(ctx, *id, fluidrelayservers.RegenerateKeyRequest{KeyName: keyName}); err != nil {
+						return fmt.Errorf("regenerating %s for %s: %v", keyName, id, err)
+					}
+				}
+			}
+
 			return nil
 		},
 	}
@@ -209,6 +269,9 @@ func (s Server) Read() sdk.ResourceFunc {
 
 			server, err := client.Get(ctx, *id)
 			if err != nil {
+				if response.WasNotFound(server.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
 				return fmt.Errorf("retrieving %s: %v", id, err)
 			}
 
@@ -229,6 +292,7 @@ func (s Server) Read() sdk.ResourceFunc {
 				if prop.FrsTenantId != nil {
 					output.FrsTenantId = *prop.FrsTenantId
 				}
+				output.CustomerManagedKey = flattenFluidRelayServerCustomerManagedKey(prop.Encryption)
 				if points := prop.FluidRelayEndpoints; points != nil {
 					if points.OrdererEndpoints != nil {
 						output.OrdererEndpoints = *points.OrdererEndpoints
@@ -241,6 +305,17 @@ func (s Server) Read() sdk.ResourceFunc {
 			if val, ok := meta.ResourceData.GetOk("storage_sku"); ok {
 				output.StorageSKU = val.(string)
 			}
+			output.RotateKeysOn = meta.ResourceData.Get("rotate_keys_on").(string)
+
+			keys, err := client.ListKeys(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing keys for %s: %v", id, err)
+			}
+			if model := keys.Model; model != nil {
+				output.PrimaryKey = utils.NormalizeNilableString(model.Key1)
+				output.SecondaryKey = utils.NormalizeNilableString(model.Key2)
+			}
+
 			return meta.Encode(output)
 		},
 	}
@@ -269,3 +344,37 @@ func (s Server) Delete() sdk.ResourceFunc {
 func (s Server) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return fluidrelayservers.ValidateFluidRelayServerID
 }
+
+func expandFluidRelayServerCustomerManagedKey(input []CustomerManagedKeyModel) *fluidrelayservers.EncryptionProperties {
+	if len(input) == 0 {
+		return nil
+	}
+
+	identityType := fluidrelayservers.CmkIdentityTypeUserAssigned
+	return &fluidrelayservers.EncryptionProperties{
+		CustomerManagedKeyEncryption: &fluidrelayservers.CustomerManagedKeyEncryptionProperties{
+			KeyEncryptionKeyUrl: utils.String(input[0].KeyVaultKeyId),
+			KeyEncryptionKeyIdentity: &fluidrelayservers.CustomerManagedKeyEncryptionPropertiesKeyEncryptionKeyIdentity{
+				IdentityType:                   &identityType,
+				UserAssignedIdentityResourceId: utils.String(input[0].UserAssignedIdentityId),
+			},
+		},
+	}
+}
+
+func flattenFluidRelayServerCustomerManagedKey(input *fluidrelayservers.EncryptionProperties) []CustomerManagedKeyModel {
+	if input == nil || input.CustomerManagedKeyEncryption == nil {
+		return nil
+	}
+
+	cmk := input.CustomerManagedKeyEncryption
+	output := CustomerManagedKeyModel{}
+	if cmk.KeyEncryptionKeyUrl != nil {
+		output.KeyVaultKeyId = *cmk.KeyEncryptionKeyUrl
+	}
+	if kek := cmk.KeyEncryptionKeyIdentity; kek != nil && kek.UserAssignedIdentityResourceId != nil {
+		output.UserAssignedIdentityId = *kek.UserAssignedIdentityResourceId
+	}
+
+	return []CustomerManagedKeyModel{output}
+}
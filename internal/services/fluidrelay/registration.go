@@ -9,7 +9,9 @@ func (r Registration) Name() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		ServerDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
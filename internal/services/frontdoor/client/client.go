@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-04-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-05-01/checkfrontdoornameavailabilitywithsubscription"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-05-01/frontdoors"
 )
 
@@ -12,19 +13,24 @@ import (
 // ones are available, we should leave the older resources on the older API version.
 
 type Client struct {
-	FrontDoorsClient       *frontdoors.FrontDoorsClient
-	FrontDoorsPolicyClient *webapplicationfirewallpolicies.WebApplicationFirewallPoliciesClient
+	FrontDoorsClient                 *frontdoors.FrontDoorsClient
+	FrontDoorsNameAvailabilityClient *checkfrontdoornameavailabilitywithsubscription.CheckFrontDoorNameAvailabilityWithSubscriptionClient
+	FrontDoorsPolicyClient           *webapplicationfirewallpolicies.WebApplicationFirewallPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	frontDoorsClient := frontdoors.NewFrontDoorsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&frontDoorsClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorsNameAvailabilityClient := checkfrontdoornameavailabilitywithsubscription.NewCheckFrontDoorNameAvailabilityWithSubscriptionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&frontDoorsNameAvailabilityClient.Client, o.ResourceManagerAuthorizer)
+
 	frontDoorsPolicyClient := webapplicationfirewallpolicies.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&frontDoorsPolicyClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		FrontDoorsClient:       &frontDoorsClient,
-		FrontDoorsPolicyClient: &frontDoorsPolicyClient,
+		FrontDoorsClient:                 &frontDoorsClient,
+		FrontDoorsNameAvailabilityClient: &frontDoorsNameAvailabilityClient,
+		FrontDoorsPolicyClient:           &frontDoorsPolicyClient,
 	}
 }
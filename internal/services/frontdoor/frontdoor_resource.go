@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-05-01/checkfrontdoornameavailabilitywithsubscription"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-05-01/frontdoors"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -75,6 +76,25 @@ func resourceFrontDoorCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		return tf.ImportAsExistsError("azurerm_frontdoor", id.ID())
 	}
 
+	availabilityClient := meta.(*clients.Client).Frontdoor.FrontDoorsNameAvailabilityClient
+	availabilityRequest := checkfrontdoornameavailabilitywithsubscription.CheckNameAvailabilityInput{
+		Name: id.FrontDoorName,
+		Type: checkfrontdoornameavailabilitywithsubscription.ResourceTypeMicrosoftPointNetworkFrontDoors,
+	}
+	available, err := availabilityClient.FrontDoorNameAvailabilityWithSubscriptionCheck(ctx, checkfrontdoornameavailabilitywithsubscription.NewSubscriptionID(subscriptionId), availabilityRequest)
+	if err != nil {
+		return fmt.Errorf("checking if the name %q was available: %+v", id.FrontDoorName, err)
+	}
+	if model := available.Model; model != nil && model.NameAvailability != nil {
+		if err := azure.CheckNameAvailability("Front Door", id.FrontDoorName, azure.NameAvailabilityResult{
+			NameAvailable: utils.Bool(*model.NameAvailability == checkfrontdoornameavailabilitywithsubscription.AvailabilityAvailable),
+			Reason:        utils.NormalizeNilableString(model.Reason),
+			Message:       model.Message,
+		}); err != nil {
+			return err
+		}
+	}
+
 	var backendCertNameCheck bool
 	var backendPoolsSendReceiveTimeoutSeconds int64
 	if bps, ok := d.Get("backend_pool_settings").([]interface{}); ok && len(bps) > 0 {
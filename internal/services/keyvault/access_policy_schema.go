@@ -48,11 +48,14 @@ func keyPermissions() []string {
 		"Delete",
 		"Encrypt",
 		"Get",
+		"GetRotationPolicy",
 		"Import",
 		"List",
 		"Purge",
 		"Recover",
 		"Restore",
+		"Rotate",
+		"SetRotationPolicy",
 		"Sign",
 		"UnwrapKey",
 		"Update",
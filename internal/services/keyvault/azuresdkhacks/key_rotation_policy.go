@@ -0,0 +1,139 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since Key Rotation Policies are only available from API Version `7.3`
+// of the Key Vault Data Plane API, whereas the Key Vault Nested Item resources are built against API Version
+// `7.1`. Once the resources have been migrated to a newer API Version this can be removed.
+
+const keyRotationPolicyAPIVersion = "7.3"
+
+type KeyRotationPolicyClient struct {
+	autorest.Client
+}
+
+func NewKeyRotationPolicyClient() KeyRotationPolicyClient {
+	return KeyRotationPolicyClient{
+		Client: autorest.NewClientWithUserAgent(""),
+	}
+}
+
+type KeyRotationPolicy struct {
+	autorest.Response `json:"-"`
+	ID                *string                      `json:"id,omitempty"`
+	LifetimeActions   *[]KeyRotationLifetimeAction `json:"lifetimeActions,omitempty"`
+	Attributes        *KeyRotationPolicyAttributes `json:"attributes,omitempty"`
+}
+
+type KeyRotationLifetimeAction struct {
+	Trigger *KeyRotationLifetimeActionTrigger `json:"trigger,omitempty"`
+	Action  *KeyRotationLifetimeActionType    `json:"action,omitempty"`
+}
+
+type KeyRotationLifetimeActionTrigger struct {
+	TimeAfterCreate  *string `json:"timeAfterCreate,omitempty"`
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
+
+type KeyRotationLifetimeActionType struct {
+	Type KeyRotationPolicyAction `json:"type,omitempty"`
+}
+
+type KeyRotationPolicyAttributes struct {
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+	Created    *int64  `json:"created,omitempty"`
+	Updated    *int64  `json:"updated,omitempty"`
+}
+
+type KeyRotationPolicyAction string
+
+const (
+	KeyRotationPolicyActionNotify KeyRotationPolicyAction = "Notify"
+	KeyRotationPolicyActionRotate KeyRotationPolicyAction = "Rotate"
+)
+
+// GetKeyRotationPolicy retrieves the Rotation Policy for the specified Key.
+func (c KeyRotationPolicyClient) GetKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string) (result KeyRotationPolicy, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseURL,
+		}),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", map[string]interface{}{
+			"key-name": autorest.Encode("path", keyName),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": keyRotationPolicyAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Send(req, autorest.DoRetryForStatusCodes(c.RetryAttempts, c.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// UpdateKeyRotationPolicy sets the Rotation Policy for the specified Key.
+func (c KeyRotationPolicyClient) UpdateKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string, input KeyRotationPolicy) (result KeyRotationPolicy, err error) {
+	input.ID = nil
+	if input.Attributes != nil {
+		input.Attributes.Created = nil
+		input.Attributes.Updated = nil
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseURL,
+		}),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", map[string]interface{}{
+			"key-name": autorest.Encode("path", keyName),
+		}),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": keyRotationPolicyAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Send(req, autorest.DoRetryForStatusCodes(c.RetryAttempts, c.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,203 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the Managed HSM Data Plane APIs (Role Definitions, Role Assignments
+// and the Security Domain) aren't available in the vendored Key Vault Data Plane SDK (API Version `7.1`).
+// Once the resources have been migrated to an SDK which includes these this can be removed.
+
+const managedHSMDataPlaneAPIVersion = "7.3"
+
+type ManagedHSMDataPlaneClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+func NewManagedHSMDataPlaneClient(baseUri string) ManagedHSMDataPlaneClient {
+	return ManagedHSMDataPlaneClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseUri,
+	}
+}
+
+type ManagedHSMRoleDefinition struct {
+	autorest.Response `json:"-"`
+	ID                *string                             `json:"id,omitempty"`
+	Name              *string                             `json:"name,omitempty"`
+	Type              *string                             `json:"type,omitempty"`
+	Properties        *ManagedHSMRoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+type ManagedHSMRoleDefinitionProperties struct {
+	RoleName         *string                     `json:"roleName,omitempty"`
+	Description      *string                     `json:"description,omitempty"`
+	RoleType         *string                     `json:"type,omitempty"`
+	Permissions      *[]ManagedHSMRolePermission `json:"permissions,omitempty"`
+	AssignableScopes *[]string                   `json:"assignableScopes,omitempty"`
+}
+
+type ManagedHSMRolePermission struct {
+	Actions        *[]string `json:"actions,omitempty"`
+	NotActions     *[]string `json:"notActions,omitempty"`
+	DataActions    *[]string `json:"dataActions,omitempty"`
+	NotDataActions *[]string `json:"notDataActions,omitempty"`
+}
+
+type ManagedHSMRoleAssignment struct {
+	autorest.Response `json:"-"`
+	ID                *string                             `json:"id,omitempty"`
+	Name              *string                             `json:"name,omitempty"`
+	Type              *string                             `json:"type,omitempty"`
+	Properties        *ManagedHSMRoleAssignmentProperties `json:"properties,omitempty"`
+}
+
+type ManagedHSMRoleAssignmentProperties struct {
+	Scope            *string `json:"scope,omitempty"`
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	PrincipalID      *string `json:"principalId,omitempty"`
+}
+
+type ManagedHSMSecurityDomainCertificates struct {
+	Certificates []ManagedHSMSecurityDomainJsonWebKey `json:"certificates"`
+	Required     int                                  `json:"required"`
+}
+
+type ManagedHSMSecurityDomainJsonWebKey struct {
+	Algorithm                  string   `json:"alg"`
+	E                          string   `json:"e"`
+	KeyID                      string   `json:"kid"`
+	KeyOps                     []string `json:"key_ops"`
+	KeyType                    string   `json:"kty"`
+	N                          string   `json:"n"`
+	X509CertificateChain       []string `json:"x5c"`
+	X509CertificateThumbprint  string   `json:"x5t"`
+	X509CertificateThumbprint2 string   `json:"x5t#S256"`
+}
+
+type ManagedHSMSecurityDomain struct {
+	autorest.Response `json:"-"`
+	Value             *string `json:"value,omitempty"`
+}
+
+type ManagedHSMSecurityDomainOperationStatus struct {
+	autorest.Response `json:"-"`
+	Status            *string `json:"status,omitempty"`
+	StatusDetails     *string `json:"status_details,omitempty"`
+}
+
+// GetRoleDefinition retrieves the specified Role Definition.
+func (c ManagedHSMDataPlaneClient) GetRoleDefinition(ctx context.Context, scope string, name string) (result ManagedHSMRoleDefinition, err error) {
+	resp, err := c.send(ctx, "GetRoleDefinition", http.MethodGet, roleItemPath(scope, "roleDefinitions"), name, nil, &result, http.StatusOK)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateOrUpdateRoleDefinition creates or updates the specified Role Definition.
+func (c ManagedHSMDataPlaneClient) CreateOrUpdateRoleDefinition(ctx context.Context, scope string, name string, properties ManagedHSMRoleDefinitionProperties) (result ManagedHSMRoleDefinition, err error) {
+	input := ManagedHSMRoleDefinition{
+		Properties: &properties,
+	}
+	resp, err := c.send(ctx, "CreateOrUpdateRoleDefinition", http.MethodPut, roleItemPath(scope, "roleDefinitions"), name, input, &result, http.StatusCreated)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// DeleteRoleDefinition deletes the specified Role Definition.
+func (c ManagedHSMDataPlaneClient) DeleteRoleDefinition(ctx context.Context, scope string, name string) (result autorest.Response, err error) {
+	resp, err := c.send(ctx, "DeleteRoleDefinition", http.MethodDelete, roleItemPath(scope, "roleDefinitions"), name, nil, nil, http.StatusOK)
+	result = autorest.Response{Response: resp}
+	return
+}
+
+// GetRoleAssignment retrieves the specified Role Assignment.
+func (c ManagedHSMDataPlaneClient) GetRoleAssignment(ctx context.Context, scope string, name string) (result ManagedHSMRoleAssignment, err error) {
+	resp, err := c.send(ctx, "GetRoleAssignment", http.MethodGet, roleItemPath(scope, "roleAssignments"), name, nil, &result, http.StatusOK)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// CreateRoleAssignment creates the specified Role Assignment, Role Assignments cannot be updated.
+func (c ManagedHSMDataPlaneClient) CreateRoleAssignment(ctx context.Context, scope string, name string, properties ManagedHSMRoleAssignmentProperties) (result ManagedHSMRoleAssignment, err error) {
+	input := ManagedHSMRoleAssignment{
+		Properties: &properties,
+	}
+	resp, err := c.send(ctx, "CreateRoleAssignment", http.MethodPut, roleItemPath(scope, "roleAssignments"), name, input, &result, http.StatusCreated)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// DeleteRoleAssignment deletes the specified Role Assignment.
+func (c ManagedHSMDataPlaneClient) DeleteRoleAssignment(ctx context.Context, scope string, name string) (result autorest.Response, err error) {
+	resp, err := c.send(ctx, "DeleteRoleAssignment", http.MethodDelete, roleItemPath(scope, "roleAssignments"), name, nil, nil, http.StatusOK)
+	result = autorest.Response{Response: resp}
+	return
+}
+
+// DownloadSecurityDomain requests the Security Domain of the Managed HSM encrypted using the specified certificates,
+// which activates the Managed HSM. The returned value can be used to restore the Managed HSM.
+func (c ManagedHSMDataPlaneClient) DownloadSecurityDomain(ctx context.Context, input ManagedHSMSecurityDomainCertificates) (result ManagedHSMSecurityDomain, err error) {
+	resp, err := c.send(ctx, "DownloadSecurityDomain", http.MethodPost, "/securitydomain/download", "", input, &result, http.StatusAccepted)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// GetSecurityDomainDownloadStatus retrieves the status of the pending Security Domain download.
+func (c ManagedHSMDataPlaneClient) GetSecurityDomainDownloadStatus(ctx context.Context) (result ManagedHSMSecurityDomainOperationStatus, err error) {
+	resp, err := c.send(ctx, "GetSecurityDomainDownloadStatus", http.MethodGet, "/securitydomain/download/pending", "", nil, &result, http.StatusOK)
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+func roleItemPath(scope string, itemType string) string {
+	if scope == "/" {
+		scope = ""
+	}
+	return scope + "/providers/Microsoft.Authorization/" + itemType + "/{name}"
+}
+
+func (c ManagedHSMDataPlaneClient) send(ctx context.Context, operation string, method string, path string, name string, input interface{}, output interface{}, statusCode int) (*http.Response, error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.WithMethod(method),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": c.BaseURI,
+		}),
+		autorest.WithPathParameters(path, map[string]interface{}{
+			"name": autorest.Encode("path", name),
+		}),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": managedHSMDataPlaneAPIVersion,
+		}),
+	}
+	if input != nil {
+		decorators = append(decorators, autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(input))
+	}
+
+	req, err := autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "keyvault.ManagedHSMDataPlaneClient", operation, nil, "Failure preparing request")
+	}
+
+	resp, err := c.Send(req, autorest.DoRetryForStatusCodes(c.RetryAttempts, c.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "keyvault.ManagedHSMDataPlaneClient", operation, resp, "Failure sending request")
+	}
+
+	responders := []autorest.RespondDecorator{
+		azure.WithErrorUnlessStatusCode(statusCode),
+	}
+	if output != nil {
+		responders = append(responders, autorest.ByUnmarshallingJSON(output))
+	}
+	responders = append(responders, autorest.ByClosing())
+	if err := autorest.Respond(resp, responders...); err != nil {
+		return resp, autorest.NewErrorWithError(err, "keyvault.ManagedHSMDataPlaneClient", operation, resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
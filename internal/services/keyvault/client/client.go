@@ -1,22 +1,34 @@
 package client
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2021-10-01/keyvault"
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type Client struct {
-	ManagedHsmClient *keyvault.ManagedHsmsClient
-	ManagementClient *keyvaultmgmt.BaseClient
-	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+	KeyRotationPolicyClient *azuresdkhacks.KeyRotationPolicyClient
+	ManagedHsmClient        *keyvault.ManagedHsmsClient
+	ManagementClient        *keyvaultmgmt.BaseClient
+	VaultsClient            *keyvault.VaultsClient
+	options                 *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	managedHsmClient := keyvault.NewManagedHsmsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedHsmClient.Client, o.ResourceManagerAuthorizer)
 
+	keyRotationPolicyClient := azuresdkhacks.NewKeyRotationPolicyClient()
+	o.ConfigureClient(&keyRotationPolicyClient.Client, o.KeyVaultAuthorizer)
+
 	managementClient := keyvaultmgmt.New()
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
@@ -24,10 +36,11 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ManagedHsmClient: &managedHsmClient,
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
-		options:          o,
+		KeyRotationPolicyClient: &keyRotationPolicyClient,
+		ManagedHsmClient:        &managedHsmClient,
+		ManagementClient:        &managementClient,
+		VaultsClient:            &vaultsClient,
+		options:                 o,
 	}
 }
 
@@ -36,3 +49,40 @@ func (client Client) KeyVaultClientForSubscription(subscriptionId string) *keyva
 	client.options.ConfigureClient(&vaultsClient.Client, client.options.ResourceManagerAuthorizer)
 	return &vaultsClient
 }
+
+func (client Client) PermissionsClientForSubscription(subscriptionId string) *authorization.PermissionsClient {
+	permissionsClient := authorization.NewPermissionsClientWithBaseURI(client.options.ResourceManagerEndpoint, subscriptionId)
+	client.options.ConfigureClient(&permissionsClient.Client, client.options.ResourceManagerAuthorizer)
+	return &permissionsClient
+}
+
+// ManagedHSMDataPlaneClient returns a client for the Data Plane of the specified Managed HSM, or nil if it doesn't exist
+func (client Client) ManagedHSMDataPlaneClient(ctx context.Context, managedHSMId parse.ManagedHSMId) (*azuresdkhacks.ManagedHSMDataPlaneClient, error) {
+	managedHsmClient := keyvault.NewManagedHsmsClientWithBaseURI(client.options.ResourceManagerEndpoint, managedHSMId.SubscriptionId)
+	client.options.ConfigureClient(&managedHsmClient.Client, client.options.ResourceManagerAuthorizer)
+
+	resp, err := managedHsmClient.Get(ctx, managedHSMId.ResourceGroup, managedHSMId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", managedHSMId, err)
+	}
+	if resp.Properties == nil || resp.Properties.HsmURI == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.hsmUri` was nil", managedHSMId)
+	}
+
+	endpoint := client.options.Environment.ResourceIdentifiers.ManagedHSM
+	if endpoint == "" || endpoint == azure.NotAvailable {
+		return nil, fmt.Errorf("the Managed HSM Data Plane is not available in the %q Environment", client.options.Environment.Name)
+	}
+	authorizer, err := client.options.TokenFunc(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", endpoint, err)
+	}
+
+	dataPlaneClient := azuresdkhacks.NewManagedHSMDataPlaneClient(*resp.Properties.HsmURI)
+	client.options.ConfigureClient(&dataPlaneClient.Client, authorizer)
+	return &dataPlaneClient, nil
+}
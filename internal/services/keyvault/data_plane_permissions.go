@@ -0,0 +1,193 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2021-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type nestedItemType string
+
+const (
+	nestedItemTypeCertificate nestedItemType = "certificates"
+	nestedItemTypeKey         nestedItemType = "keys"
+	nestedItemTypeSecret      nestedItemType = "secrets"
+)
+
+// nestedItemDataActions maps the Access Policy permission for each Nested Item type to the equivalent RBAC Data Action
+var nestedItemDataActions = map[nestedItemType]map[string]string{
+	nestedItemTypeCertificate: {
+		"create": "Microsoft.KeyVault/vaults/certificates/create/action",
+		"get":    "Microsoft.KeyVault/vaults/certificates/read",
+		"import": "Microsoft.KeyVault/vaults/certificates/import/action",
+	},
+	nestedItemTypeKey: {
+		"create": "Microsoft.KeyVault/vaults/keys/create/action",
+		"get":    "Microsoft.KeyVault/vaults/keys/read",
+	},
+	nestedItemTypeSecret: {
+		"get": "Microsoft.KeyVault/vaults/secrets/getSecret/action",
+		"set": "Microsoft.KeyVault/vaults/secrets/setSecret/action",
+	},
+}
+
+// validateNestedItemDataPlanePermissions checks during plan that the authenticated principal has the data plane
+// permissions required to manage a Nested Item within the Key Vault, when opted-in via the Features block
+func validateNestedItemDataPlanePermissions(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}, itemType nestedItemType, permissions []string) error {
+	client := meta.(*clients.Client)
+	if !client.Features.KeyVault.ValidateDataPlanePermissions {
+		return nil
+	}
+
+	if d.Id() != "" && len(d.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+
+	keyVaultIdRaw := d.Get("key_vault_id").(string)
+	if keyVaultIdRaw == "" || !d.NewValueKnown("key_vault_id") {
+		// the Key Vault is being created in this apply, so there's nothing to check yet
+		return nil
+	}
+
+	keyVaultId, err := parse.VaultID(keyVaultIdRaw)
+	if err != nil {
+		return err
+	}
+
+	vault, err := client.KeyVault.KeyVaultClientForSubscription(keyVaultId.SubscriptionId).Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s to validate data plane permissions: %+v", *keyVaultId, err)
+	}
+	if vault.Properties == nil {
+		return fmt.Errorf("retrieving %s to validate data plane permissions: `properties` was nil", *keyVaultId)
+	}
+
+	var missing []string
+	if vault.Properties.EnableRbacAuthorization != nil && *vault.Properties.EnableRbacAuthorization {
+		permissionsClient := client.KeyVault.PermissionsClientForSubscription(keyVaultId.SubscriptionId)
+		iterator, err := permissionsClient.ListForResourceComplete(ctx, keyVaultId.ResourceGroup, "Microsoft.KeyVault", "", "vaults", keyVaultId.Name)
+		if err != nil {
+			return fmt.Errorf("listing permissions for %s: %+v", *keyVaultId, err)
+		}
+		granted := make([]authorization.Permission, 0)
+		for iterator.NotDone() {
+			granted = append(granted, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing permissions for %s: %+v", *keyVaultId, err)
+			}
+		}
+
+		for _, permission := range permissions {
+			dataAction := nestedItemDataActions[itemType][permission]
+			if !dataActionIsGranted(granted, dataAction) {
+				missing = append(missing, dataAction)
+			}
+		}
+	} else {
+		objectId := client.Account.ObjectId
+		if objectId == "" {
+			log.Printf("[DEBUG] Skipping validation of data plane permissions for %s since the Object ID of the authenticated principal is unknown", *keyVaultId)
+			return nil
+		}
+
+		granted := accessPolicyPermissionsForPrincipal(vault.Properties.AccessPolicies, itemType, client.Account.TenantId, objectId, client.Account.ClientId)
+		for _, permission := range permissions {
+			if !granted[permission] && !granted["all"] {
+				missing = append(missing, permission)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("the authenticated principal is missing the data plane permissions %q on %s which are required to manage %s - these can be granted via an Access Policy or a Role Assignment, or this check can be disabled by setting `validate_data_plane_permissions` to `false` within the `key_vault` block of the `features` block", strings.Join(missing, ", "), *keyVaultId, itemType)
+	}
+
+	return nil
+}
+
+// accessPolicyPermissionsForPrincipal returns the (lower-cased) permissions granted to the principal for the
+// specified Nested Item type. Permissions granted via membership of a group cannot be detected here.
+func accessPolicyPermissionsForPrincipal(input *[]keyvault.AccessPolicyEntry, itemType nestedItemType, tenantId, objectId, clientId string) map[string]bool {
+	output := make(map[string]bool)
+	if input == nil {
+		return output
+	}
+
+	for _, policy := range *input {
+		if policy.ObjectID == nil || !strings.EqualFold(*policy.ObjectID, objectId) {
+			continue
+		}
+		if policy.TenantID == nil || !strings.EqualFold(policy.TenantID.String(), tenantId) {
+			continue
+		}
+		if policy.ApplicationID != nil && !strings.EqualFold(policy.ApplicationID.String(), clientId) {
+			continue
+		}
+		if policy.Permissions == nil {
+			continue
+		}
+
+		permissions := make([]string, 0)
+		switch itemType {
+		case nestedItemTypeCertificate:
+			if policy.Permissions.Certificates != nil {
+				for _, v := range *policy.Permissions.Certificates {
+					permissions = append(permissions, string(v))
+				}
+			}
+		case nestedItemTypeKey:
+			if policy.Permissions.Keys != nil {
+				for _, v := range *policy.Permissions.Keys {
+					permissions = append(permissions, string(v))
+				}
+			}
+		case nestedItemTypeSecret:
+			if policy.Permissions.Secrets != nil {
+				for _, v := range *policy.Permissions.Secrets {
+					permissions = append(permissions, string(v))
+				}
+			}
+		}
+
+		for _, v := range permissions {
+			output[strings.ToLower(v)] = true
+		}
+	}
+
+	return output
+}
+
+// dataActionIsGranted returns whether the Data Action is allowed, and not denied, by any of the permissions
+func dataActionIsGranted(input []authorization.Permission, dataAction string) bool {
+	for _, permission := range input {
+		if permission.DataActions == nil || !dataActionMatchesAny(*permission.DataActions, dataAction) {
+			continue
+		}
+		if permission.NotDataActions != nil && dataActionMatchesAny(*permission.NotDataActions, dataAction) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func dataActionMatchesAny(patterns []string, dataAction string) bool {
+	for _, pattern := range patterns {
+		expression := "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+		if matched, _ := regexp.MatchString(expression, dataAction); matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,146 @@
+package keyvault
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2021-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestDataActionIsGranted(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Input      []authorization.Permission
+		DataAction string
+		Expected   bool
+	}{
+		{
+			Name:       "No Permissions",
+			Input:      []authorization.Permission{},
+			DataAction: "Microsoft.KeyVault/vaults/secrets/getSecret/action",
+			Expected:   false,
+		},
+		{
+			Name: "Exact Match",
+			Input: []authorization.Permission{
+				{
+					DataActions: &[]string{"Microsoft.KeyVault/vaults/secrets/getSecret/action"},
+				},
+			},
+			DataAction: "Microsoft.KeyVault/vaults/secrets/getSecret/action",
+			Expected:   true,
+		},
+		{
+			Name: "Wildcard Match",
+			Input: []authorization.Permission{
+				{
+					DataActions: &[]string{"Microsoft.KeyVault/vaults/secrets/*"},
+				},
+			},
+			DataAction: "Microsoft.KeyVault/vaults/secrets/setSecret/action",
+			Expected:   true,
+		},
+		{
+			Name: "Case Insensitive Match",
+			Input: []authorization.Permission{
+				{
+					DataActions: &[]string{"microsoft.keyvault/vaults/*"},
+				},
+			},
+			DataAction: "Microsoft.KeyVault/vaults/keys/read",
+			Expected:   true,
+		},
+		{
+			Name: "Wildcard Match for a different Nested Item type",
+			Input: []authorization.Permission{
+				{
+					DataActions: &[]string{"Microsoft.KeyVault/vaults/keys/*"},
+				},
+			},
+			DataAction: "Microsoft.KeyVault/vaults/secrets/getSecret/action",
+			Expected:   false,
+		},
+		{
+			Name: "Denied via Not Data Actions",
+			Input: []authorization.Permission{
+				{
+					DataActions:    &[]string{"Microsoft.KeyVault/vaults/secrets/*"},
+					NotDataActions: &[]string{"Microsoft.KeyVault/vaults/secrets/setSecret/action"},
+				},
+			},
+			DataAction: "Microsoft.KeyVault/vaults/secrets/setSecret/action",
+			Expected:   false,
+		},
+		{
+			Name: "Denied in one Permission but Granted in another",
+			Input: []authorization.Permission{
+				{
+					DataActions:    &[]string{"Microsoft.KeyVault/vaults/secrets/*"},
+					NotDataActions: &[]string{"Microsoft.KeyVault/vaults/secrets/setSecret/action"},
+				},
+				{
+					DataActions: &[]string{"Microsoft.KeyVault/vaults/secrets/setSecret/action"},
+				},
+			},
+			DataAction: "Microsoft.KeyVault/vaults/secrets/setSecret/action",
+			Expected:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := dataActionIsGranted(v.Input, v.DataAction)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestAccessPolicyPermissionsForPrincipal(t *testing.T) {
+	tenantId := "00000000-0000-0000-0000-000000000001"
+	objectId := "00000000-0000-0000-0000-000000000002"
+	clientId := "00000000-0000-0000-0000-000000000003"
+	tenantUuid := uuid.FromStringOrNil(tenantId)
+	otherUuid := uuid.FromStringOrNil("00000000-0000-0000-0000-000000000004")
+
+	policies := []keyvault.AccessPolicyEntry{
+		{
+			TenantID: &tenantUuid,
+			ObjectID: utils.String(objectId),
+			Permissions: &keyvault.Permissions{
+				Secrets: &[]keyvault.SecretPermissions{"Get", keyvault.SecretPermissionsList},
+			},
+		},
+		{
+			TenantID:      &tenantUuid,
+			ObjectID:      utils.String(objectId),
+			ApplicationID: &otherUuid,
+			Permissions: &keyvault.Permissions{
+				Secrets: &[]keyvault.SecretPermissions{keyvault.SecretPermissionsSet},
+			},
+		},
+		{
+			TenantID: &tenantUuid,
+			ObjectID: utils.String("00000000-0000-0000-0000-000000000005"),
+			Permissions: &keyvault.Permissions{
+				Keys: &[]keyvault.KeyPermissions{keyvault.KeyPermissionsCreate},
+			},
+		},
+	}
+
+	secrets := accessPolicyPermissionsForPrincipal(&policies, nestedItemTypeSecret, tenantId, objectId, clientId)
+	if !secrets["get"] || !secrets["list"] {
+		t.Fatalf("expected `get` and `list` to be granted for secrets but got %+v", secrets)
+	}
+	if secrets["set"] {
+		t.Fatalf("expected `set` not to be granted for secrets since it's scoped to another application")
+	}
+
+	keys := accessPolicyPermissionsForPrincipal(&policies, nestedItemTypeKey, tenantId, objectId, clientId)
+	if len(keys) != 0 {
+		t.Fatalf("expected no permissions to be granted for keys but got %+v", keys)
+	}
+}
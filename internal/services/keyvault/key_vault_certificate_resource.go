@@ -415,6 +415,14 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			permissions := []string{"get", "create"}
+			if v, ok := d.GetOk("certificate"); ok && len(v.([]interface{})) > 0 {
+				permissions = []string{"get", "import"}
+			}
+			return validateNestedItemDataPlanePermissions(ctx, d, meta, nestedItemTypeCertificate, permissions)
+		}),
 	}
 }
 
@@ -466,6 +474,11 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 				if err = recoverDeletedCertificate(ctx, d, meta, *keyVaultBaseUrl, name); err != nil {
 					return err
 				}
+
+				// the recovered Certificate contains the previous contents, so import the configured Certificate as a new version
+				if _, err := client.ImportCertificate(ctx, *keyVaultBaseUrl, name, importParameters); err != nil {
+					return err
+				}
 			} else {
 				return err
 			}
@@ -481,6 +494,11 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 				if err = recoverDeletedCertificate(ctx, d, meta, *keyVaultBaseUrl, name); err != nil {
 					return err
 				}
+
+				// the recovered Certificate contains the previous contents, so create a new version using the configured policy
+				if _, err := client.CreateCertificate(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
+					return err
+				}
 			} else {
 				return err
 			}
@@ -523,7 +541,7 @@ func recoverDeletedCertificate(ctx context.Context, d *pluginsdk.ResourceData, m
 	if err != nil {
 		return err
 	}
-	log.Printf("[DEBUG] Recovering Secret %q with ID: %q", name, *recoveredCertificate.ID)
+	log.Printf("[DEBUG] Recovering Certificate %q with ID: %q", name, *recoveredCertificate.ID)
 	if certificate := recoveredCertificate.ID; certificate != nil {
 		stateConf := &pluginsdk.StateChangeConf{
 			Pending:                   []string{"pending"},
@@ -536,9 +554,9 @@ func recoverDeletedCertificate(ctx context.Context, d *pluginsdk.ResourceData, m
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-			return fmt.Errorf("waiting for Key Vault Secret %q to become available: %s", name, err)
+			return fmt.Errorf("waiting for Key Vault Certificate %q to become available: %s", name, err)
 		}
-		log.Printf("[DEBUG] Secret %q recovered with ID: %q", name, *recoveredCertificate.ID)
+		log.Printf("[DEBUG] Certificate %q recovered with ID: %q", name, *recoveredCertificate.ID)
 	}
 	return nil
 }
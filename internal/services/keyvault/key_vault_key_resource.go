@@ -11,13 +11,16 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -138,6 +141,66 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+							AtLeastOneOf: []string{
+								"rotation_policy.0.expire_after",
+								"rotation_policy.0.automatic",
+							},
+						},
+
+						"notify_before_expiry": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.ISO8601Duration,
+							RequiredWith: []string{"rotation_policy.0.expire_after"},
+						},
+
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							AtLeastOneOf: []string{
+								"rotation_policy.0.expire_after",
+								"rotation_policy.0.automatic",
+							},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -191,6 +254,10 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			return validateNestedItemDataPlanePermissions(ctx, d, meta, nestedItemTypeKey, []string{"get", "create"})
+		}),
 	}
 }
 
@@ -283,15 +350,26 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 				}
 
 				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-					return fmt.Errorf("waiting for Key Vault Secret %q to become available: %s", name, err)
+					return fmt.Errorf("waiting for Key Vault Key %q to become available: %s", name, err)
 				}
 				log.Printf("[DEBUG] Key %q recovered with ID: %q", name, *kid)
+
+				// the recovered Key contains the previous key material and attributes, so create a new version using the configured values
+				if _, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
+					return fmt.Errorf("Creating Key: %+v", err)
+				}
 			}
 		} else {
 			return fmt.Errorf("Creating Key: %+v", err)
 		}
 	}
 
+	if v, ok := d.GetOk("rotation_policy"); ok {
+		if _, err := keyVaultsClient.KeyRotationPolicyClient.UpdateKeyRotationPolicy(ctx, *keyVaultBaseUri, name, expandKeyVaultKeyRotationPolicy(v.([]interface{}))); err != nil {
+			return fmt.Errorf("setting the Rotation Policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
 	// "" indicates the latest version
 	read, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
 	if err != nil {
@@ -365,6 +443,14 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.HasChange("rotation_policy") {
+		// removing the block resets the Key to having no Rotation Policy
+		policy := expandKeyVaultKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))
+		if _, err := keyVaultsClient.KeyRotationPolicyClient.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, policy); err != nil {
+			return fmt.Errorf("updating the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
@@ -450,6 +536,18 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	rotationPolicy, err := keyVaultsClient.KeyRotationPolicyClient.GetKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		// existing configurations may not have been granted the `GetRotationPolicy` permission, so only
+		// surface this when a Rotation Policy is being managed
+		if !utils.ResponseWasForbidden(rotationPolicy.Response) || len(d.Get("rotation_policy").([]interface{})) > 0 {
+			return fmt.Errorf("retrieving the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		log.Printf("[DEBUG] Skipping reading the Rotation Policy for Key %q (Key Vault %q) since permission was denied", id.Name, id.KeyVaultBaseUrl)
+	} else if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(rotationPolicy)); err != nil {
+		return fmt.Errorf("setting `rotation_policy`: %+v", err)
+	}
+
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
@@ -632,3 +730,98 @@ func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
 	}
 	return nil
 }
+
+func expandKeyVaultKeyRotationPolicy(input []interface{}) azuresdkhacks.KeyRotationPolicy {
+	lifetimeActions := make([]azuresdkhacks.KeyRotationLifetimeAction, 0)
+	output := azuresdkhacks.KeyRotationPolicy{
+		LifetimeActions: &lifetimeActions,
+		Attributes:      &azuresdkhacks.KeyRotationPolicyAttributes{},
+	}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+	if expireAfter := v["expire_after"].(string); expireAfter != "" {
+		output.Attributes.ExpiryTime = utils.String(expireAfter)
+	}
+
+	if notifyBeforeExpiry := v["notify_before_expiry"].(string); notifyBeforeExpiry != "" {
+		lifetimeActions = append(lifetimeActions, azuresdkhacks.KeyRotationLifetimeAction{
+			Trigger: &azuresdkhacks.KeyRotationLifetimeActionTrigger{
+				TimeBeforeExpiry: utils.String(notifyBeforeExpiry),
+			},
+			Action: &azuresdkhacks.KeyRotationLifetimeActionType{
+				Type: azuresdkhacks.KeyRotationPolicyActionNotify,
+			},
+		})
+	}
+
+	if automaticRaw := v["automatic"].([]interface{}); len(automaticRaw) > 0 && automaticRaw[0] != nil {
+		automatic := automaticRaw[0].(map[string]interface{})
+		trigger := azuresdkhacks.KeyRotationLifetimeActionTrigger{}
+		if timeAfterCreation := automatic["time_after_creation"].(string); timeAfterCreation != "" {
+			trigger.TimeAfterCreate = utils.String(timeAfterCreation)
+		}
+		if timeBeforeExpiry := automatic["time_before_expiry"].(string); timeBeforeExpiry != "" {
+			trigger.TimeBeforeExpiry = utils.String(timeBeforeExpiry)
+		}
+		lifetimeActions = append(lifetimeActions, azuresdkhacks.KeyRotationLifetimeAction{
+			Trigger: &trigger,
+			Action: &azuresdkhacks.KeyRotationLifetimeActionType{
+				Type: azuresdkhacks.KeyRotationPolicyActionRotate,
+			},
+		})
+	}
+
+	return output
+}
+
+func flattenKeyVaultKeyRotationPolicy(input azuresdkhacks.KeyRotationPolicy) []interface{} {
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Trigger == nil {
+				continue
+			}
+
+			timeAfterCreate := ""
+			if action.Trigger.TimeAfterCreate != nil {
+				timeAfterCreate = *action.Trigger.TimeAfterCreate
+			}
+			timeBeforeExpiry := ""
+			if action.Trigger.TimeBeforeExpiry != nil {
+				timeBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+			}
+
+			switch {
+			case strings.EqualFold(string(action.Action.Type), string(azuresdkhacks.KeyRotationPolicyActionNotify)):
+				notifyBeforeExpiry = timeBeforeExpiry
+			case strings.EqualFold(string(action.Action.Type), string(azuresdkhacks.KeyRotationPolicyActionRotate)):
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": timeAfterCreate,
+					"time_before_expiry":  timeBeforeExpiry,
+				})
+			}
+		}
+	}
+
+	// the API returns a default policy (which only notifies) for Keys without a Rotation Policy
+	if expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expire_after":         expireAfter,
+			"notify_before_expiry": notifyBeforeExpiry,
+			"automatic":            automatic,
+		},
+	}
+}
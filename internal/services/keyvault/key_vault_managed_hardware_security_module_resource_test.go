@@ -27,6 +27,18 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"update":   testAccKeyVaultManagedHardwareSecurityModule_requiresImport,
 			"complete": testAccKeyVaultManagedHardwareSecurityModule_complete,
 		},
+		"security_domain": {
+			"basic": testAccKeyVaultManagedHardwareSecurityModuleSecurityDomain_basic,
+		},
+		"role_definition": {
+			"basic":          testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_basic,
+			"update":         testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_update,
+			"requiresImport": testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_requiresImport,
+		},
+		"role_assignment": {
+			"basic":          testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic,
+			"requiresImport": testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_requiresImport,
+		},
 	})
 }
 
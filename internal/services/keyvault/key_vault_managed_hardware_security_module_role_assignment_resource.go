@@ -0,0 +1,203 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel struct {
+	Name             string `tfschema:"name"`
+	ManagedHSMId     string `tfschema:"managed_hsm_id"`
+	Scope            string `tfschema:"scope"`
+	RoleDefinitionId string `tfschema:"role_definition_id"`
+	PrincipalId      string `tfschema:"principal_id"`
+}
+
+var _ sdk.Resource = KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct{}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_role_assignment"
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) ModelObject() interface{} {
+	return &KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel{}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMRoleAssignmentID
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedHSMID,
+		},
+
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^/(keys(/[^/]+)?)?$`),
+				"`scope` must be `/`, `/keys` or `/keys/{keyName}`",
+			),
+		},
+
+		"role_definition_id": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: suppress.CaseDifference,
+		},
+
+		"principal_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedHSMId, err := parse.ManagedHSMID(model.ManagedHSMId)
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, *managedHSMId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("%s was not found", *managedHSMId)
+			}
+
+			id := parse.NewManagedHSMRoleAssignmentID(managedHSMId.SubscriptionId, managedHSMId.ResourceGroup, managedHSMId.Name, model.Scope, model.Name)
+			existing, err := client.GetRoleAssignment(ctx, id.Scope, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := azuresdkhacks.ManagedHSMRoleAssignmentProperties{
+				RoleDefinitionID: utils.String(model.RoleDefinitionId),
+				PrincipalID:      utils.String(model.PrincipalId),
+			}
+			if _, err := client.CreateRoleAssignment(ctx, id.Scope, id.Name, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			managedHSMId := parse.NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName)
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, managedHSMId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetRoleAssignment(ctx, id.Scope, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel{
+				Name:         id.Name,
+				ManagedHSMId: managedHSMId.ID(),
+				Scope:        id.Scope,
+			}
+
+			if props := resp.Properties; props != nil {
+				model.RoleDefinitionId = utils.NormalizeNilableString(props.RoleDefinitionID)
+				model.PrincipalId = utils.NormalizeNilableString(props.PrincipalID)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, parse.NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName))
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return nil
+			}
+
+			if resp, err := client.DeleteRoleAssignment(ctx, id.Scope, id.Name); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
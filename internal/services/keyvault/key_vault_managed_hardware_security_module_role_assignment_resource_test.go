@@ -0,0 +1,99 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct{}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.KeyVault.ManagedHSMDataPlaneClient(ctx, parse.NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName))
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return utils.Bool(false), nil
+	}
+
+	resp, err := client.GetRoleAssignment(ctx, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "1e243909-064c-6ac3-84e9-1c8bf8d6ad52"
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module_role_definition.test.managed_hsm_id
+  scope              = "/keys"
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}.basic(data))
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "import" {
+  name               = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.name
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.managed_hsm_id
+  scope              = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.scope
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.role_definition_id
+  principal_id       = azurerm_key_vault_managed_hardware_security_module_role_assignment.test.principal_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,336 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel struct {
+	Name              string                                                     `tfschema:"name"`
+	ManagedHSMId      string                                                     `tfschema:"managed_hsm_id"`
+	RoleName          string                                                     `tfschema:"role_name"`
+	Description       string                                                     `tfschema:"description"`
+	Permission        []KeyVaultManagedHardwareSecurityModuleRolePermissionModel `tfschema:"permission"`
+	RoleType          string                                                     `tfschema:"role_type"`
+	ResourceManagerId string                                                     `tfschema:"resource_manager_id"`
+}
+
+type KeyVaultManagedHardwareSecurityModuleRolePermissionModel struct {
+	Actions        []string `tfschema:"actions"`
+	NotActions     []string `tfschema:"not_actions"`
+	DataActions    []string `tfschema:"data_actions"`
+	NotDataActions []string `tfschema:"not_data_actions"`
+}
+
+var _ sdk.ResourceWithUpdate = KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource struct{}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_role_definition"
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) ModelObject() interface{} {
+	return &KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel{}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMRoleDefinitionID
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedHSMID,
+		},
+
+		"role_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"permission": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"not_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"data_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"not_data_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"role_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"resource_manager_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedHSMId, err := parse.ManagedHSMID(model.ManagedHSMId)
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, *managedHSMId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("%s was not found", *managedHSMId)
+			}
+
+			id := parse.NewManagedHSMRoleDefinitionID(managedHSMId.SubscriptionId, managedHSMId.ResourceGroup, managedHSMId.Name, model.Name)
+			existing, err := client.GetRoleDefinition(ctx, id.Scope(), id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateRoleDefinition(ctx, id.Scope(), id.Name, expandManagedHSMRoleDefinitionProperties(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			managedHSMId := parse.NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName)
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, managedHSMId)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetRoleDefinition(ctx, id.Scope(), id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel{
+				Name:              id.Name,
+				ManagedHSMId:      managedHSMId.ID(),
+				ResourceManagerId: utils.NormalizeNilableString(resp.ID),
+			}
+
+			if props := resp.Properties; props != nil {
+				model.RoleName = utils.NormalizeNilableString(props.RoleName)
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.RoleType = utils.NormalizeNilableString(props.RoleType)
+				model.Permission = flattenManagedHSMRolePermissions(props.Permissions)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, parse.NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName))
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("the Managed HSM for %s was not found", *id)
+			}
+
+			if _, err := client.CreateOrUpdateRoleDefinition(ctx, id.Scope(), id.Name, expandManagedHSMRoleDefinitionProperties(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, parse.NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName))
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return nil
+			}
+
+			if resp, err := client.DeleteRoleDefinition(ctx, id.Scope(), id.Name); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagedHSMRoleDefinitionProperties(input KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel) azuresdkhacks.ManagedHSMRoleDefinitionProperties {
+	permissions := make([]azuresdkhacks.ManagedHSMRolePermission, 0)
+	for _, v := range input.Permission {
+		permissions = append(permissions, azuresdkhacks.ManagedHSMRolePermission{
+			Actions:        expandManagedHSMRolePermissionActions(v.Actions),
+			NotActions:     expandManagedHSMRolePermissionActions(v.NotActions),
+			DataActions:    expandManagedHSMRolePermissionActions(v.DataActions),
+			NotDataActions: expandManagedHSMRolePermissionActions(v.NotDataActions),
+		})
+	}
+
+	output := azuresdkhacks.ManagedHSMRoleDefinitionProperties{
+		RoleName:         utils.String(input.RoleName),
+		RoleType:         utils.String("CustomRole"),
+		Permissions:      &permissions,
+		AssignableScopes: &[]string{"/"},
+	}
+	if input.Description != "" {
+		output.Description = utils.String(input.Description)
+	}
+
+	return output
+}
+
+func flattenManagedHSMRolePermissions(input *[]azuresdkhacks.ManagedHSMRolePermission) []KeyVaultManagedHardwareSecurityModuleRolePermissionModel {
+	output := make([]KeyVaultManagedHardwareSecurityModuleRolePermissionModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, KeyVaultManagedHardwareSecurityModuleRolePermissionModel{
+			Actions:        flattenManagedHSMRolePermissionActions(v.Actions),
+			NotActions:     flattenManagedHSMRolePermissionActions(v.NotActions),
+			DataActions:    flattenManagedHSMRolePermissionActions(v.DataActions),
+			NotDataActions: flattenManagedHSMRolePermissionActions(v.NotDataActions),
+		})
+	}
+
+	return output
+}
+
+func expandManagedHSMRolePermissionActions(input []string) *[]string {
+	output := make([]string, 0)
+	output = append(output, input...)
+	return &output
+}
+
+func flattenManagedHSMRolePermissionActions(input *[]string) []string {
+	output := make([]string, 0)
+	if input != nil {
+		output = append(output, *input...)
+	}
+	return output
+}
@@ -0,0 +1,170 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource struct{}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_manager_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMRoleDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.KeyVault.ManagedHSMDataPlaneClient(ctx, parse.NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName))
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return utils.Bool(false), nil
+	}
+
+	resp, err := client.GetRoleDefinition(ctx, id.Scope(), id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "c9562a52-2bd9-2671-3d89-cea5b4798a6b"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module_security_domain.test.managed_hsm_id
+  role_name      = "acctest-role-%d"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+    ]
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleSecurityDomainResource{}.template(data), data.RandomInteger)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "c9562a52-2bd9-2671-3d89-cea5b4798a6b"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module_security_domain.test.managed_hsm_id
+  role_name      = "acctest-role-updated-%d"
+  description    = "Acceptance Test Role Definition"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+      "Microsoft.KeyVault/managedHsm/keys/write/action",
+      "Microsoft.KeyVault/managedHsm/keys/encrypt/action",
+    ]
+
+    not_data_actions = [
+      "Microsoft.KeyVault/managedHsm/roleAssignments/delete/action",
+    ]
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleSecurityDomainResource{}.template(data), data.RandomInteger)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "import" {
+  name           = azurerm_key_vault_managed_hardware_security_module_role_definition.test.name
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module_role_definition.test.managed_hsm_id
+  role_name      = azurerm_key_vault_managed_hardware_security_module_role_definition.test.role_name
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+    ]
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,254 @@
+package keyvault
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha1" // nolint: gosec
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleSecurityDomainModel struct {
+	ManagedHSMId  string   `tfschema:"managed_hsm_id"`
+	Certificates  []string `tfschema:"certificates"`
+	Quorum        int      `tfschema:"quorum"`
+	EncryptedData string   `tfschema:"encrypted_data"`
+}
+
+var _ sdk.ResourceWithCustomImporter = KeyVaultManagedHardwareSecurityModuleSecurityDomainResource{}
+
+type KeyVaultManagedHardwareSecurityModuleSecurityDomainResource struct{}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_security_domain"
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) ModelObject() interface{} {
+	return &KeyVaultManagedHardwareSecurityModuleSecurityDomainModel{}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMID
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_hsm_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedHSMID,
+		},
+
+		"certificates": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 3,
+			MaxItems: 10,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"quorum": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(2, 10),
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"encrypted_data": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeyVaultManagedHardwareSecurityModuleSecurityDomainModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.ManagedHSMID(model.ManagedHSMId)
+			if err != nil {
+				return err
+			}
+
+			if model.Quorum > len(model.Certificates) {
+				return fmt.Errorf("`quorum` (%d) cannot be greater than the number of `certificates` (%d)", model.Quorum, len(model.Certificates))
+			}
+
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, *id)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return fmt.Errorf("%s was not found", *id)
+			}
+
+			// the Security Domain can only be downloaded once, after which the Managed HSM is activated
+			existing, err := client.GetSecurityDomainDownloadStatus(ctx)
+			if err == nil && existing.Status != nil && strings.EqualFold(*existing.Status, "Success") {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			certificates := make([]azuresdkhacks.ManagedHSMSecurityDomainJsonWebKey, 0)
+			for i, v := range model.Certificates {
+				certificate, err := expandManagedHSMSecurityDomainCertificate(v)
+				if err != nil {
+					return fmt.Errorf("parsing `certificates.%d`: %+v", i, err)
+				}
+				certificates = append(certificates, *certificate)
+			}
+
+			resp, err := client.DownloadSecurityDomain(ctx, azuresdkhacks.ManagedHSMSecurityDomainCertificates{
+				Certificates: certificates,
+				Required:     model.Quorum,
+			})
+			if err != nil {
+				return fmt.Errorf("downloading the Security Domain for %s: %+v", *id, err)
+			}
+			if resp.Value == nil {
+				return fmt.Errorf("downloading the Security Domain for %s: `value` was nil", *id)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending: []string{"InProgress"},
+				Target:  []string{"Success"},
+				Refresh: func() (interface{}, string, error) {
+					status, err := client.GetSecurityDomainDownloadStatus(ctx)
+					if err != nil {
+						return nil, "", fmt.Errorf("retrieving the status of the Security Domain download: %+v", err)
+					}
+					if status.Status == nil {
+						return nil, "", fmt.Errorf("retrieving the status of the Security Domain download: `status` was nil")
+					}
+					if strings.EqualFold(*status.Status, "Failed") {
+						return nil, "", fmt.Errorf("the Security Domain download failed: %s", utils.NormalizeNilableString(status.StatusDetails))
+					}
+					return status, *status.Status, nil
+				},
+				MinTimeout: 10 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for the Security Domain for %s to be downloaded: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			if err := metadata.ResourceData.Set("encrypted_data", *resp.Value); err != nil {
+				return fmt.Errorf("setting `encrypted_data`: %+v", err)
+			}
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.KeyVault.ManagedHSMDataPlaneClient(ctx, *id)
+			if err != nil {
+				return err
+			}
+			if client == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			// the certificates, quorum and encrypted Security Domain can't be retrieved, so these are kept as-is
+			return metadata.ResourceData.Set("managed_hsm_id", id.ID())
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedHSMID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] the Security Domain for %s cannot be removed, the Managed HSM will remain activated - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("the Security Domain of a Managed HSM can only be downloaded once, as such %q cannot be imported", r.ResourceType())
+	}
+}
+
+// expandManagedHSMSecurityDomainCertificate converts a PEM encoded certificate into the JSON Web Key format
+// expected by the Security Domain API
+func expandManagedHSMSecurityDomainCertificate(input string) (*azuresdkhacks.ManagedHSMSecurityDomainJsonWebKey, error) {
+	block, _ := pem.Decode([]byte(input))
+	if block == nil {
+		return nil, fmt.Errorf("expected a PEM encoded certificate")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %+v", err)
+	}
+
+	publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected the certificate to contain an RSA public key")
+	}
+
+	sha1Thumbprint := sha1.Sum(certificate.Raw) // nolint: gosec
+	sha256Thumbprint := sha256.Sum256(certificate.Raw)
+
+	return &azuresdkhacks.ManagedHSMSecurityDomainJsonWebKey{
+		Algorithm:                  "RSA-OAEP-256",
+		E:                          base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		KeyID:                      hex.EncodeToString(sha256Thumbprint[:]),
+		KeyOps:                     []string{"verify", "encrypt", "wrapKey"},
+		KeyType:                    "RSA",
+		N:                          base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		X509CertificateChain:       []string{base64.StdEncoding.EncodeToString(certificate.Raw)},
+		X509CertificateThumbprint:  base64.RawURLEncoding.EncodeToString(sha1Thumbprint[:]),
+		X509CertificateThumbprint2: base64.RawURLEncoding.EncodeToString(sha256Thumbprint[:]),
+	}, nil
+}
@@ -0,0 +1,163 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleSecurityDomainResource struct{}
+
+func testAccKeyVaultManagedHardwareSecurityModuleSecurityDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_security_domain", "test")
+	r := KeyVaultManagedHardwareSecurityModuleSecurityDomainResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("encrypted_data").IsSet(),
+			),
+		},
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.KeyVault.ManagedHSMDataPlaneClient(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return utils.Bool(false), nil
+	}
+
+	resp, err := client.GetSecurityDomainDownloadStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Security Domain download status for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Status != nil && strings.EqualFold(*resp.Status, "Success")), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+`, r.template(data))
+}
+
+// template returns a Managed HSM which has been activated, for use in the tests for the Data Plane resources
+func (KeyVaultManagedHardwareSecurityModuleSecurityDomainResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-KV-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acchsmkv%[3]s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    certificate_permissions = [
+      "Create",
+      "Delete",
+      "Get",
+      "Purge",
+      "Update",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  count        = 3
+  name         = "acchsmcert${count.index}"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage = [
+        "cRLSign",
+        "dataEncipherment",
+        "digitalSignature",
+        "keyAgreement",
+        "keyCertSign",
+        "keyEncipherment",
+      ]
+
+      subject            = "CN=hello-world"
+      validity_in_months = 12
+    }
+  }
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module" "test" {
+  name                       = "kvHsm%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  sku_name                   = "Standard_B1"
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  admin_object_ids           = [data.azurerm_client_config.current.object_id]
+  purge_protection_enabled   = false
+  soft_delete_retention_days = 7
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_security_domain" "test" {
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  certificates   = [for c in azurerm_key_vault_certificate.test : "-----BEGIN CERTIFICATE-----\n${c.certificate_data_base64}\n-----END CERTIFICATE-----"]
+  quorum         = 2
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
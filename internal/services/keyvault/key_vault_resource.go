@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpoint"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
@@ -45,9 +46,24 @@ func resourceKeyVault() *pluginsdk.Resource {
 		Update: resourceKeyVaultUpdate,
 		Delete: resourceKeyVaultDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.VaultID(id)
 			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			id, err := parse.VaultID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			privateEndpoints, err := privateendpoint.Import(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, id.ID())
+			if err != nil {
+				return nil, fmt.Errorf("retrieving Private Endpoints for %s: %+v", *id, err)
+			}
+			if err := d.Set("private_endpoint", privateEndpoints); err != nil {
+				return nil, fmt.Errorf("setting `private_endpoint`: %+v", err)
+			}
+
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
 		SchemaVersion: 2,
@@ -187,6 +203,8 @@ func resourceKeyVault() *pluginsdk.Resource {
 				},
 			},
 
+			"private_endpoint": privateendpoint.Schema([]string{"vault"}),
+
 			"purge_protection_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -279,6 +297,24 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		recoverSoftDeletedKeyVault = true
 	}
 
+	if !recoverSoftDeletedKeyVault {
+		availabilityRequest := keyvault.VaultCheckNameAvailabilityParameters{
+			Name: utils.String(id.Name),
+			Type: utils.String("Microsoft.KeyVault/vaults"),
+		}
+		available, err := client.CheckNameAvailability(ctx, availabilityRequest)
+		if err != nil {
+			return fmt.Errorf("checking if the name %q was available: %+v", id.Name, err)
+		}
+		if err := azure.CheckNameAvailability("Key Vault", id.Name, azure.NameAvailabilityResult{
+			NameAvailable: available.NameAvailable,
+			Reason:        string(available.Reason),
+			Message:       available.Message,
+		}); err != nil {
+			return err
+		}
+	}
+
 	tenantUUID := uuid.FromStringOrNil(d.Get("tenant_id").(string))
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
@@ -393,6 +429,12 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("private_endpoint"); ok {
+		if err := privateendpoint.CreateOrUpdate(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, location, id.ID(), privateendpoint.Expand(v.([]interface{})), d.Get("tags").(map[string]interface{})); err != nil {
+			return fmt.Errorf("creating Private Endpoints for %s: %+v", id, err)
+		}
+	}
+
 	return resourceKeyVaultRead(d, meta)
 }
 
@@ -593,6 +635,13 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("private_endpoint") {
+		oldRaw, newRaw := d.GetChange("private_endpoint")
+		if err := privateendpoint.Update(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, d.Get("location").(string), id.ID(), privateendpoint.Expand(oldRaw.([]interface{})), privateendpoint.Expand(newRaw.([]interface{})), d.Get("tags").(map[string]interface{})); err != nil {
+			return fmt.Errorf("updating Private Endpoints for %s: %+v", *id, err)
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceKeyVaultRead(d, meta)
@@ -680,6 +729,14 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `contact` for KeyVault: %+v", err)
 	}
 
+	privateEndpoints, err := privateendpoint.Flatten(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, privateendpoint.Expand(d.Get("private_endpoint").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("retrieving Private Endpoints for %s: %+v", *id, err)
+	}
+	if err := d.Set("private_endpoint", privateEndpoints); err != nil {
+		return fmt.Errorf("setting `private_endpoint`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -696,6 +753,10 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByName(id.Name, keyVaultResourceName)
 	defer locks.UnlockByName(id.Name, keyVaultResourceName)
 
+	if err := privateendpoint.Delete(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, privateendpoint.Expand(d.Get("private_endpoint").([]interface{}))); err != nil {
+		return fmt.Errorf("deleting Private Endpoints for %s: %+v", *id, err)
+	}
+
 	read, err := client.Get(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
@@ -98,6 +98,10 @@ func resourceKeyVaultSecret() *pluginsdk.Resource {
 
 			"tags": tags.SchemaWithMax(15),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			return validateNestedItemDataPlanePermissions(ctx, d, meta, nestedItemTypeSecret, []string{"get", "set"})
+		}),
 	}
 }
 
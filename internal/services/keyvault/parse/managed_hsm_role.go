@@ -0,0 +1,149 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Managed HSM Role Definitions and Role Assignments are Data Plane items, as such these IDs are Terraform specific
+// and combine the Resource Manager ID of the Managed HSM with the Data Plane scope of the item.
+
+const (
+	managedHSMRoleAssignmentsSegment = "/providers/Microsoft.Authorization/roleAssignments/"
+	managedHSMRoleDefinitionsSegment = "/providers/Microsoft.Authorization/roleDefinitions/"
+)
+
+type ManagedHSMRoleDefinitionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ManagedHSMName string
+	Name           string
+}
+
+func NewManagedHSMRoleDefinitionID(subscriptionId, resourceGroup, managedHSMName, name string) ManagedHSMRoleDefinitionId {
+	return ManagedHSMRoleDefinitionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ManagedHSMName: managedHSMName,
+		Name:           name,
+	}
+}
+
+func (id ManagedHSMRoleDefinitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Managed H S M Name %q", id.ManagedHSMName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed H S M Role Definition", segmentsStr)
+}
+
+func (id ManagedHSMRoleDefinitionId) ID() string {
+	return NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName).ID() + managedHSMRoleDefinitionsSegment + id.Name
+}
+
+// Scope returns the Data Plane scope of the Role Definition, which is always the root scope
+func (id ManagedHSMRoleDefinitionId) Scope() string {
+	return "/"
+}
+
+// ManagedHSMRoleDefinitionID parses a ManagedHSMRoleDefinition ID into an ManagedHSMRoleDefinitionId struct
+func ManagedHSMRoleDefinitionID(input string) (*ManagedHSMRoleDefinitionId, error) {
+	managedHSMId, scope, name, err := parseManagedHSMScopedID(input, managedHSMRoleDefinitionsSegment)
+	if err != nil {
+		return nil, err
+	}
+	if scope != "/" {
+		return nil, fmt.Errorf("expected a Managed HSM Role Definition to be at the root scope but got %q", scope)
+	}
+
+	return &ManagedHSMRoleDefinitionId{
+		SubscriptionId: managedHSMId.SubscriptionId,
+		ResourceGroup:  managedHSMId.ResourceGroup,
+		ManagedHSMName: managedHSMId.Name,
+		Name:           name,
+	}, nil
+}
+
+type ManagedHSMRoleAssignmentId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ManagedHSMName string
+	Scope          string
+	Name           string
+}
+
+func NewManagedHSMRoleAssignmentID(subscriptionId, resourceGroup, managedHSMName, scope, name string) ManagedHSMRoleAssignmentId {
+	return ManagedHSMRoleAssignmentId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ManagedHSMName: managedHSMName,
+		Scope:          scope,
+		Name:           name,
+	}
+}
+
+func (id ManagedHSMRoleAssignmentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Managed H S M Name %q", id.ManagedHSMName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed H S M Role Assignment", segmentsStr)
+}
+
+func (id ManagedHSMRoleAssignmentId) ID() string {
+	return NewManagedHSMID(id.SubscriptionId, id.ResourceGroup, id.ManagedHSMName).ID() + strings.TrimSuffix(id.Scope, "/") + managedHSMRoleAssignmentsSegment + id.Name
+}
+
+// ManagedHSMRoleAssignmentID parses a ManagedHSMRoleAssignment ID into an ManagedHSMRoleAssignmentId struct
+func ManagedHSMRoleAssignmentID(input string) (*ManagedHSMRoleAssignmentId, error) {
+	managedHSMId, scope, name, err := parseManagedHSMScopedID(input, managedHSMRoleAssignmentsSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedHSMRoleAssignmentId{
+		SubscriptionId: managedHSMId.SubscriptionId,
+		ResourceGroup:  managedHSMId.ResourceGroup,
+		ManagedHSMName: managedHSMId.Name,
+		Scope:          scope,
+		Name:           name,
+	}, nil
+}
+
+// parseManagedHSMScopedID splits an ID in the format `{managedHSMId}{scope}{itemSegment}{name}` into its components
+func parseManagedHSMScopedID(input, itemSegment string) (*ManagedHSMId, string, string, error) {
+	parts := strings.Split(input, itemSegment)
+	if len(parts) != 2 {
+		return nil, "", "", fmt.Errorf("expected the ID %q to contain a single %q segment", input, strings.Trim(itemSegment, "/"))
+	}
+
+	name := parts[1]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, "", "", fmt.Errorf("expected the ID %q to end with a name but got %q", input, name)
+	}
+
+	// the Managed HSM ID is made up of the first 8 segments, anything following that is the Data Plane scope
+	segments := strings.Split(strings.TrimPrefix(parts[0], "/"), "/")
+	if len(segments) < 8 {
+		return nil, "", "", fmt.Errorf("expected the ID %q to start with a Managed HSM ID", input)
+	}
+
+	managedHSMId, err := ManagedHSMID("/" + strings.Join(segments[0:8], "/"))
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	scope := "/" + strings.Join(segments[8:], "/")
+	for _, segment := range segments[8:] {
+		if segment == "" {
+			return nil, "", "", fmt.Errorf("expected the scope within the ID %q not to contain empty segments", input)
+		}
+	}
+
+	return managedHSMId, scope, name, nil
+}
@@ -0,0 +1,209 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ManagedHSMRoleDefinitionId{}
+var _ resourceids.Id = ManagedHSMRoleAssignmentId{}
+
+func TestManagedHSMRoleDefinitionIDFormatter(t *testing.T) {
+	actual := NewManagedHSMRoleDefinitionID("12345678-1234-9876-4563-123456789012", "resGroup1", "hsm1", "def1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/providers/Microsoft.Authorization/roleDefinitions/def1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestManagedHSMRoleDefinitionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagedHSMRoleDefinitionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Role Definition
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1",
+			Error: true,
+		},
+
+		{
+			// missing value for Role Definition
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/providers/Microsoft.Authorization/roleDefinitions/",
+			Error: true,
+		},
+
+		{
+			// not a Managed HSM
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Authorization/roleDefinitions/def1",
+			Error: true,
+		},
+
+		{
+			// not at the root scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/keys/providers/Microsoft.Authorization/roleDefinitions/def1",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/providers/Microsoft.Authorization/roleDefinitions/def1",
+			Expected: &ManagedHSMRoleDefinitionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ManagedHSMName: "hsm1",
+				Name:           "def1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMRoleDefinitionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ManagedHSMName != v.Expected.ManagedHSMName {
+			t.Fatalf("Expected %q but got %q for ManagedHSMName", v.Expected.ManagedHSMName, actual.ManagedHSMName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestManagedHSMRoleAssignmentIDFormatter(t *testing.T) {
+	testData := []struct {
+		Scope    string
+		Expected string
+	}{
+		{
+			Scope:    "/",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+		{
+			Scope:    "/keys",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/keys/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		},
+	}
+
+	for _, v := range testData {
+		actual := NewManagedHSMRoleAssignmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "hsm1", v.Scope, "assignment1").ID()
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestManagedHSMRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagedHSMRoleAssignmentId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing Role Assignment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1",
+			Error: true,
+		},
+
+		{
+			// missing value for Role Assignment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/providers/Microsoft.Authorization/roleAssignments/",
+			Error: true,
+		},
+
+		{
+			// empty segment within the scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/keys//providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Error: true,
+		},
+
+		{
+			// valid at the root scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: &ManagedHSMRoleAssignmentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ManagedHSMName: "hsm1",
+				Scope:          "/",
+				Name:           "assignment1",
+			},
+		},
+
+		{
+			// valid at a key scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/managedHSMs/hsm1/keys/key1/providers/Microsoft.Authorization/roleAssignments/assignment1",
+			Expected: &ManagedHSMRoleAssignmentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ManagedHSMName: "hsm1",
+				Scope:          "/keys/key1",
+				Name:           "assignment1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ManagedHSMName != v.Expected.ManagedHSMName {
+			t.Fatalf("Expected %q but got %q for ManagedHSMName", v.Expected.ManagedHSMName, actual.ManagedHSMName)
+		}
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
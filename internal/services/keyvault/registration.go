@@ -63,5 +63,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 }
 
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{}
+	return []sdk.Resource{
+		KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{},
+		KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{},
+		KeyVaultManagedHardwareSecurityModuleSecurityDomainResource{},
+	}
 }
@@ -150,7 +150,7 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 							Computed: true,
 						},
 						"identity_ids": {
-							Type:     pluginsdk.TypeList,
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							MinItems: 1,
 							Elem: &pluginsdk.Schema{
@@ -1433,7 +1433,7 @@ func expandAzureRmVirtualMachineIdentity(d *pluginsdk.ResourceData) *compute.Vir
 	identityType := compute.ResourceIdentityType(identity["type"].(string))
 
 	identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
-	for _, id := range identity["identity_ids"].([]interface{}) {
+	for _, id := range identity["identity_ids"].(*pluginsdk.Set).List() {
 		identityIds[id.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
 	}
 
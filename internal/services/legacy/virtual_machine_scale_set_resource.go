@@ -87,7 +87,7 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 							}, false),
 						},
 						"identity_ids": {
-							Type:     pluginsdk.TypeList,
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
@@ -1978,7 +1978,7 @@ func expandAzureRmVirtualMachineScaleSetIdentity(d *pluginsdk.ResourceData) *com
 	identityType := compute.ResourceIdentityType(identity["type"].(string))
 
 	identityIds := make(map[string]*compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue)
-	for _, id := range identity["identity_ids"].([]interface{}) {
+	for _, id := range identity["identity_ids"].(*pluginsdk.Set).List() {
 		identityIds[id.(string)] = &compute.VirtualMachineScaleSetIdentityUserAssignedIdentitiesValue{}
 	}
 
@@ -1,8 +1,10 @@
 package loadbalancer
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
@@ -44,9 +46,61 @@ func resourceArmLoadBalancerRule() *pluginsdk.Resource {
 		},
 
 		Schema: resourceArmLoadBalancerRuleSchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceArmLoadBalancerRuleCustomizeDiff),
 	}
 }
 
+// resourceArmLoadBalancerRuleCustomizeDiff surfaces the HA Ports port combinations which the API rejects
+// with an opaque 400 during apply, so that they're caught at plan time instead.
+func resourceArmLoadBalancerRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"protocol", "frontend_port", "backend_port"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	protocol := d.Get("protocol").(string)
+	frontendPort := d.Get("frontend_port").(int)
+	backendPort := d.Get("backend_port").(int)
+
+	// HA Ports are configured using the protocol `All` with both the frontend and backend port set to `0`
+	isAllProtocol := strings.EqualFold(protocol, string(network.TransportProtocolAll))
+	if isAllProtocol && (frontendPort != 0 || backendPort != 0) {
+		return fmt.Errorf("`frontend_port` and `backend_port` must both be `0` when `protocol` is `All` (HA Ports)")
+	}
+	if !isAllProtocol && (frontendPort == 0 || backendPort == 0) {
+		return fmt.Errorf("`frontend_port` and `backend_port` can only be `0` when `protocol` is `All` (HA Ports), got `protocol` %q", protocol)
+	}
+
+	return nil
+}
+
+// validateLoadBalancerRuleHaPorts ensures HA Ports are only used on Gateway and internal Standard Load Balancers
+func validateLoadBalancerRuleHaPorts(d *pluginsdk.ResourceData, loadBalancer *network.LoadBalancer) error {
+	if !strings.EqualFold(d.Get("protocol").(string), string(network.TransportProtocolAll)) {
+		return nil
+	}
+
+	if loadBalancer.Sku == nil || loadBalancer.Sku.Name == network.LoadBalancerSkuNameBasic {
+		return fmt.Errorf("HA Ports (`protocol` set to `All` with `frontend_port` and `backend_port` set to `0`) are only supported on `Standard` and `Gateway` SKU Load Balancers")
+	}
+
+	if loadBalancer.Sku.Name == network.LoadBalancerSkuNameGateway {
+		return nil
+	}
+
+	frontendIPConfigurationName := d.Get("frontend_ip_configuration_name").(string)
+	if config, exists := FindLoadBalancerFrontEndIpConfigurationByName(loadBalancer, frontendIPConfigurationName); exists && config.FrontendIPConfigurationPropertiesFormat != nil {
+		props := config.FrontendIPConfigurationPropertiesFormat
+		if props.PublicIPAddress != nil || props.PublicIPPrefix != nil {
+			return fmt.Errorf("HA Ports (`protocol` set to `All` with `frontend_port` and `backend_port` set to `0`) are only supported on internal Load Balancers but Frontend IP Configuration %q uses a Public IP", frontendIPConfigurationName)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmLoadBalancerRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -74,6 +128,10 @@ func resourceArmLoadBalancerRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("failed to retrieve Load Balancer %q (resource group %q) for Rule %q: %+v", id.LoadBalancerName, id.ResourceGroup, id.Name, err)
 	}
 
+	if err := validateLoadBalancerRuleHaPorts(d, &loadBalancer); err != nil {
+		return err
+	}
+
 	newLbRule, err := expandAzureRmLoadBalancerRule(d, &loadBalancer)
 	if err != nil {
 		return fmt.Errorf("expanding Load Balancer Rule: %+v", err)
@@ -4,6 +4,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
 )
 
 type Client struct {
@@ -16,6 +17,7 @@ type Client struct {
 	SharedKeysClient           *operationalinsights.SharedKeysClient
 	SolutionsClient            *operationsmanagement.SolutionsClient
 	StorageInsightsClient      *operationalinsights.StorageInsightConfigsClient
+	TablesClient               *tables.TablesClient
 	WorkspacesClient           *operationalinsights.WorkspacesClient
 }
 
@@ -50,6 +52,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	LinkedStorageAccountClient := operationalinsights.NewLinkedStorageAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LinkedStorageAccountClient.Client, o.ResourceManagerAuthorizer)
 
+	TablesClient := tables.NewTablesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&TablesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ClusterClient:              &ClusterClient,
 		DataExportClient:           &DataExportClient,
@@ -60,6 +65,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		SharedKeysClient:           &SharedKeysClient,
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
+		TablesClient:               &TablesClient,
 		WorkspacesClient:           &WorkspacesClient,
 	}
 }
@@ -6,11 +6,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2017-04-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -55,9 +57,13 @@ func resourceLogAnalyticsDataExport() *pluginsdk.Resource {
 			},
 
 			"destination_resource_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.Any(
+					storageValidate.StorageAccountID,
+					eventhubs.ValidateNamespaceID,
+					eventhubs.ValidateEventhubID,
+				),
 			},
 
 			"enabled": {
@@ -72,7 +78,7 @@ func resourceLogAnalyticsDataExport() *pluginsdk.Resource {
 				MinItems: 1,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.NoZeroValues,
+					ValidateFunc: validate.LogAnalyticsTableName,
 				},
 			},
 
@@ -109,11 +115,9 @@ func resourceOperationalinsightsDataExportCreateUpdate(d *pluginsdk.ResourceData
 
 	parameters := operationalinsights.DataExport{
 		DataExportProperties: &operationalinsights.DataExportProperties{
-			Destination: &operationalinsights.Destination{
-				ResourceID: utils.String(d.Get("destination_resource_id").(string)),
-			},
-			TableNames: utils.ExpandStringSlice(d.Get("table_names").(*pluginsdk.Set).List()),
-			Enable:     utils.Bool(d.Get("enabled").(bool)),
+			Destination: expandDataExportDestination(d.Get("destination_resource_id").(string)),
+			TableNames:  utils.ExpandStringSlice(d.Get("table_names").(*pluginsdk.Set).List()),
+			Enable:      utils.Bool(d.Get("enabled").(bool)),
 		},
 	}
 
@@ -172,6 +176,23 @@ func resourceOperationalinsightsDataExportDelete(d *pluginsdk.ResourceData, meta
 	return nil
 }
 
+func expandDataExportDestination(input string) *operationalinsights.Destination {
+	// an Event Hub is exported to by specifying the Event Hub Namespace and the name of the Event Hub within the metadata
+	if eventHubId, err := eventhubs.ParseEventhubIDInsensitively(input); err == nil {
+		namespaceId := eventhubs.NewNamespaceID(eventHubId.SubscriptionId, eventHubId.ResourceGroupName, eventHubId.NamespaceName)
+		return &operationalinsights.Destination{
+			ResourceID: utils.String(namespaceId.ID()),
+			DestinationMetaData: &operationalinsights.DestinationMetaData{
+				EventHubName: utils.String(eventHubId.EventHubName),
+			},
+		}
+	}
+
+	return &operationalinsights.Destination{
+		ResourceID: utils.String(input),
+	}
+}
+
 func flattenDataExportDestination(input *operationalinsights.Destination) string {
 	if input == nil {
 		return ""
@@ -182,5 +203,11 @@ func flattenDataExportDestination(input *operationalinsights.Destination) string
 		resourceID = *input.ResourceID
 	}
 
+	if metadata := input.DestinationMetaData; metadata != nil && metadata.EventHubName != nil && *metadata.EventHubName != "" {
+		if namespaceId, err := eventhubs.ParseNamespaceIDInsensitively(resourceID); err == nil {
+			resourceID = eventhubs.NewEventhubID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, *metadata.EventHubName).ID()
+		}
+	}
+
 	return resourceID
 }
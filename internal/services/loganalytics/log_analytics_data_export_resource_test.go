@@ -85,6 +85,36 @@ func TestAccLogAnalyticsDataExportRule_complete(t *testing.T) {
 	})
 }
 
+func TestAccLogAnalyticsDataExportRule_toEventHubNamespace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_data_export_rule", "test")
+	r := LogAnalyticsDataExportRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.toEventHubNamespace(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsDataExportRule_toEventHub(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_data_export_rule", "test")
+	r := LogAnalyticsDataExportRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.toEventHub(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t LogAnalyticsDataExportRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LogAnalyticsDataExportID(state.ID)
 	if err != nil {
@@ -184,3 +214,54 @@ resource "azurerm_log_analytics_data_export_rule" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r LogAnalyticsDataExportRuleResource) eventHubTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctest-EHN-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsDataExportRuleResource) toEventHubNamespace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_data_export_rule" "test" {
+  name                    = "acctest-DER-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  workspace_resource_id   = azurerm_log_analytics_workspace.test.id
+  destination_resource_id = azurerm_eventhub_namespace.test.id
+  table_names             = ["Heartbeat"]
+  enabled                 = true
+}
+`, r.eventHubTemplate(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsDataExportRuleResource) toEventHub(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctest-EH-%[2]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_log_analytics_data_export_rule" "test" {
+  name                    = "acctest-DER-%[2]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  workspace_resource_id   = azurerm_log_analytics_workspace.test.id
+  destination_resource_id = azurerm_eventhub.test.id
+  table_names             = ["Heartbeat"]
+  enabled                 = true
+}
+`, r.eventHubTemplate(data), data.RandomInteger)
+}
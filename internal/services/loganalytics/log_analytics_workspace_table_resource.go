@@ -0,0 +1,272 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogAnalyticsWorkspaceTableResourceModel struct {
+	Name                 string `tfschema:"name"`
+	WorkspaceId          string `tfschema:"workspace_id"`
+	Plan                 string `tfschema:"plan"`
+	RetentionInDays      int64  `tfschema:"retention_in_days"`
+	TotalRetentionInDays int64  `tfschema:"total_retention_in_days"`
+}
+
+type LogAnalyticsWorkspaceTableResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceTableResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableResource{}
+)
+
+// the API uses a value of `-1` to reset the retention back to the workspace default
+const logAnalyticsWorkspaceTableDefaultRetention int64 = -1
+
+func (r LogAnalyticsWorkspaceTableResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_table"
+}
+
+func (r LogAnalyticsWorkspaceTableResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceTableResourceModel{}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.LogAnalyticsWorkspaceID,
+		},
+
+		"plan": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(tables.TablePlanEnumAnalytics),
+			ValidateFunc: validation.StringInSlice(tables.PossibleValuesForTablePlanEnum(), false),
+		},
+
+		"retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(4, 730),
+		},
+
+		"total_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(4, 2555),
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if rd.Get("plan").(string) == string(tables.TablePlanEnumBasic) {
+				if _, ok := rd.GetOk("retention_in_days"); ok {
+					return fmt.Errorf("`retention_in_days` cannot be set when `plan` is `Basic` since the interactive retention is fixed at 8 days")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogAnalyticsWorkspaceTableResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			workspaceId, err := parse.LogAnalyticsWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.WorkspaceName, model.Name)
+
+			// the Tables within a Workspace are managed by the service, as such this resource manages the
+			// configuration of an existing Table rather than creating one
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found - the Table must already exist within the Log Analytics Workspace", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandLogAnalyticsWorkspaceTable(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceTableResourceModel{
+				Name:        id.TableName,
+				WorkspaceId: parse.NewLogAnalyticsWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				Plan:        string(tables.TablePlanEnumAnalytics),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.Plan != nil {
+						state.Plan = string(*props.Plan)
+					}
+
+					// the retention values are only tracked when they've been explicitly set, since otherwise
+					// these are inherited from the Log Analytics Workspace
+					if state.Plan == string(tables.TablePlanEnumAnalytics) && props.RetentionInDays != nil && (props.RetentionInDaysAsDefault == nil || !*props.RetentionInDaysAsDefault) {
+						state.RetentionInDays = *props.RetentionInDays
+					}
+
+					if props.TotalRetentionInDays != nil && (props.TotalRetentionInDaysAsDefault == nil || !*props.TotalRetentionInDaysAsDefault) {
+						state.TotalRetentionInDays = *props.TotalRetentionInDays
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTableResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandLogAnalyticsWorkspaceTable(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTableResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Table can't be removed from the Workspace, so instead the retention is reset to the Workspace defaults
+			props := tables.TableProperties{
+				TotalRetentionInDays: utils.Int64(logAnalyticsWorkspaceTableDefaultRetention),
+			}
+			if model.Plan == string(tables.TablePlanEnumAnalytics) {
+				props.RetentionInDays = utils.Int64(logAnalyticsWorkspaceTableDefaultRetention)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, tables.Table{Properties: &props}); err != nil {
+				return fmt.Errorf("resetting the retention for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLogAnalyticsWorkspaceTable(model LogAnalyticsWorkspaceTableResourceModel) tables.Table {
+	plan := tables.TablePlanEnum(model.Plan)
+	props := tables.TableProperties{
+		Plan:                 &plan,
+		TotalRetentionInDays: utils.Int64(logAnalyticsWorkspaceTableDefaultRetention),
+	}
+
+	if model.TotalRetentionInDays != 0 {
+		props.TotalRetentionInDays = utils.Int64(model.TotalRetentionInDays)
+	}
+
+	// the interactive retention is fixed for Tables using the `Basic` plan and can't be specified
+	if plan == tables.TablePlanEnumAnalytics {
+		props.RetentionInDays = utils.Int64(logAnalyticsWorkspaceTableDefaultRetention)
+		if model.RetentionInDays != 0 {
+			props.RetentionInDays = utils.Int64(model.RetentionInDays)
+		}
+	}
+
+	return tables.Table{
+		Properties: &props,
+	}
+}
@@ -0,0 +1,65 @@
+package tables
+
+import "strings"
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumDeleting   ProvisioningStateEnum = "Deleting"
+	ProvisioningStateEnumInProgress ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumSucceeded  ProvisioningStateEnum = "Succeeded"
+	ProvisioningStateEnumUpdating   ProvisioningStateEnum = "Updating"
+)
+
+func PossibleValuesForProvisioningStateEnum() []string {
+	return []string{
+		string(ProvisioningStateEnumDeleting),
+		string(ProvisioningStateEnumInProgress),
+		string(ProvisioningStateEnumSucceeded),
+		string(ProvisioningStateEnumUpdating),
+	}
+}
+
+func parseProvisioningStateEnum(input string) (*ProvisioningStateEnum, error) {
+	vals := map[string]ProvisioningStateEnum{
+		"deleting":   ProvisioningStateEnumDeleting,
+		"inprogress": ProvisioningStateEnumInProgress,
+		"succeeded":  ProvisioningStateEnumSucceeded,
+		"updating":   ProvisioningStateEnumUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStateEnum(input)
+	return &out, nil
+}
+
+type TablePlanEnum string
+
+const (
+	TablePlanEnumAnalytics TablePlanEnum = "Analytics"
+	TablePlanEnumBasic     TablePlanEnum = "Basic"
+)
+
+func PossibleValuesForTablePlanEnum() []string {
+	return []string{
+		string(TablePlanEnumAnalytics),
+		string(TablePlanEnumBasic),
+	}
+}
+
+func parseTablePlanEnum(input string) (*TablePlanEnum, error) {
+	vals := map[string]TablePlanEnum{
+		"analytics": TablePlanEnumAnalytics,
+		"basic":     TablePlanEnumBasic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TablePlanEnum(input)
+	return &out, nil
+}
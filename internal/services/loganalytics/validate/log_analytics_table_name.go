@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func LogAnalyticsTableName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if len(v) > 63 {
+		errors = append(errors, fmt.Errorf("length should be less than %d, got %q", 63, v))
+		return
+	}
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must start with a letter and can only contain letters, numbers and underscores, got %q", k, v))
+		return
+	}
+	return
+}
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestLogAnalyticsTableName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid name starts with number",
+			Input:    "1Heartbeat",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid name starts with underscore",
+			Input:    "_Heartbeat",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid characters hyphen",
+			Input:    "Security-Event",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid characters space",
+			Input:    "Security Event",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid name too long",
+			Input:    "ThisIsTooLooooooooooooooooooooooooooooooooooooooooooooongTable_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Valid name",
+			Input:    "Heartbeat",
+			Expected: true,
+		},
+		{
+			Name:     "Valid custom table name",
+			Input:    "MyCustomLogs_CL",
+			Expected: true,
+		},
+		{
+			Name:     "Valid name max length",
+			Input:    "ThisIsTheLoooooooooooooooooooooooooooooooooooooooongestTable_CL",
+			Expected: true,
+		},
+	}
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, errors := LogAnalyticsTableName(v.Input, "table_names")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %v but got %v (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
@@ -6,14 +6,19 @@ import (
 )
 
 type Client struct {
-	SpatialAnchorsAccountClient *resource.ResourceClient
+	RemoteRenderingAccountClient *resource.ResourceClient
+	SpatialAnchorsAccountClient  *resource.ResourceClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	RemoteRenderingAccountClient := resource.NewResourceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RemoteRenderingAccountClient.Client, o.ResourceManagerAuthorizer)
+
 	SpatialAnchorsAccountClient := resource.NewResourceClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&SpatialAnchorsAccountClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		SpatialAnchorsAccountClient: &SpatialAnchorsAccountClient,
+		RemoteRenderingAccountClient: &RemoteRenderingAccountClient,
+		SpatialAnchorsAccountClient:  &SpatialAnchorsAccountClient,
 	}
 }
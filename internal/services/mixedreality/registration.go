@@ -28,13 +28,15 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_spatial_anchors_account": dataSourceSpatialAnchorsAccount(),
+		"azurerm_remote_rendering_account": dataSourceRemoteRenderingAccount(),
+		"azurerm_spatial_anchors_account":  dataSourceSpatialAnchorsAccount(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_spatial_anchors_account": resourceSpatialAnchorsAccount(),
+		"azurerm_remote_rendering_account": resourceRemoteRenderingAccount(),
+		"azurerm_spatial_anchors_account":  resourceSpatialAnchorsAccount(),
 	}
 }
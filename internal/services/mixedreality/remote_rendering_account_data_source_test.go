@@ -0,0 +1,38 @@
+package mixedreality_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RemoteRenderingAccountDataSource struct{}
+
+func TestAccRemoteRenderingAccountDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_remote_rendering_account", "test")
+	r := RemoteRenderingAccountDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("account_id").Exists(),
+				check.That(data.ResourceName).Key("account_domain").Exists(),
+			),
+		},
+	})
+}
+
+func (RemoteRenderingAccountDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_remote_rendering_account" "test" {
+  name                = azurerm_remote_rendering_account.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, RemoteRenderingAccountResource{}.basic(data))
+}
@@ -0,0 +1,167 @@
+package mixedreality_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mixedreality/2021-01-01/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RemoteRenderingAccountResource struct{}
+
+func TestAccRemoteRenderingAccount_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_remote_rendering_account", "test")
+	r := RemoteRenderingAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("account_id").Exists(),
+				check.That(data.ResourceName).Key("account_domain").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRemoteRenderingAccount_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_remote_rendering_account", "test")
+	r := RemoteRenderingAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRemoteRenderingAccount_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_remote_rendering_account", "test")
+	r := RemoteRenderingAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.Environment").HasValue("Production"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRemoteRenderingAccount_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_remote_rendering_account", "test")
+	r := RemoteRenderingAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (RemoteRenderingAccountResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := resource.ParseRemoteRenderingAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MixedReality.RemoteRenderingAccountClient.RemoteRenderingAccountsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (RemoteRenderingAccountResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mr-%d"
+  location = "%s"
+}
+
+resource "azurerm_remote_rendering_account" "test" {
+  name                = "accTEst_rra%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r RemoteRenderingAccountResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_remote_rendering_account" "import" {
+  name                = azurerm_remote_rendering_account.test.name
+  location            = azurerm_remote_rendering_account.test.location
+  resource_group_name = azurerm_remote_rendering_account.test.resource_group_name
+}
+`, r.basic(data))
+}
+
+func (RemoteRenderingAccountResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mr-%d"
+  location = "%s"
+}
+
+resource "azurerm_remote_rendering_account" "test" {
+  name                = "accTEst_rra%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    Environment = "Production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
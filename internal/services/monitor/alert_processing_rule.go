@@ -0,0 +1,516 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AlertProcessingRuleConditionModel struct {
+	AlertContext        []AlertProcessingRuleSingleConditionModel `tfschema:"alert_context"`
+	AlertRuleId         []AlertProcessingRuleSingleConditionModel `tfschema:"alert_rule_id"`
+	AlertRuleName       []AlertProcessingRuleSingleConditionModel `tfschema:"alert_rule_name"`
+	Description         []AlertProcessingRuleSingleConditionModel `tfschema:"description"`
+	MonitorCondition    []AlertProcessingRuleSingleConditionModel `tfschema:"monitor_condition"`
+	MonitorService      []AlertProcessingRuleSingleConditionModel `tfschema:"monitor_service"`
+	Severity            []AlertProcessingRuleSingleConditionModel `tfschema:"severity"`
+	SignalType          []AlertProcessingRuleSingleConditionModel `tfschema:"signal_type"`
+	TargetResource      []AlertProcessingRuleSingleConditionModel `tfschema:"target_resource"`
+	TargetResourceGroup []AlertProcessingRuleSingleConditionModel `tfschema:"target_resource_group"`
+	TargetResourceType  []AlertProcessingRuleSingleConditionModel `tfschema:"target_resource_type"`
+}
+
+type AlertProcessingRuleSingleConditionModel struct {
+	Operator string   `tfschema:"operator"`
+	Values   []string `tfschema:"values"`
+}
+
+type AlertProcessingRuleScheduleModel struct {
+	EffectiveFrom  string                               `tfschema:"effective_from"`
+	EffectiveUntil string                               `tfschema:"effective_until"`
+	Recurrence     []AlertProcessingRuleRecurrenceModel `tfschema:"recurrence"`
+	TimeZone       string                               `tfschema:"time_zone"`
+}
+
+type AlertProcessingRuleRecurrenceModel struct {
+	Daily   []AlertProcessingRuleDailyModel   `tfschema:"daily"`
+	Weekly  []AlertProcessingRuleWeeklyModel  `tfschema:"weekly"`
+	Monthly []AlertProcessingRuleMonthlyModel `tfschema:"monthly"`
+}
+
+type AlertProcessingRuleDailyModel struct {
+	StartTime string `tfschema:"start_time"`
+	EndTime   string `tfschema:"end_time"`
+}
+
+type AlertProcessingRuleWeeklyModel struct {
+	DaysOfWeek []string `tfschema:"days_of_week"`
+	StartTime  string   `tfschema:"start_time"`
+	EndTime    string   `tfschema:"end_time"`
+}
+
+type AlertProcessingRuleMonthlyModel struct {
+	DaysOfMonth []int  `tfschema:"days_of_month"`
+	StartTime   string `tfschema:"start_time"`
+	EndTime     string `tfschema:"end_time"`
+}
+
+var (
+	alertProcessingRuleScheduleDateTimeRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}$`)
+	alertProcessingRuleRecurrenceTimeRegex   = regexp.MustCompile(`^([0-1]\d|2[0-3]):[0-5]\d:[0-5]\d$`)
+)
+
+func schemaAlertProcessingRuleScopes() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+	}
+}
+
+func schemaAlertProcessingRuleConditions() *pluginsdk.Schema {
+	allOperators := []string{
+		string(alertprocessingrules.OperatorEquals),
+		string(alertprocessingrules.OperatorNotEquals),
+		string(alertprocessingrules.OperatorContains),
+		string(alertprocessingrules.OperatorDoesNotContain),
+	}
+	equalityOperators := []string{
+		string(alertprocessingrules.OperatorEquals),
+		string(alertprocessingrules.OperatorNotEquals),
+	}
+
+	conditions := map[string]*pluginsdk.Schema{
+		"alert_context":         schemaActionRuleCondition(allOperators, nil),
+		"alert_rule_id":         schemaActionRuleCondition(allOperators, nil),
+		"alert_rule_name":       schemaActionRuleCondition(allOperators, nil),
+		"description":           schemaActionRuleCondition(allOperators, nil),
+		"monitor_condition":     schemaActionRuleCondition(equalityOperators, []string{"Fired", "Resolved"}),
+		"monitor_service":       schemaActionRuleCondition(equalityOperators, alertProcessingRuleMonitorServices()),
+		"severity":              schemaActionRuleCondition(equalityOperators, []string{"Sev0", "Sev1", "Sev2", "Sev3", "Sev4"}),
+		"signal_type":           schemaActionRuleCondition(equalityOperators, []string{"Metric", "Log", "Unknown", "Health"}),
+		"target_resource":       schemaActionRuleCondition(allOperators, nil),
+		"target_resource_group": schemaActionRuleCondition(allOperators, nil),
+		"target_resource_type":  schemaActionRuleCondition(allOperators, nil),
+	}
+
+	atLeastOneOf := make([]string, 0)
+	for k := range conditions {
+		atLeastOneOf = append(atLeastOneOf, fmt.Sprintf("condition.0.%s", k))
+	}
+	for _, v := range conditions {
+		v.AtLeastOneOf = atLeastOneOf
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: conditions,
+		},
+	}
+}
+
+func alertProcessingRuleMonitorServices() []string {
+	// the API accepts a wider list of values than is defined in the swagger, as such these are defined here
+	return []string{
+		"ActivityLog Administrative",
+		"ActivityLog Autoscale",
+		"ActivityLog Policy",
+		"ActivityLog Recommendation",
+		"ActivityLog Security",
+		"Application Insights",
+		"Azure Backup",
+		"Azure Stack Edge",
+		"Azure Stack Hub",
+		"Custom",
+		"Data Box Gateway",
+		"Health Platform",
+		"Log Alerts V2",
+		"Log Analytics",
+		"Platform",
+		"Prometheus",
+		"Resource Health",
+		"Smart Detector",
+		"VM Insights - Health",
+	}
+}
+
+func schemaAlertProcessingRuleSchedule() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"effective_from": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(alertProcessingRuleScheduleDateTimeRegex, "`effective_from` must be in the format `yyyy-MM-ddTHH:mm:ss`"),
+				},
+
+				"effective_until": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(alertProcessingRuleScheduleDateTimeRegex, "`effective_until` must be in the format `yyyy-MM-ddTHH:mm:ss`"),
+				},
+
+				"time_zone": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "UTC",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"recurrence": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"daily": {
+								Type:         pluginsdk.TypeList,
+								Optional:     true,
+								AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"start_time": schemaAlertProcessingRuleRecurrenceTime(true),
+										"end_time":   schemaAlertProcessingRuleRecurrenceTime(true),
+									},
+								},
+							},
+
+							"weekly": {
+								Type:         pluginsdk.TypeList,
+								Optional:     true,
+								AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"days_of_week": {
+											Type:     pluginsdk.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &pluginsdk.Schema{
+												Type:         pluginsdk.TypeString,
+												ValidateFunc: validation.StringInSlice(alertprocessingrules.PossibleValuesForDaysOfWeek(), false),
+											},
+										},
+										"start_time": schemaAlertProcessingRuleRecurrenceTime(false),
+										"end_time":   schemaAlertProcessingRuleRecurrenceTime(false),
+									},
+								},
+							},
+
+							"monthly": {
+								Type:         pluginsdk.TypeList,
+								Optional:     true,
+								AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"days_of_month": {
+											Type:     pluginsdk.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &pluginsdk.Schema{
+												Type:         pluginsdk.TypeInt,
+												ValidateFunc: validation.IntBetween(1, 31),
+											},
+										},
+										"start_time": schemaAlertProcessingRuleRecurrenceTime(false),
+										"end_time":   schemaAlertProcessingRuleRecurrenceTime(false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaAlertProcessingRuleRecurrenceTime(required bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     required,
+		Optional:     !required,
+		ValidateFunc: validation.StringMatch(alertProcessingRuleRecurrenceTimeRegex, "the time must be in the format `HH:mm:ss`"),
+	}
+}
+
+// importAlertProcessingRule ensures that the Alert Processing Rule being imported performs the expected Action,
+// since both the Action Group and Suppression resources share the same Resource ID.
+func importAlertProcessingRule(actionType alertprocessingrules.ActionType) sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		client := metadata.Client.Monitor.AlertProcessingRulesClient
+		resp, err := client.AlertProcessingRulesGetByName(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+		}
+
+		for _, action := range resp.Model.Properties.Actions {
+			if alertProcessingRuleActionType(action) != actionType {
+				return fmt.Errorf("importing %s: expected an Action of type %q but got %q", *id, actionType, alertProcessingRuleActionType(action))
+			}
+		}
+
+		return nil
+	}
+}
+
+func alertProcessingRuleActionType(input alertprocessingrules.Action) alertprocessingrules.ActionType {
+	switch input.(type) {
+	case alertprocessingrules.AddActionGroups:
+		return alertprocessingrules.ActionTypeAddActionGroups
+	case alertprocessingrules.RemoveAllActionGroups:
+		return alertprocessingrules.ActionTypeRemoveAllActionGroups
+	}
+	return ""
+}
+
+func expandAlertProcessingRuleConditions(input []AlertProcessingRuleConditionModel) *[]alertprocessingrules.Condition {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	fields := []struct {
+		field     alertprocessingrules.Field
+		condition []AlertProcessingRuleSingleConditionModel
+	}{
+		{alertprocessingrules.FieldAlertContext, v.AlertContext},
+		{alertprocessingrules.FieldAlertRuleId, v.AlertRuleId},
+		{alertprocessingrules.FieldAlertRuleName, v.AlertRuleName},
+		{alertprocessingrules.FieldDescription, v.Description},
+		{alertprocessingrules.FieldMonitorCondition, v.MonitorCondition},
+		{alertprocessingrules.FieldMonitorService, v.MonitorService},
+		{alertprocessingrules.FieldSeverity, v.Severity},
+		{alertprocessingrules.FieldSignalType, v.SignalType},
+		{alertprocessingrules.FieldTargetResource, v.TargetResource},
+		{alertprocessingrules.FieldTargetResourceGroup, v.TargetResourceGroup},
+		{alertprocessingrules.FieldTargetResourceType, v.TargetResourceType},
+	}
+
+	result := make([]alertprocessingrules.Condition, 0)
+	for _, f := range fields {
+		if len(f.condition) == 0 {
+			continue
+		}
+
+		field := f.field
+		operator := alertprocessingrules.Operator(f.condition[0].Operator)
+		values := f.condition[0].Values
+		result = append(result, alertprocessingrules.Condition{
+			Field:    &field,
+			Operator: &operator,
+			Values:   &values,
+		})
+	}
+	return &result
+}
+
+func expandAlertProcessingRuleSchedule(input []AlertProcessingRuleScheduleModel) *alertprocessingrules.Schedule {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	schedule := alertprocessingrules.Schedule{
+		Recurrences: expandAlertProcessingRuleRecurrences(v.Recurrence),
+		TimeZone:    utils.String(v.TimeZone),
+	}
+
+	if v.EffectiveFrom != "" {
+		schedule.EffectiveFrom = utils.String(v.EffectiveFrom)
+	}
+
+	if v.EffectiveUntil != "" {
+		schedule.EffectiveUntil = utils.String(v.EffectiveUntil)
+	}
+
+	return &schedule
+}
+
+func expandAlertProcessingRuleRecurrences(input []AlertProcessingRuleRecurrenceModel) *[]alertprocessingrules.Recurrence {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]alertprocessingrules.Recurrence, 0)
+	for _, v := range input[0].Daily {
+		result = append(result, alertprocessingrules.DailyRecurrence{
+			StartTime: utils.String(v.StartTime),
+			EndTime:   utils.String(v.EndTime),
+		})
+	}
+
+	for _, v := range input[0].Weekly {
+		daysOfWeek := make([]alertprocessingrules.DaysOfWeek, 0)
+		for _, day := range v.DaysOfWeek {
+			daysOfWeek = append(daysOfWeek, alertprocessingrules.DaysOfWeek(day))
+		}
+
+		recurrence := alertprocessingrules.WeeklyRecurrence{
+			DaysOfWeek: daysOfWeek,
+		}
+		if v.StartTime != "" {
+			recurrence.StartTime = utils.String(v.StartTime)
+		}
+		if v.EndTime != "" {
+			recurrence.EndTime = utils.String(v.EndTime)
+		}
+		result = append(result, recurrence)
+	}
+
+	for _, v := range input[0].Monthly {
+		daysOfMonth := make([]int64, 0)
+		for _, day := range v.DaysOfMonth {
+			daysOfMonth = append(daysOfMonth, int64(day))
+		}
+
+		recurrence := alertprocessingrules.MonthlyRecurrence{
+			DaysOfMonth: daysOfMonth,
+		}
+		if v.StartTime != "" {
+			recurrence.StartTime = utils.String(v.StartTime)
+		}
+		if v.EndTime != "" {
+			recurrence.EndTime = utils.String(v.EndTime)
+		}
+		result = append(result, recurrence)
+	}
+
+	return &result
+}
+
+func flattenAlertProcessingRuleConditions(input *[]alertprocessingrules.Condition) []AlertProcessingRuleConditionModel {
+	if input == nil || len(*input) == 0 {
+		return make([]AlertProcessingRuleConditionModel, 0)
+	}
+
+	result := AlertProcessingRuleConditionModel{}
+	for _, v := range *input {
+		if v.Field == nil {
+			continue
+		}
+
+		operator := ""
+		if v.Operator != nil {
+			operator = string(*v.Operator)
+		}
+		values := make([]string, 0)
+		if v.Values != nil {
+			values = *v.Values
+		}
+		condition := []AlertProcessingRuleSingleConditionModel{{
+			Operator: operator,
+			Values:   values,
+		}}
+
+		switch *v.Field {
+		case alertprocessingrules.FieldAlertContext:
+			result.AlertContext = condition
+		case alertprocessingrules.FieldAlertRuleId:
+			result.AlertRuleId = condition
+		case alertprocessingrules.FieldAlertRuleName:
+			result.AlertRuleName = condition
+		case alertprocessingrules.FieldDescription:
+			result.Description = condition
+		case alertprocessingrules.FieldMonitorCondition:
+			result.MonitorCondition = condition
+		case alertprocessingrules.FieldMonitorService:
+			result.MonitorService = condition
+		case alertprocessingrules.FieldSeverity:
+			result.Severity = condition
+		case alertprocessingrules.FieldSignalType:
+			result.SignalType = condition
+		case alertprocessingrules.FieldTargetResource:
+			result.TargetResource = condition
+		case alertprocessingrules.FieldTargetResourceGroup:
+			result.TargetResourceGroup = condition
+		case alertprocessingrules.FieldTargetResourceType:
+			result.TargetResourceType = condition
+		}
+	}
+
+	return []AlertProcessingRuleConditionModel{result}
+}
+
+func flattenAlertProcessingRuleSchedule(input *alertprocessingrules.Schedule) []AlertProcessingRuleScheduleModel {
+	if input == nil {
+		return make([]AlertProcessingRuleScheduleModel, 0)
+	}
+
+	return []AlertProcessingRuleScheduleModel{{
+		EffectiveFrom:  utils.NormalizeNilableString(input.EffectiveFrom),
+		EffectiveUntil: utils.NormalizeNilableString(input.EffectiveUntil),
+		Recurrence:     flattenAlertProcessingRuleRecurrences(input.Recurrences),
+		TimeZone:       utils.NormalizeNilableString(input.TimeZone),
+	}}
+}
+
+func flattenAlertProcessingRuleRecurrences(input *[]alertprocessingrules.Recurrence) []AlertProcessingRuleRecurrenceModel {
+	if input == nil || len(*input) == 0 {
+		return make([]AlertProcessingRuleRecurrenceModel, 0)
+	}
+
+	result := AlertProcessingRuleRecurrenceModel{
+		Daily:   make([]AlertProcessingRuleDailyModel, 0),
+		Weekly:  make([]AlertProcessingRuleWeeklyModel, 0),
+		Monthly: make([]AlertProcessingRuleMonthlyModel, 0),
+	}
+	for _, recurrence := range *input {
+		switch v := recurrence.(type) {
+		case alertprocessingrules.DailyRecurrence:
+			result.Daily = append(result.Daily, AlertProcessingRuleDailyModel{
+				StartTime: utils.NormalizeNilableString(v.StartTime),
+				EndTime:   utils.NormalizeNilableString(v.EndTime),
+			})
+
+		case alertprocessingrules.WeeklyRecurrence:
+			daysOfWeek := make([]string, 0)
+			for _, day := range v.DaysOfWeek {
+				daysOfWeek = append(daysOfWeek, string(day))
+			}
+			result.Weekly = append(result.Weekly, AlertProcessingRuleWeeklyModel{
+				DaysOfWeek: daysOfWeek,
+				StartTime:  utils.NormalizeNilableString(v.StartTime),
+				EndTime:    utils.NormalizeNilableString(v.EndTime),
+			})
+
+		case alertprocessingrules.MonthlyRecurrence:
+			daysOfMonth := make([]int, 0)
+			for _, day := range v.DaysOfMonth {
+				daysOfMonth = append(daysOfMonth, int(day))
+			}
+			result.Monthly = append(result.Monthly, AlertProcessingRuleMonthlyModel{
+				DaysOfMonth: daysOfMonth,
+				StartTime:   utils.NormalizeNilableString(v.StartTime),
+				EndTime:     utils.NormalizeNilableString(v.EndTime),
+			})
+		}
+	}
+
+	return []AlertProcessingRuleRecurrenceModel{result}
+}
@@ -0,0 +1,146 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the `predictiveAutoscalePolicy` property is only available from API Version
+// `2022-10-01` of the Autoscale Settings API, whereas the Monitor resources are built against API Version `2021-07-01-preview`.
+// Once the Autoscale Setting resource has been migrated to a newer API Version this can be removed.
+
+const autoscaleSettingsAPIVersion = "2022-10-01"
+
+type PredictiveAutoscalePolicyScaleMode string
+
+const (
+	PredictiveAutoscalePolicyScaleModeDisabled     PredictiveAutoscalePolicyScaleMode = "Disabled"
+	PredictiveAutoscalePolicyScaleModeEnabled      PredictiveAutoscalePolicyScaleMode = "Enabled"
+	PredictiveAutoscalePolicyScaleModeForecastOnly PredictiveAutoscalePolicyScaleMode = "ForecastOnly"
+)
+
+type AutoscaleSettingsClient struct {
+	sdkClient *insights.AutoscaleSettingsClient
+}
+
+func NewAutoscaleSettingsClient(client *insights.AutoscaleSettingsClient) AutoscaleSettingsClient {
+	return AutoscaleSettingsClient{
+		sdkClient: client,
+	}
+}
+
+type AutoscaleSettingResource struct {
+	autorest.Response `json:"-"`
+	*AutoscaleSetting `json:"properties,omitempty"`
+	ID                *string            `json:"id,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	Type              *string            `json:"type,omitempty"`
+	Location          *string            `json:"location,omitempty"`
+	Tags              map[string]*string `json:"tags"`
+}
+
+func (asr AutoscaleSettingResource) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	if asr.AutoscaleSetting != nil {
+		objectMap["properties"] = asr.AutoscaleSetting
+	}
+	if asr.Location != nil {
+		objectMap["location"] = asr.Location
+	}
+	if asr.Tags != nil {
+		objectMap["tags"] = asr.Tags
+	}
+	return json.Marshal(objectMap)
+}
+
+type AutoscaleSetting struct {
+	insights.AutoscaleSetting
+	PredictiveAutoscalePolicy *PredictiveAutoscalePolicy `json:"predictiveAutoscalePolicy,omitempty"`
+}
+
+type PredictiveAutoscalePolicy struct {
+	ScaleMode          PredictiveAutoscalePolicyScaleMode `json:"scaleMode,omitempty"`
+	ScaleLookAheadTime *string                            `json:"scaleLookAheadTime,omitempty"`
+}
+
+func (c AutoscaleSettingsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, autoscaleSettingName string, parameters AutoscaleSettingResource) (result AutoscaleSettingResource, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, autoscaleSettingName, autorest.AsPut(), autorest.WithJSON(parameters))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.AutoscaleSettingsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.CreateOrUpdateSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "insights.AutoscaleSettingsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.AutoscaleSettingsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c AutoscaleSettingsClient) Get(ctx context.Context, resourceGroupName string, autoscaleSettingName string) (result AutoscaleSettingResource, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, autoscaleSettingName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.AutoscaleSettingsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "insights.AutoscaleSettingsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "insights.AutoscaleSettingsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c AutoscaleSettingsClient) preparer(ctx context.Context, resourceGroupName string, autoscaleSettingName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"autoscaleSettingName": autorest.Encode("path", autoscaleSettingName),
+		"resourceGroupName":    autorest.Encode("path", resourceGroupName),
+		"subscriptionId":       autorest.Encode("path", c.sdkClient.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": autoscaleSettingsAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+	}, decorators...)
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/Microsoft.Insights/autoscalesettings/{autoscaleSettingName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -26,6 +26,8 @@ func resourceMonitorActionRuleActionGroup() *pluginsdk.Resource {
 		Update: resourceMonitorActionRuleActionGroupCreateUpdate,
 		Delete: resourceMonitorActionRuleActionGroupDelete,
 
+		DeprecationMessage: `This resource has been deprecated in favour of the 'azurerm_monitor_alert_processing_rule_action_group' resource and will be removed in v4.0 of the AzureRM Provider`,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
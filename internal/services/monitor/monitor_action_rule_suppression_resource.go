@@ -26,6 +26,8 @@ func resourceMonitorActionRuleSuppression() *pluginsdk.Resource {
 		Update: resourceMonitorActionRuleSuppressionCreateUpdate,
 		Delete: resourceMonitorActionRuleSuppressionDelete,
 
+		DeprecationMessage: `This resource has been deprecated in favour of the 'azurerm_monitor_alert_processing_rule_suppression' resource and will be removed in v4.0 of the AzureRM Provider`,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -0,0 +1,449 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// activityLogStorageContainerName is the container which Azure Monitor writes the Activity Log into
+const activityLogStorageContainerName = "insights-activity-logs"
+
+func resourceMonitorActivityLogExportToStorageImmutable() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorActivityLogExportToStorageImmutableCreate,
+		Read:   resourceMonitorActivityLogExportToStorageImmutableRead,
+		Update: resourceMonitorActivityLogExportToStorageImmutableUpdate,
+		Delete: resourceMonitorActivityLogExportToStorageImmutableDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SubscriptionDiagnosticSettingID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MonitorDiagnosticSettingName,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"categories": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Administrative",
+						"Alert",
+						"Autoscale",
+						"Policy",
+						"Recommendation",
+						"ResourceHealth",
+						"Security",
+						"ServiceHealth",
+					}, false),
+				},
+			},
+
+			"immutability_period_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 146000),
+			},
+
+			"immutability_policy_locked": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"storage_container_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if d.Id() == "" {
+					return nil
+				}
+
+				oldLocked, newLocked := d.GetChange("immutability_policy_locked")
+				if !oldLocked.(bool) {
+					return nil
+				}
+				if !newLocked.(bool) {
+					return fmt.Errorf("`immutability_policy_locked` cannot be set to `false` once the Immutability Policy has been locked")
+				}
+
+				oldPeriod, newPeriod := d.GetChange("immutability_period_in_days")
+				if newPeriod.(int) < oldPeriod.(int) {
+					return fmt.Errorf("`immutability_period_in_days` can only be increased once the Immutability Policy has been locked")
+				}
+
+				return nil
+			},
+		),
+	}
+}
+
+func resourceMonitorActivityLogExportToStorageImmutableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	containersClient := meta.(*clients.Client).Storage.BlobContainersClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewSubscriptionDiagnosticSettingID(subscriptionId, d.Get("name").(string))
+	resourceUri := subscriptionDiagnosticSettingResourceUri(id)
+
+	existing, err := client.Get(ctx, resourceUri, id.DiagnosticSettingName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_monitor_activity_log_export_to_storage_immutable", id.ID())
+	}
+
+	storageAccountId, err := storageParse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Container is created (and removed) alongside the Diagnostic Setting, since an existing Container and any
+	// Immutability Policy on it may be protecting data which isn't managed by Terraform
+	container, err := containersClient.Get(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName)
+	if err != nil && !utils.ResponseWasNotFound(container.Response) {
+		return fmt.Errorf("checking for presence of existing Container %q (%s): %+v", activityLogStorageContainerName, *storageAccountId, err)
+	}
+	if !utils.ResponseWasNotFound(container.Response) {
+		return fmt.Errorf("Container %q already exists within %s - the Activity Log can only be exported into a Container created by this resource, so the existing Container must be removed first", activityLogStorageContainerName, *storageAccountId)
+	}
+
+	// the Container needs to exist (and be protected) before Azure Monitor starts writing the Activity Log into it
+	log.Printf("[DEBUG] Creating Container %q (%s)..", activityLogStorageContainerName, *storageAccountId)
+	input := storage.BlobContainer{
+		ContainerProperties: &storage.ContainerProperties{
+			PublicAccess: storage.PublicAccessNone,
+		},
+	}
+	if _, err := containersClient.Create(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName, input); err != nil {
+		return fmt.Errorf("creating Container %q (%s): %+v", activityLogStorageContainerName, *storageAccountId, err)
+	}
+
+	etag, err := upsertActivityLogImmutabilityPolicy(ctx, containersClient, *storageAccountId, d.Get("immutability_period_in_days").(int), false)
+	if err != nil {
+		return deleteActivityLogContainerAfterError(ctx, containersClient, *storageAccountId, err)
+	}
+
+	if err := upsertActivityLogDiagnosticSetting(ctx, client, id, storageAccountId.ID(), d.Get("categories").(*pluginsdk.Set).List()); err != nil {
+		return deleteActivityLogContainerAfterError(ctx, containersClient, *storageAccountId, err)
+	}
+
+	// from here the resource is tracked in the state, so should anything below fail it's tainted and the Delete cleans up
+	d.SetId(id.ID())
+
+	setting, err := client.Get(ctx, resourceUri, id.DiagnosticSettingName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if props := setting.DiagnosticSettings; props == nil || props.StorageAccountID == nil {
+		return fmt.Errorf("%s isn't exporting the Activity Log to %s", id, *storageAccountId)
+	}
+
+	// the Immutability Policy is locked last, since once locked neither it nor the Container can be removed
+	if d.Get("immutability_policy_locked").(bool) {
+		if err := lockActivityLogImmutabilityPolicy(ctx, containersClient, *storageAccountId, etag); err != nil {
+			return err
+		}
+	}
+
+	return resourceMonitorActivityLogExportToStorageImmutableRead(d, meta)
+}
+
+func resourceMonitorActivityLogExportToStorageImmutableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	containersClient := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubscriptionDiagnosticSettingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, subscriptionDiagnosticSettingResourceUri(*id), id.DiagnosticSettingName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DiagnosticSettingName)
+	d.Set("storage_container_name", activityLogStorageContainerName)
+
+	storageAccountId := ""
+	categories := make([]interface{}, 0)
+	if props := resp.DiagnosticSettings; props != nil {
+		if props.StorageAccountID != nil {
+			parsed, err := storageParse.StorageAccountID(*props.StorageAccountID)
+			if err != nil {
+				return err
+			}
+			storageAccountId = parsed.ID()
+		}
+
+		if props.Logs != nil {
+			for _, v := range *props.Logs {
+				if v.Category != nil && v.Enabled != nil && *v.Enabled {
+					categories = append(categories, *v.Category)
+				}
+			}
+		}
+	}
+	d.Set("storage_account_id", storageAccountId)
+	if err := d.Set("categories", categories); err != nil {
+		return fmt.Errorf("setting `categories`: %+v", err)
+	}
+
+	if storageAccountId != "" {
+		parsed, err := storageParse.StorageAccountID(storageAccountId)
+		if err != nil {
+			return err
+		}
+
+		policy, err := containersClient.GetImmutabilityPolicy(ctx, parsed.ResourceGroup, parsed.Name, activityLogStorageContainerName, "")
+		if err != nil && !utils.ResponseWasNotFound(policy.Response) {
+			return fmt.Errorf("retrieving Immutability Policy for Container %q (%s): %+v", activityLogStorageContainerName, *parsed, err)
+		}
+
+		period := 0
+		locked := false
+		if props := policy.ImmutabilityPolicyProperty; props != nil {
+			if props.ImmutabilityPeriodSinceCreationInDays != nil {
+				period = int(*props.ImmutabilityPeriodSinceCreationInDays)
+			}
+			locked = props.State == storage.ImmutabilityPolicyStateLocked
+		}
+		d.Set("immutability_period_in_days", period)
+		d.Set("immutability_policy_locked", locked)
+	}
+
+	return nil
+}
+
+func resourceMonitorActivityLogExportToStorageImmutableUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	containersClient := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubscriptionDiagnosticSettingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storageAccountId, err := storageParse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("categories") {
+		if err := upsertActivityLogDiagnosticSetting(ctx, client, *id, storageAccountId.ID(), d.Get("categories").(*pluginsdk.Set).List()); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("immutability_period_in_days", "immutability_policy_locked") {
+		oldLocked, _ := d.GetChange("immutability_policy_locked")
+		etag, err := upsertActivityLogImmutabilityPolicy(ctx, containersClient, *storageAccountId, d.Get("immutability_period_in_days").(int), oldLocked.(bool))
+		if err != nil {
+			return err
+		}
+
+		if !oldLocked.(bool) && d.Get("immutability_policy_locked").(bool) {
+			if err := lockActivityLogImmutabilityPolicy(ctx, containersClient, *storageAccountId, etag); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceMonitorActivityLogExportToStorageImmutableRead(d, meta)
+}
+
+func resourceMonitorActivityLogExportToStorageImmutableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	containersClient := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SubscriptionDiagnosticSettingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, subscriptionDiagnosticSettingResourceUri(*id), id.DiagnosticSettingName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	storageAccountId, err := storageParse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	return deleteActivityLogContainer(ctx, containersClient, *storageAccountId)
+}
+
+func subscriptionDiagnosticSettingResourceUri(id parse.SubscriptionDiagnosticSettingId) string {
+	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
+	return fmt.Sprintf("subscriptions/%s", id.SubscriptionId)
+}
+
+func upsertActivityLogDiagnosticSetting(ctx context.Context, client *insights.DiagnosticSettingsClient, id parse.SubscriptionDiagnosticSettingId, storageAccountId string, categories []interface{}) error {
+	logs := make([]insights.LogSettings, 0)
+	for _, v := range categories {
+		logs = append(logs, insights.LogSettings{
+			Category: utils.String(v.(string)),
+			Enabled:  utils.Bool(true),
+		})
+	}
+
+	parameters := insights.DiagnosticSettingsResource{
+		DiagnosticSettings: &insights.DiagnosticSettings{
+			StorageAccountID: utils.String(storageAccountId),
+			Logs:             &logs,
+		},
+	}
+	if _, err := client.CreateOrUpdate(ctx, subscriptionDiagnosticSettingResourceUri(id), parameters, id.DiagnosticSettingName); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// upsertActivityLogImmutabilityPolicy creates/updates the Immutability Policy on the Activity Log container, returning
+// the etag of the policy - once locked the policy can only be extended
+func upsertActivityLogImmutabilityPolicy(ctx context.Context, client *storage.BlobContainersClient, storageAccountId storageParse.StorageAccountId, period int, alreadyLocked bool) (string, error) {
+	existing, err := client.GetImmutabilityPolicy(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName, "")
+	if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+		return "", fmt.Errorf("retrieving Immutability Policy for Container %q (%s): %+v", activityLogStorageContainerName, storageAccountId, err)
+	}
+	etag := ""
+	if existing.Etag != nil {
+		etag = *existing.Etag
+	}
+
+	input := &storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(period)),
+			// Azure Monitor writes the Activity Log to Append Blobs, which requires protected append writes
+			AllowProtectedAppendWrites: utils.Bool(true),
+		},
+	}
+
+	var policy storage.ImmutabilityPolicy
+	if alreadyLocked {
+		input.ImmutabilityPolicyProperty.AllowProtectedAppendWrites = nil
+		policy, err = client.ExtendImmutabilityPolicy(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName, etag, input)
+		if err != nil {
+			return "", fmt.Errorf("extending Immutability Policy for Container %q (%s): %+v", activityLogStorageContainerName, storageAccountId, err)
+		}
+	} else {
+		policy, err = client.CreateOrUpdateImmutabilityPolicy(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName, input, etag)
+		if err != nil {
+			return "", fmt.Errorf("creating/updating Immutability Policy for Container %q (%s): %+v", activityLogStorageContainerName, storageAccountId, err)
+		}
+	}
+
+	if policy.Etag == nil {
+		return "", fmt.Errorf("retrieving Immutability Policy for Container %q (%s): `etag` was nil", activityLogStorageContainerName, storageAccountId)
+	}
+
+	return *policy.Etag, nil
+}
+
+func lockActivityLogImmutabilityPolicy(ctx context.Context, client *storage.BlobContainersClient, storageAccountId storageParse.StorageAccountId, etag string) error {
+	if _, err := client.LockImmutabilityPolicy(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName, etag); err != nil {
+		return fmt.Errorf("locking Immutability Policy for Container %q (%s): %+v", activityLogStorageContainerName, storageAccountId, err)
+	}
+
+	return nil
+}
+
+// deleteActivityLogContainer removes the Immutability Policy and the Activity Log container - a locked Immutability Policy
+// can't be removed, so in that case the Container (and the logs within it) are retained until the policy expires
+func deleteActivityLogContainer(ctx context.Context, client *storage.BlobContainersClient, storageAccountId storageParse.StorageAccountId) error {
+	policy, err := client.GetImmutabilityPolicy(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName, "")
+	if err != nil && !utils.ResponseWasNotFound(policy.Response) {
+		return fmt.Errorf("retrieving Immutability Policy for Container %q (%s): %+v", activityLogStorageContainerName, storageAccountId, err)
+	}
+	if props := policy.ImmutabilityPolicyProperty; props != nil && props.State == storage.ImmutabilityPolicyStateLocked {
+		log.Printf("[DEBUG] The Immutability Policy for Container %q (%s) is locked - leaving the Container in place", activityLogStorageContainerName, storageAccountId)
+		return nil
+	}
+
+	if !utils.ResponseWasNotFound(policy.Response) && policy.Etag != nil {
+		if _, err := client.DeleteImmutabilityPolicy(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName, *policy.Etag); err != nil {
+			return fmt.Errorf("deleting Immutability Policy for Container %q (%s): %+v", activityLogStorageContainerName, storageAccountId, err)
+		}
+	}
+
+	if resp, err := client.Delete(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, activityLogStorageContainerName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Container %q (%s): %+v", activityLogStorageContainerName, storageAccountId, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteActivityLogContainerAfterError removes the Container created during Create when a later step fails before the
+// resource is tracked in the state, since it would otherwise prevent the resource from being created again
+func deleteActivityLogContainerAfterError(ctx context.Context, client *storage.BlobContainersClient, storageAccountId storageParse.StorageAccountId, err error) error {
+	if cleanupErr := deleteActivityLogContainer(ctx, client, storageAccountId); cleanupErr != nil {
+		return fmt.Errorf("%+v\n\nadditionally, removing Container %q (%s) failed: %+v", err, activityLogStorageContainerName, storageAccountId, cleanupErr)
+	}
+
+	return err
+}
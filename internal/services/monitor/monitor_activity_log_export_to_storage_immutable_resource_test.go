@@ -0,0 +1,178 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorActivityLogExportToStorageImmutableResource struct{}
+
+func TestAccMonitorActivityLogExportToStorageImmutable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_export_to_storage_immutable", "test")
+	r := MonitorActivityLogExportToStorageImmutableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_container_name").HasValue("insights-activity-logs"),
+				check.That(data.ResourceName).Key("immutability_policy_locked").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorActivityLogExportToStorageImmutable_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_export_to_storage_immutable", "test")
+	r := MonitorActivityLogExportToStorageImmutableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorActivityLogExportToStorageImmutable_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_export_to_storage_immutable", "test")
+	r := MonitorActivityLogExportToStorageImmutableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 7),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutability_period_in_days").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorActivityLogExportToStorageImmutable_existingContainer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_export_to_storage_immutable", "test")
+	r := MonitorActivityLogExportToStorageImmutableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.existingContainer(data),
+			ExpectError: regexp.MustCompile("already exists within"),
+		},
+	})
+}
+
+func (MonitorActivityLogExportToStorageImmutableResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SubscriptionDiagnosticSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, fmt.Sprintf("subscriptions/%s", id.SubscriptionId), id.DiagnosticSettingName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MonitorActivityLogExportToStorageImmutableResource) basic(data acceptance.TestData, period int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_activity_log_export_to_storage_immutable" "test" {
+  name                        = "acctest-ds-%d"
+  storage_account_id          = azurerm_storage_account.test.id
+  categories                  = ["Administrative", "Security"]
+  immutability_period_in_days = %d
+}
+`, r.template(data), data.RandomInteger, period)
+}
+
+func (r MonitorActivityLogExportToStorageImmutableResource) updated(data acceptance.TestData, period int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_activity_log_export_to_storage_immutable" "test" {
+  name                        = "acctest-ds-%d"
+  storage_account_id          = azurerm_storage_account.test.id
+  categories                  = ["Administrative", "Policy", "Security", "ServiceHealth"]
+  immutability_period_in_days = %d
+}
+`, r.template(data), data.RandomInteger, period)
+}
+
+func (r MonitorActivityLogExportToStorageImmutableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_activity_log_export_to_storage_immutable" "import" {
+  name                        = azurerm_monitor_activity_log_export_to_storage_immutable.test.name
+  storage_account_id          = azurerm_monitor_activity_log_export_to_storage_immutable.test.storage_account_id
+  categories                  = azurerm_monitor_activity_log_export_to_storage_immutable.test.categories
+  immutability_period_in_days = azurerm_monitor_activity_log_export_to_storage_immutable.test.immutability_period_in_days
+}
+`, r.basic(data, 7))
+}
+
+func (r MonitorActivityLogExportToStorageImmutableResource) existingContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "insights-activity-logs"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_monitor_activity_log_export_to_storage_immutable" "test" {
+  name                        = "acctest-ds-%d"
+  storage_account_id          = azurerm_storage_account.test.id
+  categories                  = ["Administrative", "Security"]
+  immutability_period_in_days = 7
+
+  depends_on = [azurerm_storage_container.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MonitorActivityLogExportToStorageImmutableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,241 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AlertProcessingRuleActionGroupModel struct {
+	Name              string                              `tfschema:"name"`
+	ResourceGroupName string                              `tfschema:"resource_group_name"`
+	AddActionGroupIds []string                            `tfschema:"add_action_group_ids"`
+	Scopes            []string                            `tfschema:"scopes"`
+	Condition         []AlertProcessingRuleConditionModel `tfschema:"condition"`
+	Description       string                              `tfschema:"description"`
+	Enabled           bool                                `tfschema:"enabled"`
+	Schedule          []AlertProcessingRuleScheduleModel  `tfschema:"schedule"`
+	Tags              map[string]interface{}              `tfschema:"tags"`
+}
+
+type AlertProcessingRuleActionGroupResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = AlertProcessingRuleActionGroupResource{}
+	_ sdk.ResourceWithCustomImporter = AlertProcessingRuleActionGroupResource{}
+)
+
+func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
+	return "azurerm_monitor_alert_processing_rule_action_group"
+}
+
+func (r AlertProcessingRuleActionGroupResource) ModelObject() interface{} {
+	return &AlertProcessingRuleActionGroupModel{}
+}
+
+func (r AlertProcessingRuleActionGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return alertprocessingrules.ValidateActionRuleID
+}
+
+func (r AlertProcessingRuleActionGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ActionRuleName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"add_action_group_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ActionGroupID,
+			},
+		},
+
+		"scopes": schemaAlertProcessingRuleScopes(),
+
+		"condition": schemaAlertProcessingRuleConditions(),
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"schedule": schemaAlertProcessingRuleSchedule(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertProcessingRuleActionGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := alertprocessingrules.NewActionRuleID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.AlertProcessingRulesGetByName(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.AlertProcessingRulesCreateOrUpdate(ctx, id, expandAlertProcessingRuleActionGroup(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+
+			id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.AlertProcessingRulesGetByName(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AlertProcessingRuleActionGroupModel{
+				Name:              id.ActionRuleName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					actionGroupIds := make([]string, 0)
+					for _, action := range props.Actions {
+						if v, ok := action.(alertprocessingrules.AddActionGroups); ok {
+							actionGroupIds = append(actionGroupIds, v.ActionGroupIds...)
+						}
+					}
+					state.AddActionGroupIds = actionGroupIds
+					state.Condition = flattenAlertProcessingRuleConditions(props.Conditions)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Enabled = props.Enabled == nil || *props.Enabled
+					state.Schedule = flattenAlertProcessingRuleSchedule(props.Schedule)
+					state.Scopes = props.Scopes
+				}
+
+				state.Tags = tags.Flatten(model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+
+			id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AlertProcessingRuleActionGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.AlertProcessingRulesCreateOrUpdate(ctx, *id, expandAlertProcessingRuleActionGroup(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+
+			id, err := alertprocessingrules.ParseActionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.AlertProcessingRulesDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertProcessingRuleActionGroupResource) CustomImporter() sdk.ResourceRunFunc {
+	return importAlertProcessingRule(alertprocessingrules.ActionTypeAddActionGroups)
+}
+
+func expandAlertProcessingRuleActionGroup(model AlertProcessingRuleActionGroupModel) alertprocessingrules.AlertProcessingRule {
+	return alertprocessingrules.AlertProcessingRule{
+		// Alert Processing Rules are a global resource
+		Location: "global",
+		Properties: &alertprocessingrules.AlertProcessingRuleProperties{
+			Actions: []alertprocessingrules.Action{
+				alertprocessingrules.AddActionGroups{
+					ActionGroupIds: model.AddActionGroupIds,
+				},
+			},
+			Conditions:  expandAlertProcessingRuleConditions(model.Condition),
+			Description: utils.String(model.Description),
+			Enabled:     utils.Bool(model.Enabled),
+			Schedule:    expandAlertProcessingRuleSchedule(model.Schedule),
+			Scopes:      model.Scopes,
+		},
+		Tags: tags.Expand(model.Tags),
+	}
+}
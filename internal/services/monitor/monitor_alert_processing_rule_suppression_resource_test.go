@@ -0,0 +1,233 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AlertProcessingRuleSuppressionResource struct{}
+
+func TestAccAlertProcessingRuleSuppression_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_suppression", "test")
+	r := AlertProcessingRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAlertProcessingRuleSuppression_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_suppression", "test")
+	r := AlertProcessingRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAlertProcessingRuleSuppression_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_suppression", "test")
+	r := AlertProcessingRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAlertProcessingRuleSuppression_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_suppression", "test")
+	r := AlertProcessingRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AlertProcessingRuleSuppressionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := alertprocessingrules.ParseActionRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.AlertProcessingRulesClient.AlertProcessingRulesGetByName(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r AlertProcessingRuleSuppressionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
+  name                = "acctest-moapr-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AlertProcessingRuleSuppressionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "import" {
+  name                = azurerm_monitor_alert_processing_rule_suppression.test.name
+  resource_group_name = azurerm_monitor_alert_processing_rule_suppression.test.resource_group_name
+  scopes              = azurerm_monitor_alert_processing_rule_suppression.test.scopes
+}
+`, r.basic(data))
+}
+
+func (r AlertProcessingRuleSuppressionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
+  name                = "acctest-moapr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+  description         = "alert processing rule suppression test"
+  enabled             = false
+
+  condition {
+    alert_context {
+      operator = "Contains"
+      values   = ["context1", "context2"]
+    }
+
+    alert_rule_id {
+      operator = "Contains"
+      values   = ["ruleId1", "ruleId2"]
+    }
+
+    alert_rule_name {
+      operator = "DoesNotContain"
+      values   = ["ruleName1", "ruleName2"]
+    }
+
+    description {
+      operator = "DoesNotContain"
+      values   = ["description1", "description2"]
+    }
+
+    monitor_condition {
+      operator = "NotEquals"
+      values   = ["Fired"]
+    }
+
+    monitor_service {
+      operator = "Equals"
+      values   = ["Data Box Gateway", "Resource Health", "Prometheus"]
+    }
+
+    severity {
+      operator = "Equals"
+      values   = ["Sev0", "Sev1", "Sev2"]
+    }
+
+    signal_type {
+      operator = "Equals"
+      values   = ["Metric", "Log"]
+    }
+
+    target_resource_type {
+      operator = "Equals"
+      values   = ["Microsoft.Compute/VirtualMachines", "microsoft.batch/batchaccounts"]
+    }
+  }
+
+  schedule {
+    effective_from  = "2022-01-01T01:02:03"
+    effective_until = "2022-02-02T01:02:03"
+    time_zone       = "Pacific Standard Time"
+
+    recurrence {
+      daily {
+        start_time = "17:00:00"
+        end_time   = "09:00:00"
+      }
+
+      weekly {
+        days_of_week = ["Saturday", "Sunday"]
+      }
+
+      monthly {
+        days_of_month = [1, 15]
+        start_time    = "09:00:00"
+        end_time      = "17:00:00"
+      }
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AlertProcessingRuleSuppressionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-monitor-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -1,6 +1,7 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -39,6 +41,8 @@ func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
 			0: migration.AutoscaleSettingUpgradeV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceMonitorAutoScaleSettingCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -398,13 +402,51 @@ func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
 				},
 			},
 
+			"predictive": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"scale_mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(azuresdkhacks.PredictiveAutoscalePolicyScaleModeEnabled),
+								string(azuresdkhacks.PredictiveAutoscalePolicyScaleModeForecastOnly),
+							}, false),
+						},
+						"look_ahead_time": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601DurationBetween("PT1M", "PT1H"),
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
 }
 
+func resourceMonitorAutoScaleSettingCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// a profile is either scheduled on a `fixed_date` or a `recurrence`, which the API only rejects at apply time
+	for i, v := range d.Get("profile").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		raw := v.(map[string]interface{})
+		if len(raw["fixed_date"].([]interface{})) > 0 && len(raw["recurrence"].([]interface{})) > 0 {
+			return fmt.Errorf("`profile.%d`: only one of `fixed_date` or `recurrence` can be specified", i)
+		}
+	}
+
+	return nil
+}
+
 func resourceMonitorAutoScaleSettingCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.AutoscaleSettingsClient
+	client := azuresdkhacks.NewAutoscaleSettingsClient(meta.(*clients.Client).Monitor.AutoscaleSettingsClient)
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -440,13 +482,16 @@ func resourceMonitorAutoScaleSettingCreateUpdate(d *pluginsdk.ResourceData, meta
 	t := d.Get("tags").(map[string]interface{})
 	expandedTags := tags.Expand(t)
 
-	parameters := insights.AutoscaleSettingResource{
+	parameters := azuresdkhacks.AutoscaleSettingResource{
 		Location: utils.String(location),
-		AutoscaleSetting: &insights.AutoscaleSetting{
-			Enabled:           &enabled,
-			Profiles:          profiles,
-			Notifications:     notifications,
-			TargetResourceURI: &targetResourceId,
+		AutoscaleSetting: &azuresdkhacks.AutoscaleSetting{
+			AutoscaleSetting: insights.AutoscaleSetting{
+				Enabled:           &enabled,
+				Profiles:          profiles,
+				Notifications:     notifications,
+				TargetResourceURI: &targetResourceId,
+			},
+			PredictiveAutoscalePolicy: expandAzureRmMonitorAutoScaleSettingPredictive(d.Get("predictive").([]interface{})),
 		},
 		Tags: expandedTags,
 	}
@@ -461,7 +506,7 @@ func resourceMonitorAutoScaleSettingCreateUpdate(d *pluginsdk.ResourceData, meta
 }
 
 func resourceMonitorAutoScaleSettingRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.AutoscaleSettingsClient
+	client := azuresdkhacks.NewAutoscaleSettingsClient(meta.(*clients.Client).Monitor.AutoscaleSettingsClient)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -503,6 +548,10 @@ func resourceMonitorAutoScaleSettingRead(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("setting `notification` of %s: %+v", *id, err)
 	}
 
+	if err = d.Set("predictive", flattenAzureRmMonitorAutoScaleSettingPredictive(resp.PredictiveAutoscalePolicy)); err != nil {
+		return fmt.Errorf("setting `predictive` of %s: %+v", *id, err)
+	}
+
 	// Return a new tag map filtered by the specified tag names.
 	tagMap := tags.Filter(resp.Tags, "$type")
 	return tags.FlattenAndSet(d, tagMap)
@@ -748,6 +797,24 @@ func expandAzureRmMonitorAutoScaleSettingNotificationWebhook(input []interface{}
 	return &webhooks
 }
 
+func expandAzureRmMonitorAutoScaleSettingPredictive(input []interface{}) *azuresdkhacks.PredictiveAutoscalePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return &azuresdkhacks.PredictiveAutoscalePolicy{
+			ScaleMode: azuresdkhacks.PredictiveAutoscalePolicyScaleModeDisabled,
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	result := azuresdkhacks.PredictiveAutoscalePolicy{
+		ScaleMode: azuresdkhacks.PredictiveAutoscalePolicyScaleMode(raw["scale_mode"].(string)),
+	}
+	if v := raw["look_ahead_time"].(string); v != "" {
+		result.ScaleLookAheadTime = utils.String(v)
+	}
+
+	return &result
+}
+
 func expandAzureRmMonitorAutoScaleSettingRuleDimensions(input []interface{}) *[]insights.ScaleRuleMetricDimension {
 	dimensions := make([]insights.ScaleRuleMetricDimension, 0)
 
@@ -1046,6 +1113,20 @@ func flattenAzureRmMonitorAutoScaleSettingNotification(notifications *[]insights
 	return results
 }
 
+func flattenAzureRmMonitorAutoScaleSettingPredictive(input *azuresdkhacks.PredictiveAutoscalePolicy) []interface{} {
+	// the API returns a `scaleMode` of `Disabled` when predictive autoscale isn't configured
+	if input == nil || input.ScaleMode == azuresdkhacks.PredictiveAutoscalePolicyScaleModeDisabled || input.ScaleMode == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"scale_mode":      string(input.ScaleMode),
+			"look_ahead_time": utils.NormalizeNilableString(input.ScaleLookAheadTime),
+		},
+	}
+}
+
 func flattenAzureRmMonitorAutoScaleSettingRulesDimensions(dimensions *[]insights.ScaleRuleMetricDimension) []interface{} {
 	results := make([]interface{}, 0)
 
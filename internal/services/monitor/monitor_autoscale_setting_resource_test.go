@@ -251,6 +251,48 @@ func TestAccMonitorAutoScaleSetting_fixedDate(t *testing.T) {
 	})
 }
 
+func TestAccMonitorAutoScaleSetting_predictive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_autoscale_setting", "test")
+	r := MonitorAutoScaleSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.predictive(data, "ForecastOnly", "PT5M"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.0.scale_mode").HasValue("ForecastOnly"),
+				check.That(data.ResourceName).Key("predictive.0.look_ahead_time").HasValue("PT5M"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.predictive(data, "Enabled", "PT1M"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.0.scale_mode").HasValue("Enabled"),
+				check.That(data.ResourceName).Key("predictive.0.look_ahead_time").HasValue("PT1M"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAzureRMMonitorAutoScaleSetting_multipleRulesDimensions(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_autoscale_setting", "test")
 	r := MonitorAutoScaleSettingResource{}
@@ -811,6 +853,56 @@ resource "azurerm_monitor_autoscale_setting" "test" {
 `, template, data.RandomInteger)
 }
 
+func (MonitorAutoScaleSettingResource) predictive(data acceptance.TestData, scaleMode string, lookAheadTime string) string {
+	template := MonitorAutoScaleSettingResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_autoscale_setting" "test" {
+  name                = "acctestautoscale-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  target_resource_id  = azurerm_virtual_machine_scale_set.test.id
+
+  predictive {
+    scale_mode      = "%s"
+    look_ahead_time = "%s"
+  }
+
+  profile {
+    name = "metricRules"
+
+    capacity {
+      default = 1
+      minimum = 1
+      maximum = 10
+    }
+
+    rule {
+      metric_trigger {
+        metric_name              = "Percentage CPU"
+        metric_resource_id       = azurerm_virtual_machine_scale_set.test.id
+        time_grain               = "PT1M"
+        statistic                = "Average"
+        time_window              = "PT5M"
+        time_aggregation         = "Last"
+        operator                 = "GreaterThan"
+        threshold                = 75
+        divide_by_instance_count = true
+      }
+
+      scale_action {
+        direction = "Increase"
+        type      = "ChangeCount"
+        value     = 1
+        cooldown  = "PT1M"
+      }
+    }
+  }
+}
+`, template, data.RandomInteger, scaleMode, lookAheadTime)
+}
+
 func (MonitorAutoScaleSettingResource) multipleRulesDimensions(data acceptance.TestData) string {
 	template := MonitorAutoScaleSettingResource{}.template(data)
 	return fmt.Sprintf(`
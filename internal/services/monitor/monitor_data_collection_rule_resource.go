@@ -3,40 +3,48 @@ package monitor
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type DataCollectionRule struct {
-	DataFlows         []DataFlow             `tfschema:"data_flow"`
-	DataSources       []DataSource           `tfschema:"data_sources"`
-	Description       string                 `tfschema:"description"`
-	Destinations      []Destination          `tfschema:"destinations"`
-	Kind              string                 `tfschema:"kind"`
-	Name              string                 `tfschema:"name"`
-	Location          string                 `tfschema:"location"`
-	ResourceGroupName string                 `tfschema:"resource_group_name"`
-	Tags              map[string]interface{} `tfschema:"tags"`
+	DataCollectionEndpointId string                 `tfschema:"data_collection_endpoint_id"`
+	DataFlows                []DataFlow             `tfschema:"data_flow"`
+	DataSources              []DataSource           `tfschema:"data_sources"`
+	Description              string                 `tfschema:"description"`
+	Destinations             []Destination          `tfschema:"destinations"`
+	Kind                     string                 `tfschema:"kind"`
+	Name                     string                 `tfschema:"name"`
+	Location                 string                 `tfschema:"location"`
+	ResourceGroupName        string                 `tfschema:"resource_group_name"`
+	StreamDeclarations       []StreamDeclaration    `tfschema:"stream_declaration"`
+	Tags                     map[string]interface{} `tfschema:"tags"`
 }
 
 type DataFlow struct {
-	Destinations []string `tfschema:"destinations"`
-	Streams      []string `tfschema:"streams"`
+	BuiltInTransform string   `tfschema:"built_in_transform"`
+	Destinations     []string `tfschema:"destinations"`
+	OutputStream     string   `tfschema:"output_stream"`
+	Streams          []string `tfschema:"streams"`
+	TransformKql     string   `tfschema:"transform_kql"`
 }
 
 type DataSource struct {
 	Extensions          []Extension       `tfschema:"extension"`
+	LogFiles            []LogFile         `tfschema:"log_file"`
 	PerformanceCounters []PerfCounter     `tfschema:"performance_counter"`
 	Syslog              []Syslog          `tfschema:"syslog"`
 	WindowsEventLogs    []WindowsEventLog `tfschema:"windows_event_log"`
@@ -55,6 +63,22 @@ type Extension struct {
 	Streams           []string `tfschema:"streams"`
 }
 
+type LogFile struct {
+	FilePatterns []string         `tfschema:"file_patterns"`
+	Format       string           `tfschema:"format"`
+	Name         string           `tfschema:"name"`
+	Settings     []LogFileSetting `tfschema:"settings"`
+	Streams      []string         `tfschema:"streams"`
+}
+
+type LogFileSetting struct {
+	Text []LogFileTextSetting `tfschema:"text"`
+}
+
+type LogFileTextSetting struct {
+	RecordStartTimestampFormat string `tfschema:"record_start_timestamp_format"`
+}
+
 type PerfCounter struct {
 	CounterSpecifiers          []string `tfschema:"counter_specifiers"`
 	Name                       string   `tfschema:"name"`
@@ -83,6 +107,16 @@ type LogAnalytic struct {
 	WorkspaceResourceId string `tfschema:"workspace_resource_id"`
 }
 
+type StreamDeclaration struct {
+	StreamName string                    `tfschema:"stream_name"`
+	Columns    []StreamDeclarationColumn `tfschema:"column"`
+}
+
+type StreamDeclarationColumn struct {
+	Name string `tfschema:"name"`
+	Type string `tfschema:"type"`
+}
+
 type DataCollectionRuleResource struct {
 }
 
@@ -120,10 +154,26 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 						MinItems: 1,
 						Elem: &pluginsdk.Schema{
 							Type: pluginsdk.TypeString,
-							ValidateFunc: validation.StringInSlice(
-								datacollectionrules.PossibleValuesForKnownDataFlowStreams(), false),
+							ValidateFunc: validation.Any(
+								validation.StringInSlice(datacollectionrules.PossibleValuesForKnownDataFlowStreams(), false),
+								validate.DataCollectionRuleCustomStreamName),
 						},
 					},
+					"built_in_transform": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"output_stream": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"transform_kql": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
 				},
 			},
 		},
@@ -222,6 +272,68 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 							},
 						},
 					},
+					"log_file": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"streams": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validate.DataCollectionRuleCustomStreamName,
+									},
+								},
+								"file_patterns": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+								"format": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice(
+										datacollectionrules.PossibleValuesForKnownLogFilesDataSourceFormat(), false),
+								},
+								"settings": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"text": {
+												Type:     pluginsdk.TypeList,
+												Required: true,
+												MaxItems: 1,
+												Elem: &pluginsdk.Resource{
+													Schema: map[string]*pluginsdk.Schema{
+														"record_start_timestamp_format": {
+															Type:     pluginsdk.TypeString,
+															Required: true,
+															ValidateFunc: validation.StringInSlice(
+																datacollectionrules.PossibleValuesForKnownLogFileTextSettingsRecordStartTimestampFormat(),
+																false),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 					"performance_counter": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -331,6 +443,12 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"data_collection_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
 		"description": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -343,6 +461,40 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 				datacollectionrules.PossibleValuesForKnownDataCollectionRuleResourceKind(), false),
 		},
 
+		"stream_declaration": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"stream_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.DataCollectionRuleCustomStreamName,
+					},
+					"column": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice(
+										datacollectionrules.PossibleValuesForKnownColumnDefinitionType(), false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
 		"tags": commonschema.Tags(),
 	}
 }
@@ -396,14 +548,19 @@ func (r DataCollectionRuleResource) Create() sdk.ResourceFunc {
 				Location: azure.NormalizeLocation(state.Location),
 				Name:     utils.String(state.Name),
 				Properties: &datacollectionrules.DataCollectionRule{
-					DataFlows:    expandDataCollectionRuleDataFlows(state.DataFlows),
-					DataSources:  dataSources,
-					Description:  utils.String(state.Description),
-					Destinations: expandDataCollectionRuleDestinations(state.Destinations),
+					DataFlows:          expandDataCollectionRuleDataFlows(state.DataFlows),
+					DataSources:        dataSources,
+					Description:        utils.String(state.Description),
+					Destinations:       expandDataCollectionRuleDestinations(state.Destinations),
+					StreamDeclarations: expandDataCollectionRuleStreamDeclarations(state.StreamDeclarations),
 				},
 				Tags: tags.Expand(state.Tags),
 			}
 
+			if state.DataCollectionEndpointId != "" {
+				input.Properties.DataCollectionEndpointId = utils.String(state.DataCollectionEndpointId)
+			}
+
 			if _, err := client.Create(ctx, id, input); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
@@ -434,34 +591,39 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 
-			var description, kind, location string
+			var dataCollectionEndpointId, description, kind, location string
 			var tag map[string]interface{}
 			var dataFlows []DataFlow
 			var dataSources []DataSource
 			var destinations []Destination
+			var streamDeclarations []StreamDeclaration
 
 			if model := resp.Model; model != nil {
 				kind = flattenDataCollectionRuleKind(model.Kind)
 				location = azure.NormalizeLocation(model.Location)
 				tag = tags.Flatten(model.Tags)
 				if prop := model.Properties; prop != nil {
+					dataCollectionEndpointId = flattenStringPtr(prop.DataCollectionEndpointId)
 					description = flattenStringPtr(prop.Description)
 					dataFlows = flattenDataCollectionRuleDataFlows(prop.DataFlows)
 					dataSources = flattenDataCollectionRuleDataSources(prop.DataSources)
 					destinations = flattenDataCollectionRuleDestinations(prop.Destinations)
+					streamDeclarations = flattenDataCollectionRuleStreamDeclarations(prop.StreamDeclarations)
 				}
 			}
 
 			return metadata.Encode(&DataCollectionRule{
-				Name:              id.DataCollectionRuleName,
-				ResourceGroupName: id.ResourceGroupName,
-				DataFlows:         dataFlows,
-				DataSources:       dataSources,
-				Description:       description,
-				Destinations:      destinations,
-				Kind:              kind,
-				Location:          location,
-				Tags:              tag,
+				Name:                     id.DataCollectionRuleName,
+				ResourceGroupName:        id.ResourceGroupName,
+				DataCollectionEndpointId: dataCollectionEndpointId,
+				DataFlows:                dataFlows,
+				DataSources:              dataSources,
+				Description:              description,
+				Destinations:             destinations,
+				Kind:                     kind,
+				Location:                 location,
+				StreamDeclarations:       streamDeclarations,
+				Tags:                     tag,
 			})
 		},
 		Timeout: 5 * time.Minute,
@@ -503,6 +665,13 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 				existing.Tags = tags.Expand(state.Tags)
 			}
 
+			if metadata.ResourceData.HasChange("data_collection_endpoint_id") {
+				existing.Properties.DataCollectionEndpointId = nil
+				if state.DataCollectionEndpointId != "" {
+					existing.Properties.DataCollectionEndpointId = utils.String(state.DataCollectionEndpointId)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("data_flow") {
 				existing.Properties.DataFlows = expandDataCollectionRuleDataFlows(state.DataFlows)
 			}
@@ -523,6 +692,10 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 				existing.Properties.Destinations = expandDataCollectionRuleDestinations(state.Destinations)
 			}
 
+			if metadata.ResourceData.HasChange("stream_declaration") {
+				existing.Properties.StreamDeclarations = expandDataCollectionRuleStreamDeclarations(state.StreamDeclarations)
+			}
+
 			// otherwise Service will return an error: "The resource definition is invalid."
 			existing.SystemData = nil
 
@@ -576,10 +749,24 @@ func expandDataCollectionRuleDataFlows(input []DataFlow) *[]datacollectionrules.
 
 	result := make([]datacollectionrules.DataFlow, 0)
 	for _, v := range input {
-		result = append(result, datacollectionrules.DataFlow{
+		dataFlow := datacollectionrules.DataFlow{
 			Destinations: stringSlice(v.Destinations),
 			Streams:      expandDataCollectionRuleDataFlowStreams(v.Streams),
-		})
+		}
+
+		if v.BuiltInTransform != "" {
+			dataFlow.BuiltInTransform = utils.String(v.BuiltInTransform)
+		}
+
+		if v.OutputStream != "" {
+			dataFlow.OutputStream = utils.String(v.OutputStream)
+		}
+
+		if v.TransformKql != "" {
+			dataFlow.TransformKql = utils.String(v.TransformKql)
+		}
+
+		result = append(result, dataFlow)
 	}
 	return &result
 }
@@ -607,6 +794,7 @@ func expandDataCollectionRuleDataSources(input []DataSource) (*datacollectionrul
 	}
 	return &datacollectionrules.DataSourcesSpec{
 		Extensions:          extension,
+		LogFiles:            expandDataCollectionRuleDataSourceLogFiles(input[0].LogFiles),
 		PerformanceCounters: expandDataCollectionRuleDataSourcePerfCounters(input[0].PerformanceCounters),
 		Syslog:              expandDataCollectionRuleDataSourceSyslog(input[0].Syslog),
 		WindowsEventLogs:    expandDataCollectionRuleDataSourceWindowsEventLogs(input[0].WindowsEventLogs),
@@ -653,6 +841,36 @@ func expandDataCollectionRuleDataSourceExtensionStreams(input []string) *[]datac
 	return &result
 }
 
+func expandDataCollectionRuleDataSourceLogFiles(input []LogFile) *[]datacollectionrules.LogFilesDataSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]datacollectionrules.LogFilesDataSource, 0)
+	for _, v := range input {
+		result = append(result, datacollectionrules.LogFilesDataSource{
+			FilePatterns: v.FilePatterns,
+			Format:       datacollectionrules.KnownLogFilesDataSourceFormat(v.Format),
+			Name:         utils.String(v.Name),
+			Settings:     expandDataCollectionRuleDataSourceLogFileSettings(v.Settings),
+			Streams:      v.Streams,
+		})
+	}
+	return &result
+}
+
+func expandDataCollectionRuleDataSourceLogFileSettings(input []LogFileSetting) *datacollectionrules.LogFileSettings {
+	if len(input) == 0 || len(input[0].Text) == 0 {
+		return nil
+	}
+
+	return &datacollectionrules.LogFileSettings{
+		Text: &datacollectionrules.LogFileTextSettings{
+			RecordStartTimestampFormat: datacollectionrules.KnownLogFileTextSettingsRecordStartTimestampFormat(input[0].Text[0].RecordStartTimestampFormat),
+		},
+	}
+}
+
 func expandDataCollectionRuleDataSourcePerfCounters(input []PerfCounter) *[]datacollectionrules.PerfCounterDataSource {
 	if len(input) == 0 {
 		return nil
@@ -787,6 +1005,28 @@ func expandDataCollectionRuleDestinationLogAnalytics(input []LogAnalytic) *[]dat
 	return &result
 }
 
+func expandDataCollectionRuleStreamDeclarations(input []StreamDeclaration) *map[string]datacollectionrules.StreamDeclaration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make(map[string]datacollectionrules.StreamDeclaration)
+	for _, v := range input {
+		columns := make([]datacollectionrules.ColumnDefinition, 0)
+		for _, column := range v.Columns {
+			columnType := datacollectionrules.KnownColumnDefinitionType(column.Type)
+			columns = append(columns, datacollectionrules.ColumnDefinition{
+				Name: utils.String(column.Name),
+				Type: &columnType,
+			})
+		}
+		result[v.StreamName] = datacollectionrules.StreamDeclaration{
+			Columns: &columns,
+		}
+	}
+	return &result
+}
+
 func flattenDataCollectionRuleKind(input *datacollectionrules.KnownDataCollectionRuleResourceKind) string {
 	if input == nil {
 		return ""
@@ -816,8 +1056,11 @@ func flattenDataCollectionRuleDataFlows(input *[]datacollectionrules.DataFlow) [
 	result := make([]DataFlow, 0)
 	for _, v := range *input {
 		result = append(result, DataFlow{
-			Destinations: flattenStringSlicePtr(v.Destinations),
-			Streams:      flattenDataCollectionRuleDataFlowStreams(v.Streams),
+			BuiltInTransform: flattenStringPtr(v.BuiltInTransform),
+			Destinations:     flattenStringSlicePtr(v.Destinations),
+			OutputStream:     flattenStringPtr(v.OutputStream),
+			Streams:          flattenDataCollectionRuleDataFlowStreams(v.Streams),
+			TransformKql:     flattenStringPtr(v.TransformKql),
 		})
 	}
 	return result
@@ -842,6 +1085,7 @@ func flattenDataCollectionRuleDataSources(input *datacollectionrules.DataSources
 
 	return []DataSource{{
 		Extensions:          flattenDataCollectionRuleDataSourceExtensions(input.Extensions),
+		LogFiles:            flattenDataCollectionRuleDataSourceLogFiles(input.LogFiles),
 		PerformanceCounters: flattenDataCollectionRuleDataSourcePerfCounters(input.PerformanceCounters),
 		Syslog:              flattenDataCollectionRuleDataSourceSyslog(input.Syslog),
 		WindowsEventLogs:    flattenDataCollectionRuleWindowsEventLogs(input.WindowsEventLogs),
@@ -883,6 +1127,36 @@ func flattenDataCollectionRuleDataSourceExtensionStreams(input *[]datacollection
 	return result
 }
 
+func flattenDataCollectionRuleDataSourceLogFiles(input *[]datacollectionrules.LogFilesDataSource) []LogFile {
+	if input == nil {
+		return make([]LogFile, 0)
+	}
+
+	result := make([]LogFile, 0)
+	for _, v := range *input {
+		result = append(result, LogFile{
+			Name:         flattenStringPtr(v.Name),
+			FilePatterns: v.FilePatterns,
+			Format:       string(v.Format),
+			Settings:     flattenDataCollectionRuleDataSourceLogFileSettings(v.Settings),
+			Streams:      v.Streams,
+		})
+	}
+	return result
+}
+
+func flattenDataCollectionRuleDataSourceLogFileSettings(input *datacollectionrules.LogFileSettings) []LogFileSetting {
+	if input == nil || input.Text == nil {
+		return make([]LogFileSetting, 0)
+	}
+
+	return []LogFileSetting{{
+		Text: []LogFileTextSetting{{
+			RecordStartTimestampFormat: string(input.Text.RecordStartTimestampFormat),
+		}},
+	}}
+}
+
 func flattenDataCollectionRuleDataSourcePerfCounters(input *[]datacollectionrules.PerfCounterDataSource) []PerfCounter {
 	if input == nil {
 		return make([]PerfCounter, 0)
@@ -1015,3 +1289,38 @@ func flattenDataCollectionRuleDestinationLogAnalytics(input *[]datacollectionrul
 	}
 	return result
 }
+
+func flattenDataCollectionRuleStreamDeclarations(input *map[string]datacollectionrules.StreamDeclaration) []StreamDeclaration {
+	if input == nil {
+		return make([]StreamDeclaration, 0)
+	}
+
+	// the API returns the Stream Declarations as a map, so sort these by name to keep the output stable
+	streamNames := make([]string, 0)
+	for k := range *input {
+		streamNames = append(streamNames, k)
+	}
+	sort.Strings(streamNames)
+
+	result := make([]StreamDeclaration, 0)
+	for _, streamName := range streamNames {
+		columns := make([]StreamDeclarationColumn, 0)
+		if v := (*input)[streamName].Columns; v != nil {
+			for _, column := range *v {
+				columnType := ""
+				if column.Type != nil {
+					columnType = string(*column.Type)
+				}
+				columns = append(columns, StreamDeclarationColumn{
+					Name: flattenStringPtr(column.Name),
+					Type: columnType,
+				})
+			}
+		}
+		result = append(result, StreamDeclaration{
+			StreamName: streamName,
+			Columns:    columns,
+		})
+	}
+	return result
+}
@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -113,6 +113,21 @@ func TestAccMonitorDataCollectionRule_complete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorDataCollectionRule_customLog(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule", "test")
+	r := MonitorDataCollectionRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customLog(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r MonitorDataCollectionRuleResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -351,6 +366,68 @@ resource "azurerm_monitor_data_collection_rule" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorDataCollectionRuleResource) customLog(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                = "acctestmdcr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  destinations {
+    log_analytics {
+      workspace_resource_id = azurerm_log_analytics_workspace.test.id
+      name                  = "test-destination-log"
+    }
+  }
+
+  data_flow {
+    streams       = ["Custom-MyTableRawData"]
+    destinations  = ["test-destination-log"]
+    output_stream = "Microsoft-Syslog"
+    transform_kql = "source | project TimeGenerated = Time, Computer, Message = AdditionalContext"
+  }
+
+  data_sources {
+    log_file {
+      name          = "test-datasource-logfile"
+      format        = "text"
+      streams       = ["Custom-MyTableRawData"]
+      file_patterns = ["C:\\JavaLogs\\*.log"]
+      settings {
+        text {
+          record_start_timestamp_format = "ISO 8601"
+        }
+      }
+    }
+  }
+
+  stream_declaration {
+    stream_name = "Custom-MyTableRawData"
+    column {
+      name = "Time"
+      type = "datetime"
+    }
+    column {
+      name = "Computer"
+      type = "string"
+    }
+    column {
+      name = "AdditionalContext"
+      type = "string"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MonitorDataCollectionRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,61 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SubscriptionDiagnosticSettingId struct {
+	SubscriptionId        string
+	DiagnosticSettingName string
+}
+
+func NewSubscriptionDiagnosticSettingID(subscriptionId, diagnosticSettingName string) SubscriptionDiagnosticSettingId {
+	return SubscriptionDiagnosticSettingId{
+		SubscriptionId:        subscriptionId,
+		DiagnosticSettingName: diagnosticSettingName,
+	}
+}
+
+func (id SubscriptionDiagnosticSettingId) String() string {
+	segments := []string{
+		fmt.Sprintf("Diagnostic Setting Name %q", id.DiagnosticSettingName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Subscription Diagnostic Setting", segmentsStr)
+}
+
+func (id SubscriptionDiagnosticSettingId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Insights/diagnosticSettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.DiagnosticSettingName)
+}
+
+// SubscriptionDiagnosticSettingID parses a SubscriptionDiagnosticSetting ID into an SubscriptionDiagnosticSettingId struct
+func SubscriptionDiagnosticSettingID(input string) (*SubscriptionDiagnosticSettingId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SubscriptionDiagnosticSettingId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.DiagnosticSettingName, err = id.PopSegment("diagnosticSettings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
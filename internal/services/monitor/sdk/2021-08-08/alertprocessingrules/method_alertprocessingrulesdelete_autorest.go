@@ -0,0 +1,62 @@
+package alertprocessingrules
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type AlertProcessingRulesDeleteOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// AlertProcessingRulesDelete ...
+func (c AlertProcessingRulesClient) AlertProcessingRulesDelete(ctx context.Context, id ActionRuleId) (result AlertProcessingRulesDeleteOperationResponse, err error) {
+	req, err := c.preparerForAlertProcessingRulesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertprocessingrules.AlertProcessingRulesClient", "AlertProcessingRulesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertprocessingrules.AlertProcessingRulesClient", "AlertProcessingRulesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForAlertProcessingRulesDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "alertprocessingrules.AlertProcessingRulesClient", "AlertProcessingRulesDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForAlertProcessingRulesDelete prepares the AlertProcessingRulesDelete request.
+func (c AlertProcessingRulesClient) preparerForAlertProcessingRulesDelete(ctx context.Context, id ActionRuleId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForAlertProcessingRulesDelete handles the response to the AlertProcessingRulesDelete request. The method always
+// closes the http.Response Body.
+func (c AlertProcessingRulesClient) responderForAlertProcessingRulesDelete(resp *http.Response) (result AlertProcessingRulesDeleteOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,15 @@
+package alertprocessingrules
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type AlertProcessingRule struct {
+	Id         *string                        `json:"id,omitempty"`
+	Location   string                         `json:"location"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *AlertProcessingRuleProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData         `json:"systemData,omitempty"`
+	Tags       *map[string]string             `json:"tags,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package datacollectionrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DataCollectionRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDataCollectionRulesClientWithBaseURI(endpoint string) DataCollectionRulesClient {
+	return DataCollectionRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
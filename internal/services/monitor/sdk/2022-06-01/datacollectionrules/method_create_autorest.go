@@ -0,0 +1,65 @@
+package datacollectionrules
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *DataCollectionRuleResource
+}
+
+// Create ...
+func (c DataCollectionRulesClient) Create(ctx context.Context, id DataCollectionRuleId, input DataCollectionRuleResource) (result CreateOperationResponse, err error) {
+	req, err := c.preparerForCreate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRulesClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRulesClient", "Create", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datacollectionrules.DataCollectionRulesClient", "Create", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreate prepares the Create request.
+func (c DataCollectionRulesClient) preparerForCreate(ctx context.Context, id DataCollectionRuleId, input DataCollectionRuleResource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreate handles the response to the Create request. The method always
+// closes the http.Response Body.
+func (c DataCollectionRulesClient) responderForCreate(resp *http.Response) (result CreateOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
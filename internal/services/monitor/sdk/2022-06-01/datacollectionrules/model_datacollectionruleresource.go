@@ -0,0 +1,17 @@
+package datacollectionrules
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type DataCollectionRuleResource struct {
+	Etag       *string                              `json:"etag,omitempty"`
+	Id         *string                              `json:"id,omitempty"`
+	Kind       *KnownDataCollectionRuleResourceKind `json:"kind,omitempty"`
+	Location   string                               `json:"location"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *DataCollectionRule                  `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData               `json:"systemData,omitempty"`
+	Tags       *map[string]string                   `json:"tags,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package datacollectionrules
+
+type DataFlow struct {
+	BuiltInTransform *string                 `json:"builtInTransform,omitempty"`
+	Destinations     *[]string               `json:"destinations,omitempty"`
+	OutputStream     *string                 `json:"outputStream,omitempty"`
+	Streams          *[]KnownDataFlowStreams `json:"streams,omitempty"`
+	TransformKql     *string                 `json:"transformKql,omitempty"`
+}
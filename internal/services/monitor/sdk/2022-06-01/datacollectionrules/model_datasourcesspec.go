@@ -0,0 +1,9 @@
+package datacollectionrules
+
+type DataSourcesSpec struct {
+	Extensions          *[]ExtensionDataSource       `json:"extensions,omitempty"`
+	LogFiles            *[]LogFilesDataSource        `json:"logFiles,omitempty"`
+	PerformanceCounters *[]PerfCounterDataSource     `json:"performanceCounters,omitempty"`
+	Syslog              *[]SyslogDataSource          `json:"syslog,omitempty"`
+	WindowsEventLogs    *[]WindowsEventLogDataSource `json:"windowsEventLogs,omitempty"`
+}
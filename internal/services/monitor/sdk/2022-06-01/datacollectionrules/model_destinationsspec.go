@@ -0,0 +1,6 @@
+package datacollectionrules
+
+type DestinationsSpec struct {
+	AzureMonitorMetrics *AzureMonitorMetricsDestination `json:"azureMonitorMetrics,omitempty"`
+	LogAnalytics        *[]LogAnalyticsDestination      `json:"logAnalytics,omitempty"`
+}
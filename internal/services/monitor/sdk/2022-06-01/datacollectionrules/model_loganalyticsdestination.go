@@ -0,0 +1,7 @@
+package datacollectionrules
+
+type LogAnalyticsDestination struct {
+	Name                *string `json:"name,omitempty"`
+	WorkspaceId         *string `json:"workspaceId,omitempty"`
+	WorkspaceResourceId *string `json:"workspaceResourceId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package datacollectionrules
+
+type LogFileTextSettings struct {
+	RecordStartTimestampFormat KnownLogFileTextSettingsRecordStartTimestampFormat `json:"recordStartTimestampFormat"`
+}
@@ -0,0 +1,19 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func DataCollectionRuleCustomStreamName(i interface{}, k string) (warning []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, append(errors, fmt.Errorf("expected type of %s to be string", k))
+	}
+
+	if !regexp.MustCompile(`^Custom-.+$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must begin with `Custom-`", k))
+	}
+
+	return
+}
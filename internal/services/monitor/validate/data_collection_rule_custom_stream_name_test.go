@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestDataCollectionRuleCustomStreamName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// prefix only
+			input:    "Custom-",
+			expected: false,
+		},
+		{
+			// built-in stream
+			input:    "Microsoft-Syslog",
+			expected: false,
+		},
+		{
+			// prefix is case sensitive
+			input:    "custom-MyTable_CL",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "Custom-MyTable_CL",
+			expected: true,
+		},
+		{
+			// raw data stream
+			input:    "Custom-MyTableRawData",
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := DataCollectionRuleCustomStreamName(v.input, "stream_name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+)
+
+// NOTE: this workaround client exists since `zoneRedundant` is only available from API Version `2021-11-01`
+// of the SQL API, whereas the SQL Managed Instance resource is built against API Version `2020-11-01-preview`.
+// Once the resource has been migrated to a newer API Version this can be removed.
+
+const managedInstanceZoneRedundantAPIVersion = "2021-11-01"
+
+type ManagedInstanceZoneRedundantClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedInstanceZoneRedundantClientWithBaseURI(endpoint string) ManagedInstanceZoneRedundantClient {
+	return ManagedInstanceZoneRedundantClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		baseUri: endpoint,
+	}
+}
+
+type managedInstanceZoneRedundant struct {
+	Properties *managedInstanceZoneRedundantProperties `json:"properties,omitempty"`
+}
+
+type managedInstanceZoneRedundantProperties struct {
+	ZoneRedundant *bool `json:"zoneRedundant,omitempty"`
+}
+
+// Get returns whether the SQL Managed Instance is Zone Redundant.
+func (c ManagedInstanceZoneRedundantClient) Get(ctx context.Context, id parse.ManagedInstanceId) (bool, error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": managedInstanceZoneRedundantAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return false, autorest.NewErrorWithError(err, "sql.ManagedInstancesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return false, autorest.NewErrorWithError(err, "sql.ManagedInstancesClient", "Get", resp, "Failure sending request")
+	}
+
+	var result managedInstanceZoneRedundant
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return false, autorest.NewErrorWithError(err, "sql.ManagedInstancesClient", "Get", resp, "Failure responding to request")
+	}
+
+	if result.Properties == nil || result.Properties.ZoneRedundant == nil {
+		return false, nil
+	}
+	return *result.Properties.ZoneRedundant, nil
+}
+
+// CreateOrUpdate sends the SQL Managed Instance with `zoneRedundant` set using the newer API Version, so that
+// Zone Redundancy is configured as a part of the same (long-running) operation as the rest of the SQL Managed Instance.
+func (c ManagedInstanceZoneRedundantClient) CreateOrUpdate(ctx context.Context, id parse.ManagedInstanceId, input sql.ManagedInstance, zoneRedundant bool) (result polling.LongRunningPoller, err error) {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return result, fmt.Errorf("marshaling %s: %+v", id, err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return result, fmt.Errorf("unmarshaling %s: %+v", id, err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["zoneRedundant"] = zoneRedundant
+	body["properties"] = properties
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": managedInstanceZoneRedundantAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "sql.ManagedInstancesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "sql.ManagedInstancesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	result, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "sql.ManagedInstancesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,159 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
+)
+
+// NOTE: this workaround client exists since `leastPrivilegeMode` is only available from API Version
+// `2023-01-01-preview` of the SQL Virtual Machine API, whereas the SQL Virtual Machine resource is built
+// against API Version `2022-02-01`. Once the resource has been migrated to a newer API Version this can be removed.
+
+const sqlVirtualMachineLeastPrivilegeModeAPIVersion = "2023-01-01-preview"
+
+type SqlVirtualMachineLeastPrivilegeModeClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSqlVirtualMachineLeastPrivilegeModeClientWithBaseURI(endpoint string) SqlVirtualMachineLeastPrivilegeModeClient {
+	return SqlVirtualMachineLeastPrivilegeModeClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		baseUri: endpoint,
+	}
+}
+
+type SqlVirtualMachineLeastPrivilegeMode struct {
+	Properties *SqlVirtualMachineLeastPrivilegeModeProperties `json:"properties,omitempty"`
+}
+
+type SqlVirtualMachineLeastPrivilegeModeProperties struct {
+	LeastPrivilegeMode *string `json:"leastPrivilegeMode,omitempty"`
+}
+
+// Get returns the Least Privilege Mode for the SQL Virtual Machine, which is `NotSet` when unspecified. Since the Preview
+// API Version isn't available everywhere, a 400 or 404 from it is treated as the Least Privilege Mode being unspecified.
+func (c SqlVirtualMachineLeastPrivilegeModeClient) Get(ctx context.Context, id sqlvirtualmachines.SqlVirtualMachineId) (*string, error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": sqlVirtualMachineLeastPrivilegeModeAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "Get", resp, "Failure sending request")
+	}
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] API Version %q returned a %d for %s - assuming the Least Privilege Mode is unspecified", sqlVirtualMachineLeastPrivilegeModeAPIVersion, resp.StatusCode, id)
+		_ = autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+		return nil, nil
+	}
+
+	var result SqlVirtualMachineLeastPrivilegeMode
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "Get", resp, "Failure responding to request")
+	}
+
+	if result.Properties == nil {
+		return nil, nil
+	}
+	return result.Properties.LeastPrivilegeMode, nil
+}
+
+// UpdateThenPoll sets the Least Privilege Mode for the SQL Virtual Machine. Since the API replaces the SQL Virtual
+// Machine with the payload sent in a PUT, the existing SQL Virtual Machine is retrieved and sent back in full (as-is,
+// so that properties not modelled here are retained) with only the Least Privilege Mode changed.
+func (c SqlVirtualMachineLeastPrivilegeModeClient) UpdateThenPoll(ctx context.Context, id sqlvirtualmachines.SqlVirtualMachineId, mode string) error {
+	existing, err := c.getRaw(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	properties, ok := existing["properties"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	properties["leastPrivilegeMode"] = mode
+	// these are read-only and can't be sent back to the API
+	delete(properties, "provisioningState")
+	delete(existing, "systemData")
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(existing),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": sqlVirtualMachineLeastPrivilegeModeAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// getRaw returns the SQL Virtual Machine as the untyped payload returned by the API, so that it can be sent back without
+// losing any properties.
+func (c SqlVirtualMachineLeastPrivilegeModeClient) getRaw(ctx context.Context, id sqlvirtualmachines.SqlVirtualMachineId) (map[string]interface{}, error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": sqlVirtualMachineLeastPrivilegeModeAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "Get", resp, "Failure sending request")
+	}
+
+	result := make(map[string]interface{})
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "sqlvirtualmachines.SqlVirtualMachinesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
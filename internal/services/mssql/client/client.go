@@ -4,6 +4,10 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-05-01-preview/sqlvulnerabilityassessmentrulebaselines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-05-01-preview/sqlvulnerabilityassessmentssettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-08-01-preview/startstopmanagedinstanceschedules"
 )
 
 type Client struct {
@@ -23,7 +27,9 @@ type Client struct {
 	LongTermRetentionPoliciesClient                    *sql.LongTermRetentionPoliciesClient
 	ManagedDatabasesClient                             *sql.ManagedDatabasesClient
 	ManagedInstancesClient                             *sql.ManagedInstancesClient
+	ManagedInstanceStartStopSchedulesClient            *startstopmanagedinstanceschedules.StartStopManagedInstanceSchedulesClient
 	ManagedInstanceVulnerabilityAssessmentsClient      *sql.ManagedInstanceVulnerabilityAssessmentsClient
+	ManagedInstanceZoneRedundantClient                 *azuresdkhacks.ManagedInstanceZoneRedundantClient
 	OutboundFirewallRulesClient                        *sql.OutboundFirewallRulesClient
 	ManagedInstanceAdministratorsClient                *sql.ManagedInstanceAdministratorsClient
 	ManagedInstanceAzureADOnlyAuthenticationsClient    *sql.ManagedInstanceAzureADOnlyAuthenticationsClient
@@ -38,8 +44,11 @@ type Client struct {
 	ServerSecurityAlertPoliciesClient                  *sql.ServerSecurityAlertPoliciesClient
 	ServerVulnerabilityAssessmentsClient               *sql.ServerVulnerabilityAssessmentsClient
 	ServersClient                                      *sql.ServersClient
+	SqlVulnerabilityAssessmentRuleBaselinesClient      *sqlvulnerabilityassessmentrulebaselines.SqlVulnerabilityAssessmentRuleBaselinesClient
+	SqlVulnerabilityAssessmentsSettingsClient          *sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentsSettingsClient
 	TransparentDataEncryptionsClient                   *sql.TransparentDataEncryptionsClient
 	VirtualMachinesClient                              *sqlvirtualmachines.SqlVirtualMachinesClient
+	VirtualMachinesLeastPrivilegeModeClient            *azuresdkhacks.SqlVirtualMachineLeastPrivilegeModeClient
 	VirtualNetworkRulesClient                          *sql.VirtualNetworkRulesClient
 }
 
@@ -92,6 +101,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	managedInstancesClient := sql.NewManagedInstancesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstancesClient.Client, o.ResourceManagerAuthorizer)
 
+	managedInstanceStartStopSchedulesClient := startstopmanagedinstanceschedules.NewStartStopManagedInstanceSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedInstanceStartStopSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
+	managedInstanceZoneRedundantClient := azuresdkhacks.NewManagedInstanceZoneRedundantClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managedInstanceZoneRedundantClient.Client, o.ResourceManagerAuthorizer)
+
 	managedInstancesAdministratorsClient := sql.NewManagedInstanceAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstancesAdministratorsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -143,9 +158,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	virtualMachinesClient := sqlvirtualmachines.NewSqlVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&virtualMachinesClient.Client, o.ResourceManagerAuthorizer)
 
+	virtualMachinesLeastPrivilegeModeClient := azuresdkhacks.NewSqlVirtualMachineLeastPrivilegeModeClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualMachinesLeastPrivilegeModeClient.Client, o.ResourceManagerAuthorizer)
+
 	virtualNetworkRulesClient := sql.NewVirtualNetworkRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&virtualNetworkRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	sqlVulnerabilityAssessmentRuleBaselinesClient := sqlvulnerabilityassessmentrulebaselines.NewSqlVulnerabilityAssessmentRuleBaselinesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sqlVulnerabilityAssessmentRuleBaselinesClient.Client, o.ResourceManagerAuthorizer)
+
+	sqlVulnerabilityAssessmentsSettingsClient := sqlvulnerabilityassessmentssettings.NewSqlVulnerabilityAssessmentsSettingsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sqlVulnerabilityAssessmentsSettingsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		BackupShortTermRetentionPoliciesClient:             &backupShortTermRetentionPoliciesClient,
 		DatabaseExtendedBlobAuditingPoliciesClient:         &databaseExtendedBlobAuditingPoliciesClient,
@@ -165,6 +189,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		ManagedInstanceAdministratorsClient:             &managedInstancesAdministratorsClient,
 		ManagedInstanceAzureADOnlyAuthenticationsClient: &managedInstanceAzureADOnlyAuthenticationsClient,
 		ManagedInstancesClient:                          &managedInstancesClient,
+		ManagedInstanceStartStopSchedulesClient:         &managedInstanceStartStopSchedulesClient,
+		ManagedInstanceZoneRedundantClient:              &managedInstanceZoneRedundantClient,
 		ManagedInstanceVulnerabilityAssessmentsClient:   &managedInstanceVulnerabilityAssessmentsClient,
 		OutboundFirewallRulesClient:                     &outboundFirewallRulesClient,
 		ReplicationLinksClient:                          &replicationLinksClient,
@@ -178,8 +204,11 @@ func NewClient(o *common.ClientOptions) *Client {
 		ServerSecurityAlertPoliciesClient:               &serverSecurityAlertPoliciesClient,
 		ServerVulnerabilityAssessmentsClient:            &serverVulnerabilityAssessmentsClient,
 		ServersClient:                                   &serversClient,
+		SqlVulnerabilityAssessmentRuleBaselinesClient:   &sqlVulnerabilityAssessmentRuleBaselinesClient,
+		SqlVulnerabilityAssessmentsSettingsClient:       &sqlVulnerabilityAssessmentsSettingsClient,
 		TransparentDataEncryptionsClient:                &transparentDataEncryptionsClient,
 		VirtualMachinesClient:                           &virtualMachinesClient,
+		VirtualMachinesLeastPrivilegeModeClient:         &virtualMachinesLeastPrivilegeModeClient,
 		VirtualNetworkRulesClient:                       &virtualNetworkRulesClient,
 	}
 }
@@ -0,0 +1,220 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-05-01-preview/sqlvulnerabilityassessmentrulebaselines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlDatabaseVulnerabilityAssessmentBaselineModel struct {
+	DatabaseId     string                                                    `tfschema:"database_id"`
+	RuleId         string                                                    `tfschema:"rule_id"`
+	BaselineResult []MsSqlDatabaseVulnerabilityAssessmentBaselineResultModel `tfschema:"baseline_result"`
+}
+
+type MsSqlDatabaseVulnerabilityAssessmentBaselineResultModel struct {
+	Result []string `tfschema:"result"`
+}
+
+var _ sdk.Resource = MsSqlDatabaseVulnerabilityAssessmentBaselineResource{}
+var _ sdk.ResourceWithUpdate = MsSqlDatabaseVulnerabilityAssessmentBaselineResource{}
+
+type MsSqlDatabaseVulnerabilityAssessmentBaselineResource struct{}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) ResourceType() string {
+	return "azurerm_mssql_database_vulnerability_assessment_baseline"
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) ModelObject() interface{} {
+	return &MsSqlDatabaseVulnerabilityAssessmentBaselineModel{}
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sqlvulnerabilityassessmentrulebaselines.ValidateBaselineRuleID
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DatabaseID,
+		},
+
+		"rule_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"baseline_result": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"result": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.SqlVulnerabilityAssessmentRuleBaselinesClient
+
+			var model MsSqlDatabaseVulnerabilityAssessmentBaselineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.DatabaseID(model.DatabaseId)
+			if err != nil {
+				return fmt.Errorf("parsing `database_id`: %v", err)
+			}
+
+			// both the SQL Vulnerability Assessment and the Baseline are singletons which are always named `default`
+			id := sqlvulnerabilityassessmentrulebaselines.NewBaselineRuleID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name, "default", "default", model.RuleId)
+
+			metadata.Logger.Infof("Import check for %s", id)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+			if _, err := client.CreateOrUpdate(ctx, id, expandMsSqlDatabaseVulnerabilityAssessmentBaseline(model.BaselineResult)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.SqlVulnerabilityAssessmentRuleBaselinesClient
+
+			id, err := sqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlDatabaseVulnerabilityAssessmentBaselineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+			if _, err := client.CreateOrUpdate(ctx, *id, expandMsSqlDatabaseVulnerabilityAssessmentBaseline(model.BaselineResult)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.SqlVulnerabilityAssessmentRuleBaselinesClient
+
+			id, err := sqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlDatabaseVulnerabilityAssessmentBaselineModel{
+				DatabaseId: parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.DatabaseName).ID(),
+				RuleId:     id.RuleName,
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				results := make([]MsSqlDatabaseVulnerabilityAssessmentBaselineResultModel, 0)
+				for _, result := range model.Properties.Results {
+					results = append(results, MsSqlDatabaseVulnerabilityAssessmentBaselineResultModel{
+						Result: result,
+					})
+				}
+				state.BaselineResult = results
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.SqlVulnerabilityAssessmentRuleBaselinesClient
+
+			id, err := sqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMsSqlDatabaseVulnerabilityAssessmentBaseline(input []MsSqlDatabaseVulnerabilityAssessmentBaselineResultModel) sqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselineInput {
+	results := make([][]string, 0)
+	for _, item := range input {
+		results = append(results, item.Result)
+	}
+
+	return sqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselineInput{
+		Properties: &sqlvulnerabilityassessmentrulebaselines.DatabaseSqlVulnerabilityAssessmentRuleBaselineInputProperties{
+			LatestScan: false,
+			Results:    results,
+		},
+	}
+}
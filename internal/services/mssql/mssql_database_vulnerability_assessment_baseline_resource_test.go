@@ -0,0 +1,182 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-05-01-preview/sqlvulnerabilityassessmentrulebaselines"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlDatabaseVulnerabilityAssessmentBaselineResource struct{}
+
+func TestAccMsSqlDatabaseVulnerabilityAssessmentBaseline_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_vulnerability_assessment_baseline", "test")
+	r := MsSqlDatabaseVulnerabilityAssessmentBaselineResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabaseVulnerabilityAssessmentBaseline_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_vulnerability_assessment_baseline", "test")
+	r := MsSqlDatabaseVulnerabilityAssessmentBaselineResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlDatabaseVulnerabilityAssessmentBaseline_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_vulnerability_assessment_baseline", "test")
+	r := MsSqlDatabaseVulnerabilityAssessmentBaselineResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := sqlvulnerabilityassessmentrulebaselines.ParseBaselineRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.SqlVulnerabilityAssessmentRuleBaselinesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+}
+`, MsSqlServerVulnerabilityAssessmentExpressConfigurationResource{}.basic(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_vulnerability_assessment_baseline" "test" {
+  database_id = azurerm_mssql_database.test.id
+  rule_id     = "VA2111"
+
+  baseline_result {
+    result = [
+      "SCHEMA",
+      "dbo",
+      "CONTROL",
+      "SQL_USER",
+      "adminuser1"
+    ]
+  }
+
+  depends_on = [azurerm_mssql_server_vulnerability_assessment_express_configuration.test]
+}
+`, r.template(data))
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_vulnerability_assessment_baseline" "import" {
+  database_id = azurerm_mssql_database_vulnerability_assessment_baseline.test.database_id
+  rule_id     = azurerm_mssql_database_vulnerability_assessment_baseline.test.rule_id
+
+  baseline_result {
+    result = [
+      "SCHEMA",
+      "dbo",
+      "CONTROL",
+      "SQL_USER",
+      "adminuser1"
+    ]
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlDatabaseVulnerabilityAssessmentBaselineResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_vulnerability_assessment_baseline" "test" {
+  database_id = azurerm_mssql_database.test.id
+  rule_id     = "VA2111"
+
+  baseline_result {
+    result = [
+      "SCHEMA",
+      "dbo",
+      "CONTROL",
+      "SQL_USER",
+      "adminuser1"
+    ]
+  }
+
+  baseline_result {
+    result = [
+      "SCHEMA",
+      "dbo",
+      "CONTROL",
+      "SQL_USER",
+      "adminuser2"
+    ]
+  }
+
+  depends_on = [azurerm_mssql_server_vulnerability_assessment_express_configuration.test]
+}
+`, r.template(data))
+}
@@ -46,6 +46,7 @@ type MsSqlManagedInstanceModel struct {
 	Tags                         map[string]string         `tfschema:"tags"`
 	TimezoneId                   string                    `tfschema:"timezone_id"`
 	VCores                       int                       `tfschema:"vcores"`
+	ZoneRedundantEnabled         bool                      `tfschema:"zone_redundant_enabled"`
 }
 
 var _ sdk.Resource = MsSqlManagedInstanceResource{}
@@ -227,6 +228,12 @@ func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.StringIsNotEmpty,
 			ForceNew:     true,
 		},
+
+		"zone_redundant_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 }
 
@@ -269,6 +276,7 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 		Timeout: 24 * time.Hour,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.MSSQL.ManagedInstancesClient
+			zoneRedundantClient := metadata.Client.MSSQL.ManagedInstanceZoneRedundantClient
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
 			var model MsSqlManagedInstanceModel
@@ -320,13 +328,13 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 
 			metadata.Logger.Infof("Creating %s", id)
 
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+			poller, err := zoneRedundantClient.CreateOrUpdate(ctx, id, parameters, model.ZoneRedundantEnabled)
 			if err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				if response.WasConflict(future.Response()) {
+			if err = poller.PollUntilDone(); err != nil {
+				if response.WasConflict(poller.HttpResponse) {
 					return fmt.Errorf("sql managed instance names need to be globally unique and %q is already in use", id.Name)
 				}
 
@@ -343,7 +351,7 @@ func (r MsSqlManagedInstanceResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 24 * time.Hour,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.MSSQL.ManagedInstancesClient
+			client := metadata.Client.MSSQL.ManagedInstanceZoneRedundantClient
 
 			id, err := parse.ManagedInstanceID(metadata.ResourceData.Id())
 			if err != nil {
@@ -390,12 +398,12 @@ func (r MsSqlManagedInstanceResource) Update() sdk.ResourceFunc {
 
 			metadata.Logger.Infof("Updating %s", id)
 
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, properties)
+			poller, err := client.CreateOrUpdate(ctx, *id, properties, state.ZoneRedundantEnabled)
 			if err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			if err = poller.PollUntilDone(); err != nil {
 				return fmt.Errorf("waiting for update of %s: %+v", id, err)
 			}
 
@@ -487,6 +495,12 @@ func (r MsSqlManagedInstanceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			zoneRedundant, err := metadata.Client.MSSQL.ManagedInstanceZoneRedundantClient.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving the zone redundancy for %s: %+v", id, err)
+			}
+			model.ZoneRedundantEnabled = zoneRedundant
+
 			return metadata.Encode(&model)
 		},
 	}
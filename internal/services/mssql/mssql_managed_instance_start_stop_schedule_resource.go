@@ -0,0 +1,279 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-08-01-preview/startstopmanagedinstanceschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceStartStopScheduleModel struct {
+	ManagedInstanceId string                                      `tfschema:"managed_instance_id"`
+	Description       string                                      `tfschema:"description"`
+	Schedule          []MsSqlManagedInstanceStartStopScheduleItem `tfschema:"schedule"`
+	TimezoneId        string                                      `tfschema:"timezone_id"`
+	NextExecutionTime string                                      `tfschema:"next_execution_time"`
+	NextRunAction     string                                      `tfschema:"next_run_action"`
+}
+
+type MsSqlManagedInstanceStartStopScheduleItem struct {
+	StartDay  string `tfschema:"start_day"`
+	StartTime string `tfschema:"start_time"`
+	StopDay   string `tfschema:"stop_day"`
+	StopTime  string `tfschema:"stop_time"`
+}
+
+var _ sdk.Resource = MsSqlManagedInstanceStartStopScheduleResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedInstanceStartStopScheduleResource{}
+
+type MsSqlManagedInstanceStartStopScheduleResource struct{}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_start_stop_schedule"
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceStartStopScheduleModel{}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedInstanceStartStopScheduleID
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	scheduleTime := &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "the time must be in the format `HH:mm`"),
+	}
+
+	scheduleDay := &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(startstopmanagedinstanceschedules.PossibleValuesForDayOfWeek(), false),
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedInstanceID,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_day":  scheduleDay,
+					"start_time": scheduleTime,
+					"stop_day":   scheduleDay,
+					"stop_time":  scheduleTime,
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"timezone_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "UTC",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"next_execution_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_run_action": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			var model MsSqlManagedInstanceStartStopScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managedInstanceId, err := parse.ManagedInstanceID(model.ManagedInstanceId)
+			if err != nil {
+				return fmt.Errorf("parsing `managed_instance_id`: %v", err)
+			}
+
+			// the Start/Stop Schedule is a singleton which is always named `default`
+			id := parse.NewManagedInstanceStartStopScheduleID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, "default")
+			sdkId := startstopmanagedinstanceschedules.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+
+			metadata.Logger.Infof("Import check for %s", id)
+			existing, err := client.Get(ctx, sdkId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			if _, err := client.CreateOrUpdate(ctx, sdkId, expandMsSqlManagedInstanceStartStopSchedule(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedInstanceStartStopScheduleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			sdkId := startstopmanagedinstanceschedules.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+			if _, err := client.CreateOrUpdate(ctx, sdkId, expandMsSqlManagedInstanceStartStopSchedule(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			sdkId := startstopmanagedinstanceschedules.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+			resp, err := client.Get(ctx, sdkId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := MsSqlManagedInstanceStartStopScheduleModel{
+				ManagedInstanceId: parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.NextExecutionTime = utils.NormalizeNilableString(props.NextExecutionTime)
+					state.NextRunAction = utils.NormalizeNilableString(props.NextRunAction)
+					state.TimezoneId = utils.NormalizeNilableString(props.TimeZoneId)
+
+					schedule := make([]MsSqlManagedInstanceStartStopScheduleItem, 0)
+					for _, item := range props.ScheduleList {
+						schedule = append(schedule, MsSqlManagedInstanceStartStopScheduleItem{
+							StartDay:  string(item.StartDay),
+							StartTime: item.StartTime,
+							StopDay:   string(item.StopDay),
+							StopTime:  item.StopTime,
+						})
+					}
+					state.Schedule = schedule
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.ManagedInstanceStartStopSchedulesClient
+
+			id, err := parse.ManagedInstanceStartStopScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			sdkId := startstopmanagedinstanceschedules.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+			if _, err := client.Delete(ctx, sdkId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMsSqlManagedInstanceStartStopSchedule(model MsSqlManagedInstanceStartStopScheduleModel) startstopmanagedinstanceschedules.StartStopManagedInstanceSchedule {
+	schedule := make([]startstopmanagedinstanceschedules.ScheduleItem, 0)
+	for _, item := range model.Schedule {
+		schedule = append(schedule, startstopmanagedinstanceschedules.ScheduleItem{
+			StartDay:  startstopmanagedinstanceschedules.DayOfWeek(item.StartDay),
+			StartTime: item.StartTime,
+			StopDay:   startstopmanagedinstanceschedules.DayOfWeek(item.StopDay),
+			StopTime:  item.StopTime,
+		})
+	}
+
+	properties := startstopmanagedinstanceschedules.StartStopManagedInstanceScheduleProperties{
+		ScheduleList: schedule,
+		TimeZoneId:   utils.String(model.TimezoneId),
+	}
+
+	if model.Description != "" {
+		properties.Description = utils.String(model.Description)
+	}
+
+	return startstopmanagedinstanceschedules.StartStopManagedInstanceSchedule{
+		Properties: &properties,
+	}
+}
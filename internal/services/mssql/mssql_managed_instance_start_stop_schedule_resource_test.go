@@ -0,0 +1,158 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-08-01-preview/startstopmanagedinstanceschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlManagedInstanceStartStopScheduleResource struct{}
+
+func TestAccMsSqlManagedInstanceStartStopSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_start_stop_schedule", "test")
+	r := MsSqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("next_execution_time").Exists(),
+				check.That(data.ResourceName).Key("next_run_action").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedInstanceStartStopSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_start_stop_schedule", "test")
+	r := MsSqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlManagedInstanceStartStopSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_start_stop_schedule", "test")
+	r := MsSqlManagedInstanceStartStopScheduleResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceStartStopScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	managedInstanceId := startstopmanagedinstanceschedules.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName)
+	resp, err := client.MSSQL.ManagedInstanceStartStopSchedulesClient.Get(ctx, managedInstanceId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_start_stop_schedule" "test" {
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "08:00"
+    stop_day   = "Monday"
+    stop_time  = "18:00"
+  }
+}
+`, MsSqlManagedInstanceResource{}.basic(data))
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_start_stop_schedule" "import" {
+  managed_instance_id = azurerm_mssql_managed_instance_start_stop_schedule.test.managed_instance_id
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "08:00"
+    stop_day   = "Monday"
+    stop_time  = "18:00"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlManagedInstanceStartStopScheduleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_start_stop_schedule" "test" {
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+  description         = "Business hours"
+  timezone_id         = "Pacific Standard Time"
+
+  schedule {
+    start_day  = "Monday"
+    start_time = "09:00"
+    stop_day   = "Friday"
+    stop_time  = "17:30"
+  }
+
+  schedule {
+    start_day  = "Saturday"
+    start_time = "10:00"
+    stop_day   = "Saturday"
+    stop_time  = "14:00"
+  }
+}
+`, MsSqlManagedInstanceResource{}.basic(data))
+}
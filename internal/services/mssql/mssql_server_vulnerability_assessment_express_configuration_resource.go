@@ -0,0 +1,165 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-05-01-preview/sqlvulnerabilityassessmentssettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlServerVulnerabilityAssessmentExpressConfigurationModel struct {
+	ServerId string `tfschema:"server_id"`
+}
+
+var _ sdk.Resource = MsSqlServerVulnerabilityAssessmentExpressConfigurationResource{}
+
+type MsSqlServerVulnerabilityAssessmentExpressConfigurationResource struct{}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) ResourceType() string {
+	return "azurerm_mssql_server_vulnerability_assessment_express_configuration"
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) ModelObject() interface{} {
+	return &MsSqlServerVulnerabilityAssessmentExpressConfigurationModel{}
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sqlvulnerabilityassessmentssettings.ValidateSqlVulnerabilityAssessmentID
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ServerID,
+		},
+	}
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.SqlVulnerabilityAssessmentsSettingsClient
+
+			var model MsSqlServerVulnerabilityAssessmentExpressConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := parse.ServerID(model.ServerId)
+			if err != nil {
+				return fmt.Errorf("parsing `server_id`: %v", err)
+			}
+
+			// the Express Configuration is a singleton which is always named `default`
+			id := sqlvulnerabilityassessmentssettings.NewSqlVulnerabilityAssessmentID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, "default")
+
+			metadata.Logger.Infof("Import check for %s", id)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if vulnerabilityAssessmentExpressConfigurationIsEnabled(existing.Model) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			state := sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateEnabled
+			parameters := sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessment{
+				Properties: &sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentPolicyProperties{
+					State: &state,
+				},
+			}
+
+			metadata.Logger.Infof("Enabling %s", id)
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("enabling %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.SqlVulnerabilityAssessmentsSettingsClient
+
+			id, err := sqlvulnerabilityassessmentssettings.ParseSqlVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if !vulnerabilityAssessmentExpressConfigurationIsEnabled(resp.Model) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := MsSqlServerVulnerabilityAssessmentExpressConfigurationModel{
+				ServerId: parse.NewServerID(id.SubscriptionId, id.ResourceGroupName, id.ServerName).ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.SqlVulnerabilityAssessmentsSettingsClient
+
+			id, err := sqlvulnerabilityassessmentssettings.ParseSqlVulnerabilityAssessmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Express Configuration can't be removed, instead it's disabled
+			state := sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateDisabled
+			parameters := sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessment{
+				Properties: &sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentPolicyProperties{
+					State: &state,
+				},
+			}
+
+			metadata.Logger.Infof("Disabling %s", id)
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("disabling %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func vulnerabilityAssessmentExpressConfigurationIsEnabled(input *sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessment) bool {
+	if input == nil || input.Properties == nil || input.Properties.State == nil {
+		return false
+	}
+
+	return *input.Properties.State == sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateEnabled
+}
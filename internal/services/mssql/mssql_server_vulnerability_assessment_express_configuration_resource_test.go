@@ -0,0 +1,86 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2022-05-01-preview/sqlvulnerabilityassessmentssettings"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlServerVulnerabilityAssessmentExpressConfigurationResource struct{}
+
+func TestAccMsSqlServerVulnerabilityAssessmentExpressConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_vulnerability_assessment_express_configuration", "test")
+	r := MsSqlServerVulnerabilityAssessmentExpressConfigurationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlServerVulnerabilityAssessmentExpressConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_vulnerability_assessment_express_configuration", "test")
+	r := MsSqlServerVulnerabilityAssessmentExpressConfigurationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := sqlvulnerabilityassessmentssettings.ParseSqlVulnerabilityAssessmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.SqlVulnerabilityAssessmentsSettingsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	enabled := resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.State != nil && *resp.Model.Properties.State == sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentStateEnabled
+	return utils.Bool(enabled), nil
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_vulnerability_assessment_express_configuration" "test" {
+  server_id = azurerm_mssql_server.test.id
+}
+`, MsSqlServerResource{}.basic(data))
+}
+
+func (r MsSqlServerVulnerabilityAssessmentExpressConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_vulnerability_assessment_express_configuration" "import" {
+  server_id = azurerm_mssql_server_vulnerability_assessment_express_configuration.test.server_id
+}
+`, r.basic(data))
+}
@@ -227,6 +227,26 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"sql_management": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(sqlvirtualmachines.SqlManagementModeFull),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sqlvirtualmachines.SqlManagementModeFull),
+					string(sqlvirtualmachines.SqlManagementModeLightWeight),
+				}, false),
+			},
+
+			"least_privilege_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "NotSet",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Enabled",
+					"NotSet",
+				}, false),
+			},
+
 			"sql_connectivity_port": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -303,6 +323,24 @@ func resourceMsSqlVirtualMachineCustomDiff(ctx context.Context, d *pluginsdk.Res
 		return d.ForceNew("auto_backup")
 	}
 
+	// the SQL IaaS Extension can be upgraded from `LightWeight` to `Full` in-place, but can't be downgraded
+	if oldMode, newMode := d.GetChange("sql_management"); oldMode.(string) == string(sqlvirtualmachines.SqlManagementModeFull) && newMode.(string) == string(sqlvirtualmachines.SqlManagementModeLightWeight) {
+		if err := d.ForceNew("sql_management"); err != nil {
+			return err
+		}
+	}
+
+	// Least Privilege Mode can't be disabled once it's been enabled
+	if oldMode, newMode := d.GetChange("least_privilege_mode"); oldMode.(string) == "Enabled" && newMode.(string) != "Enabled" {
+		if err := d.ForceNew("least_privilege_mode"); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("least_privilege_mode").(string) == "Enabled" && d.Get("sql_management").(string) != string(sqlvirtualmachines.SqlManagementModeFull) {
+		return fmt.Errorf("`least_privilege_mode` can only be `Enabled` when `sql_management` is set to `Full`")
+	}
+
 	encryptionEnabled := d.Get("auto_backup.0.encryption_enabled")
 	v, ok := d.GetOk("auto_backup.0.encryption_password")
 
@@ -352,7 +390,7 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	connectivityType := sqlvirtualmachines.ConnectivityType(d.Get("sql_connectivity_type").(string))
-	sqlManagement := sqlvirtualmachines.SqlManagementModeFull
+	sqlManagement := sqlvirtualmachines.SqlManagementMode(d.Get("sql_management").(string))
 	sqlServerLicenseType := sqlvirtualmachines.SqlServerLicenseType(d.Get("sql_license_type").(string))
 
 	parameters := sqlvirtualmachines.SqlVirtualMachine{
@@ -386,6 +424,13 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 
 	d.SetId(id.ID())
 
+	if leastPrivilegeMode := d.Get("least_privilege_mode").(string); (d.IsNewResource() && leastPrivilegeMode != "NotSet") || (!d.IsNewResource() && d.HasChange("least_privilege_mode")) {
+		leastPrivilegeClient := meta.(*clients.Client).MSSQL.VirtualMachinesLeastPrivilegeModeClient
+		if err := leastPrivilegeClient.UpdateThenPoll(ctx, id, leastPrivilegeMode); err != nil {
+			return fmt.Errorf("updating `least_privilege_mode` for %s: %+v", id, err)
+		}
+	}
+
 	// Wait for the auto backup settings to take effect
 	// See: https://github.com/Azure/azure-rest-api-specs/issues/12818
 	if autoBackup := d.Get("auto_backup"); (d.IsNewResource() && len(autoBackup.([]interface{})) > 0) || (!d.IsNewResource() && d.HasChange("auto_backup")) {
@@ -437,6 +482,12 @@ func resourceMsSqlVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 
 			d.Set("virtual_machine_id", props.VirtualMachineResourceId)
 			d.Set("sql_license_type", string(*props.SqlServerLicenseType))
+
+			sqlManagement := string(sqlvirtualmachines.SqlManagementModeFull)
+			if props.SqlManagement != nil {
+				sqlManagement = string(*props.SqlManagement)
+			}
+			d.Set("sql_management", sqlManagement)
 			if err := d.Set("auto_backup", flattenSqlVirtualMachineAutoBackup(props.AutoBackupSettings, d)); err != nil {
 				return fmt.Errorf("setting `auto_backup`: %+v", err)
 			}
@@ -474,6 +525,21 @@ func resourceMsSqlVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 			}
 		}
 	}
+
+	// the Least Privilege Mode is only available from a Preview API Version, so this is only retrieved when it's been
+	// enabled (or when importing, where nothing is known yet) - otherwise it's left as `NotSet`
+	if d.Get("least_privilege_mode").(string) != "NotSet" {
+		leastPrivilegeMode, err := meta.(*clients.Client).MSSQL.VirtualMachinesLeastPrivilegeModeClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving `least_privilege_mode` for %s: %+v", id, err)
+		}
+		if leastPrivilegeMode == nil || *leastPrivilegeMode == "" {
+			d.Set("least_privilege_mode", "NotSet")
+		} else {
+			d.Set("least_privilege_mode", *leastPrivilegeMode)
+		}
+	}
+
 	return nil
 }
 
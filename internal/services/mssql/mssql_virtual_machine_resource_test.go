@@ -170,6 +170,38 @@ func TestAccMsSqlVirtualMachine_storageConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlVirtualMachine_sqlManagementAndLeastPrivilegeMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine", "test")
+	r := MsSqlVirtualMachineResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sqlManagement(data, "LightWeight"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sql_management").HasValue("LightWeight"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.sqlManagement(data, "Full"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sql_management").HasValue("Full"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.leastPrivilegeMode(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("least_privilege_mode").HasValue("Enabled"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (MsSqlVirtualMachineResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := sqlvirtualmachines.ParseSqlVirtualMachineID(state.ID)
 	if err != nil {
@@ -313,6 +345,31 @@ resource "azurerm_mssql_virtual_machine" "test" {
 `, r.template(data))
 }
 
+func (r MsSqlVirtualMachineResource) sqlManagement(data acceptance.TestData, mode string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id = azurerm_virtual_machine.test.id
+  sql_license_type   = "PAYG"
+  sql_management     = "%[2]s"
+}
+`, r.template(data), mode)
+}
+
+func (r MsSqlVirtualMachineResource) leastPrivilegeMode(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  virtual_machine_id   = azurerm_virtual_machine.test.id
+  sql_license_type     = "PAYG"
+  sql_management       = "Full"
+  least_privilege_mode = "Enabled"
+}
+`, r.template(data))
+}
+
 func (r MsSqlVirtualMachineResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
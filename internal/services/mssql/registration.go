@@ -69,11 +69,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		MsSqlDatabaseVulnerabilityAssessmentBaselineResource{},
 		MsSqlFailoverGroupResource{},
 		MsSqlManagedDatabaseResource{},
 		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
 		MsSqlManagedInstanceFailoverGroupResource{},
 		MsSqlManagedInstanceResource{},
+		MsSqlManagedInstanceStartStopScheduleResource{},
+		MsSqlServerVulnerabilityAssessmentExpressConfigurationResource{},
 		ServerDNSAliasResource{},
 	}
 }
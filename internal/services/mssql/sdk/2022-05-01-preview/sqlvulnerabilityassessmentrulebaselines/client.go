@@ -0,0 +1,15 @@
+package sqlvulnerabilityassessmentrulebaselines
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SqlVulnerabilityAssessmentRuleBaselinesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSqlVulnerabilityAssessmentRuleBaselinesClientWithBaseURI(endpoint string) SqlVulnerabilityAssessmentRuleBaselinesClient {
+	return SqlVulnerabilityAssessmentRuleBaselinesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,176 @@
+package sqlvulnerabilityassessmentrulebaselines
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = BaselineRuleId{}
+
+// BaselineRuleId is a struct representing the Resource ID for a Baseline Rule
+type BaselineRuleId struct {
+	SubscriptionId                 string
+	ResourceGroupName              string
+	ServerName                     string
+	DatabaseName                   string
+	SqlVulnerabilityAssessmentName string
+	BaselineName                   string
+	RuleName                       string
+}
+
+// NewBaselineRuleID returns a new BaselineRuleId struct
+func NewBaselineRuleID(subscriptionId string, resourceGroupName string, serverName string, databaseName string, sqlVulnerabilityAssessmentName string, baselineName string, ruleName string) BaselineRuleId {
+	return BaselineRuleId{
+		SubscriptionId:                 subscriptionId,
+		ResourceGroupName:              resourceGroupName,
+		ServerName:                     serverName,
+		DatabaseName:                   databaseName,
+		SqlVulnerabilityAssessmentName: sqlVulnerabilityAssessmentName,
+		BaselineName:                   baselineName,
+		RuleName:                       ruleName,
+	}
+}
+
+// ParseBaselineRuleID parses 'input' into a BaselineRuleId
+func ParseBaselineRuleID(input string) (*BaselineRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(BaselineRuleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := BaselineRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServerName, ok = parsed.Parsed["serverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serverName' was not found in the resource id %q", input)
+	}
+
+	if id.DatabaseName, ok = parsed.Parsed["databaseName"]; !ok {
+		return nil, fmt.Errorf("the segment 'databaseName' was not found in the resource id %q", input)
+	}
+
+	if id.SqlVulnerabilityAssessmentName, ok = parsed.Parsed["sqlVulnerabilityAssessmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'sqlVulnerabilityAssessmentName' was not found in the resource id %q", input)
+	}
+
+	if id.BaselineName, ok = parsed.Parsed["baselineName"]; !ok {
+		return nil, fmt.Errorf("the segment 'baselineName' was not found in the resource id %q", input)
+	}
+
+	if id.RuleName, ok = parsed.Parsed["ruleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'ruleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseBaselineRuleIDInsensitively parses 'input' case-insensitively into a BaselineRuleId
+// note: this method should only be used for API response data and not user input
+func ParseBaselineRuleIDInsensitively(input string) (*BaselineRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(BaselineRuleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := BaselineRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServerName, ok = parsed.Parsed["serverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serverName' was not found in the resource id %q", input)
+	}
+
+	if id.DatabaseName, ok = parsed.Parsed["databaseName"]; !ok {
+		return nil, fmt.Errorf("the segment 'databaseName' was not found in the resource id %q", input)
+	}
+
+	if id.SqlVulnerabilityAssessmentName, ok = parsed.Parsed["sqlVulnerabilityAssessmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'sqlVulnerabilityAssessmentName' was not found in the resource id %q", input)
+	}
+
+	if id.BaselineName, ok = parsed.Parsed["baselineName"]; !ok {
+		return nil, fmt.Errorf("the segment 'baselineName' was not found in the resource id %q", input)
+	}
+
+	if id.RuleName, ok = parsed.Parsed["ruleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'ruleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateBaselineRuleID checks that 'input' can be parsed as a Baseline Rule ID
+func ValidateBaselineRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseBaselineRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Baseline Rule ID
+func (id BaselineRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/sqlVulnerabilityAssessments/%s/baselines/%s/rules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.DatabaseName, id.SqlVulnerabilityAssessmentName, id.BaselineName, id.RuleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Baseline Rule ID
+func (id BaselineRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "serverValue"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("databaseName", "databaseValue"),
+		resourceids.StaticSegment("staticSqlVulnerabilityAssessments", "sqlVulnerabilityAssessments", "sqlVulnerabilityAssessments"),
+		resourceids.UserSpecifiedSegment("sqlVulnerabilityAssessmentName", "sqlVulnerabilityAssessmentValue"),
+		resourceids.StaticSegment("staticBaselines", "baselines", "baselines"),
+		resourceids.UserSpecifiedSegment("baselineName", "baselineValue"),
+		resourceids.StaticSegment("staticRules", "rules", "rules"),
+		resourceids.UserSpecifiedSegment("ruleName", "ruleValue"),
+	}
+}
+
+// String returns a human-readable description of this Baseline Rule ID
+func (id BaselineRuleId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Server Name: %q", id.ServerName),
+		fmt.Sprintf("Database Name: %q", id.DatabaseName),
+		fmt.Sprintf("Sql Vulnerability Assessment Name: %q", id.SqlVulnerabilityAssessmentName),
+		fmt.Sprintf("Baseline Name: %q", id.BaselineName),
+		fmt.Sprintf("Rule Name: %q", id.RuleName),
+	}
+	return fmt.Sprintf("Baseline Rule (%s)", strings.Join(components, "\n"))
+}
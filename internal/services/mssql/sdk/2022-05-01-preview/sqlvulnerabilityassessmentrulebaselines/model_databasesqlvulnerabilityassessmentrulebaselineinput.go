@@ -0,0 +1,13 @@
+package sqlvulnerabilityassessmentrulebaselines
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type DatabaseSqlVulnerabilityAssessmentRuleBaselineInput struct {
+	Id         *string                                                        `json:"id,omitempty"`
+	Name       *string                                                        `json:"name,omitempty"`
+	Properties *DatabaseSqlVulnerabilityAssessmentRuleBaselineInputProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData                                         `json:"systemData,omitempty"`
+	Type       *string                                                        `json:"type,omitempty"`
+}
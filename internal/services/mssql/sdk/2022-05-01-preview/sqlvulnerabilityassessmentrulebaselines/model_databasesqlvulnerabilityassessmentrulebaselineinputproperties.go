@@ -0,0 +1,6 @@
+package sqlvulnerabilityassessmentrulebaselines
+
+type DatabaseSqlVulnerabilityAssessmentRuleBaselineInputProperties struct {
+	LatestScan bool       `json:"latestScan"`
+	Results    [][]string `json:"results"`
+}
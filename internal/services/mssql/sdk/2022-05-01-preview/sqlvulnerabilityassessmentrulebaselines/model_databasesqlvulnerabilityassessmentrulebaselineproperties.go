@@ -0,0 +1,5 @@
+package sqlvulnerabilityassessmentrulebaselines
+
+type DatabaseSqlVulnerabilityAssessmentRuleBaselineProperties struct {
+	Results [][]string `json:"results"`
+}
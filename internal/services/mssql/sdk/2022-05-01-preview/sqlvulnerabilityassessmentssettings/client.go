@@ -0,0 +1,15 @@
+package sqlvulnerabilityassessmentssettings
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SqlVulnerabilityAssessmentsSettingsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSqlVulnerabilityAssessmentsSettingsClientWithBaseURI(endpoint string) SqlVulnerabilityAssessmentsSettingsClient {
+	return SqlVulnerabilityAssessmentsSettingsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,31 @@
+package sqlvulnerabilityassessmentssettings
+
+import "strings"
+
+type SqlVulnerabilityAssessmentState string
+
+const (
+	SqlVulnerabilityAssessmentStateDisabled SqlVulnerabilityAssessmentState = "Disabled"
+	SqlVulnerabilityAssessmentStateEnabled  SqlVulnerabilityAssessmentState = "Enabled"
+)
+
+func PossibleValuesForSqlVulnerabilityAssessmentState() []string {
+	return []string{
+		string(SqlVulnerabilityAssessmentStateDisabled),
+		string(SqlVulnerabilityAssessmentStateEnabled),
+	}
+}
+
+func parseSqlVulnerabilityAssessmentState(input string) (*SqlVulnerabilityAssessmentState, error) {
+	vals := map[string]SqlVulnerabilityAssessmentState{
+		"disabled": SqlVulnerabilityAssessmentStateDisabled,
+		"enabled":  SqlVulnerabilityAssessmentStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SqlVulnerabilityAssessmentState(input)
+	return &out, nil
+}
@@ -0,0 +1,137 @@
+package sqlvulnerabilityassessmentssettings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SqlVulnerabilityAssessmentId{}
+
+// SqlVulnerabilityAssessmentId is a struct representing the Resource ID for a Sql Vulnerability Assessment
+type SqlVulnerabilityAssessmentId struct {
+	SubscriptionId                 string
+	ResourceGroupName              string
+	ServerName                     string
+	SqlVulnerabilityAssessmentName string
+}
+
+// NewSqlVulnerabilityAssessmentID returns a new SqlVulnerabilityAssessmentId struct
+func NewSqlVulnerabilityAssessmentID(subscriptionId string, resourceGroupName string, serverName string, sqlVulnerabilityAssessmentName string) SqlVulnerabilityAssessmentId {
+	return SqlVulnerabilityAssessmentId{
+		SubscriptionId:                 subscriptionId,
+		ResourceGroupName:              resourceGroupName,
+		ServerName:                     serverName,
+		SqlVulnerabilityAssessmentName: sqlVulnerabilityAssessmentName,
+	}
+}
+
+// ParseSqlVulnerabilityAssessmentID parses 'input' into a SqlVulnerabilityAssessmentId
+func ParseSqlVulnerabilityAssessmentID(input string) (*SqlVulnerabilityAssessmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SqlVulnerabilityAssessmentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SqlVulnerabilityAssessmentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServerName, ok = parsed.Parsed["serverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serverName' was not found in the resource id %q", input)
+	}
+
+	if id.SqlVulnerabilityAssessmentName, ok = parsed.Parsed["sqlVulnerabilityAssessmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'sqlVulnerabilityAssessmentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseSqlVulnerabilityAssessmentIDInsensitively parses 'input' case-insensitively into a SqlVulnerabilityAssessmentId
+// note: this method should only be used for API response data and not user input
+func ParseSqlVulnerabilityAssessmentIDInsensitively(input string) (*SqlVulnerabilityAssessmentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SqlVulnerabilityAssessmentId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SqlVulnerabilityAssessmentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServerName, ok = parsed.Parsed["serverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serverName' was not found in the resource id %q", input)
+	}
+
+	if id.SqlVulnerabilityAssessmentName, ok = parsed.Parsed["sqlVulnerabilityAssessmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'sqlVulnerabilityAssessmentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateSqlVulnerabilityAssessmentID checks that 'input' can be parsed as a Sql Vulnerability Assessment ID
+func ValidateSqlVulnerabilityAssessmentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSqlVulnerabilityAssessmentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Sql Vulnerability Assessment ID
+func (id SqlVulnerabilityAssessmentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/sqlVulnerabilityAssessments/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.SqlVulnerabilityAssessmentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Sql Vulnerability Assessment ID
+func (id SqlVulnerabilityAssessmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "serverValue"),
+		resourceids.StaticSegment("staticSqlVulnerabilityAssessments", "sqlVulnerabilityAssessments", "sqlVulnerabilityAssessments"),
+		resourceids.UserSpecifiedSegment("sqlVulnerabilityAssessmentName", "sqlVulnerabilityAssessmentValue"),
+	}
+}
+
+// String returns a human-readable description of this Sql Vulnerability Assessment ID
+func (id SqlVulnerabilityAssessmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Server Name: %q", id.ServerName),
+		fmt.Sprintf("Sql Vulnerability Assessment Name: %q", id.SqlVulnerabilityAssessmentName),
+	}
+	return fmt.Sprintf("Sql Vulnerability Assessment (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,13 @@
+package sqlvulnerabilityassessmentssettings
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type SqlVulnerabilityAssessment struct {
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *SqlVulnerabilityAssessmentPolicyProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData                      `json:"systemData,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package sqlvulnerabilityassessmentssettings
+
+type SqlVulnerabilityAssessmentPolicyProperties struct {
+	State *SqlVulnerabilityAssessmentState `json:"state,omitempty"`
+}
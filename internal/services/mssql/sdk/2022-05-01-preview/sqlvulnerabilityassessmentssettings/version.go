@@ -0,0 +1,9 @@
+package sqlvulnerabilityassessmentssettings
+
+import "fmt"
+
+const defaultApiVersion = "2022-05-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/sqlvulnerabilityassessmentssettings/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package startstopmanagedinstanceschedules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StartStopManagedInstanceSchedulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStartStopManagedInstanceSchedulesClientWithBaseURI(endpoint string) StartStopManagedInstanceSchedulesClient {
+	return StartStopManagedInstanceSchedulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,46 @@
+package startstopmanagedinstanceschedules
+
+import "strings"
+
+type DayOfWeek string
+
+const (
+	DayOfWeekFriday    DayOfWeek = "Friday"
+	DayOfWeekMonday    DayOfWeek = "Monday"
+	DayOfWeekSaturday  DayOfWeek = "Saturday"
+	DayOfWeekSunday    DayOfWeek = "Sunday"
+	DayOfWeekThursday  DayOfWeek = "Thursday"
+	DayOfWeekTuesday   DayOfWeek = "Tuesday"
+	DayOfWeekWednesday DayOfWeek = "Wednesday"
+)
+
+func PossibleValuesForDayOfWeek() []string {
+	return []string{
+		string(DayOfWeekFriday),
+		string(DayOfWeekMonday),
+		string(DayOfWeekSaturday),
+		string(DayOfWeekSunday),
+		string(DayOfWeekThursday),
+		string(DayOfWeekTuesday),
+		string(DayOfWeekWednesday),
+	}
+}
+
+func parseDayOfWeek(input string) (*DayOfWeek, error) {
+	vals := map[string]DayOfWeek{
+		"friday":    DayOfWeekFriday,
+		"monday":    DayOfWeekMonday,
+		"saturday":  DayOfWeekSaturday,
+		"sunday":    DayOfWeekSunday,
+		"thursday":  DayOfWeekThursday,
+		"tuesday":   DayOfWeekTuesday,
+		"wednesday": DayOfWeekWednesday,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DayOfWeek(input)
+	return &out, nil
+}
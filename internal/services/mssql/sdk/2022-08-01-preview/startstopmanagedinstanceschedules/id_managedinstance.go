@@ -0,0 +1,124 @@
+package startstopmanagedinstanceschedules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ManagedInstanceId{}
+
+// ManagedInstanceId is a struct representing the Resource ID for a Managed Instance
+type ManagedInstanceId struct {
+	SubscriptionId      string
+	ResourceGroupName   string
+	ManagedInstanceName string
+}
+
+// NewManagedInstanceID returns a new ManagedInstanceId struct
+func NewManagedInstanceID(subscriptionId string, resourceGroupName string, managedInstanceName string) ManagedInstanceId {
+	return ManagedInstanceId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroupName:   resourceGroupName,
+		ManagedInstanceName: managedInstanceName,
+	}
+}
+
+// ParseManagedInstanceID parses 'input' into a ManagedInstanceId
+func ParseManagedInstanceID(input string) (*ManagedInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ManagedInstanceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ManagedInstanceId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ManagedInstanceName, ok = parsed.Parsed["managedInstanceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managedInstanceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseManagedInstanceIDInsensitively parses 'input' case-insensitively into a ManagedInstanceId
+// note: this method should only be used for API response data and not user input
+func ParseManagedInstanceIDInsensitively(input string) (*ManagedInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ManagedInstanceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ManagedInstanceId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ManagedInstanceName, ok = parsed.Parsed["managedInstanceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managedInstanceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateManagedInstanceID checks that 'input' can be parsed as a Managed Instance ID
+func ValidateManagedInstanceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseManagedInstanceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Managed Instance ID
+func (id ManagedInstanceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/managedInstances/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ManagedInstanceName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Managed Instance ID
+func (id ManagedInstanceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticManagedInstances", "managedInstances", "managedInstances"),
+		resourceids.UserSpecifiedSegment("managedInstanceName", "managedInstanceValue"),
+	}
+}
+
+// String returns a human-readable description of this Managed Instance ID
+func (id ManagedInstanceId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Managed Instance Name: %q", id.ManagedInstanceName),
+	}
+	return fmt.Sprintf("Managed Instance (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,13 @@
+package startstopmanagedinstanceschedules
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type StartStopManagedInstanceSchedule struct {
+	Id         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *StartStopManagedInstanceScheduleProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData                      `json:"systemData,omitempty"`
+	Type       *string                                     `json:"type,omitempty"`
+}
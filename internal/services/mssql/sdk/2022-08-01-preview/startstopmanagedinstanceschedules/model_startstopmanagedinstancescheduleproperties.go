@@ -0,0 +1,9 @@
+package startstopmanagedinstanceschedules
+
+type StartStopManagedInstanceScheduleProperties struct {
+	Description       *string        `json:"description,omitempty"`
+	NextExecutionTime *string        `json:"nextExecutionTime,omitempty"`
+	NextRunAction     *string        `json:"nextRunAction,omitempty"`
+	ScheduleList      []ScheduleItem `json:"scheduleList"`
+	TimeZoneId        *string        `json:"timeZoneId,omitempty"`
+}
@@ -4,21 +4,25 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-01-01/mysql"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2021-05-01/mysqlflexibleservers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-09-30-preview/backupandexport"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-09-30-preview/backups"
 )
 
 type Client struct {
-	ConfigurationsClient               *mysql.ConfigurationsClient
-	DatabasesClient                    *mysql.DatabasesClient
-	FirewallRulesClient                *mysql.FirewallRulesClient
-	FlexibleDatabasesClient            *mysqlflexibleservers.DatabasesClient
-	FlexibleServerConfigurationsClient *mysqlflexibleservers.ConfigurationsClient
-	FlexibleServerClient               *mysqlflexibleservers.ServersClient
-	FlexibleServerFirewallRulesClient  *mysqlflexibleservers.FirewallRulesClient
-	ServersClient                      *mysql.ServersClient
-	ServerKeysClient                   *mysql.ServerKeysClient
-	ServerSecurityAlertPoliciesClient  *mysql.ServerSecurityAlertPoliciesClient
-	VirtualNetworkRulesClient          *mysql.VirtualNetworkRulesClient
-	ServerAdministratorsClient         *mysql.ServerAdministratorsClient
+	ConfigurationsClient                *mysql.ConfigurationsClient
+	DatabasesClient                     *mysql.DatabasesClient
+	FirewallRulesClient                 *mysql.FirewallRulesClient
+	FlexibleDatabasesClient             *mysqlflexibleservers.DatabasesClient
+	FlexibleServerBackupAndExportClient *backupandexport.BackupAndExportClient
+	FlexibleServerBackupsClient         *backups.BackupsClient
+	FlexibleServerConfigurationsClient  *mysqlflexibleservers.ConfigurationsClient
+	FlexibleServerClient                *mysqlflexibleservers.ServersClient
+	FlexibleServerFirewallRulesClient   *mysqlflexibleservers.FirewallRulesClient
+	ServersClient                       *mysql.ServersClient
+	ServerKeysClient                    *mysql.ServerKeysClient
+	ServerSecurityAlertPoliciesClient   *mysql.ServerSecurityAlertPoliciesClient
+	VirtualNetworkRulesClient           *mysql.VirtualNetworkRulesClient
+	ServerAdministratorsClient          *mysql.ServerAdministratorsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -34,6 +38,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleDatabasesClient := mysqlflexibleservers.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleDatabasesClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerBackupAndExportClient := backupandexport.NewBackupAndExportClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerBackupAndExportClient.Client, o.ResourceManagerAuthorizer)
+
+	flexibleServerBackupsClient := backups.NewBackupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerBackupsClient.Client, o.ResourceManagerAuthorizer)
+
 	flexibleServerClient := mysqlflexibleservers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleServerClient.Client, o.ResourceManagerAuthorizer)
 
@@ -59,17 +69,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&serverAdministratorsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ConfigurationsClient:               &ConfigurationsClient,
-		DatabasesClient:                    &DatabasesClient,
-		FirewallRulesClient:                &FirewallRulesClient,
-		FlexibleDatabasesClient:            &flexibleDatabasesClient,
-		FlexibleServerClient:               &flexibleServerClient,
-		FlexibleServerFirewallRulesClient:  &flexibleServerFirewallRulesClient,
-		FlexibleServerConfigurationsClient: &flexibleServerConfigurationsClient,
-		ServersClient:                      &ServersClient,
-		ServerKeysClient:                   &ServerKeysClient,
-		ServerSecurityAlertPoliciesClient:  &serverSecurityAlertPoliciesClient,
-		VirtualNetworkRulesClient:          &VirtualNetworkRulesClient,
-		ServerAdministratorsClient:         &serverAdministratorsClient,
+		ConfigurationsClient:                &ConfigurationsClient,
+		DatabasesClient:                     &DatabasesClient,
+		FirewallRulesClient:                 &FirewallRulesClient,
+		FlexibleDatabasesClient:             &flexibleDatabasesClient,
+		FlexibleServerBackupAndExportClient: &flexibleServerBackupAndExportClient,
+		FlexibleServerBackupsClient:         &flexibleServerBackupsClient,
+		FlexibleServerClient:                &flexibleServerClient,
+		FlexibleServerFirewallRulesClient:   &flexibleServerFirewallRulesClient,
+		FlexibleServerConfigurationsClient:  &flexibleServerConfigurationsClient,
+		ServersClient:                       &ServersClient,
+		ServerKeysClient:                    &ServerKeysClient,
+		ServerSecurityAlertPoliciesClient:   &serverSecurityAlertPoliciesClient,
+		VirtualNetworkRulesClient:           &VirtualNetworkRulesClient,
+		ServerAdministratorsClient:          &serverAdministratorsClient,
 	}
 }
@@ -0,0 +1,227 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-09-30-preview/backupandexport"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-09-30-preview/backups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MySqlFlexibleServerBackupModel struct {
+	Name          string                                 `tfschema:"name"`
+	ServerId      string                                 `tfschema:"server_id"`
+	Export        []MySqlFlexibleServerBackupExportModel `tfschema:"export"`
+	BackupType    string                                 `tfschema:"backup_type"`
+	CompletedTime string                                 `tfschema:"completed_time"`
+	Source        string                                 `tfschema:"source"`
+}
+
+type MySqlFlexibleServerBackupExportModel struct {
+	StorageSasUrls []string `tfschema:"storage_sas_urls"`
+}
+
+var _ sdk.Resource = MySqlFlexibleServerBackupResource{}
+
+type MySqlFlexibleServerBackupResource struct{}
+
+func (r MySqlFlexibleServerBackupResource) ResourceType() string {
+	return "azurerm_mysql_flexible_server_backup"
+}
+
+func (r MySqlFlexibleServerBackupResource) ModelObject() interface{} {
+	return &MySqlFlexibleServerBackupModel{}
+}
+
+func (r MySqlFlexibleServerBackupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return backups.ValidateBackupID
+}
+
+func (r MySqlFlexibleServerBackupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][-_a-zA-Z0-9]{0,127}$`),
+				"`name` must be between 1 and 128 characters, start with a letter or number and can only contain letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FlexibleServerID,
+		},
+
+		"export": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"storage_sas_urls": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MySqlFlexibleServerBackupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"backup_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"completed_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MySqlFlexibleServerBackupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServerBackupsClient
+
+			var model MySqlFlexibleServerBackupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := parse.FlexibleServerID(model.ServerId)
+			if err != nil {
+				return err
+			}
+
+			id := backups.NewBackupID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if len(model.Export) > 0 {
+				// the backup is taken and exported to the Storage Account as a part of the same operation
+				exportClient := metadata.Client.MySQL.FlexibleServerBackupAndExportClient
+				exportServerId := backupandexport.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.ServerName)
+				parameters := backupandexport.BackupAndExportRequest{
+					BackupSettings: backupandexport.BackupSettings{
+						BackupName: id.BackupName,
+					},
+					TargetDetails: backupandexport.FullBackupStoreDetails{
+						ObjectType: "FullBackupStoreDetails",
+						SasUriList: model.Export[0].StorageSasUrls,
+					},
+				}
+
+				if err := exportClient.CreateThenPoll(ctx, exportServerId, parameters); err != nil {
+					return fmt.Errorf("creating and exporting %s: %+v", id, err)
+				}
+			} else {
+				if err := client.PutThenPoll(ctx, id); err != nil {
+					return fmt.Errorf("creating %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MySqlFlexibleServerBackupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServerBackupsClient
+
+			id, err := backups.ParseBackupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config MySqlFlexibleServerBackupModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := MySqlFlexibleServerBackupModel{
+				Name:     id.BackupName,
+				ServerId: parse.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.ServerName).ID(),
+				// the SAS URLs of the export aren't returned by the API, so these are pulled from the config
+				Export: config.Export,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.BackupType = utils.NormalizeNilableString(props.BackupType)
+					state.CompletedTime = utils.NormalizeNilableString(props.CompletedTime)
+					state.Source = utils.NormalizeNilableString(props.Source)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MySqlFlexibleServerBackupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := backups.ParseBackupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// On-Demand Backups can't be deleted, instead these are removed by the service once the backup
+			// retention period of the MySQL Flexible Server has elapsed - so this is a no-op
+			metadata.Logger.Infof("%s can't be deleted and will be removed once the backup retention period has elapsed - removing from state", *id)
+
+			return nil
+		},
+	}
+}
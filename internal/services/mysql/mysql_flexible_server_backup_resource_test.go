@@ -0,0 +1,150 @@
+package mysql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-09-30-preview/backups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MySqlFlexibleServerBackupResource struct{}
+
+func TestAccMySqlFlexibleServerBackup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_backup", "test")
+	r := MySqlFlexibleServerBackupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("backup_type").Exists(),
+				check.That(data.ResourceName).Key("completed_time").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMySqlFlexibleServerBackup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_backup", "test")
+	r := MySqlFlexibleServerBackupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMySqlFlexibleServerBackup_export(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_backup", "test")
+	r := MySqlFlexibleServerBackupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.export(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("export"),
+	})
+}
+
+func (MySqlFlexibleServerBackupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := backups.ParseBackupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MySQL.FlexibleServerBackupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (MySqlFlexibleServerBackupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_backup" "test" {
+  name      = "acctest-backup-%d"
+  server_id = azurerm_mysql_flexible_server.test.id
+}
+`, MySqlFlexibleServerResource{}.basic(data), data.RandomInteger)
+}
+
+func (r MySqlFlexibleServerBackupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_backup" "import" {
+  name      = azurerm_mysql_flexible_server_backup.test.name
+  server_id = azurerm_mysql_flexible_server_backup.test.server_id
+}
+`, r.basic(data))
+}
+
+func (MySqlFlexibleServerBackupResource) export(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "backups"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "2022-01-01"
+  expiry = "2042-01-01"
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = true
+  }
+}
+
+resource "azurerm_mysql_flexible_server_backup" "test" {
+  name      = "acctest-backup-%[3]d"
+  server_id = azurerm_mysql_flexible_server.test.id
+
+  export {
+    storage_sas_urls = ["${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}${data.azurerm_storage_account_blob_container_sas.test.sas}"]
+  }
+}
+`, MySqlFlexibleServerResource{}.basic(data), data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,15 @@
+package backupandexport
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BackupAndExportClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBackupAndExportClientWithBaseURI(endpoint string) BackupAndExportClient {
+	return BackupAndExportClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package backupandexport
+
+type BackupAndExportRequest struct {
+	BackupSettings BackupSettings         `json:"backupSettings"`
+	TargetDetails  FullBackupStoreDetails `json:"targetDetails"`
+}
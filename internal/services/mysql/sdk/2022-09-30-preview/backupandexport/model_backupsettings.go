@@ -0,0 +1,5 @@
+package backupandexport
+
+type BackupSettings struct {
+	BackupName string `json:"backupName"`
+}
@@ -0,0 +1,6 @@
+package backupandexport
+
+type FullBackupStoreDetails struct {
+	ObjectType string   `json:"objectType"`
+	SasUriList []string `json:"sasUriList"`
+}
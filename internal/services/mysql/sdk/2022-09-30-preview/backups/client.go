@@ -0,0 +1,15 @@
+package backups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BackupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBackupsClientWithBaseURI(endpoint string) BackupsClient {
+	return BackupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,137 @@
+package backups
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = BackupId{}
+
+// BackupId is a struct representing the Resource ID for a Backup
+type BackupId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ServerName        string
+	BackupName        string
+}
+
+// NewBackupID returns a new BackupId struct
+func NewBackupID(subscriptionId string, resourceGroupName string, serverName string, backupName string) BackupId {
+	return BackupId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ServerName:        serverName,
+		BackupName:        backupName,
+	}
+}
+
+// ParseBackupID parses 'input' into a BackupId
+func ParseBackupID(input string) (*BackupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(BackupId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := BackupId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServerName, ok = parsed.Parsed["serverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serverName' was not found in the resource id %q", input)
+	}
+
+	if id.BackupName, ok = parsed.Parsed["backupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'backupName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseBackupIDInsensitively parses 'input' case-insensitively into a BackupId
+// note: this method should only be used for API response data and not user input
+func ParseBackupIDInsensitively(input string) (*BackupId, error) {
+	parser := resourceids.NewParserFromResourceIdType(BackupId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := BackupId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServerName, ok = parsed.Parsed["serverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serverName' was not found in the resource id %q", input)
+	}
+
+	if id.BackupName, ok = parsed.Parsed["backupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'backupName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateBackupID checks that 'input' can be parsed as a Backup ID
+func ValidateBackupID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseBackupID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Backup ID
+func (id BackupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/flexibleServers/%s/backups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.BackupName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Backup ID
+func (id BackupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDBforMySQL", "Microsoft.DBforMySQL", "Microsoft.DBforMySQL"),
+		resourceids.StaticSegment("staticFlexibleServers", "flexibleServers", "flexibleServers"),
+		resourceids.UserSpecifiedSegment("serverName", "serverValue"),
+		resourceids.StaticSegment("staticBackups", "backups", "backups"),
+		resourceids.UserSpecifiedSegment("backupName", "backupValue"),
+	}
+}
+
+// String returns a human-readable description of this Backup ID
+func (id BackupId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Server Name: %q", id.ServerName),
+		fmt.Sprintf("Backup Name: %q", id.BackupName),
+	}
+	return fmt.Sprintf("Backup (%s)", strings.Join(components, "\n"))
+}
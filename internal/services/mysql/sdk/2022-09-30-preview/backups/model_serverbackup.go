@@ -0,0 +1,13 @@
+package backups
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type ServerBackup struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *ServerBackupProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData  `json:"systemData,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package backups
+
+type ServerBackupProperties struct {
+	BackupType    *string `json:"backupType,omitempty"`
+	CompletedTime *string `json:"completedTime,omitempty"`
+	Source        *string `json:"source,omitempty"`
+}
@@ -0,0 +1,9 @@
+package backups
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-30-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/backups/%s", defaultApiVersion)
+}
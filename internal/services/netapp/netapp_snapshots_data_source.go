@@ -0,0 +1,123 @@
+package netapp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2021-10-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceNetAppSnapshots() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetAppSnapshotsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"volume_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: snapshots.ValidateVolumeID,
+			},
+
+			"snapshots": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"snapshot_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"creation_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetAppSnapshotsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.SnapshotClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := snapshots.ParseVolumeID(d.Get("volume_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.List(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("listing snapshots for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("volume_id", id.ID())
+
+	if err := d.Set("snapshots", flattenNetAppSnapshots(resp.Model)); err != nil {
+		return fmt.Errorf("setting `snapshots`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetAppSnapshots(input *snapshots.SnapshotsList) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Value == nil {
+		return results
+	}
+
+	for _, item := range *input.Value {
+		// the API returns the name of the Snapshot prefixed with the Account, Pool and Volume names, so we parse it from the ID instead
+		id := ""
+		name := ""
+		if item.Id != nil {
+			parsed, err := snapshots.ParseSnapshotIDInsensitively(*item.Id)
+			if err != nil {
+				continue
+			}
+			id = parsed.ID()
+			name = parsed.SnapshotName
+		}
+
+		snapshotId := ""
+		creationTime := ""
+		if props := item.Properties; props != nil {
+			if props.SnapshotId != nil {
+				snapshotId = *props.SnapshotId
+			}
+			if props.Created != nil {
+				creationTime = *props.Created
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":            id,
+			"name":          name,
+			"snapshot_id":   snapshotId,
+			"creation_time": creationTime,
+		})
+	}
+
+	return results
+}
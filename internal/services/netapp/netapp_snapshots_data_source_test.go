@@ -0,0 +1,40 @@
+package netapp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetAppSnapshotsDataSource struct{}
+
+func TestAccDataSourceNetAppSnapshots_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_netapp_snapshots", "test")
+	r := NetAppSnapshotsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("snapshots.#").HasValue("1"),
+				check.That(data.ResourceName).Key("snapshots.0.name").HasValue(fmt.Sprintf("acctest-NetAppSnapshot-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("snapshots.0.snapshot_id").Exists(),
+				check.That(data.ResourceName).Key("snapshots.0.creation_time").Exists(),
+			),
+		},
+	})
+}
+
+func (NetAppSnapshotsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_netapp_snapshots" "test" {
+  volume_id = azurerm_netapp_volume.test.id
+
+  depends_on = [azurerm_netapp_snapshot.test]
+}
+`, NetAppSnapshotResource{}.basic(data))
+}
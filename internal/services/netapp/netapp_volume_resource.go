@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2021-10-01/capacitypools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2021-10-01/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2021-10-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2021-10-01/volumesreplication"
@@ -366,10 +367,29 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 
 		snapshotClient := meta.(*clients.Client).NetApp.SnapshotClient
-		_, err = snapshotClient.Get(ctx, *parsedSnapshotResourceID)
+		snapshot, err := snapshotClient.Get(ctx, *parsedSnapshotResourceID)
 		if err != nil {
 			return fmt.Errorf("getting snapshot from %s: %+v", id, err)
 		}
+		if model := snapshot.Model; model != nil && model.Properties != nil && model.Properties.SnapshotId != nil {
+			snapshotID = *model.Properties.SnapshotId
+		}
+		if snapshotID == "" {
+			return fmt.Errorf("retrieving the Snapshot ID for %s: `snapshotId` was nil", *parsedSnapshotResourceID)
+		}
+
+		// the Service Level of the Capacity Pool the new Volume is created in must match the Volume's `service_level`
+		poolClient := meta.(*clients.Client).NetApp.PoolClient
+		poolId := capacitypools.NewCapacityPoolID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.PoolName)
+		pool, err := poolClient.PoolsGet(ctx, poolId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", poolId, err)
+		}
+		if model := pool.Model; model != nil {
+			if !strings.EqualFold(string(model.Properties.ServiceLevel), string(serviceLevel)) {
+				return fmt.Errorf("the `service_level` of %s (%q) must match the Service Level of %s (%q) when creating a Volume from a Snapshot", id, string(serviceLevel), poolId, string(model.Properties.ServiceLevel))
+			}
+		}
 
 		sourceVolumeId := volumes.NewVolumeID(parsedSnapshotResourceID.SubscriptionId, parsedSnapshotResourceID.ResourceGroupName, parsedSnapshotResourceID.AccountName, parsedSnapshotResourceID.PoolName, parsedSnapshotResourceID.VolumeName)
 		// Validate if properties that cannot be changed matches (protocols, subnet_id, location, resource group, account_name, pool_name, service_level)
@@ -422,7 +442,6 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 			UsageThreshold:  storageQuotaInGB,
 			ExportPolicy:    exportPolicyRule,
 			VolumeType:      utils.String(volumeType),
-			SnapshotId:      utils.String(snapshotID),
 			DataProtection: &volumes.VolumePropertiesDataProtection{
 				Replication: dataProtectionReplication.Replication,
 				Snapshot:    dataProtectionSnapshotPolicy.Snapshot,
@@ -432,6 +451,10 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if snapshotID != "" {
+		parameters.Properties.SnapshotId = utils.String(snapshotID)
+	}
+
 	if throughputMibps, ok := d.GetOk("throughput_in_mibps"); ok {
 		parameters.Properties.ThroughputMibps = utils.Float(throughputMibps.(float64))
 	}
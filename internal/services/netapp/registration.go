@@ -33,6 +33,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_netapp_volume":          dataSourceNetAppVolume(),
 		"azurerm_netapp_snapshot":        dataSourceNetAppSnapshot(),
 		"azurerm_netapp_snapshot_policy": dataSourceNetAppSnapshotPolicy(),
+		"azurerm_netapp_snapshots":       dataSourceNetAppSnapshots(),
 	}
 }
 
@@ -0,0 +1,222 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePrivateDnsZoneGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsZoneGroupCreateUpdate,
+		Read:   resourcePrivateDnsZoneGroupRead,
+		Update: resourcePrivateDnsZoneGroupCreateUpdate,
+		Delete: resourcePrivateDnsZoneGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.PrivateDnsZoneGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateLinkName,
+			},
+
+			"private_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateEndpointID,
+			},
+
+			"private_dns_zone_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+				},
+			},
+
+			"private_dns_zone_configs": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"private_dns_zone_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"record_sets": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"fqdn": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"ttl": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+									"ip_addresses": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePrivateDnsZoneGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateEndpointId, err := parse.PrivateEndpointID(d.Get("private_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewPrivateDnsZoneGroupID(privateEndpointId.SubscriptionId, privateEndpointId.ResourceGroup, privateEndpointId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		// 1 Private Endpoint can have 1 Private DNS Zone Group, so any existing Private DNS Zone Group needs to be imported
+		existing, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, client, *privateEndpointId)
+		if err != nil {
+			return err
+		}
+		if existing != nil && len(*existing) > 0 {
+			return tf.ImportAsExistsError("azurerm_private_dns_zone_group", (*existing)[0].ID())
+		}
+	}
+
+	privateDnsZoneConfigs, err := expandPrivateDnsZoneConfigs(d.Get("private_dns_zone_ids").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.PrivateDNSZoneGroup{
+		Name: utils.String(id.Name),
+		PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: privateDnsZoneConfigs,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.PrivateEndpointName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePrivateDnsZoneGroupRead(d, meta)
+}
+
+func resourcePrivateDnsZoneGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PrivateDnsZoneGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	flattened, err := retrieveAndFlattenPrivateDnsZone(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if flattened == nil {
+		log.Printf("[INFO] %s does not exist - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("private_endpoint_id", parse.NewPrivateEndpointID(id.SubscriptionId, id.ResourceGroup, id.PrivateEndpointName).ID())
+	d.Set("private_dns_zone_ids", flattened.DnsZoneGroup["private_dns_zone_ids"])
+
+	if err := d.Set("private_dns_zone_configs", flattened.DnsZoneConfig); err != nil {
+		return fmt.Errorf("setting `private_dns_zone_configs`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsZoneGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.PrivateDnsZoneGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.PrivateEndpointName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
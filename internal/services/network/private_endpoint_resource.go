@@ -84,10 +84,18 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			"ignore_private_dns_zone_group": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"private_dns_zone_group"},
+			},
+
 			"private_dns_zone_group": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"ignore_private_dns_zone_group"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"id": {
@@ -392,7 +400,8 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	// 1 Private Endpoint can have 1 Private DNS Zone Group - so to update we need to Delete & Recreate
-	if d.HasChange("private_dns_zone_group") {
+	// when the Private DNS Zone Group is being ignored it's managed using the `azurerm_private_dns_zone_group` resource instead
+	if d.HasChange("private_dns_zone_group") && !d.Get("ignore_private_dns_zone_group").(bool) {
 		existingDnsZoneGroups, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, dnsClient, *id)
 		if err != nil {
 			return err
@@ -523,6 +532,11 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 	if err := d.Set("private_dns_zone_configs", privateDnsZoneConfigs); err != nil {
 		return fmt.Errorf("setting `private_dns_zone_configs`: %+v", err)
 	}
+	ignorePrivateDnsZoneGroup := d.Get("ignore_private_dns_zone_group").(bool)
+	if ignorePrivateDnsZoneGroup {
+		privateDnsZoneGroups = make([]interface{}, 0)
+	}
+	d.Set("ignore_private_dns_zone_group", ignorePrivateDnsZoneGroup)
 	if err := d.Set("private_dns_zone_group", privateDnsZoneGroups); err != nil {
 		return fmt.Errorf("setting `private_dns_zone_group`: %+v", err)
 	}
@@ -541,11 +555,13 @@ func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	log.Printf("[DEBUG] Deleting the Private DNS Zone Group associated with Private Endpoint %q / Resource Group %q..", id.Name, id.ResourceGroup)
-	if err := deletePrivateDnsZoneGroupForPrivateEndpoint(ctx, dnsZoneGroupsClient, *id); err != nil {
-		return err
+	if !d.Get("ignore_private_dns_zone_group").(bool) {
+		log.Printf("[DEBUG] Deleting the Private DNS Zone Group associated with Private Endpoint %q / Resource Group %q..", id.Name, id.ResourceGroup)
+		if err := deletePrivateDnsZoneGroupForPrivateEndpoint(ctx, dnsZoneGroupsClient, *id); err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Deleted the Private DNS Zone Group associated with Private Endpoint %q / Resource Group %q.", id.Name, id.ResourceGroup)
 	}
-	log.Printf("[DEBUG] Deleted the Private DNS Zone Group associated with Private Endpoint %q / Resource Group %q.", id.Name, id.ResourceGroup)
 
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
 	parameters := network.PrivateEndpoint{
@@ -756,28 +772,15 @@ func createPrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *ne
 	item := inputRaw[0].(map[string]interface{})
 
 	dnsGroupName := item["name"].(string)
-	privateDnsZoneIdsRaw := item["private_dns_zone_ids"].([]interface{})
-	privateDnsZoneConfigs := make([]network.PrivateDNSZoneConfig, 0)
-	for _, item := range privateDnsZoneIdsRaw {
-		v := item.(string)
-
-		privateDnsZone, err := privatezones.ParsePrivateDnsZoneID(v)
-		if err != nil {
-			return err
-		}
-
-		privateDnsZoneConfigs = append(privateDnsZoneConfigs, network.PrivateDNSZoneConfig{
-			Name: utils.String(privateDnsZone.PrivateZoneName),
-			PrivateDNSZonePropertiesFormat: &network.PrivateDNSZonePropertiesFormat{
-				PrivateDNSZoneID: utils.String(privateDnsZone.ID()),
-			},
-		})
+	privateDnsZoneConfigs, err := expandPrivateDnsZoneConfigs(item["private_dns_zone_ids"].([]interface{}))
+	if err != nil {
+		return err
 	}
 
 	parameters := network.PrivateDNSZoneGroup{
 		Name: utils.String(id.Name),
 		PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
-			PrivateDNSZoneConfigs: &privateDnsZoneConfigs,
+			PrivateDNSZoneConfigs: privateDnsZoneConfigs,
 		},
 	}
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, dnsGroupName, parameters)
@@ -791,6 +794,27 @@ func createPrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *ne
 	return nil
 }
 
+func expandPrivateDnsZoneConfigs(input []interface{}) (*[]network.PrivateDNSZoneConfig, error) {
+	privateDnsZoneConfigs := make([]network.PrivateDNSZoneConfig, 0)
+	for _, item := range input {
+		v := item.(string)
+
+		privateDnsZone, err := privatezones.ParsePrivateDnsZoneID(v)
+		if err != nil {
+			return nil, err
+		}
+
+		privateDnsZoneConfigs = append(privateDnsZoneConfigs, network.PrivateDNSZoneConfig{
+			Name: utils.String(privateDnsZone.PrivateZoneName),
+			PrivateDNSZonePropertiesFormat: &network.PrivateDNSZonePropertiesFormat{
+				PrivateDNSZoneID: utils.String(privateDnsZone.ID()),
+			},
+		})
+	}
+
+	return &privateDnsZoneConfigs, nil
+}
+
 func deletePrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId) error {
 	// lookup and delete the (should be, Single) Private DNS Zone Group associated with this Private Endpoint
 	privateDnsZoneIds, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, client, id)
@@ -0,0 +1,479 @@
+// Package privateendpoint provisions Private Endpoints through a `private_endpoint` block on the resource they connect
+// to. Whilst resources are otherwise modelled 1:1 with the Azure API, this block is an opt-in convenience where each
+// entry remains a single Private Endpoint (and its Private DNS Zone Group) whose lifecycle is bound to the parent - it's
+// created after and deleted before the parent, and connects to nothing else. Anything beyond this (for example manual
+// approval, multiple connections or custom IP configurations) should use the `azurerm_private_endpoint` resource.
+package privateendpoint
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	networkClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// privateDnsZoneGroupName is the name of the Private DNS Zone Group which is created on each Private Endpoint
+// provisioned through the `private_endpoint` block to register the A-Records in the Private DNS Zones.
+const privateDnsZoneGroupName = "default"
+
+// these match the names used to lock Virtual Networks and Subnets within the `network` package, which can't be imported
+// here since it'd introduce an import cycle
+const (
+	subnetResourceName         = "azurerm_subnet"
+	virtualNetworkResourceName = "azurerm_virtual_network"
+)
+
+// PrivateEndpoint is a Private Endpoint (and optionally the Private DNS Zone Group registering its A-Records) which is
+// provisioned alongside the resource it connects to, through the `private_endpoint` block.
+type PrivateEndpoint struct {
+	Name              string
+	SubnetId          string
+	SubresourceName   string
+	PrivateDnsZoneIds []string
+}
+
+// Schema returns the `private_endpoint` block for a resource, where `subresourceNames` are the Private Link
+// sub-resources (Group IDs) the resource supports.
+func Schema(subresourceNames []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.PrivateLinkName,
+				},
+
+				"subnet_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.SubnetID,
+				},
+
+				"subresource_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(subresourceNames, false),
+				},
+
+				"private_dns_zone_ids": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+					},
+				},
+
+				"id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"private_ip_address": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func Expand(input []interface{}) []PrivateEndpoint {
+	output := make([]PrivateEndpoint, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		output = append(output, PrivateEndpoint{
+			Name:              v["name"].(string),
+			SubnetId:          v["subnet_id"].(string),
+			SubresourceName:   v["subresource_name"].(string),
+			PrivateDnsZoneIds: *utils.ExpandStringSlice(v["private_dns_zone_ids"].([]interface{})),
+		})
+	}
+	return output
+}
+
+// CreateOrUpdate provisions the Private Endpoints connecting to `targetResourceId` in the Resource Group and Location
+// of the target resource, and the Private DNS Zone Group for each which has Private DNS Zones specified.
+func CreateOrUpdate(ctx context.Context, client *networkClient.Client, subscriptionId, resourceGroup, location, targetResourceId string, input []PrivateEndpoint, t map[string]interface{}) error {
+	for _, item := range input {
+		id := parse.NewPrivateEndpointID(subscriptionId, resourceGroup, item.Name)
+
+		if err := createOrUpdatePrivateEndpoint(ctx, client, id, location, targetResourceId, item, t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createOrUpdatePrivateEndpoint(ctx context.Context, client *networkClient.Client, id parse.PrivateEndpointId, location, targetResourceId string, item PrivateEndpoint, t map[string]interface{}) error {
+	subnetId, err := parse.SubnetIDInsensitively(item.SubnetId)
+	if err != nil {
+		return err
+	}
+
+	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
+	locks.ByName(subnetId.VirtualNetworkName, virtualNetworkResourceName)
+	defer locks.UnlockByName(subnetId.VirtualNetworkName, virtualNetworkResourceName)
+	locks.ByName(subnetId.Name, subnetResourceName)
+	defer locks.UnlockByName(subnetId.Name, subnetResourceName)
+
+	parameters := network.PrivateEndpoint{
+		Location: utils.String(azure.NormalizeLocation(location)),
+		PrivateEndpointProperties: &network.PrivateEndpointProperties{
+			PrivateLinkServiceConnections: &[]network.PrivateLinkServiceConnection{
+				{
+					Name: utils.String(item.Name),
+					PrivateLinkServiceConnectionProperties: &network.PrivateLinkServiceConnectionProperties{
+						PrivateLinkServiceID: utils.String(targetResourceId),
+						GroupIds:             &[]string{item.SubresourceName},
+					},
+				},
+			},
+			Subnet: &network.Subnet{
+				ID: utils.String(item.SubnetId),
+			},
+		},
+		Tags: tags.Expand(t),
+	}
+
+	log.Printf("[DEBUG] Creating/Updating %s for %q..", id, targetResourceId)
+	future, err := client.PrivateEndpointClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.PrivateEndpointClient.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	if len(item.PrivateDnsZoneIds) == 0 {
+		return deletePrivateDnsZoneGroup(ctx, client.PrivateDnsZoneGroupClient, id)
+	}
+
+	return createPrivateDnsZoneGroup(ctx, client.PrivateDnsZoneGroupClient, id, item.PrivateDnsZoneIds)
+}
+
+// Update deletes the Private Endpoints which have been removed from (or need to be recreated in) the `private_endpoint`
+// block and then creates or updates those which have been added or changed. Tags are only applied when a Private
+// Endpoint is (re)created, so that changing the Tags of the parent resource doesn't update every Private Endpoint.
+func Update(ctx context.Context, client *networkClient.Client, subscriptionId, resourceGroup, location, targetResourceId string, existing, desired []PrivateEndpoint, t map[string]interface{}) error {
+	if err := Delete(ctx, client, subscriptionId, resourceGroup, privateEndpointsToDelete(existing, desired)); err != nil {
+		return err
+	}
+
+	return CreateOrUpdate(ctx, client, subscriptionId, resourceGroup, location, targetResourceId, privateEndpointsToCreateOrUpdate(existing, desired), t)
+}
+
+// Flatten returns the `private_endpoint` block for the Private Endpoints in `input`, omitting any which no longer exist.
+func Flatten(ctx context.Context, client *networkClient.Client, subscriptionId, resourceGroup string, input []PrivateEndpoint) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	for _, item := range input {
+		id := parse.NewPrivateEndpointID(subscriptionId, resourceGroup, item.Name)
+
+		resp, err := client.PrivateEndpointClient.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] %s was not found - removing from `private_endpoint`", id)
+				continue
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		subnetId := ""
+		subresourceName := ""
+		privateIpAddress := ""
+		if props := resp.PrivateEndpointProperties; props != nil {
+			if props.Subnet != nil && props.Subnet.ID != nil {
+				subnetId = *props.Subnet.ID
+			}
+			if connections := props.PrivateLinkServiceConnections; connections != nil && len(*connections) > 0 {
+				if connProps := (*connections)[0].PrivateLinkServiceConnectionProperties; connProps != nil && connProps.GroupIds != nil && len(*connProps.GroupIds) > 0 {
+					subresourceName = (*connProps.GroupIds)[0]
+				}
+			}
+			// the Custom DNS Configs are empty when a Private DNS Zone Group is attached, so this is retrieved from the NIC
+			if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 {
+				if nicId := (*nics)[0].ID; nicId != nil && *nicId != "" {
+					privateIpAddress, err = retrievePrivateIpAddress(ctx, client.InterfacesClient, *nicId)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		privateDnsZoneIds, err := retrievePrivateDnsZoneIds(ctx, client.PrivateDnsZoneGroupClient, id)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                 id.Name,
+			"subnet_id":            subnetId,
+			"subresource_name":     subresourceName,
+			"private_dns_zone_ids": utils.FlattenStringSlice(&privateDnsZoneIds),
+			"id":                   id.ID(),
+			"private_ip_address":   privateIpAddress,
+		})
+	}
+
+	return output, nil
+}
+
+// Delete removes the Private Endpoints in `input`, together with their Private DNS Zone Group.
+func Delete(ctx context.Context, client *networkClient.Client, subscriptionId, resourceGroup string, input []PrivateEndpoint) error {
+	for _, item := range input {
+		id := parse.NewPrivateEndpointID(subscriptionId, resourceGroup, item.Name)
+
+		if err := deletePrivateEndpoint(ctx, client, id, item.SubnetId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Import returns the `private_endpoint` block for the Private Endpoints in the Resource Group of `targetResourceId`
+// which were provisioned through the `private_endpoint` block - that is, those with a single Private Link Service
+// Connection to `targetResourceId` which shares the name of the Private Endpoint.
+func Import(ctx context.Context, client *networkClient.Client, subscriptionId, resourceGroup, targetResourceId string) ([]interface{}, error) {
+	input := make([]PrivateEndpoint, 0)
+
+	iterator, err := client.PrivateEndpointClient.ListComplete(ctx, resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("listing Private Endpoints within Resource Group %q: %+v", resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.Name != nil && item.PrivateEndpointProperties != nil {
+			if connections := item.PrivateEndpointProperties.PrivateLinkServiceConnections; connections != nil && len(*connections) == 1 {
+				connection := (*connections)[0]
+				if connection.Name != nil && *connection.Name == *item.Name && connection.PrivateLinkServiceConnectionProperties != nil {
+					if serviceId := connection.PrivateLinkServiceConnectionProperties.PrivateLinkServiceID; serviceId != nil && strings.EqualFold(*serviceId, targetResourceId) {
+						input = append(input, PrivateEndpoint{
+							Name: *item.Name,
+						})
+					}
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("enumerating Private Endpoints within Resource Group %q: %+v", resourceGroup, err)
+		}
+	}
+
+	return Flatten(ctx, client, subscriptionId, resourceGroup, input)
+}
+
+func deletePrivateEndpoint(ctx context.Context, client *networkClient.Client, id parse.PrivateEndpointId, subnetId string) error {
+	// the Subnet isn't known when the `private_endpoint` block was only partially populated, in which case there's
+	// nothing to lock on
+	if subnetId != "" {
+		parsedSubnetId, err := parse.SubnetIDInsensitively(subnetId)
+		if err != nil {
+			return err
+		}
+
+		locks.ByName(parsedSubnetId.VirtualNetworkName, virtualNetworkResourceName)
+		defer locks.UnlockByName(parsedSubnetId.VirtualNetworkName, virtualNetworkResourceName)
+		locks.ByName(parsedSubnetId.Name, subnetResourceName)
+		defer locks.UnlockByName(parsedSubnetId.Name, subnetResourceName)
+	}
+
+	if err := deletePrivateDnsZoneGroup(ctx, client.PrivateDnsZoneGroupClient, id); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %s..", id)
+	future, err := client.PrivateEndpointClient.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.PrivateEndpointClient.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// privateEndpointsToDelete returns the Private Endpoints in `existing` which are either not present in `desired`, or
+// whose Subnet or Sub-Resource has changed - since these can't be updated in-place.
+func privateEndpointsToDelete(existing, desired []PrivateEndpoint) []PrivateEndpoint {
+	output := make([]PrivateEndpoint, 0)
+	for _, o := range existing {
+		keep := false
+		for _, n := range desired {
+			if strings.EqualFold(o.Name, n.Name) && strings.EqualFold(o.SubnetId, n.SubnetId) && strings.EqualFold(o.SubresourceName, n.SubresourceName) {
+				keep = true
+				break
+			}
+		}
+		if !keep {
+			output = append(output, o)
+		}
+	}
+	return output
+}
+
+// privateEndpointsToCreateOrUpdate returns the Private Endpoints in `desired` which either aren't present in `existing`
+// or whose configuration has changed.
+func privateEndpointsToCreateOrUpdate(existing, desired []PrivateEndpoint) []PrivateEndpoint {
+	output := make([]PrivateEndpoint, 0)
+	for _, n := range desired {
+		unchanged := false
+		for _, o := range existing {
+			if strings.EqualFold(o.Name, n.Name) && strings.EqualFold(o.SubnetId, n.SubnetId) && strings.EqualFold(o.SubresourceName, n.SubresourceName) && privateDnsZoneIdsMatch(o.PrivateDnsZoneIds, n.PrivateDnsZoneIds) {
+				unchanged = true
+				break
+			}
+		}
+		if !unchanged {
+			output = append(output, n)
+		}
+	}
+	return output
+}
+
+func privateDnsZoneIdsMatch(first, second []string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for i := range first {
+		if !strings.EqualFold(first[i], second[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func createPrivateDnsZoneGroup(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId, privateDnsZoneIds []string) error {
+	configs := make([]network.PrivateDNSZoneConfig, 0)
+	for _, v := range privateDnsZoneIds {
+		privateDnsZoneId, err := privatezones.ParsePrivateDnsZoneID(v)
+		if err != nil {
+			return err
+		}
+
+		configs = append(configs, network.PrivateDNSZoneConfig{
+			Name: utils.String(privateDnsZoneId.PrivateZoneName),
+			PrivateDNSZonePropertiesFormat: &network.PrivateDNSZonePropertiesFormat{
+				PrivateDNSZoneID: utils.String(privateDnsZoneId.ID()),
+			},
+		})
+	}
+
+	groupId := parse.NewPrivateDnsZoneGroupID(id.SubscriptionId, id.ResourceGroup, id.Name, privateDnsZoneGroupName)
+	parameters := network.PrivateDNSZoneGroup{
+		Name: utils.String(groupId.Name),
+		PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: &configs,
+		},
+	}
+
+	log.Printf("[DEBUG] Creating/Updating %s..", groupId)
+	future, err := client.CreateOrUpdate(ctx, groupId.ResourceGroup, groupId.PrivateEndpointName, groupId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", groupId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", groupId, err)
+	}
+
+	return nil
+}
+
+func deletePrivateDnsZoneGroup(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId) error {
+	groupId := parse.NewPrivateDnsZoneGroupID(id.SubscriptionId, id.ResourceGroup, id.Name, privateDnsZoneGroupName)
+
+	log.Printf("[DEBUG] Deleting %s..", groupId)
+	future, err := client.Delete(ctx, groupId.ResourceGroup, groupId.PrivateEndpointName, groupId.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", groupId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", groupId, err)
+		}
+	}
+
+	return nil
+}
+
+func retrievePrivateDnsZoneIds(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId) ([]string, error) {
+	output := make([]string, 0)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, privateDnsZoneGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return output, nil
+		}
+		return nil, fmt.Errorf("retrieving Private DNS Zone Group %q for %s: %+v", privateDnsZoneGroupName, id, err)
+	}
+
+	if props := resp.PrivateDNSZoneGroupPropertiesFormat; props != nil && props.PrivateDNSZoneConfigs != nil {
+		for _, config := range *props.PrivateDNSZoneConfigs {
+			if config.PrivateDNSZonePropertiesFormat == nil || config.PrivateDNSZonePropertiesFormat.PrivateDNSZoneID == nil {
+				continue
+			}
+			privateDnsZoneId, err := privatezones.ParsePrivateDnsZoneIDInsensitively(*config.PrivateDNSZonePropertiesFormat.PrivateDNSZoneID)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, privateDnsZoneId.ID())
+		}
+	}
+
+	return output, nil
+}
+
+func retrievePrivateIpAddress(ctx context.Context, client *network.InterfacesClient, networkInterfaceId string) (string, error) {
+	id, err := parse.NetworkInterfaceID(networkInterfaceId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil && len(*props.IPConfigurations) > 0 {
+		if configProps := (*props.IPConfigurations)[0].InterfaceIPConfigurationPropertiesFormat; configProps != nil && configProps.PrivateIPAddress != nil {
+			return *configProps.PrivateIPAddress, nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,116 @@
+package privateendpoint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrivateEndpointsToDelete(t *testing.T) {
+	subnetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	otherSubnetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet2"
+
+	blob := PrivateEndpoint{Name: "blob", SubnetId: subnetId, SubresourceName: "blob"}
+	file := PrivateEndpoint{Name: "file", SubnetId: subnetId, SubresourceName: "file"}
+
+	cases := []struct {
+		Existing []PrivateEndpoint
+		Desired  []PrivateEndpoint
+		Expected []PrivateEndpoint
+	}{
+		{
+			// unchanged
+			Existing: []PrivateEndpoint{blob, file},
+			Desired:  []PrivateEndpoint{blob, file},
+			Expected: []PrivateEndpoint{},
+		},
+		{
+			// removed
+			Existing: []PrivateEndpoint{blob, file},
+			Desired:  []PrivateEndpoint{blob},
+			Expected: []PrivateEndpoint{file},
+		},
+		{
+			// only the DNS Zones have changed, which can be updated in-place
+			Existing: []PrivateEndpoint{blob},
+			Desired:  []PrivateEndpoint{{Name: "blob", SubnetId: subnetId, SubresourceName: "blob", PrivateDnsZoneIds: []string{"zone"}}},
+			Expected: []PrivateEndpoint{},
+		},
+		{
+			// subnet changed
+			Existing: []PrivateEndpoint{blob},
+			Desired:  []PrivateEndpoint{{Name: "blob", SubnetId: otherSubnetId, SubresourceName: "blob"}},
+			Expected: []PrivateEndpoint{blob},
+		},
+		{
+			// sub-resource changed
+			Existing: []PrivateEndpoint{blob},
+			Desired:  []PrivateEndpoint{{Name: "blob", SubnetId: subnetId, SubresourceName: "dfs"}},
+			Expected: []PrivateEndpoint{blob},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %+v -> %+v", tc.Existing, tc.Desired)
+		actual := privateEndpointsToDelete(tc.Existing, tc.Desired)
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateEndpointsToCreateOrUpdate(t *testing.T) {
+	subnetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	otherSubnetId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet2"
+
+	blob := PrivateEndpoint{Name: "blob", SubnetId: subnetId, SubresourceName: "blob"}
+	file := PrivateEndpoint{Name: "file", SubnetId: subnetId, SubresourceName: "file"}
+	blobWithZone := PrivateEndpoint{Name: "blob", SubnetId: subnetId, SubresourceName: "blob", PrivateDnsZoneIds: []string{"zone"}}
+	blobInOtherSubnet := PrivateEndpoint{Name: "blob", SubnetId: otherSubnetId, SubresourceName: "blob"}
+
+	cases := []struct {
+		Existing []PrivateEndpoint
+		Desired  []PrivateEndpoint
+		Expected []PrivateEndpoint
+	}{
+		{
+			// unchanged
+			Existing: []PrivateEndpoint{blob, file},
+			Desired:  []PrivateEndpoint{blob, file},
+			Expected: []PrivateEndpoint{},
+		},
+		{
+			// added
+			Existing: []PrivateEndpoint{blob},
+			Desired:  []PrivateEndpoint{blob, file},
+			Expected: []PrivateEndpoint{file},
+		},
+		{
+			// removed
+			Existing: []PrivateEndpoint{blob, file},
+			Desired:  []PrivateEndpoint{blob},
+			Expected: []PrivateEndpoint{},
+		},
+		{
+			// DNS Zones changed
+			Existing: []PrivateEndpoint{blob},
+			Desired:  []PrivateEndpoint{blobWithZone},
+			Expected: []PrivateEndpoint{blobWithZone},
+		},
+		{
+			// subnet changed
+			Existing: []PrivateEndpoint{blob},
+			Desired:  []PrivateEndpoint{blobInOtherSubnet},
+			Expected: []PrivateEndpoint{blobInOtherSubnet},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %+v -> %+v", tc.Existing, tc.Desired)
+		actual := privateEndpointsToCreateOrUpdate(tc.Existing, tc.Desired)
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
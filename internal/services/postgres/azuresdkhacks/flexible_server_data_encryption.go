@@ -0,0 +1,165 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/servers"
+)
+
+// NOTE: this workaround client exists since `identity` and `dataEncryption` are only available from API Version
+// `2022-12-01` of the PostgreSQL Flexible Server API, whereas the PostgreSQL Flexible Server resource is built
+// against API Version `2021-06-01`. Once the resource has been migrated to a newer API Version this can be removed.
+
+const flexibleServerDataEncryptionAPIVersion = "2022-12-01"
+
+type FlexibleServerDataEncryptionClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFlexibleServerDataEncryptionClientWithBaseURI(endpoint string) FlexibleServerDataEncryptionClient {
+	return FlexibleServerDataEncryptionClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		baseUri: endpoint,
+	}
+}
+
+type DataEncryptionType string
+
+const (
+	DataEncryptionTypeAzureKeyVault DataEncryptionType = "AzureKeyVault"
+	DataEncryptionTypeSystemManaged DataEncryptionType = "SystemManaged"
+)
+
+type DataEncryption struct {
+	Type                            *DataEncryptionType `json:"type,omitempty"`
+	PrimaryKeyURI                   *string             `json:"primaryKeyURI,omitempty"`
+	PrimaryUserAssignedIdentityId   *string             `json:"primaryUserAssignedIdentityId,omitempty"`
+	GeoBackupKeyURI                 *string             `json:"geoBackupKeyURI,omitempty"`
+	GeoBackupUserAssignedIdentityId *string             `json:"geoBackupUserAssignedIdentityId,omitempty"`
+}
+
+type FlexibleServerDataEncryption struct {
+	Identity   *identity.UserAssignedMap               `json:"identity,omitempty"`
+	Properties *flexibleServerDataEncryptionProperties `json:"properties,omitempty"`
+}
+
+type flexibleServerDataEncryptionProperties struct {
+	DataEncryption *DataEncryption `json:"dataEncryption,omitempty"`
+}
+
+// DataEncryption returns the Data Encryption configured for the PostgreSQL Flexible Server, if any.
+func (s FlexibleServerDataEncryption) DataEncryption() *DataEncryption {
+	if s.Properties == nil {
+		return nil
+	}
+	return s.Properties.DataEncryption
+}
+
+// Get returns the Identity and Data Encryption for the PostgreSQL Flexible Server.
+func (c FlexibleServerDataEncryptionClient) Get(ctx context.Context, id servers.FlexibleServerId) (result FlexibleServerDataEncryption, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": flexibleServerDataEncryptionAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "servers.ServersClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "servers.ServersClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "servers.ServersClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Create sends the PostgreSQL Flexible Server with the `identity` and `dataEncryption` set using the newer API Version,
+// since Data Encryption using a Customer Managed Key can only be enabled when the PostgreSQL Flexible Server is created.
+func (c FlexibleServerDataEncryptionClient) Create(ctx context.Context, id servers.FlexibleServerId, input servers.Server, userAssignedIdentity *identity.UserAssignedMap, dataEncryption *DataEncryption) (result polling.LongRunningPoller, err error) {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return result, fmt.Errorf("marshaling %s: %+v", id, err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return result, fmt.Errorf("unmarshaling %s: %+v", id, err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	if dataEncryption != nil {
+		properties["dataEncryption"] = dataEncryption
+	}
+	body["properties"] = properties
+
+	if userAssignedIdentity != nil {
+		body["identity"] = userAssignedIdentity
+	}
+
+	return c.send(ctx, id, autorest.AsPut(), body, "Create")
+}
+
+// Update patches the `identity` and `dataEncryption` of the PostgreSQL Flexible Server, which allows the identities
+// and the version of the Customer Managed Key to be changed in-place.
+func (c FlexibleServerDataEncryptionClient) Update(ctx context.Context, id servers.FlexibleServerId, userAssignedIdentity *identity.UserAssignedMap, dataEncryption *DataEncryption) (result polling.LongRunningPoller, err error) {
+	body := map[string]interface{}{
+		"identity": userAssignedIdentity,
+	}
+	if dataEncryption != nil {
+		body["properties"] = map[string]interface{}{
+			"dataEncryption": dataEncryption,
+		}
+	}
+
+	return c.send(ctx, id, autorest.AsPatch(), body, "Update")
+}
+
+func (c FlexibleServerDataEncryptionClient) send(ctx context.Context, id servers.FlexibleServerId, method autorest.PrepareDecorator, body map[string]interface{}, operation string) (result polling.LongRunningPoller, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		method,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": flexibleServerDataEncryptionAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "servers.ServersClient", operation, nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "servers.ServersClient", operation, resp, "Failure sending request")
+	}
+
+	result, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "servers.ServersClient", operation, resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
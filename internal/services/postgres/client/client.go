@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/serverrestart"
 	flexibleservers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/virtualendpoints"
 )
 
 type Client struct {
@@ -23,6 +25,7 @@ type Client struct {
 	DatabasesClient                     *databases.DatabasesClient
 	FirewallRulesClient                 *firewallrules.FirewallRulesClient
 	FlexibleServersClient               *flexibleservers.ServersClient
+	FlexibleServerDataEncryptionClient  *azuresdkhacks.FlexibleServerDataEncryptionClient
 	FlexibleServersConfigurationsClient *flexibleserverconfigurations.ConfigurationsClient
 	FlexibleServerFirewallRuleClient    *flexibleserverfirewallrules.FirewallRulesClient
 	FlexibleServerDatabaseClient        *flexibleserverdatabases.DatabasesClient
@@ -33,6 +36,7 @@ type Client struct {
 	VirtualNetworkRulesClient           *virtualnetworkrules.VirtualNetworkRulesClient
 	ServerAdministratorsClient          *serveradministrators.ServerAdministratorsClient
 	ReplicasClient                      *replicas.ReplicasClient
+	VirtualEndpointsClient              *virtualendpoints.VirtualEndpointsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -66,6 +70,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServersClient := flexibleservers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&flexibleServersClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerDataEncryptionClient := azuresdkhacks.NewFlexibleServerDataEncryptionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerDataEncryptionClient.Client, o.ResourceManagerAuthorizer)
+
 	restartServerClient := serverrestart.NewServerRestartClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&restartServerClient.Client, o.ResourceManagerAuthorizer)
 
@@ -78,12 +85,16 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleServerConfigurationsClient := flexibleserverconfigurations.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&flexibleServerConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	virtualEndpointsClient := virtualendpoints.NewVirtualEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ConfigurationsClient:                &configurationsClient,
 		DatabasesClient:                     &databasesClient,
 		FirewallRulesClient:                 &firewallRulesClient,
 		FlexibleServersConfigurationsClient: &flexibleServerConfigurationsClient,
 		FlexibleServersClient:               &flexibleServersClient,
+		FlexibleServerDataEncryptionClient:  &flexibleServerDataEncryptionClient,
 		ServerRestartClient:                 &restartServerClient,
 		FlexibleServerFirewallRuleClient:    &flexibleServerFirewallRuleClient,
 		FlexibleServerDatabaseClient:        &flexibleServerDatabaseClient,
@@ -93,5 +104,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		VirtualNetworkRulesClient:           &virtualNetworkRulesClient,
 		ServerAdministratorsClient:          &serverAdministratorsClient,
 		ReplicasClient:                      &replicasClient,
+		VirtualEndpointsClient:              &virtualEndpointsClient,
 	}
 }
@@ -1,13 +1,16 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/serverrestart"
@@ -16,7 +19,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -206,6 +211,43 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				},
 			},
 
+			"identity": commonschema.UserAssignedIdentityOptional(),
+
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+						},
+
+						"primary_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+						},
+
+						"geo_backup_key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemId,
+							RequiredWith: []string{"customer_managed_key.0.geo_backup_user_assigned_identity_id"},
+						},
+
+						"geo_backup_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+							RequiredWith: []string{"customer_managed_key.0.geo_backup_key_vault_key_id"},
+						},
+					},
+				},
+			},
+
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -218,6 +260,14 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// Data Encryption using a Customer Managed Key can only be enabled (or disabled) when the server is
+			// created, however the keys and identities (e.g. rotating to a new key version) can be updated in-place
+			pluginsdk.ForceNewIfChange("customer_managed_key", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
+		),
 	}
 }
 
@@ -318,8 +368,36 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.PointInTimeUTC = utils.String(v.String())
 	}
 
-	if err = client.CreateThenPoll(ctx, id, parameters); err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
+	userAssignedIdentity, err := identity.ExpandUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	dataEncryption := expandFlexibleServerDataEncryption(d.Get("customer_managed_key").([]interface{}))
+	if dataEncryption != nil {
+		if userAssignedIdentity.Type != identity.TypeUserAssigned {
+			return fmt.Errorf("`identity` is required when `customer_managed_key` is specified")
+		}
+		if dataEncryption.GeoBackupKeyURI != nil && !d.Get("geo_redundant_backup_enabled").(bool) {
+			return fmt.Errorf("`geo_redundant_backup_enabled` must be `true` when `customer_managed_key.0.geo_backup_key_vault_key_id` is specified")
+		}
+	}
+
+	if userAssignedIdentity.Type == identity.TypeUserAssigned {
+		// the `identity` and `customer_managed_key` are only available in a newer API Version, as such these are
+		// sent using a workaround client when specified
+		dataEncryptionClient := meta.(*clients.Client).Postgres.FlexibleServerDataEncryptionClient
+		poller, err := dataEncryptionClient.Create(ctx, id, parameters, userAssignedIdentity, dataEncryption)
+		if err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+		if err := poller.PollUntilDone(); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		}
+	} else {
+		if err = client.CreateThenPoll(ctx, id, parameters); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
 	}
 
 	// `maintenance_window` could only be updated with, could not be created with
@@ -409,6 +487,24 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 			return fmt.Errorf("flattening `sku_name` for %s: %v", id, err)
 		}
 
+		dataEncryptionClient := meta.(*clients.Client).Postgres.FlexibleServerDataEncryptionClient
+		dataEncryption, err := dataEncryptionClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving the Identity and Data Encryption for %s: %+v", id, err)
+		}
+
+		flattenedIdentity, err := identity.FlattenUserAssignedMap(dataEncryption.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if err := d.Set("customer_managed_key", flattenFlexibleServerDataEncryption(dataEncryption.DataEncryption())); err != nil {
+			return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+		}
+
 		d.Set("sku_name", sku)
 
 		return tags.FlattenAndSet(d, model.Tags)
@@ -511,6 +607,27 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
+	if d.HasChanges("identity", "customer_managed_key") {
+		userAssignedIdentity, err := identity.ExpandUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+
+		dataEncryption := expandFlexibleServerDataEncryption(d.Get("customer_managed_key").([]interface{}))
+		if dataEncryption != nil && userAssignedIdentity.Type != identity.TypeUserAssigned {
+			return fmt.Errorf("`identity` is required when `customer_managed_key` is specified")
+		}
+
+		dataEncryptionClient := meta.(*clients.Client).Postgres.FlexibleServerDataEncryptionClient
+		poller, err := dataEncryptionClient.Update(ctx, *id, userAssignedIdentity, dataEncryption)
+		if err != nil {
+			return fmt.Errorf("updating the Identity and Data Encryption for %s: %+v", id, err)
+		}
+		if err := poller.PollUntilDone(); err != nil {
+			return fmt.Errorf("waiting for the update of the Identity and Data Encryption for %s: %+v", id, err)
+		}
+	}
+
 	if requireFailover {
 		restartClient := meta.(*clients.Client).Postgres.ServerRestartClient
 
@@ -717,3 +834,60 @@ func flattenFlexibleServerHighAvailability(ha *servers.HighAvailability) []inter
 		},
 	}
 }
+
+func expandFlexibleServerDataEncryption(input []interface{}) *azuresdkhacks.DataEncryption {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	encryptionType := azuresdkhacks.DataEncryptionTypeAzureKeyVault
+	dataEncryption := azuresdkhacks.DataEncryption{
+		Type:                          &encryptionType,
+		PrimaryKeyURI:                 utils.String(v["key_vault_key_id"].(string)),
+		PrimaryUserAssignedIdentityId: utils.String(v["primary_user_assigned_identity_id"].(string)),
+	}
+
+	if geoBackupKeyId := v["geo_backup_key_vault_key_id"].(string); geoBackupKeyId != "" {
+		dataEncryption.GeoBackupKeyURI = utils.String(geoBackupKeyId)
+	}
+
+	if geoBackupIdentityId := v["geo_backup_user_assigned_identity_id"].(string); geoBackupIdentityId != "" {
+		dataEncryption.GeoBackupUserAssignedIdentityId = utils.String(geoBackupIdentityId)
+	}
+
+	return &dataEncryption
+}
+
+func flattenFlexibleServerDataEncryption(input *azuresdkhacks.DataEncryption) []interface{} {
+	if input == nil || input.Type == nil || *input.Type != azuresdkhacks.DataEncryptionTypeAzureKeyVault {
+		return []interface{}{}
+	}
+
+	var primaryKeyId, primaryIdentityId, geoBackupKeyId, geoBackupIdentityId string
+	if input.PrimaryKeyURI != nil {
+		primaryKeyId = *input.PrimaryKeyURI
+	}
+	if input.PrimaryUserAssignedIdentityId != nil {
+		if id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.PrimaryUserAssignedIdentityId); err == nil {
+			primaryIdentityId = id.ID()
+		}
+	}
+	if input.GeoBackupKeyURI != nil {
+		geoBackupKeyId = *input.GeoBackupKeyURI
+	}
+	if input.GeoBackupUserAssignedIdentityId != nil {
+		if id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.GeoBackupUserAssignedIdentityId); err == nil {
+			geoBackupIdentityId = id.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":                     primaryKeyId,
+			"primary_user_assigned_identity_id":    primaryIdentityId,
+			"geo_backup_key_vault_key_id":          geoBackupKeyId,
+			"geo_backup_user_assigned_identity_id": geoBackupIdentityId,
+		},
+	}
+}
@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/virtualendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PostgresqlFlexibleServerVirtualEndpointModel struct {
+	Name            string   `tfschema:"name"`
+	SourceServerId  string   `tfschema:"source_server_id"`
+	ReplicaServerId string   `tfschema:"replica_server_id"`
+	Type            string   `tfschema:"type"`
+	Fqdns           []string `tfschema:"fqdns"`
+}
+
+var _ sdk.ResourceWithUpdate = PostgresqlFlexibleServerVirtualEndpointResource{}
+
+type PostgresqlFlexibleServerVirtualEndpointResource struct{}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) ResourceType() string {
+	return "azurerm_postgresql_flexible_server_virtual_endpoint"
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) ModelObject() interface{} {
+	return &PostgresqlFlexibleServerVirtualEndpointModel{}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualendpoints.ValidateVirtualEndpointID
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FlexibleServerName,
+		},
+
+		"source_server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: servers.ValidateFlexibleServerID,
+		},
+
+		"replica_server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: servers.ValidateFlexibleServerID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(virtualendpoints.VirtualEndpointTypeReadWrite),
+			ValidateFunc: validation.StringInSlice(virtualendpoints.PossibleValuesForVirtualEndpointType(), false),
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdns": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.VirtualEndpointsClient
+
+			var model PostgresqlFlexibleServerVirtualEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sourceServerId, err := servers.ParseFlexibleServerID(model.SourceServerId)
+			if err != nil {
+				return err
+			}
+
+			replicaServerId, err := servers.ParseFlexibleServerID(model.ReplicaServerId)
+			if err != nil {
+				return err
+			}
+
+			id := virtualendpoints.NewVirtualEndpointID(sourceServerId.SubscriptionId, sourceServerId.ResourceGroupName, sourceServerId.ServerName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			endpointType := virtualendpoints.VirtualEndpointType(model.Type)
+			parameters := virtualendpoints.VirtualEndpointResource{
+				Properties: &virtualendpoints.VirtualEndpointResourceProperties{
+					EndpointType: &endpointType,
+					Members:      &[]string{replicaServerId.ServerName},
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.VirtualEndpointsClient
+
+			id, err := virtualendpoints.ParseVirtualEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config PostgresqlFlexibleServerVirtualEndpointModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sourceServerId := servers.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+			state := PostgresqlFlexibleServerVirtualEndpointModel{
+				Name:           id.VirtualEndpointName,
+				SourceServerId: sourceServerId.ID(),
+				Type:           string(virtualendpoints.VirtualEndpointTypeReadWrite),
+				Fqdns:          make([]string, 0),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.EndpointType != nil {
+						state.Type = string(*props.EndpointType)
+					}
+
+					if props.Members != nil {
+						state.ReplicaServerId = flattenPostgresqlFlexibleServerVirtualEndpointReplica(*props.Members, sourceServerId, config.ReplicaServerId)
+					}
+
+					if props.VirtualEndpoints != nil {
+						state.Fqdns = *props.VirtualEndpoints
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.VirtualEndpointsClient
+
+			id, err := virtualendpoints.ParseVirtualEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PostgresqlFlexibleServerVirtualEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			replicaServerId, err := servers.ParseFlexibleServerID(model.ReplicaServerId)
+			if err != nil {
+				return err
+			}
+
+			endpointType := virtualendpoints.VirtualEndpointType(model.Type)
+			parameters := virtualendpoints.VirtualEndpointResourceForPatch{
+				Properties: &virtualendpoints.VirtualEndpointResourceProperties{
+					EndpointType: &endpointType,
+					Members:      &[]string{replicaServerId.ServerName},
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Postgres.VirtualEndpointsClient
+
+			id, err := virtualendpoints.ParseVirtualEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// flattenPostgresqlFlexibleServerVirtualEndpointReplica returns the ID of the replica from the members of the Virtual
+// Endpoint, which contain the names of both the source and the replica PostgreSQL Flexible Servers
+func flattenPostgresqlFlexibleServerVirtualEndpointReplica(members []string, sourceServerId servers.FlexibleServerId, configuredReplicaServerId string) string {
+	for _, member := range members {
+		if strings.EqualFold(member, sourceServerId.ServerName) {
+			continue
+		}
+
+		// the members only contain the name of the PostgreSQL Flexible Server, so the configured ID is used when the
+		// name matches since the replica can be in a different Resource Group to the source server
+		if configuredId, err := servers.ParseFlexibleServerID(configuredReplicaServerId); err == nil && strings.EqualFold(configuredId.ServerName, member) {
+			return configuredId.ID()
+		}
+
+		return servers.NewFlexibleServerID(sourceServerId.SubscriptionId, sourceServerId.ResourceGroupName, member).ID()
+	}
+
+	return ""
+}
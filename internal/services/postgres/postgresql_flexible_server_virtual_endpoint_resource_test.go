@@ -0,0 +1,105 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2023-06-01-preview/virtualendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// NOTE: Read Replicas can't currently be provisioned using the `azurerm_postgresql_flexible_server` resource, as such
+// these tests require an existing PostgreSQL Flexible Server and an existing Read Replica of it to be specified.
+
+type PostgresqlFlexibleServerVirtualEndpointResource struct{}
+
+func TestAccPostgresqlFlexibleServerVirtualEndpoint_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_POSTGRES_FLEXIBLE_SERVER_ID") == "" || os.Getenv("ARM_TEST_POSTGRES_FLEXIBLE_SERVER_REPLICA_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_POSTGRES_FLEXIBLE_SERVER_ID` and/or `ARM_TEST_POSTGRES_FLEXIBLE_SERVER_REPLICA_ID` are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_virtual_endpoint", "test")
+	r := PostgresqlFlexibleServerVirtualEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdns.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPostgresqlFlexibleServerVirtualEndpoint_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_POSTGRES_FLEXIBLE_SERVER_ID") == "" || os.Getenv("ARM_TEST_POSTGRES_FLEXIBLE_SERVER_REPLICA_ID") == "" {
+		t.Skip("Skipping as `ARM_TEST_POSTGRES_FLEXIBLE_SERVER_ID` and/or `ARM_TEST_POSTGRES_FLEXIBLE_SERVER_REPLICA_ID` are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_virtual_endpoint", "test")
+	r := PostgresqlFlexibleServerVirtualEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (PostgresqlFlexibleServerVirtualEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualendpoints.ParseVirtualEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Postgres.VirtualEndpointsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (PostgresqlFlexibleServerVirtualEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_postgresql_flexible_server_virtual_endpoint" "test" {
+  name              = "acctest-ve-%d"
+  source_server_id  = "%s"
+  replica_server_id = "%s"
+  type              = "ReadWrite"
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_POSTGRES_FLEXIBLE_SERVER_ID"), os.Getenv("ARM_TEST_POSTGRES_FLEXIBLE_SERVER_REPLICA_ID"))
+}
+
+func (r PostgresqlFlexibleServerVirtualEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server_virtual_endpoint" "import" {
+  name              = azurerm_postgresql_flexible_server_virtual_endpoint.test.name
+  source_server_id  = azurerm_postgresql_flexible_server_virtual_endpoint.test.source_server_id
+  replica_server_id = azurerm_postgresql_flexible_server_virtual_endpoint.test.replica_server_id
+  type              = azurerm_postgresql_flexible_server_virtual_endpoint.test.type
+}
+`, r.basic(data))
+}
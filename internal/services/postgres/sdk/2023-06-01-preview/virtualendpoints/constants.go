@@ -0,0 +1,28 @@
+package virtualendpoints
+
+import "strings"
+
+type VirtualEndpointType string
+
+const (
+	VirtualEndpointTypeReadWrite VirtualEndpointType = "ReadWrite"
+)
+
+func PossibleValuesForVirtualEndpointType() []string {
+	return []string{
+		string(VirtualEndpointTypeReadWrite),
+	}
+}
+
+func parseVirtualEndpointType(input string) (*VirtualEndpointType, error) {
+	vals := map[string]VirtualEndpointType{
+		"readwrite": VirtualEndpointTypeReadWrite,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VirtualEndpointType(input)
+	return &out, nil
+}
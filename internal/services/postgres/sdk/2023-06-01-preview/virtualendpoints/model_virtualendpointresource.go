@@ -0,0 +1,13 @@
+package virtualendpoints
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type VirtualEndpointResource struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *VirtualEndpointResourceProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData             `json:"systemData,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
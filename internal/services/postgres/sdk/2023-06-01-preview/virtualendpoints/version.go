@@ -0,0 +1,9 @@
+package virtualendpoints
+
+import "fmt"
+
+const defaultApiVersion = "2023-06-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/virtualendpoints/%s", defaultApiVersion)
+}
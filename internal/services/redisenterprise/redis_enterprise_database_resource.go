@@ -468,6 +468,21 @@ func resourceRedisEnterpriseDatabaseDelete(d *pluginsdk.ResourceData, meta inter
 	dbId := databases.NewDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, id.DatabaseName)
 	clusterId := redisenterprise.NewRedisEnterpriseID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName)
 
+	// a Database which is a part of a Geo-Replication group is force-unlinked from the remaining members of the group
+	// prior to deletion, otherwise the remaining Databases continue to reference it once it's been removed
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.GeoReplication != nil {
+		if err := forceUnlinkDatabaseFromGeoReplicationGroup(ctx, client, *id, model.Properties.GeoReplication.LinkedDatabases); err != nil {
+			return err
+		}
+	}
+
 	if _, err := client.Delete(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
@@ -650,6 +665,47 @@ func forceUnlinkDatabase(d *pluginsdk.ResourceData, meta interface{}, unlinkedDb
 	return nil
 }
 
+func forceUnlinkDatabaseFromGeoReplicationGroup(ctx context.Context, client *databases.DatabasesClient, id databases.DatabaseId, linkedDatabases *[]databases.LinkedDatabase) error {
+	if linkedDatabases == nil {
+		return nil
+	}
+
+	for _, item := range *linkedDatabases {
+		if item.Id == nil {
+			continue
+		}
+
+		linkedId, err := databases.ParseDatabaseIDInsensitively(*item.Id)
+		if err != nil {
+			return fmt.Errorf("parsing linked database ID %q: %+v", *item.Id, err)
+		}
+		if strings.EqualFold(linkedId.ID(), id.ID()) {
+			continue
+		}
+
+		// the unlink is performed by any of the remaining members of the group, so if this one no longer exists try the next
+		existing, err := client.Get(ctx, *linkedId)
+		if err != nil {
+			if response.WasNotFound(existing.HttpResponse) {
+				continue
+			}
+			return fmt.Errorf("retrieving linked %s: %+v", *linkedId, err)
+		}
+
+		log.Printf("[DEBUG] Force unlinking %s from %s..", id, *linkedId)
+		parameters := databases.ForceUnlinkParameters{
+			Ids: []string{id.ID()},
+		}
+		if err := client.ForceUnlinkThenPoll(ctx, *linkedId, parameters); err != nil {
+			return fmt.Errorf("force unlinking %s from %s: %+v", id, *linkedId, err)
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
 // Persistence is currently preview and does not return from the RP but will be fully supported in the near future
 // func flattenArmDatabasePersistence(input *redisenterprise.Persistence) []interface{} {
 // 	if input == nil {
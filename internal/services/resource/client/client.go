@@ -68,6 +68,20 @@ func NewClient(o *common.ClientOptions) *Client {
 	}
 }
 
+// GroupsClientForSubscription returns a Resource Groups client for the specified Subscription, which allows Resource Groups
+// to be managed in a Subscription other than the one the Provider is configured for
+func (c Client) GroupsClientForSubscription(subscriptionID string) *resources.GroupsClient {
+	groupsClient := resources.NewGroupsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&groupsClient.Client, c.options.ResourceManagerAuthorizer)
+	return &groupsClient
+}
+
+func (c Client) ResourcesClientForSubscription(subscriptionID string) *resources.Client {
+	resourcesClient := resources.NewClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
+	c.options.ConfigureClient(&resourcesClient.Client, c.options.ResourceManagerAuthorizer)
+	return &resourcesClient
+}
+
 func (c Client) TagsClientForSubscription(subscriptionID string) *resources.TagsClient {
 	tagsClient := resources.NewTagsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
 	c.options.ConfigureClient(&tagsClient.Client, c.options.ResourceManagerAuthorizer)
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -42,13 +43,25 @@ func resourceResourceGroup() *pluginsdk.Resource {
 
 			"location": azure.SchemaLocation(),
 
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
 }
 
 func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	if v := d.Get("subscription_id").(string); v != "" {
+		subscriptionId = v
+	}
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(subscriptionId)
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -92,7 +105,6 @@ func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface
 }
 
 func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -101,6 +113,8 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(id.SubscriptionId)
+
 	resp, err := client.Get(ctx, id.ResourceGroup)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -114,11 +128,11 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("subscription_id", id.SubscriptionId)
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Resource.GroupsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -127,9 +141,11 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	client := meta.(*clients.Client).Resource.GroupsClientForSubscription(id.SubscriptionId)
+
 	// conditionally check for nested resources and error if they exist
 	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
-		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
+		resourceClient := meta.(*clients.Client).Resource.ResourcesClientForSubscription(id.SubscriptionId)
 		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this check to account for that eventual consistency.
 		err = pluginsdk.Retry(10*time.Minute, func() *pluginsdk.RetryError {
 			results, err := resourceClient.ListByResourceGroupComplete(ctx, id.ResourceGroup, "", "provisioningState", utils.Int32(500))
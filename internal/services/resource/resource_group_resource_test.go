@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -73,6 +74,55 @@ func TestAccResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_defaultTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	assert := check.That(data.ResourceName)
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.defaultTagsConfig(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("1"),
+				assert.Key("tags.cost_center").HasValue("MSFT"),
+				assert.Key("tags_all.%").HasValue("2"),
+				assert.Key("tags_all.environment").HasValue("Production"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: testResource.defaultTagsConfig(data, "staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				assert.ExistsInAzure(testResource),
+				assert.Key("tags.%").HasValue("1"),
+				assert.Key("tags_all.%").HasValue("2"),
+				assert.Key("tags_all.environment").HasValue("staging"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroup_subscriptionId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	testResource := ResourceGroupResource{}
+
+	if data.Client().SubscriptionIDAlt == "" {
+		t.Skip("Skipping since ARM_SUBSCRIPTION_ID_ALT is not set")
+	}
+
+	data.ResourceTest(t, testResource, []acceptance.TestStep{
+		{
+			Config: testResource.subscriptionIdConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(testResource),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(data.Client().SubscriptionIDAlt),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
 	r := ResourceGroupResource{}
@@ -102,28 +152,34 @@ func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 }
 
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	resourceGroup := state.Attributes["name"]
+	id, err := parse.ResourceGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
 
-	groupsClient := client.Resource.GroupsClient
-	deleteFuture, err := groupsClient.Delete(ctx, resourceGroup, "Microsoft.Compute/virtualMachines,Microsoft.Compute/virtualMachineScaleSets")
+	groupsClient := client.Resource.GroupsClientForSubscription(id.SubscriptionId)
+	deleteFuture, err := groupsClient.Delete(ctx, id.ResourceGroup, "Microsoft.Compute/virtualMachines,Microsoft.Compute/virtualMachineScaleSets")
 	if err != nil {
-		return nil, fmt.Errorf("deleting Resource Group %q: %+v", resourceGroup, err)
+		return nil, fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	err = deleteFuture.WaitForCompletionRef(ctx, groupsClient.Client)
 	if err != nil {
-		return nil, fmt.Errorf("waiting for deletion of Resource Group %q: %+v", resourceGroup, err)
+		return nil, fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
 	}
 
 	return utils.Bool(true), nil
 }
 
 func (t ResourceGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	name := state.Attributes["name"]
+	id, err := parse.ResourceGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := client.Resource.GroupsClient.Get(ctx, name)
+	resp, err := client.Resource.GroupsClientForSubscription(id.SubscriptionId).Get(ctx, id.ResourceGroup)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving Resource Group %q: %+v", name, err)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	return utils.Bool(resp.Properties != nil), nil
@@ -182,6 +238,20 @@ resource "azurerm_resource_group" "import" {
 `, template)
 }
 
+func (t ResourceGroupResource) subscriptionIdConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name            = "acctestRG-%d"
+  location        = "%s"
+  subscription_id = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.Client().SubscriptionIDAlt)
+}
+
 func (t ResourceGroupResource) withFeatureFlag(data acceptance.TestData, featureFlagEnabled bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -233,3 +303,26 @@ resource "azurerm_resource_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (t ResourceGroupResource) defaultTagsConfig(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+
+  default_tags {
+    tags = {
+      environment = "%s"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags = {
+    cost_center = "MSFT"
+  }
+}
+`, environment, data.RandomInteger, data.Locations.Primary)
+}
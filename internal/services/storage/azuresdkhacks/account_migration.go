@@ -0,0 +1,125 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+)
+
+// NOTE: Customer-Initiated Migrations (used to change the zone-redundancy of a Storage Account) are only available from
+// API Version `2023-01-01` of the Storage API, whereas the Storage resources are built against API Version `2021-04-01`.
+// This workaround client can be removed once the Storage resources have been migrated to a newer API Version.
+
+const accountMigrationAPIVersion = "2023-01-01"
+
+type AccountMigrationClient struct {
+	client *storage.AccountsClient
+}
+
+func NewAccountMigrationClient(client storage.AccountsClient) AccountMigrationClient {
+	return AccountMigrationClient{
+		client: &client,
+	}
+}
+
+type AccountMigrationStatus string
+
+const (
+	AccountMigrationStatusComplete               AccountMigrationStatus = "Complete"
+	AccountMigrationStatusFailed                 AccountMigrationStatus = "Failed"
+	AccountMigrationStatusInProgress             AccountMigrationStatus = "InProgress"
+	AccountMigrationStatusInvalid                AccountMigrationStatus = "Invalid"
+	AccountMigrationStatusSubmittedForConversion AccountMigrationStatus = "SubmittedForConversion"
+)
+
+type AccountMigration struct {
+	autorest.Response `json:"-"`
+	Properties        *AccountMigrationProperties `json:"properties,omitempty"`
+}
+
+type AccountMigrationProperties struct {
+	TargetSkuName                 *string                 `json:"targetSkuName,omitempty"`
+	MigrationStatus               *AccountMigrationStatus `json:"migrationStatus,omitempty"`
+	MigrationFailedReason         *string                 `json:"migrationFailedReason,omitempty"`
+	MigrationFailedDetailedReason *string                 `json:"migrationFailedDetailedReason,omitempty"`
+}
+
+// StartAccountMigration starts a Customer-Initiated Migration of the specified Storage Account to the specified Sku
+func (c AccountMigrationClient) StartAccountMigration(ctx context.Context, id parse.StorageAccountId, targetSkuName string) error {
+	payload := AccountMigration{
+		Properties: &AccountMigrationProperties{
+			TargetSkuName: &targetSkuName,
+		},
+	}
+
+	req, err := c.prepare(ctx, fmt.Sprintf("%s/startAccountMigration", id.ID()), autorest.AsPost(), autorest.WithJSON(payload))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.AccountMigrationClient", "StartAccountMigration", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.AccountMigrationClient", "StartAccountMigration", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.AccountMigrationClient", "StartAccountMigration", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// GetAccountMigration returns the status of the Customer-Initiated Migration for the specified Storage Account
+func (c AccountMigrationClient) GetAccountMigration(ctx context.Context, id parse.StorageAccountId) (result AccountMigration, err error) {
+	req, err := c.prepare(ctx, fmt.Sprintf("%s/accountMigrations/default", id.ID()), autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountMigrationClient", "GetAccountMigration", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.AccountMigrationClient", "GetAccountMigration", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.AccountMigrationClient", "GetAccountMigration", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c AccountMigrationClient) prepare(ctx context.Context, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": accountMigrationAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+	}, decorators...)
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.client.BaseURI),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters))
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,135 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+)
+
+// NOTE: the `excludePrefix` and `includeDeleted` filters for Blob Inventory Policy Rules are only available from API
+// Version `2021-09-01` of the Storage API, whereas the Storage resources are built against API Version `2021-04-01`.
+// This workaround client can be removed once the Storage resources have been migrated to a newer API Version.
+
+const blobInventoryPolicyAPIVersion = "2021-09-01"
+
+type BlobInventoryPoliciesClient struct {
+	client *storage.BlobInventoryPoliciesClient
+}
+
+func NewBlobInventoryPoliciesClient(client storage.BlobInventoryPoliciesClient) BlobInventoryPoliciesClient {
+	return BlobInventoryPoliciesClient{
+		client: &client,
+	}
+}
+
+type BlobInventoryPolicy struct {
+	autorest.Response `json:"-"`
+	Properties        *BlobInventoryPolicyProperties `json:"properties,omitempty"`
+}
+
+type BlobInventoryPolicyProperties struct {
+	Policy *BlobInventoryPolicySchema `json:"policy,omitempty"`
+}
+
+type BlobInventoryPolicySchema struct {
+	Enabled *bool                      `json:"enabled,omitempty"`
+	Type    *string                    `json:"type,omitempty"`
+	Rules   *[]BlobInventoryPolicyRule `json:"rules,omitempty"`
+}
+
+type BlobInventoryPolicyRule struct {
+	Enabled     *bool                          `json:"enabled,omitempty"`
+	Name        *string                        `json:"name,omitempty"`
+	Destination *string                        `json:"destination,omitempty"`
+	Definition  *BlobInventoryPolicyDefinition `json:"definition,omitempty"`
+}
+
+type BlobInventoryPolicyDefinition struct {
+	Filters      *BlobInventoryPolicyFilter `json:"filters,omitempty"`
+	Format       storage.Format             `json:"format,omitempty"`
+	Schedule     storage.Schedule           `json:"schedule,omitempty"`
+	ObjectType   storage.ObjectType         `json:"objectType,omitempty"`
+	SchemaFields *[]string                  `json:"schemaFields,omitempty"`
+}
+
+type BlobInventoryPolicyFilter struct {
+	PrefixMatch         *[]string `json:"prefixMatch,omitempty"`
+	ExcludePrefix       *[]string `json:"excludePrefix,omitempty"`
+	BlobTypes           *[]string `json:"blobTypes,omitempty"`
+	IncludeBlobVersions *bool     `json:"includeBlobVersions,omitempty"`
+	IncludeSnapshots    *bool     `json:"includeSnapshots,omitempty"`
+	IncludeDeleted      *bool     `json:"includeDeleted,omitempty"`
+}
+
+// Get returns the specified Blob Inventory Policy
+func (c BlobInventoryPoliciesClient) Get(ctx context.Context, id parse.BlobInventoryPolicyId) (result BlobInventoryPolicy, err error) {
+	req, err := c.prepare(ctx, id.ID(), autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.BlobInventoryPoliciesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.BlobInventoryPoliciesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.BlobInventoryPoliciesClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdate creates or updates the specified Blob Inventory Policy
+func (c BlobInventoryPoliciesClient) CreateOrUpdate(ctx context.Context, id parse.BlobInventoryPolicyId, input BlobInventoryPolicy) error {
+	req, err := c.prepare(ctx, id.ID(), autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.BlobInventoryPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.BlobInventoryPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.BlobInventoryPoliciesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func (c BlobInventoryPoliciesClient) prepare(ctx context.Context, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": blobInventoryPolicyAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+	}, decorators...)
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.client.BaseURI),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters))
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,207 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+)
+
+// NOTE: Account-Level Immutability and the migration of a Container to Version-Level Immutability are only available
+// from API Version `2021-06-01` of the Storage API, as such these use the same (newer) API Version as the SFTP
+// workaround clients. These can be removed once the Storage resources have been migrated to a newer API Version.
+
+type ImmutabilityClient struct {
+	client *storage.AccountsClient
+}
+
+func NewImmutabilityClient(client storage.AccountsClient) ImmutabilityClient {
+	return ImmutabilityClient{
+		client: &client,
+	}
+}
+
+type AccountImmutabilityPolicyState string
+
+const (
+	AccountImmutabilityPolicyStateDisabled AccountImmutabilityPolicyState = "Disabled"
+	AccountImmutabilityPolicyStateLocked   AccountImmutabilityPolicyState = "Locked"
+	AccountImmutabilityPolicyStateUnlocked AccountImmutabilityPolicyState = "Unlocked"
+)
+
+func PossibleValuesForAccountImmutabilityPolicyState() []string {
+	return []string{
+		string(AccountImmutabilityPolicyStateDisabled),
+		string(AccountImmutabilityPolicyStateLocked),
+		string(AccountImmutabilityPolicyStateUnlocked),
+	}
+}
+
+type AccountImmutabilityPolicy struct {
+	AllowProtectedAppendWrites            *bool                           `json:"allowProtectedAppendWrites,omitempty"`
+	ImmutabilityPeriodSinceCreationInDays *int32                          `json:"immutabilityPeriodSinceCreationInDays,omitempty"`
+	State                                 *AccountImmutabilityPolicyState `json:"state,omitempty"`
+}
+
+type immutableStorageAccount struct {
+	Enabled            *bool                      `json:"enabled,omitempty"`
+	ImmutabilityPolicy *AccountImmutabilityPolicy `json:"immutabilityPolicy,omitempty"`
+}
+
+type immutabilityAccount struct {
+	autorest.Response `json:"-"`
+	Properties        *immutabilityAccountProperties `json:"properties,omitempty"`
+}
+
+type immutabilityAccountProperties struct {
+	ImmutableStorageWithVersioning *immutableStorageAccount `json:"immutableStorageWithVersioning,omitempty"`
+}
+
+// GetAccountImmutabilityPolicy returns the Account-Level Immutability Policy for the specified Storage Account, or nil
+// when Account-Level Immutability isn't enabled
+func (c ImmutabilityClient) GetAccountImmutabilityPolicy(ctx context.Context, id parse.StorageAccountId) (result *AccountImmutabilityPolicy, err error) {
+	req, err := prepare(ctx, c.client.BaseURI, id.ID(), autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "GetAccountImmutabilityPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "GetAccountImmutabilityPolicy", resp, "Failure sending request")
+		return
+	}
+
+	var account immutabilityAccount
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&account),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "GetAccountImmutabilityPolicy", resp, "Failure responding to request")
+		return
+	}
+
+	if props := account.Properties; props != nil && props.ImmutableStorageWithVersioning != nil {
+		immutability := props.ImmutableStorageWithVersioning
+		if immutability.Enabled != nil && *immutability.Enabled {
+			result = immutability.ImmutabilityPolicy
+			if result == nil {
+				result = &AccountImmutabilityPolicy{}
+			}
+		}
+	}
+	return
+}
+
+// CreateAccountWithImmutabilityPolicy creates the specified Storage Account with Account-Level Immutability enabled,
+// since Account-Level Immutability can only be enabled when the Storage Account is created
+func (c ImmutabilityClient) CreateAccountWithImmutabilityPolicy(ctx context.Context, id parse.StorageAccountId, input storage.AccountCreateParameters, policy AccountImmutabilityPolicy) error {
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("marshaling %s: %+v", id, err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return fmt.Errorf("unmarshaling %s: %+v", id, err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	enabled := true
+	properties["immutableStorageWithVersioning"] = immutableStorageAccount{
+		Enabled:            &enabled,
+		ImmutabilityPolicy: &policy,
+	}
+	body["properties"] = properties
+
+	req, err := prepare(ctx, c.client.BaseURI, id.ID(), autorest.AsPut(), autorest.WithJSON(body))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "CreateAccountWithImmutabilityPolicy", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "CreateAccountWithImmutabilityPolicy", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.client.Client, req.Method)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "CreateAccountWithImmutabilityPolicy", resp, "Failure responding to request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateAccountWithImmutabilityPolicy: %+v", err)
+	}
+
+	return nil
+}
+
+// UpdateAccountImmutabilityPolicy updates the Account-Level Immutability Policy for the specified Storage Account,
+// without changing any other properties of the Storage Account
+func (c ImmutabilityClient) UpdateAccountImmutabilityPolicy(ctx context.Context, id parse.StorageAccountId, policy AccountImmutabilityPolicy) error {
+	enabled := true
+	payload := immutabilityAccount{
+		Properties: &immutabilityAccountProperties{
+			ImmutableStorageWithVersioning: &immutableStorageAccount{
+				Enabled:            &enabled,
+				ImmutabilityPolicy: &policy,
+			},
+		},
+	}
+
+	req, err := prepare(ctx, c.client.BaseURI, id.ID(), autorest.AsPatch(), autorest.WithJSON(payload))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "UpdateAccountImmutabilityPolicy", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "UpdateAccountImmutabilityPolicy", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "UpdateAccountImmutabilityPolicy", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// EnableContainerVersionLevelImmutability migrates the specified (existing) Container to Version-Level Immutability,
+// which can't be reverted once it's completed
+func (c ImmutabilityClient) EnableContainerVersionLevelImmutability(ctx context.Context, id parse.StorageContainerResourceManagerId) error {
+	req, err := prepare(ctx, c.client.BaseURI, fmt.Sprintf("%s/migrate", id.ID()), autorest.AsPost())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "EnableContainerVersionLevelImmutability", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "EnableContainerVersionLevelImmutability", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, c.client.Client, req.Method)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.ImmutabilityClient", "EnableContainerVersionLevelImmutability", resp, "Failure responding to request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after EnableContainerVersionLevelImmutability: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+)
+
+// NOTE: SFTP and Local Users are only available from API Version `2021-08-01` of the Storage API, whereas the Storage
+// resources are built against API Version `2021-04-01`. These workaround clients can be removed once the Storage
+// resources have been migrated to a newer API Version.
+
+const sftpAPIVersion = "2021-08-01"
+
+type LocalUsersClient struct {
+	client *storage.AccountsClient
+}
+
+func NewLocalUsersClient(client storage.AccountsClient) LocalUsersClient {
+	return LocalUsersClient{
+		client: &client,
+	}
+}
+
+type LocalUser struct {
+	autorest.Response    `json:"-"`
+	*LocalUserProperties `json:"properties,omitempty"`
+	ID                   *string `json:"id,omitempty"`
+	Name                 *string `json:"name,omitempty"`
+	Type                 *string `json:"type,omitempty"`
+}
+
+type LocalUserProperties struct {
+	PermissionScopes  *[]PermissionScope `json:"permissionScopes,omitempty"`
+	HomeDirectory     *string            `json:"homeDirectory,omitempty"`
+	SSHAuthorizedKeys *[]SSHPublicKey    `json:"sshAuthorizedKeys,omitempty"`
+	Sid               *string            `json:"sid,omitempty"`
+	HasSharedKey      *bool              `json:"hasSharedKey,omitempty"`
+	HasSSHKey         *bool              `json:"hasSshKey,omitempty"`
+	HasSSHPassword    *bool              `json:"hasSshPassword,omitempty"`
+}
+
+type PermissionScope struct {
+	Permissions  *string `json:"permissions,omitempty"`
+	Service      *string `json:"service,omitempty"`
+	ResourceName *string `json:"resourceName,omitempty"`
+}
+
+type SSHPublicKey struct {
+	Description *string `json:"description,omitempty"`
+	Key         *string `json:"key,omitempty"`
+}
+
+type LocalUserRegeneratePasswordResult struct {
+	autorest.Response `json:"-"`
+	SSHPassword       *string `json:"sshPassword,omitempty"`
+}
+
+func (c LocalUsersClient) CreateOrUpdate(ctx context.Context, id parse.StorageAccountLocalUserId, properties LocalUser) (result LocalUser, err error) {
+	// only the properties can be sent, the remaining fields are read-only
+	payload := LocalUser{
+		LocalUserProperties: properties.LocalUserProperties,
+	}
+
+	req, err := c.preparer(ctx, id.ID(), autorest.AsPut(), autorest.WithJSON(payload))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c LocalUsersClient) Get(ctx context.Context, id parse.StorageAccountLocalUserId) (result LocalUser, err error) {
+	req, err := c.preparer(ctx, id.ID(), autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c LocalUsersClient) Delete(ctx context.Context, id parse.StorageAccountLocalUserId) (result autorest.Response, err error) {
+	req, err := c.preparer(ctx, id.ID(), autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		result = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "Delete", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c LocalUsersClient) RegeneratePassword(ctx context.Context, id parse.StorageAccountLocalUserId) (result LocalUserRegeneratePasswordResult, err error) {
+	req, err := c.preparer(ctx, id.ID()+"/regeneratePassword", autorest.AsPost())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "RegeneratePassword", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "RegeneratePassword", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.LocalUsersClient", "RegeneratePassword", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c LocalUsersClient) preparer(ctx context.Context, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	return prepare(ctx, c.client.BaseURI, path, decorators...)
+}
+
+func prepare(ctx context.Context, baseURI string, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": sftpAPIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+	}, decorators...)
+	decorators = append(decorators,
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters))
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
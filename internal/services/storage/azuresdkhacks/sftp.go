@@ -0,0 +1,93 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+)
+
+type SftpClient struct {
+	client *storage.AccountsClient
+}
+
+func NewSftpClient(client storage.AccountsClient) SftpClient {
+	return SftpClient{
+		client: &client,
+	}
+}
+
+type sftpAccount struct {
+	autorest.Response `json:"-"`
+	Properties        *sftpAccountProperties `json:"properties,omitempty"`
+}
+
+type sftpAccountProperties struct {
+	IsSftpEnabled      *bool `json:"isSftpEnabled,omitempty"`
+	IsLocalUserEnabled *bool `json:"isLocalUserEnabled,omitempty"`
+}
+
+// IsSftpEnabled returns whether SFTP is enabled for the specified Storage Account
+func (c SftpClient) IsSftpEnabled(ctx context.Context, id parse.StorageAccountId) (result bool, err error) {
+	req, err := prepare(ctx, c.client.BaseURI, id.ID(), autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.SftpClient", "IsSftpEnabled", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.SftpClient", "IsSftpEnabled", resp, "Failure sending request")
+		return
+	}
+
+	var account sftpAccount
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&account),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storage.SftpClient", "IsSftpEnabled", resp, "Failure responding to request")
+		return
+	}
+
+	if props := account.Properties; props != nil && props.IsSftpEnabled != nil {
+		result = *props.IsSftpEnabled
+	}
+	return
+}
+
+// SetSftpEnabled enables or disables SFTP (and the Local Users used to authenticate with SFTP) for the specified
+// Storage Account, without changing any other properties of the Storage Account
+func (c SftpClient) SetSftpEnabled(ctx context.Context, id parse.StorageAccountId, enabled bool) (err error) {
+	payload := sftpAccount{
+		Properties: &sftpAccountProperties{
+			IsSftpEnabled:      &enabled,
+			IsLocalUserEnabled: &enabled,
+		},
+	}
+
+	req, err := prepare(ctx, c.client.BaseURI, id.ID(), autorest.AsPatch(), autorest.WithJSON(payload))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.SftpClient", "SetSftpEnabled", nil, "Failure preparing request")
+	}
+
+	resp, err := c.client.Send(req, azure.DoRetryWithRegistration(c.client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.SftpClient", "SetSftpEnabled", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "storage.SftpClient", "SetSftpEnabled", resp, "Failure responding to request")
+	}
+
+	return nil
+}
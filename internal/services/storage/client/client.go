@@ -10,6 +10,8 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2021-04-01/objectreplicationpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-05-01/storagetaskassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
@@ -25,23 +27,31 @@ import (
 )
 
 type Client struct {
-	AccountsClient              *storage.AccountsClient
-	FileSystemsClient           *filesystems.Client
-	ADLSGen2PathsClient         *paths.Client
-	ManagementPoliciesClient    *storage.ManagementPoliciesClient
-	BlobServicesClient          *storage.BlobServicesClient
-	BlobInventoryPoliciesClient *storage.BlobInventoryPoliciesClient
-	CloudEndpointsClient        *storagesync.CloudEndpointsClient
-	EncryptionScopesClient      *storage.EncryptionScopesClient
-	Environment                 azure.Environment
-	FileServicesClient          *storage.FileServicesClient
-	ObjectReplicationClient     *objectreplicationpolicies.ObjectReplicationPoliciesClient
-	SyncServiceClient           *storagesync.ServicesClient
-	SyncGroupsClient            *storagesync.SyncGroupsClient
-	SubscriptionId              string
-
+	AccountsClient               *storage.AccountsClient
+	FileSystemsClient            *filesystems.Client
+	ADLSGen2PathsClient          *paths.Client
+	ManagementPoliciesClient     *storage.ManagementPoliciesClient
+	BlobContainersClient         *storage.BlobContainersClient
+	BlobServicesClient           *storage.BlobServicesClient
+	BlobInventoryPoliciesClient  *storage.BlobInventoryPoliciesClient
+	CloudEndpointsClient         *storagesync.CloudEndpointsClient
+	EncryptionScopesClient       *storage.EncryptionScopesClient
+	Environment                  azure.Environment
+	FileServicesClient           *storage.FileServicesClient
+	ObjectReplicationClient      *objectreplicationpolicies.ObjectReplicationPoliciesClient
+	StorageTasksClient           *storagetasks.StorageTasksClient
+	StorageTaskAssignmentsClient *storagetaskassignments.StorageTaskAssignmentsClient
+	SyncServiceClient            *storagesync.ServicesClient
+	SyncGroupsClient             *storagesync.SyncGroupsClient
+	SubscriptionId               string
+
+	fileSharesClient          *storage.FileSharesClient
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
+
+	// useResourceManager specifies whether Containers and File Shares are managed using the Resource Manager API,
+	// rather than the Data Plane API (which can be blocked by the Network Rules on the Storage Account)
+	useResourceManager bool
 }
 
 func NewClient(options *common.ClientOptions) *Client {
@@ -57,6 +67,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -72,9 +85,18 @@ func NewClient(options *common.ClientOptions) *Client {
 	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	fileSharesClient := storage.NewFileSharesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&fileSharesClient.Client, options.ResourceManagerAuthorizer)
+
 	objectReplicationPolicyClient := objectreplicationpolicies.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
+	storageTasksClient := storagetasks.NewStorageTasksClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&storageTasksClient.Client, options.ResourceManagerAuthorizer)
+
+	storageTaskAssignmentsClient := storagetaskassignments.NewStorageTaskAssignmentsClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&storageTaskAssignmentsClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -84,22 +106,27 @@ func NewClient(options *common.ClientOptions) *Client {
 	// TODO: switch Storage Containers to using the storage.BlobContainersClient
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
-		AccountsClient:              &accountsClient,
-		FileSystemsClient:           &fileSystemsClient,
-		ADLSGen2PathsClient:         &adlsGen2PathsClient,
-		ManagementPoliciesClient:    &managementPoliciesClient,
-		BlobServicesClient:          &blobServicesClient,
-		BlobInventoryPoliciesClient: &blobInventoryPoliciesClient,
-		CloudEndpointsClient:        &cloudEndpointsClient,
-		EncryptionScopesClient:      &encryptionScopesClient,
-		Environment:                 options.Environment,
-		FileServicesClient:          &fileServicesClient,
-		ObjectReplicationClient:     &objectReplicationPolicyClient,
-		SubscriptionId:              options.SubscriptionId,
-		SyncServiceClient:           &syncServiceClient,
-		SyncGroupsClient:            &syncGroupsClient,
-
+		AccountsClient:               &accountsClient,
+		FileSystemsClient:            &fileSystemsClient,
+		ADLSGen2PathsClient:          &adlsGen2PathsClient,
+		ManagementPoliciesClient:     &managementPoliciesClient,
+		BlobContainersClient:         &blobContainersClient,
+		BlobServicesClient:           &blobServicesClient,
+		BlobInventoryPoliciesClient:  &blobInventoryPoliciesClient,
+		CloudEndpointsClient:         &cloudEndpointsClient,
+		EncryptionScopesClient:       &encryptionScopesClient,
+		Environment:                  options.Environment,
+		FileServicesClient:           &fileServicesClient,
+		ObjectReplicationClient:      &objectReplicationPolicyClient,
+		StorageTasksClient:           &storageTasksClient,
+		StorageTaskAssignmentsClient: &storageTaskAssignmentsClient,
+		SubscriptionId:               options.SubscriptionId,
+		SyncServiceClient:            &syncServiceClient,
+		SyncGroupsClient:             &syncGroupsClient,
+
+		fileSharesClient:          &fileSharesClient,
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
+		useResourceManager:        !options.Features.Storage.DataPlaneAvailable,
 	}
 
 	if options.StorageUseAzureAD {
@@ -154,6 +181,10 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 }
 
 func (client Client) ContainersClient(ctx context.Context, account accountDetails) (shim.StorageContainerWrapper, error) {
+	if client.useResourceManager {
+		return shim.NewResourceManagerStorageContainerWrapper(client.BlobContainersClient), nil
+	}
+
 	if client.storageAdAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
 		containersClient.Client.Authorizer = *client.storageAdAuth
@@ -215,6 +246,10 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 }
 
 func (client Client) FileSharesClient(ctx context.Context, account accountDetails) (shim.StorageShareWrapper, error) {
+	if client.useResourceManager {
+		return shim.NewResourceManagerStorageShareWrapper(client.fileSharesClient), nil
+	}
+
 	// NOTE: Files do not support AzureAD Authentication
 
 	accountKey, err := account.AccountKey(ctx, client)
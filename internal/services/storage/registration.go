@@ -7,12 +7,26 @@ import (
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.TypedServiceRegistration                   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/storage"
 }
 
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		StorageTaskResource{},
+		StorageTaskAssignmentResource{},
+	}
+}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "Storage"
@@ -47,6 +61,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_storage_account":                      resourceStorageAccount(),
 		"azurerm_storage_account_customer_managed_key": resourceStorageAccountCustomerManagedKey(),
+		"azurerm_storage_account_local_user":           resourceStorageAccountLocalUser(),
 		"azurerm_storage_account_network_rules":        resourceStorageAccountNetworkRules(),
 		"azurerm_storage_blob":                         resourceStorageBlob(),
 		"azurerm_storage_blob_inventory_policy":        resourceStorageBlobInventoryPolicy(),
@@ -0,0 +1,139 @@
+package storagetasks
+
+import "strings"
+
+type OnFailure string
+
+const (
+	OnFailureBreak OnFailure = "break"
+)
+
+func PossibleValuesForOnFailure() []string {
+	return []string{
+		string(OnFailureBreak),
+	}
+}
+
+func parseOnFailure(input string) (*OnFailure, error) {
+	vals := map[string]OnFailure{
+		"break": OnFailureBreak,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OnFailure(input)
+	return &out, nil
+}
+
+type OnSuccess string
+
+const (
+	OnSuccessContinue OnSuccess = "continue"
+)
+
+func PossibleValuesForOnSuccess() []string {
+	return []string{
+		string(OnSuccessContinue),
+	}
+}
+
+func parseOnSuccess(input string) (*OnSuccess, error) {
+	vals := map[string]OnSuccess{
+		"continue": OnSuccessContinue,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OnSuccess(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled                       ProvisioningState = "Canceled"
+	ProvisioningStateCreating                       ProvisioningState = "Creating"
+	ProvisioningStateDeleting                       ProvisioningState = "Deleting"
+	ProvisioningStateFailed                         ProvisioningState = "Failed"
+	ProvisioningStateSucceeded                      ProvisioningState = "Succeeded"
+	ProvisioningStateValidateSubscriptionQuotaBegin ProvisioningState = "ValidateSubscriptionQuotaBegin"
+	ProvisioningStateValidateSubscriptionQuotaEnd   ProvisioningState = "ValidateSubscriptionQuotaEnd"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateValidateSubscriptionQuotaBegin),
+		string(ProvisioningStateValidateSubscriptionQuotaEnd),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":                       ProvisioningStateCanceled,
+		"creating":                       ProvisioningStateCreating,
+		"deleting":                       ProvisioningStateDeleting,
+		"failed":                         ProvisioningStateFailed,
+		"succeeded":                      ProvisioningStateSucceeded,
+		"validatesubscriptionquotabegin": ProvisioningStateValidateSubscriptionQuotaBegin,
+		"validatesubscriptionquotaend":   ProvisioningStateValidateSubscriptionQuotaEnd,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type StorageTaskOperationName string
+
+const (
+	StorageTaskOperationNameDeleteBlob                StorageTaskOperationName = "DeleteBlob"
+	StorageTaskOperationNameSetBlobExpiry             StorageTaskOperationName = "SetBlobExpiry"
+	StorageTaskOperationNameSetBlobImmutabilityPolicy StorageTaskOperationName = "SetBlobImmutabilityPolicy"
+	StorageTaskOperationNameSetBlobLegalHold          StorageTaskOperationName = "SetBlobLegalHold"
+	StorageTaskOperationNameSetBlobTags               StorageTaskOperationName = "SetBlobTags"
+	StorageTaskOperationNameSetBlobTier               StorageTaskOperationName = "SetBlobTier"
+	StorageTaskOperationNameUndeleteBlob              StorageTaskOperationName = "UndeleteBlob"
+)
+
+func PossibleValuesForStorageTaskOperationName() []string {
+	return []string{
+		string(StorageTaskOperationNameDeleteBlob),
+		string(StorageTaskOperationNameSetBlobExpiry),
+		string(StorageTaskOperationNameSetBlobImmutabilityPolicy),
+		string(StorageTaskOperationNameSetBlobLegalHold),
+		string(StorageTaskOperationNameSetBlobTags),
+		string(StorageTaskOperationNameSetBlobTier),
+		string(StorageTaskOperationNameUndeleteBlob),
+	}
+}
+
+func parseStorageTaskOperationName(input string) (*StorageTaskOperationName, error) {
+	vals := map[string]StorageTaskOperationName{
+		"deleteblob":                StorageTaskOperationNameDeleteBlob,
+		"setblobexpiry":             StorageTaskOperationNameSetBlobExpiry,
+		"setblobimmutabilitypolicy": StorageTaskOperationNameSetBlobImmutabilityPolicy,
+		"setbloblegalhold":          StorageTaskOperationNameSetBlobLegalHold,
+		"setblobtags":               StorageTaskOperationNameSetBlobTags,
+		"setblobtier":               StorageTaskOperationNameSetBlobTier,
+		"undeleteblob":              StorageTaskOperationNameUndeleteBlob,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageTaskOperationName(input)
+	return &out, nil
+}
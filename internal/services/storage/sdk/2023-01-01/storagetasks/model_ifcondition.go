@@ -0,0 +1,6 @@
+package storagetasks
+
+type IfCondition struct {
+	Condition  string                 `json:"condition"`
+	Operations []StorageTaskOperation `json:"operations"`
+}
@@ -0,0 +1,6 @@
+package storagetasks
+
+type StorageTaskAction struct {
+	Else *ElseCondition `json:"else,omitempty"`
+	If   IfCondition    `json:"if"`
+}
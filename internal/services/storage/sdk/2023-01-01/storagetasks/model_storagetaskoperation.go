@@ -0,0 +1,8 @@
+package storagetasks
+
+type StorageTaskOperation struct {
+	Name       StorageTaskOperationName `json:"name"`
+	OnFailure  *OnFailure               `json:"onFailure,omitempty"`
+	OnSuccess  *OnSuccess               `json:"onSuccess,omitempty"`
+	Parameters *map[string]string       `json:"parameters,omitempty"`
+}
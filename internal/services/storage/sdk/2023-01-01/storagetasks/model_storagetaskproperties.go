@@ -0,0 +1,28 @@
+package storagetasks
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type StorageTaskProperties struct {
+	Action            StorageTaskAction  `json:"action"`
+	CreationTimeInUtc *string            `json:"creationTimeInUtc,omitempty"`
+	Description       string             `json:"description"`
+	Enabled           bool               `json:"enabled"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	TaskVersion       *int64             `json:"taskVersion,omitempty"`
+}
+
+func (o *StorageTaskProperties) GetCreationTimeInUtcAsTime() (*time.Time, error) {
+	if o.CreationTimeInUtc == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.CreationTimeInUtc, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o *StorageTaskProperties) SetCreationTimeInUtcAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.CreationTimeInUtc = &formatted
+}
@@ -0,0 +1,15 @@
+package storagetaskassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type StorageTaskAssignmentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStorageTaskAssignmentsClientWithBaseURI(endpoint string) StorageTaskAssignmentsClient {
+	return StorageTaskAssignmentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,102 @@
+package storagetaskassignments
+
+import "strings"
+
+type IntervalUnits string
+
+const (
+	IntervalUnitsDays IntervalUnits = "Days"
+)
+
+func PossibleValuesForIntervalUnits() []string {
+	return []string{
+		string(IntervalUnitsDays),
+	}
+}
+
+func parseIntervalUnits(input string) (*IntervalUnits, error) {
+	vals := map[string]IntervalUnits{
+		"days": IntervalUnitsDays,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IntervalUnits(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted                       ProvisioningState = "Accepted"
+	ProvisioningStateCanceled                       ProvisioningState = "Canceled"
+	ProvisioningStateCreating                       ProvisioningState = "Creating"
+	ProvisioningStateDeleting                       ProvisioningState = "Deleting"
+	ProvisioningStateFailed                         ProvisioningState = "Failed"
+	ProvisioningStateSucceeded                      ProvisioningState = "Succeeded"
+	ProvisioningStateValidateSubscriptionQuotaBegin ProvisioningState = "ValidateSubscriptionQuotaBegin"
+	ProvisioningStateValidateSubscriptionQuotaEnd   ProvisioningState = "ValidateSubscriptionQuotaEnd"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateValidateSubscriptionQuotaBegin),
+		string(ProvisioningStateValidateSubscriptionQuotaEnd),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":                       ProvisioningStateAccepted,
+		"canceled":                       ProvisioningStateCanceled,
+		"creating":                       ProvisioningStateCreating,
+		"deleting":                       ProvisioningStateDeleting,
+		"failed":                         ProvisioningStateFailed,
+		"succeeded":                      ProvisioningStateSucceeded,
+		"validatesubscriptionquotabegin": ProvisioningStateValidateSubscriptionQuotaBegin,
+		"validatesubscriptionquotaend":   ProvisioningStateValidateSubscriptionQuotaEnd,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type TriggerType string
+
+const (
+	TriggerTypeOnSchedule TriggerType = "OnSchedule"
+	TriggerTypeRunOnce    TriggerType = "RunOnce"
+)
+
+func PossibleValuesForTriggerType() []string {
+	return []string{
+		string(TriggerTypeOnSchedule),
+		string(TriggerTypeRunOnce),
+	}
+}
+
+func parseTriggerType(input string) (*TriggerType, error) {
+	vals := map[string]TriggerType{
+		"onschedule": TriggerTypeOnSchedule,
+		"runonce":    TriggerTypeRunOnce,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TriggerType(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package storagetaskassignments
+
+type ExecutionTrigger struct {
+	Parameters TriggerParameters `json:"parameters"`
+	Type       TriggerType       `json:"type"`
+}
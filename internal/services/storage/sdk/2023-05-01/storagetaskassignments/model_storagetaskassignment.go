@@ -0,0 +1,8 @@
+package storagetaskassignments
+
+type StorageTaskAssignment struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties StorageTaskAssignmentProperties `json:"properties"`
+	Type       *string                         `json:"type,omitempty"`
+}
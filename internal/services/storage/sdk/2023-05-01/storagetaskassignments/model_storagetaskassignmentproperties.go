@@ -0,0 +1,10 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentProperties struct {
+	Description       string                                `json:"description"`
+	Enabled           bool                                  `json:"enabled"`
+	ExecutionContext  StorageTaskAssignmentExecutionContext `json:"executionContext"`
+	ProvisioningState *ProvisioningState                    `json:"provisioningState,omitempty"`
+	Report            StorageTaskAssignmentReport           `json:"report"`
+	TaskId            string                                `json:"taskId"`
+}
@@ -0,0 +1,5 @@
+package storagetaskassignments
+
+type StorageTaskAssignmentReport struct {
+	Prefix string `json:"prefix"`
+}
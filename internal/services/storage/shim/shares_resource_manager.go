@@ -0,0 +1,204 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/shares"
+)
+
+// the format used by the Storage Data Plane API for the Start and Expiry of an Access Policy
+const shareAccessPolicyTimeFormat = "2006-01-02T15:04:05.0000000Z"
+
+type ResourceManagerStorageShareWrapper struct {
+	client *storage.FileSharesClient
+}
+
+func NewResourceManagerStorageShareWrapper(client *storage.FileSharesClient) StorageShareWrapper {
+	return ResourceManagerStorageShareWrapper{
+		client: client,
+	}
+}
+
+func (w ResourceManagerStorageShareWrapper) Create(ctx context.Context, resourceGroup, accountName, shareName string, input shares.CreateInput) error {
+	share := storage.FileShare{
+		FileShareProperties: &storage.FileShareProperties{
+			EnabledProtocols: storage.EnabledProtocols(input.EnabledProtocol),
+			Metadata:         w.mapMetaData(input.MetaData),
+			ShareQuota:       utils.Int32(int32(input.QuotaInGB)),
+		},
+	}
+	if input.AccessTier != nil {
+		share.FileShareProperties.AccessTier = storage.ShareAccessTier(*input.AccessTier)
+	}
+
+	if _, err := w.client.Create(ctx, resourceGroup, accountName, shareName, share, ""); err != nil {
+		return fmt.Errorf("creating share: %+v", err)
+	}
+
+	return nil
+}
+
+func (w ResourceManagerStorageShareWrapper) Delete(ctx context.Context, resourceGroup, accountName, shareName string) error {
+	resp, err := w.client.Delete(ctx, resourceGroup, accountName, shareName, "", "snapshots")
+	if utils.ResponseWasNotFound(resp) {
+		return nil
+	}
+
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) Exists(ctx context.Context, resourceGroup, accountName, shareName string) (*bool, error) {
+	existing, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (w ResourceManagerStorageShareWrapper) Get(ctx context.Context, resourceGroup, accountName, shareName string) (*StorageShareProperties, error) {
+	share, err := w.client.Get(ctx, resourceGroup, accountName, shareName, "", "")
+	if err != nil {
+		if utils.ResponseWasNotFound(share.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	output := StorageShareProperties{
+		ACLs:     []shares.SignedIdentifier{},
+		MetaData: map[string]string{},
+	}
+	if props := share.FileShareProperties; props != nil {
+		output.EnabledProtocol = shares.ShareProtocol(props.EnabledProtocols)
+		if props.AccessTier != "" {
+			tier := shares.AccessTier(props.AccessTier)
+			output.AccessTier = &tier
+		}
+		if props.ShareQuota != nil {
+			output.QuotaGB = int(*props.ShareQuota)
+		}
+		for k, v := range props.Metadata {
+			if v != nil {
+				output.MetaData[k] = *v
+			}
+		}
+		if props.SignedIdentifiers != nil {
+			for _, v := range *props.SignedIdentifiers {
+				output.ACLs = append(output.ACLs, w.mapSignedIdentifier(v))
+			}
+		}
+	}
+
+	return &output, nil
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateACLs(ctx context.Context, resourceGroup, accountName, shareName string, acls []shares.SignedIdentifier) error {
+	identifiers := make([]storage.SignedIdentifier, 0)
+	for _, v := range acls {
+		identifier, err := w.expandSignedIdentifier(v)
+		if err != nil {
+			return err
+		}
+		identifiers = append(identifiers, *identifier)
+	}
+
+	return w.update(ctx, resourceGroup, accountName, shareName, storage.FileShareProperties{
+		SignedIdentifiers: &identifiers,
+	})
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateMetaData(ctx context.Context, resourceGroup, accountName, shareName string, metaData map[string]string) error {
+	return w.update(ctx, resourceGroup, accountName, shareName, storage.FileShareProperties{
+		Metadata: w.mapMetaData(metaData),
+	})
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateQuota(ctx context.Context, resourceGroup, accountName, shareName string, quotaGB int) error {
+	return w.update(ctx, resourceGroup, accountName, shareName, storage.FileShareProperties{
+		ShareQuota: utils.Int32(int32(quotaGB)),
+	})
+}
+
+func (w ResourceManagerStorageShareWrapper) UpdateTier(ctx context.Context, resourceGroup, accountName, shareName string, tier shares.AccessTier) error {
+	return w.update(ctx, resourceGroup, accountName, shareName, storage.FileShareProperties{
+		AccessTier: storage.ShareAccessTier(tier),
+	})
+}
+
+func (w ResourceManagerStorageShareWrapper) update(ctx context.Context, resourceGroup, accountName, shareName string, props storage.FileShareProperties) error {
+	share := storage.FileShare{
+		FileShareProperties: &props,
+	}
+
+	_, err := w.client.Update(ctx, resourceGroup, accountName, shareName, share)
+	return err
+}
+
+func (w ResourceManagerStorageShareWrapper) expandSignedIdentifier(input shares.SignedIdentifier) (*storage.SignedIdentifier, error) {
+	policy := storage.AccessPolicy{
+		Permission: utils.String(input.AccessPolicy.Permission),
+	}
+
+	if input.AccessPolicy.Start != "" {
+		start, err := time.Parse(time.RFC3339, input.AccessPolicy.Start)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `start` %q for Access Policy %q: %+v", input.AccessPolicy.Start, input.Id, err)
+		}
+		policy.Start = &date.Time{Time: start}
+	}
+
+	if input.AccessPolicy.Expiry != "" {
+		expiry, err := time.Parse(time.RFC3339, input.AccessPolicy.Expiry)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `expiry` %q for Access Policy %q: %+v", input.AccessPolicy.Expiry, input.Id, err)
+		}
+		policy.Expiry = &date.Time{Time: expiry}
+	}
+
+	return &storage.SignedIdentifier{
+		ID:           utils.String(input.Id),
+		AccessPolicy: &policy,
+	}, nil
+}
+
+func (w ResourceManagerStorageShareWrapper) mapSignedIdentifier(input storage.SignedIdentifier) shares.SignedIdentifier {
+	output := shares.SignedIdentifier{}
+	if input.ID != nil {
+		output.Id = *input.ID
+	}
+
+	if policy := input.AccessPolicy; policy != nil {
+		if policy.Permission != nil {
+			output.AccessPolicy.Permission = *policy.Permission
+		}
+		if policy.Start != nil {
+			output.AccessPolicy.Start = policy.Start.UTC().Format(shareAccessPolicyTimeFormat)
+		}
+		if policy.Expiry != nil {
+			output.AccessPolicy.Expiry = policy.Expiry.UTC().Format(shareAccessPolicyTimeFormat)
+		}
+	}
+
+	return output
+}
+
+func (w ResourceManagerStorageShareWrapper) mapMetaData(input map[string]string) map[string]*string {
+	output := make(map[string]*string)
+
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+
+	return output
+}
@@ -0,0 +1,411 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageAccountLocalUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountLocalUserCreate,
+		Read:   resourceStorageAccountLocalUserRead,
+		Update: resourceStorageAccountLocalUserUpdate,
+		Delete: resourceStorageAccountLocalUserDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountLocalUserID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountLocalUserName,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"home_directory": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"permission_scope": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"permissions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"create": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"delete": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"list": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"read": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"write": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+
+						"resource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"service": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blob",
+								"file",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ssh_authorized_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"ssh_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_password_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"password": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountLocalUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewLocalUsersClient(*meta.(*clients.Client).Storage.AccountsClient)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageAccountLocalUserID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_storage_account_local_user", id.ID())
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, expandStorageAccountLocalUser(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the password can only be retrieved when it's (re)generated, so it's only available at creation time
+	if d.Get("ssh_password_enabled").(bool) {
+		resp, err := client.RegeneratePassword(ctx, id)
+		if err != nil {
+			return fmt.Errorf("generating password for %s: %+v", id, err)
+		}
+		d.Set("password", utils.NormalizeNilableString(resp.SSHPassword))
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewLocalUsersClient(*meta.(*clients.Client).Storage.AccountsClient)
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, expandStorageAccountLocalUser(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if d.HasChange("ssh_password_enabled") {
+		password := ""
+		if d.Get("ssh_password_enabled").(bool) {
+			resp, err := client.RegeneratePassword(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("generating password for %s: %+v", *id, err)
+			}
+			password = utils.NormalizeNilableString(resp.SSHPassword)
+		}
+		d.Set("password", password)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewLocalUsersClient(*meta.(*clients.Client).Storage.AccountsClient)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.LocalUserName)
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+
+	if props := resp.LocalUserProperties; props != nil {
+		d.Set("home_directory", props.HomeDirectory)
+		d.Set("sid", props.Sid)
+		d.Set("ssh_key_enabled", props.HasSSHKey != nil && *props.HasSSHKey)
+		d.Set("ssh_password_enabled", props.HasSSHPassword != nil && *props.HasSSHPassword)
+
+		if err := d.Set("permission_scope", flattenStorageAccountLocalUserPermissionScopes(props.PermissionScopes)); err != nil {
+			return fmt.Errorf("setting `permission_scope`: %+v", err)
+		}
+
+		// the SSH Authorized Keys aren't always returned by the API, in which case these are retained from the configuration
+		if props.SSHAuthorizedKeys != nil {
+			if err := d.Set("ssh_authorized_key", flattenStorageAccountLocalUserSSHAuthorizedKeys(props.SSHAuthorizedKeys)); err != nil {
+				return fmt.Errorf("setting `ssh_authorized_key`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountLocalUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewLocalUsersClient(*meta.(*clients.Client).Storage.AccountsClient)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountLocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageAccountLocalUser(d *pluginsdk.ResourceData) azuresdkhacks.LocalUser {
+	props := azuresdkhacks.LocalUserProperties{
+		HasSSHKey:         utils.Bool(d.Get("ssh_key_enabled").(bool)),
+		HasSSHPassword:    utils.Bool(d.Get("ssh_password_enabled").(bool)),
+		PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{})),
+		SSHAuthorizedKeys: expandStorageAccountLocalUserSSHAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{})),
+	}
+
+	if v := d.Get("home_directory").(string); v != "" {
+		props.HomeDirectory = utils.String(v)
+	}
+
+	return azuresdkhacks.LocalUser{
+		LocalUserProperties: &props,
+	}
+}
+
+func expandStorageAccountLocalUserPermissionScopes(input []interface{}) *[]azuresdkhacks.PermissionScope {
+	output := make([]azuresdkhacks.PermissionScope, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		output = append(output, azuresdkhacks.PermissionScope{
+			Permissions:  utils.String(expandStorageAccountLocalUserPermissions(raw["permissions"].([]interface{}))),
+			ResourceName: utils.String(raw["resource_name"].(string)),
+			Service:      utils.String(raw["service"].(string)),
+		})
+	}
+
+	return &output
+}
+
+// storageAccountLocalUserPermissions maps the schema fields to the characters used by the API, in the order the API
+// expects them
+var storageAccountLocalUserPermissions = []struct {
+	field     string
+	character string
+}{
+	{field: "read", character: "r"},
+	{field: "write", character: "w"},
+	{field: "delete", character: "d"},
+	{field: "list", character: "l"},
+	{field: "create", character: "c"},
+}
+
+func expandStorageAccountLocalUserPermissions(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+	raw := input[0].(map[string]interface{})
+
+	permissions := ""
+	for _, v := range storageAccountLocalUserPermissions {
+		if raw[v.field].(bool) {
+			permissions += v.character
+		}
+	}
+
+	return permissions
+}
+
+func expandStorageAccountLocalUserSSHAuthorizedKeys(input []interface{}) *[]azuresdkhacks.SSHPublicKey {
+	output := make([]azuresdkhacks.SSHPublicKey, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		key := azuresdkhacks.SSHPublicKey{
+			Key: utils.String(raw["key"].(string)),
+		}
+		if description := raw["description"].(string); description != "" {
+			key.Description = utils.String(description)
+		}
+		output = append(output, key)
+	}
+
+	return &output
+}
+
+func flattenStorageAccountLocalUserPermissionScopes(input *[]azuresdkhacks.PermissionScope) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, map[string]interface{}{
+			"permissions":   flattenStorageAccountLocalUserPermissions(utils.NormalizeNilableString(v.Permissions)),
+			"resource_name": utils.NormalizeNilableString(v.ResourceName),
+			"service":       utils.NormalizeNilableString(v.Service),
+		})
+	}
+
+	return output
+}
+
+func flattenStorageAccountLocalUserSSHAuthorizedKeys(input *[]azuresdkhacks.SSHPublicKey) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, map[string]interface{}{
+			"description": utils.NormalizeNilableString(v.Description),
+			"key":         utils.NormalizeNilableString(v.Key),
+		})
+	}
+
+	return output
+}
+
+func flattenStorageAccountLocalUserPermissions(input string) []interface{} {
+	permissions := make(map[string]interface{})
+	for _, v := range storageAccountLocalUserPermissions {
+		permissions[v.field] = strings.Contains(input, v.character)
+	}
+
+	return []interface{}{permissions}
+}
@@ -0,0 +1,233 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageAccountLocalUserResource struct{}
+
+func TestAccStorageAccountLocalUser_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sid").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountLocalUser_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageAccountLocalUser_password(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.password(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").Exists(),
+			),
+		},
+		// the password is only available when it's generated
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccStorageAccountLocalUser_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccStorageAccountLocalUser_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := StorageAccountLocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").Exists(),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageAccountLocalUserResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageAccountLocalUserID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := azuresdkhacks.NewLocalUsersClient(*client.Storage.AccountsClient).Get(ctx, *id)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageAccountLocalUserResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_kind             = "StorageV2"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+  sftp_enabled             = true
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageAccountLocalUserResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "test" {
+  name               = "user%s"
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r StorageAccountLocalUserResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "import" {
+  name               = azurerm_storage_account_local_user.test.name
+  storage_account_id = azurerm_storage_account_local_user.test.storage_account_id
+}
+`, r.basic(data))
+}
+
+func (r StorageAccountLocalUserResource) password(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "test" {
+  name                 = "user%s"
+  storage_account_id   = azurerm_storage_account.test.id
+  ssh_password_enabled = true
+  home_directory       = azurerm_storage_container.test.name
+
+  permission_scope {
+    permissions {
+      read   = true
+      create = true
+    }
+    service       = "blob"
+    resource_name = azurerm_storage_container.test.name
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r StorageAccountLocalUserResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "test" {
+  name                 = "user%s"
+  storage_account_id   = azurerm_storage_account.test.id
+  ssh_key_enabled      = true
+  ssh_password_enabled = true
+  home_directory       = azurerm_storage_container.test.name
+
+  ssh_authorized_key {
+    description = "key1"
+    key         = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN"
+  }
+
+  permission_scope {
+    permissions {
+      read   = true
+      create = true
+      delete = true
+      list   = true
+      write  = true
+    }
+    service       = "blob"
+    resource_name = azurerm_storage_container.test.name
+  }
+}
+`, r.template(data), data.RandomString)
+}
@@ -27,7 +27,9 @@ import (
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	vnetParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpoint"
 	resource "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
@@ -62,9 +64,24 @@ func resourceStorageAccount() *pluginsdk.Resource {
 		SchemaVersion:  schemaVersion,
 		StateUpgraders: pluginsdk.StateUpgrades(upgraders),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.StorageAccountID(id)
 			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			id, err := parse.StorageAccountID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			privateEndpoints, err := privateendpoint.Import(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, id.ID())
+			if err != nil {
+				return nil, fmt.Errorf("retrieving Private Endpoints for %s: %+v", *id, err)
+			}
+			if err := d.Set("private_endpoint", privateEndpoints); err != nil {
+				return nil, fmt.Errorf("setting `private_endpoint`: %+v", err)
+			}
+
+			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -276,6 +293,38 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_protected_append_writes": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"period_since_creation_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+
+						"state": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForAccountImmutabilityPolicyState(), false),
+						},
+					},
+				},
+			},
+
 			// TODO: document this new field in 3.0
 			"allow_nested_items_to_be_public": {
 				Type:     pluginsdk.TypeBool,
@@ -364,6 +413,21 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
+			"private_endpoint": privateendpoint.Schema([]string{
+				"blob",
+				"blob_secondary",
+				"dfs",
+				"dfs_secondary",
+				"file",
+				"file_secondary",
+				"queue",
+				"queue_secondary",
+				"table",
+				"table_secondary",
+				"web",
+				"web_secondary",
+			}),
+
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 			"blob_properties": {
@@ -911,22 +975,35 @@ func resourceStorageAccount() *pluginsdk.Resource {
 						return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
 					}
 				}
+
+				if d.Get("sftp_enabled").(bool) && !d.Get("is_hns_enabled").(bool) {
+					return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+				}
+
+				if d.HasChange("immutability_policy.0.state") {
+					oldState, _ := d.GetChange("immutability_policy.0.state")
+					if oldState.(string) == string(azuresdkhacks.AccountImmutabilityPolicyStateLocked) {
+						return fmt.Errorf("the `state` of the `immutability_policy` cannot be changed once it's `Locked`")
+					}
+				}
 				return nil
 			}),
+			// Account-Level Immutability can only be enabled when the Storage Account is created and can't be disabled
+			pluginsdk.ForceNewIfChange("immutability_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
-				newAccRep := strings.ToUpper(new.(string))
+				if !storageAccountReplicationTypeRequiresMigration(old.(string), new.(string)) {
+					return false
+				}
 
-				switch strings.ToUpper(old.(string)) {
-				case "LRS", "GRS", "RAGRS":
-					if newAccRep == "GZRS" || newAccRep == "RAGZRS" || newAccRep == "ZRS" {
-						return true
-					}
-				case "ZRS", "GZRS", "RAGZRS":
-					if newAccRep == "LRS" || newAccRep == "GRS" || newAccRep == "RAGRS" {
-						return true
-					}
+				// changing the zone redundancy can only be done in-place using a Customer-Initiated Migration, which is
+				// opt-in since this can take a long time to complete and requires the geo redundancy to remain the same
+				if meta.(*clients.Client).Features.Storage.RedundancyConversionEnabled {
+					return !storageAccountReplicationTypeSupportsMigration(old.(string), new.(string))
 				}
-				return false
+
+				return true
 			}),
 		),
 	}
@@ -959,6 +1036,22 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return tf.ImportAsExistsError("azurerm_storage_account", id.ID())
 	}
 
+	availabilityRequest := storage.AccountCheckNameAvailabilityParameters{
+		Name: utils.String(id.Name),
+		Type: utils.String("Microsoft.Storage/storageAccounts"),
+	}
+	available, err := client.CheckNameAvailability(ctx, availabilityRequest)
+	if err != nil {
+		return fmt.Errorf("checking if the name %q was available: %+v", id.Name, err)
+	}
+	if err := azure.CheckNameAvailability("Storage Account", id.Name, azure.NameAvailabilityResult{
+		NameAvailable: available.NameAvailable,
+		Reason:        string(available.Reason),
+		Message:       available.Message,
+	}); err != nil {
+		return err
+	}
+
 	accountKind := d.Get("account_kind").(string)
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
@@ -1142,13 +1235,24 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	parameters.Encryption = encryption
 
 	// Create
-	future, err := client.Create(ctx, id.ResourceGroup, id.Name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating Azure Storage Account %q: %+v", id.Name, err)
-	}
+	if v, ok := d.GetOk("immutability_policy"); ok {
+		if !d.Get("blob_properties.0.versioning_enabled").(bool) {
+			return fmt.Errorf("`blob_properties.0.versioning_enabled` must be `true` when `immutability_policy` is specified")
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for Azure Storage Account %q to be created: %+v", id.Name, err)
+		immutabilityClient := azuresdkhacks.NewImmutabilityClient(*client)
+		if err := immutabilityClient.CreateAccountWithImmutabilityPolicy(ctx, id, parameters, expandStorageAccountImmutabilityPolicy(v.([]interface{}))); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+	} else {
+		future, err := client.Create(ctx, id.ResourceGroup, id.Name, parameters)
+		if err != nil {
+			return fmt.Errorf("creating Azure Storage Account %q: %+v", id.Name, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for Azure Storage Account %q to be created: %+v", id.Name, err)
+		}
 	}
 
 	d.SetId(id.ID())
@@ -1162,6 +1266,13 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("populating cache for %s: %+v", id, err)
 	}
 
+	if d.Get("sftp_enabled").(bool) {
+		sftpClient := azuresdkhacks.NewSftpClient(*client)
+		if err := sftpClient.SetSftpEnabled(ctx, id, true); err != nil {
+			return fmt.Errorf("enabling SFTP for %s: %+v", id, err)
+		}
+	}
+
 	if val, ok := d.GetOk("blob_properties"); ok {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.KindFileStorage) {
@@ -1255,6 +1366,12 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if v, ok := d.GetOk("private_endpoint"); ok {
+		if err := privateendpoint.CreateOrUpdate(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, d.Get("location").(string), id.ID(), privateendpoint.Expand(v.([]interface{})), d.Get("tags").(map[string]interface{})); err != nil {
+			return fmt.Errorf("creating Private Endpoints for %s: %+v", id, err)
+		}
+	}
+
 	return resourceStorageAccountRead(d, meta)
 }
 
@@ -1327,16 +1444,23 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("account_replication_type") {
-		sku := storage.Sku{
-			Name: storage.SkuName(storageType),
-		}
+		oldReplicationType, _ := d.GetChange("account_replication_type")
+		if storageAccountReplicationTypeRequiresMigration(oldReplicationType.(string), replicationType) {
+			if err := migrateStorageAccountReplicationType(ctx, *client, *id, storageType, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			sku := storage.Sku{
+				Name: storage.SkuName(storageType),
+			}
 
-		opts := storage.AccountUpdateParameters{
-			Sku: &sku,
-		}
+			opts := storage.AccountUpdateParameters{
+				Sku: &sku,
+			}
 
-		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
-			return fmt.Errorf("updating Azure Storage Account type %q: %+v", id.Name, err)
+			if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+				return fmt.Errorf("updating Azure Storage Account type %q: %+v", id.Name, err)
+			}
 		}
 	}
 
@@ -1436,6 +1560,20 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("sftp_enabled") {
+		sftpClient := azuresdkhacks.NewSftpClient(*client)
+		if err := sftpClient.SetSftpEnabled(ctx, *id, d.Get("sftp_enabled").(bool)); err != nil {
+			return fmt.Errorf("updating `sftp_enabled` for %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("immutability_policy") {
+		immutabilityClient := azuresdkhacks.NewImmutabilityClient(*client)
+		if err := immutabilityClient.UpdateAccountImmutabilityPolicy(ctx, *id, expandStorageAccountImmutabilityPolicy(d.Get("immutability_policy").([]interface{}))); err != nil {
+			return fmt.Errorf("updating `immutability_policy` for %s: %+v", *id, err)
+		}
+	}
+
 	if d.HasChange("min_tls_version") {
 		minimumTLSVersion := d.Get("min_tls_version").(string)
 
@@ -1653,6 +1791,13 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("private_endpoint") {
+		oldRaw, newRaw := d.GetChange("private_endpoint")
+		if err := privateendpoint.Update(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, d.Get("location").(string), id.ID(), privateendpoint.Expand(oldRaw.([]interface{})), privateendpoint.Expand(newRaw.([]interface{})), d.Get("tags").(map[string]interface{})); err != nil {
+			return fmt.Errorf("updating Private Endpoints for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceStorageAccountRead(d, meta)
 }
 
@@ -1705,6 +1850,22 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("edge_zone", flattenEdgeZone(resp.ExtendedLocation))
+
+	// SFTP is only available in a newer API Version than the one this resource uses, so is retrieved separately
+	sftpEnabled, err := azuresdkhacks.NewSftpClient(*client).IsSftpEnabled(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving `sftp_enabled` for %s: %+v", *id, err)
+	}
+	d.Set("sftp_enabled", sftpEnabled)
+
+	// Account-Level Immutability is also only available in a newer API Version, so is retrieved separately
+	immutabilityPolicy, err := azuresdkhacks.NewImmutabilityClient(*client).GetAccountImmutabilityPolicy(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving `immutability_policy` for %s: %+v", *id, err)
+	}
+	if err := d.Set("immutability_policy", flattenStorageAccountImmutabilityPolicy(immutabilityPolicy)); err != nil {
+		return fmt.Errorf("setting `immutability_policy`: %+v", err)
+	}
 	d.Set("account_kind", resp.Kind)
 
 	if sku := resp.Sku; sku != nil {
@@ -1959,6 +2120,14 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `static_website `for AzureRM Storage Account %q: %+v", id.Name, err)
 	}
 
+	privateEndpoints, err := privateendpoint.Flatten(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, privateendpoint.Expand(d.Get("private_endpoint").([]interface{})))
+	if err != nil {
+		return fmt.Errorf("retrieving Private Endpoints for %s: %+v", *id, err)
+	}
+	if err := d.Set("private_endpoint", privateEndpoints); err != nil {
+		return fmt.Errorf("setting `private_endpoint`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -1985,6 +2154,10 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	if err := privateendpoint.Delete(ctx, meta.(*clients.Client).Network, id.SubscriptionId, id.ResourceGroup, privateendpoint.Expand(d.Get("private_endpoint").([]interface{}))); err != nil {
+		return fmt.Errorf("deleting Private Endpoints for %s: %+v", *id, err)
+	}
+
 	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
 	virtualNetworkNames := make([]string, 0)
 	if props := read.AccountProperties; props != nil {
@@ -2028,6 +2201,113 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+// storageAccountReplicationTypeRequiresMigration returns whether changing between the specified replication types changes
+// the zone redundancy of the Storage Account, which requires a Customer-Initiated Migration
+func storageAccountReplicationTypeRequiresMigration(old, new string) bool {
+	return strings.Contains(strings.ToUpper(old), "ZRS") != strings.Contains(strings.ToUpper(new), "ZRS")
+}
+
+// storageAccountReplicationTypeSupportsMigration returns whether a Customer-Initiated Migration can be used to change
+// between the specified replication types, which is only the case when the geo redundancy remains the same
+// (e.g. `LRS` <-> `ZRS`, `GRS` <-> `GZRS` and `RAGRS` <-> `RAGZRS`)
+func storageAccountReplicationTypeSupportsMigration(old, new string) bool {
+	geoRedundancy := map[string]string{
+		"LRS":    "",
+		"ZRS":    "",
+		"GRS":    "GRS",
+		"GZRS":   "GRS",
+		"RAGRS":  "RAGRS",
+		"RAGZRS": "RAGRS",
+	}
+
+	return storageAccountReplicationTypeRequiresMigration(old, new) && geoRedundancy[strings.ToUpper(old)] == geoRedundancy[strings.ToUpper(new)]
+}
+
+func migrateStorageAccountReplicationType(ctx context.Context, client storage.AccountsClient, id parse.StorageAccountId, targetSkuName string, timeout time.Duration) error {
+	migrationClient := azuresdkhacks.NewAccountMigrationClient(client)
+
+	log.Printf("[DEBUG] Starting the Customer-Initiated Migration of %s to %q..", id, targetSkuName)
+	if err := migrationClient.StartAccountMigration(ctx, id, targetSkuName); err != nil {
+		return fmt.Errorf("starting the migration of %s to %q: %+v", id, targetSkuName, err)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(azuresdkhacks.AccountMigrationStatusSubmittedForConversion),
+			string(azuresdkhacks.AccountMigrationStatusInProgress),
+		},
+		Target:       []string{string(azuresdkhacks.AccountMigrationStatusComplete)},
+		Refresh:      storageAccountMigrationRefreshFunc(ctx, migrationClient, id),
+		MinTimeout:   1 * time.Minute,
+		PollInterval: 5 * time.Minute,
+		Timeout:      timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the migration of %s to %q: %+v", id, targetSkuName, err)
+	}
+
+	return nil
+}
+
+func storageAccountMigrationRefreshFunc(ctx context.Context, client azuresdkhacks.AccountMigrationClient, id parse.StorageAccountId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetAccountMigration(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the migration status of %s: %+v", id, err)
+		}
+
+		if resp.Properties == nil || resp.Properties.MigrationStatus == nil {
+			return nil, "", fmt.Errorf("retrieving the migration status of %s: `migrationStatus` was nil", id)
+		}
+
+		status := *resp.Properties.MigrationStatus
+		if status == azuresdkhacks.AccountMigrationStatusFailed {
+			return resp, string(status), fmt.Errorf("the migration of %s failed: %s (%s)", id, utils.NormalizeNilableString(resp.Properties.MigrationFailedReason), utils.NormalizeNilableString(resp.Properties.MigrationFailedDetailedReason))
+		}
+
+		return resp, string(status), nil
+	}
+}
+
+func expandStorageAccountImmutabilityPolicy(input []interface{}) azuresdkhacks.AccountImmutabilityPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return azuresdkhacks.AccountImmutabilityPolicy{}
+	}
+
+	v := input[0].(map[string]interface{})
+	state := azuresdkhacks.AccountImmutabilityPolicyState(v["state"].(string))
+	return azuresdkhacks.AccountImmutabilityPolicy{
+		AllowProtectedAppendWrites:            utils.Bool(v["allow_protected_append_writes"].(bool)),
+		ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(v["period_since_creation_in_days"].(int))),
+		State:                                 &state,
+	}
+}
+
+func flattenStorageAccountImmutabilityPolicy(input *azuresdkhacks.AccountImmutabilityPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	state := ""
+	if input.State != nil {
+		state = string(*input.State)
+	}
+
+	periodSinceCreationInDays := 0
+	if input.ImmutabilityPeriodSinceCreationInDays != nil {
+		periodSinceCreationInDays = int(*input.ImmutabilityPeriodSinceCreationInDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allow_protected_append_writes": utils.NormaliseNilableBool(input.AllowProtectedAppendWrites),
+			"period_since_creation_in_days": periodSinceCreationInDays,
+			"state":                         state,
+		},
+	}
+}
+
 func expandStorageAccountCustomDomain(d *pluginsdk.ResourceData) *storage.CustomDomain {
 	domains := d.Get("custom_domain").([]interface{})
 	if len(domains) == 0 {
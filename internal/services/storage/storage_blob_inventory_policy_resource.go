@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -132,12 +133,27 @@ func storageBlobInventoryPolicyResourceSchema() map[string]*pluginsdk.Schema {
 									},
 								},
 
+								"exclude_prefixes": {
+									Type:     pluginsdk.TypeSet,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
 								"include_blob_versions": {
 									Type:     pluginsdk.TypeBool,
 									Optional: true,
 									Default:  false,
 								},
 
+								"include_deleted": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  false,
+								},
+
 								"include_snapshots": {
 									Type:     pluginsdk.TypeBool,
 									Optional: true,
@@ -164,6 +180,7 @@ func storageBlobInventoryPolicyResourceSchema() map[string]*pluginsdk.Schema {
 func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Storage.BlobInventoryPoliciesClient
+	hackClient := azuresdkhacks.NewBlobInventoryPoliciesClient(*client)
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -186,16 +203,16 @@ func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, m
 		}
 	}
 
-	props := storage.BlobInventoryPolicy{
-		BlobInventoryPolicyProperties: &storage.BlobInventoryPolicyProperties{
-			Policy: &storage.BlobInventoryPolicySchema{
+	props := azuresdkhacks.BlobInventoryPolicy{
+		Properties: &azuresdkhacks.BlobInventoryPolicyProperties{
+			Policy: &azuresdkhacks.BlobInventoryPolicySchema{
 				Enabled: utils.Bool(true),
 				Type:    utils.String("Inventory"),
 				Rules:   expandBlobInventoryPolicyRules(d.Get("rules").(*pluginsdk.Set).List()),
 			},
 		},
 	}
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.StorageAccountName, props); err != nil {
+	if err := hackClient.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %q: %+v", id, err)
 	}
 
@@ -205,7 +222,7 @@ func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, m
 
 func resourceStorageBlobInventoryPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).Storage.BlobInventoryPoliciesClient
+	client := azuresdkhacks.NewBlobInventoryPoliciesClient(*meta.(*clients.Client).Storage.BlobInventoryPoliciesClient)
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -214,7 +231,7 @@ func resourceStorageBlobInventoryPolicyRead(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] storage %q does not exist - removing from state", d.Id())
@@ -224,7 +241,7 @@ func resourceStorageBlobInventoryPolicyRead(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("retrieving %q: %+v", id, err)
 	}
 	d.Set("storage_account_id", parse.NewStorageAccountID(subscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
-	if props := resp.BlobInventoryPolicyProperties; props != nil {
+	if props := resp.Properties; props != nil {
 		if policy := props.Policy; policy != nil {
 			if policy.Enabled == nil || !*policy.Enabled {
 				log.Printf("[INFO] storage %q is not enabled - removing from state", d.Id())
@@ -254,15 +271,15 @@ func resourceStorageBlobInventoryPolicyDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
-func expandBlobInventoryPolicyRules(input []interface{}) *[]storage.BlobInventoryPolicyRule {
-	results := make([]storage.BlobInventoryPolicyRule, 0)
+func expandBlobInventoryPolicyRules(input []interface{}) *[]azuresdkhacks.BlobInventoryPolicyRule {
+	results := make([]azuresdkhacks.BlobInventoryPolicyRule, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
-		results = append(results, storage.BlobInventoryPolicyRule{
+		results = append(results, azuresdkhacks.BlobInventoryPolicyRule{
 			Enabled:     utils.Bool(true),
 			Name:        utils.String(v["name"].(string)),
 			Destination: utils.String(v["storage_container_name"].(string)),
-			Definition: &storage.BlobInventoryPolicyDefinition{
+			Definition: &azuresdkhacks.BlobInventoryPolicyDefinition{
 				Format:       storage.Format(v["format"].(string)),
 				Schedule:     storage.Schedule(v["schedule"].(string)),
 				ObjectType:   storage.ObjectType(v["scope"].(string)),
@@ -274,20 +291,22 @@ func expandBlobInventoryPolicyRules(input []interface{}) *[]storage.BlobInventor
 	return &results
 }
 
-func expandBlobInventoryPolicyFilter(input []interface{}) *storage.BlobInventoryPolicyFilter {
+func expandBlobInventoryPolicyFilter(input []interface{}) *azuresdkhacks.BlobInventoryPolicyFilter {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	return &storage.BlobInventoryPolicyFilter{
+	return &azuresdkhacks.BlobInventoryPolicyFilter{
 		PrefixMatch:         utils.ExpandStringSlice(v["prefix_match"].(*pluginsdk.Set).List()),
+		ExcludePrefix:       utils.ExpandStringSlice(v["exclude_prefixes"].(*pluginsdk.Set).List()),
 		BlobTypes:           utils.ExpandStringSlice(v["blob_types"].(*pluginsdk.Set).List()),
 		IncludeBlobVersions: utils.Bool(v["include_blob_versions"].(bool)),
 		IncludeSnapshots:    utils.Bool(v["include_snapshots"].(bool)),
+		IncludeDeleted:      utils.Bool(v["include_deleted"].(bool)),
 	}
 }
 
-func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule) []interface{} {
+func flattenBlobInventoryPolicyRules(input *[]azuresdkhacks.BlobInventoryPolicyRule) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -321,7 +340,7 @@ func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule) [
 	return results
 }
 
-func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter) []interface{} {
+func flattenBlobInventoryPolicyFilter(input *azuresdkhacks.BlobInventoryPolicyFilter) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
@@ -334,10 +353,16 @@ func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter)
 	if input.IncludeSnapshots != nil {
 		includeSnapshots = *input.IncludeSnapshots
 	}
+	var includeDeleted bool
+	if input.IncludeDeleted != nil {
+		includeDeleted = *input.IncludeDeleted
+	}
 	return []interface{}{
 		map[string]interface{}{
 			"blob_types":            utils.FlattenStringSlice(input.BlobTypes),
+			"exclude_prefixes":      utils.FlattenStringSlice(input.ExcludePrefix),
 			"include_blob_versions": includeBlobVersions,
+			"include_deleted":       includeDeleted,
 			"include_snapshots":     includeSnapshots,
 			"prefix_match":          utils.FlattenStringSlice(input.PrefixMatch),
 		},
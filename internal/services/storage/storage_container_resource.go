@@ -1,12 +1,14 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -68,6 +70,13 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
+			// Containers within a Storage Account with Account-Level Immutability have this enabled by default
+			"version_level_immutability_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			// TODO: support for ACL's, Legal Holds and Immutability Policies
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
@@ -84,11 +93,19 @@ func resourceStorageContainer() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		// Version-Level Immutability can't be disabled once it's been enabled
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.ForceNewIfChange("version_level_immutability_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+		),
 	}
 }
 
 func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -133,11 +150,20 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id)
+
+	if d.Get("version_level_immutability_enabled").(bool) {
+		resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, accountName, "default", containerName)
+		immutabilityClient := azuresdkhacks.NewImmutabilityClient(*storageClient.AccountsClient)
+		if err := immutabilityClient.EnableContainerVersionLevelImmutability(ctx, resourceManagerId); err != nil {
+			return fmt.Errorf("enabling Version-Level Immutability for %s: %+v", resourceManagerId, err)
+		}
+	}
 	return resourceStorageContainerRead(d, meta)
 }
 
 func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -182,6 +208,17 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 	}
 
+	if d.HasChange("version_level_immutability_enabled") && d.Get("version_level_immutability_enabled").(bool) {
+		log.Printf("[DEBUG] Enabling Version-Level Immutability for Container %q (Storage Account %q / Resource Group %q)..", id.Name, id.AccountName, account.ResourceGroup)
+		resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
+		immutabilityClient := azuresdkhacks.NewImmutabilityClient(*storageClient.AccountsClient)
+		if err := immutabilityClient.EnableContainerVersionLevelImmutability(ctx, resourceManagerId); err != nil {
+			return fmt.Errorf("enabling Version-Level Immutability for %s: %+v", resourceManagerId, err)
+		}
+
+		log.Printf("[DEBUG] Enabled Version-Level Immutability for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
+	}
+
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -235,6 +272,17 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 	resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
+	// Version-Level Immutability isn't exposed by the Data Plane API, so is retrieved from the Resource Manager API
+	container, err := storageClient.BlobContainersClient.Get(ctx, resourceManagerId.ResourceGroup, resourceManagerId.StorageAccountName, resourceManagerId.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", resourceManagerId, err)
+	}
+	versionLevelImmutabilityEnabled := false
+	if props := container.ContainerProperties; props != nil && props.ImmutableStorageWithVersioning != nil && props.ImmutableStorageWithVersioning.Enabled != nil {
+		versionLevelImmutabilityEnabled = *props.ImmutableStorageWithVersioning.Enabled
+	}
+	d.Set("version_level_immutability_enabled", versionLevelImmutabilityEnabled)
+
 	return nil
 }
 
@@ -127,6 +127,29 @@ func TestAccStorageShare_acl(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_resourceManager(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceManager(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceManager(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("quota").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageShare_aclGhostedRecall(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
 	r := StorageShareResource{}
@@ -388,6 +411,51 @@ resource "azurerm_storage_share" "test" {
 `, template, data.RandomString)
 }
 
+func (r StorageShareResource) resourceManager(data acceptance.TestData, quota int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    storage {
+      data_plane_available = false
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "testshare%[3]s"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = %[4]d
+
+  metadata = {
+    hello = "world"
+  }
+
+  acl {
+    id = "MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI"
+
+    access_policy {
+      permissions = "rwd"
+      start       = "2019-07-02T09:38:21.0000000Z"
+      expiry      = "2019-07-02T10:38:21.0000000Z"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, quota)
+}
+
 func (r StorageShareResource) aclGhostedRecall(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
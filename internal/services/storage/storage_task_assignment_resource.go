@@ -0,0 +1,415 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageTaskAssignmentModel struct {
+	Name             string                          `tfschema:"name"`
+	StorageAccountId string                          `tfschema:"storage_account_id"`
+	StorageTaskId    string                          `tfschema:"storage_task_id"`
+	Description      string                          `tfschema:"description"`
+	Enabled          bool                            `tfschema:"enabled"`
+	ReportPrefix     string                          `tfschema:"report_prefix"`
+	Schedule         []StorageTaskAssignmentSchedule `tfschema:"schedule"`
+	Target           []StorageTaskAssignmentTarget   `tfschema:"target"`
+}
+
+type StorageTaskAssignmentSchedule struct {
+	Type           string `tfschema:"type"`
+	StartOn        string `tfschema:"start_on"`
+	StartFrom      string `tfschema:"start_from"`
+	EndBy          string `tfschema:"end_by"`
+	IntervalInDays int64  `tfschema:"interval_in_days"`
+}
+
+type StorageTaskAssignmentTarget struct {
+	Prefixes        []string `tfschema:"prefixes"`
+	ExcludePrefixes []string `tfschema:"exclude_prefixes"`
+}
+
+var _ sdk.ResourceWithUpdate = StorageTaskAssignmentResource{}
+
+type StorageTaskAssignmentResource struct{}
+
+func (r StorageTaskAssignmentResource) ResourceType() string {
+	return "azurerm_storage_task_assignment"
+}
+
+func (r StorageTaskAssignmentResource) ModelObject() interface{} {
+	return &StorageTaskAssignmentModel{}
+}
+
+func (r StorageTaskAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storagetaskassignments.ValidateStorageTaskAssignmentID
+}
+
+func (r StorageTaskAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTaskAssignmentName,
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountID,
+		},
+
+		"storage_task_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagetasks.ValidateStorageTaskID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"report_prefix": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(storagetaskassignments.PossibleValuesForTriggerType(), false),
+					},
+
+					"start_on": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"start_from": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"end_by": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"interval_in_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"target": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"prefixes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						AtLeastOneOf: []string{"target.0.prefixes", "target.0.exclude_prefixes"},
+					},
+
+					"exclude_prefixes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						AtLeastOneOf: []string{"target.0.prefixes", "target.0.exclude_prefixes"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageTaskAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTaskAssignmentsClient
+
+			var model StorageTaskAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := parse.StorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := storagetaskassignments.NewStorageTaskAssignmentID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandStorageTaskAssignmentProperties(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateThenPoll(ctx, id, storagetaskassignments.StorageTaskAssignment{Properties: *properties}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTaskAssignmentsClient
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageTaskAssignmentModel{
+				Name:             id.StorageTaskAssignmentName,
+				StorageAccountId: parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+
+				taskId, err := storagetasks.ParseStorageTaskIDInsensitively(props.TaskId)
+				if err != nil {
+					return err
+				}
+				state.StorageTaskId = taskId.ID()
+				state.Description = props.Description
+				state.Enabled = props.Enabled
+				state.ReportPrefix = props.Report.Prefix
+				state.Schedule = flattenStorageTaskAssignmentSchedule(props.ExecutionContext.Trigger)
+				state.Target = flattenStorageTaskAssignmentTarget(props.ExecutionContext.Target)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTaskAssignmentsClient
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageTaskAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties, err := expandStorageTaskAssignmentProperties(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateThenPoll(ctx, *id, storagetaskassignments.StorageTaskAssignment{Properties: *properties}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTaskAssignmentsClient
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageTaskAssignmentProperties(model StorageTaskAssignmentModel) (*storagetaskassignments.StorageTaskAssignmentProperties, error) {
+	trigger, err := expandStorageTaskAssignmentSchedule(model.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagetaskassignments.StorageTaskAssignmentProperties{
+		Description: model.Description,
+		Enabled:     model.Enabled,
+		ExecutionContext: storagetaskassignments.StorageTaskAssignmentExecutionContext{
+			Target:  expandStorageTaskAssignmentTarget(model.Target),
+			Trigger: *trigger,
+		},
+		Report: storagetaskassignments.StorageTaskAssignmentReport{
+			Prefix: model.ReportPrefix,
+		},
+		TaskId: model.StorageTaskId,
+	}, nil
+}
+
+func expandStorageTaskAssignmentSchedule(input []StorageTaskAssignmentSchedule) (*storagetaskassignments.ExecutionTrigger, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("`schedule` must be specified")
+	}
+
+	schedule := input[0]
+	trigger := storagetaskassignments.ExecutionTrigger{
+		Type: storagetaskassignments.TriggerType(schedule.Type),
+	}
+
+	switch trigger.Type {
+	case storagetaskassignments.TriggerTypeRunOnce:
+		if schedule.StartOn == "" {
+			return nil, fmt.Errorf("`start_on` must be specified when `type` is `RunOnce`")
+		}
+		if schedule.StartFrom != "" || schedule.EndBy != "" || schedule.IntervalInDays != 0 {
+			return nil, fmt.Errorf("`start_from`, `end_by` and `interval_in_days` cannot be specified when `type` is `RunOnce`")
+		}
+		trigger.Parameters.StartOn = utils.String(schedule.StartOn)
+
+	case storagetaskassignments.TriggerTypeOnSchedule:
+		if schedule.StartFrom == "" || schedule.EndBy == "" || schedule.IntervalInDays == 0 {
+			return nil, fmt.Errorf("`start_from`, `end_by` and `interval_in_days` must be specified when `type` is `OnSchedule`")
+		}
+		if schedule.StartOn != "" {
+			return nil, fmt.Errorf("`start_on` cannot be specified when `type` is `OnSchedule`")
+		}
+		intervalUnit := storagetaskassignments.IntervalUnitsDays
+		trigger.Parameters.StartFrom = utils.String(schedule.StartFrom)
+		trigger.Parameters.EndBy = utils.String(schedule.EndBy)
+		trigger.Parameters.Interval = utils.Int64(schedule.IntervalInDays)
+		trigger.Parameters.IntervalUnit = &intervalUnit
+	}
+
+	return &trigger, nil
+}
+
+func expandStorageTaskAssignmentTarget(input []StorageTaskAssignmentTarget) *storagetaskassignments.ExecutionTarget {
+	if len(input) == 0 {
+		return nil
+	}
+
+	target := input[0]
+	return &storagetaskassignments.ExecutionTarget{
+		Prefix:        &target.Prefixes,
+		ExcludePrefix: &target.ExcludePrefixes,
+	}
+}
+
+func flattenStorageTaskAssignmentSchedule(input storagetaskassignments.ExecutionTrigger) []StorageTaskAssignmentSchedule {
+	schedule := StorageTaskAssignmentSchedule{
+		Type:           string(input.Type),
+		IntervalInDays: utils.NormaliseNilableInt64(input.Parameters.Interval),
+	}
+
+	if v, err := input.Parameters.GetStartOnAsTime(); err == nil && v != nil {
+		schedule.StartOn = v.Format(time.RFC3339)
+	}
+	if v, err := input.Parameters.GetStartFromAsTime(); err == nil && v != nil {
+		schedule.StartFrom = v.Format(time.RFC3339)
+	}
+	if v, err := input.Parameters.GetEndByAsTime(); err == nil && v != nil {
+		schedule.EndBy = v.Format(time.RFC3339)
+	}
+
+	return []StorageTaskAssignmentSchedule{schedule}
+}
+
+func flattenStorageTaskAssignmentTarget(input *storagetaskassignments.ExecutionTarget) []StorageTaskAssignmentTarget {
+	if input == nil {
+		return []StorageTaskAssignmentTarget{}
+	}
+
+	target := StorageTaskAssignmentTarget{}
+	if input.Prefix != nil {
+		target.Prefixes = *input.Prefix
+	}
+	if input.ExcludePrefix != nil {
+		target.ExcludePrefixes = *input.ExcludePrefix
+	}
+
+	if len(target.Prefixes) == 0 && len(target.ExcludePrefixes) == 0 {
+		return []StorageTaskAssignmentTarget{}
+	}
+
+	return []StorageTaskAssignmentTarget{target}
+}
@@ -0,0 +1,216 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageTaskAssignmentResource struct{}
+
+func TestAccStorageTaskAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTaskAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTaskAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := StorageTaskAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTaskAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := storagetaskassignments.ParseStorageTaskAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.StorageTaskAssignmentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageTaskAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "reports"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_task" "test" {
+  name                = "acctestst%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  description         = "Storage Task for acceptance testing"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  action {
+    if {
+      condition = "[[equals(AccessTier, 'Cool')]]"
+
+      operation {
+        name = "SetBlobTier"
+        parameters = {
+          tier = "Hot"
+        }
+      }
+    }
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = azurerm_storage_task.test.identity.0.principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageTaskAssignmentResource) basic(data acceptance.TestData) string {
+	startOn := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "accteststa%s"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = azurerm_storage_task.test.id
+  description        = "Storage Task Assignment for acceptance testing"
+  report_prefix      = azurerm_storage_container.test.name
+
+  schedule {
+    type     = "RunOnce"
+    start_on = "%s"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString, startOn)
+}
+
+func (r StorageTaskAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "import" {
+  name               = azurerm_storage_task_assignment.test.name
+  storage_account_id = azurerm_storage_task_assignment.test.storage_account_id
+  storage_task_id    = azurerm_storage_task_assignment.test.storage_task_id
+  description        = azurerm_storage_task_assignment.test.description
+  report_prefix      = azurerm_storage_task_assignment.test.report_prefix
+
+  schedule {
+    type     = azurerm_storage_task_assignment.test.schedule.0.type
+    start_on = azurerm_storage_task_assignment.test.schedule.0.start_on
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageTaskAssignmentResource) complete(data acceptance.TestData) string {
+	startFrom := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	endBy := time.Now().UTC().Add(30 * 24 * time.Hour).Format(time.RFC3339)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "accteststa%s"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = azurerm_storage_task.test.id
+  description        = "Updated Storage Task Assignment for acceptance testing"
+  enabled            = false
+  report_prefix      = "${azurerm_storage_container.test.name}/weekly"
+
+  schedule {
+    type             = "OnSchedule"
+    start_from       = "%s"
+    end_by           = "%s"
+    interval_in_days = 7
+  }
+
+  target {
+    prefixes         = ["logs/"]
+    exclude_prefixes = ["logs/keep/"]
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString, startFrom, endBy)
+}
@@ -0,0 +1,431 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageTaskModel struct {
+	Name              string              `tfschema:"name"`
+	ResourceGroupName string              `tfschema:"resource_group_name"`
+	Location          string              `tfschema:"location"`
+	Description       string              `tfschema:"description"`
+	Enabled           bool                `tfschema:"enabled"`
+	Action            []StorageTaskAction `tfschema:"action"`
+	Tags              map[string]string   `tfschema:"tags"`
+}
+
+type StorageTaskAction struct {
+	If   []StorageTaskIfCondition   `tfschema:"if"`
+	Else []StorageTaskElseCondition `tfschema:"else"`
+}
+
+type StorageTaskIfCondition struct {
+	Condition string                 `tfschema:"condition"`
+	Operation []StorageTaskOperation `tfschema:"operation"`
+}
+
+type StorageTaskElseCondition struct {
+	Operation []StorageTaskOperation `tfschema:"operation"`
+}
+
+type StorageTaskOperation struct {
+	Name       string            `tfschema:"name"`
+	Parameters map[string]string `tfschema:"parameters"`
+	OnSuccess  string            `tfschema:"on_success"`
+	OnFailure  string            `tfschema:"on_failure"`
+}
+
+var _ sdk.ResourceWithUpdate = StorageTaskResource{}
+
+type StorageTaskResource struct{}
+
+func (r StorageTaskResource) ResourceType() string {
+	return "azurerm_storage_task"
+}
+
+func (r StorageTaskResource) ModelObject() interface{} {
+	return &StorageTaskModel{}
+}
+
+func (r StorageTaskResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storagetasks.ValidateStorageTaskID
+}
+
+func (r StorageTaskResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageTaskName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"action": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"if": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"condition": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"operation": storageTaskOperationSchema(),
+							},
+						},
+					},
+
+					"else": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"operation": storageTaskOperationSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r StorageTaskResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageTaskResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StorageTaskModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := storagetasks.NewStorageTaskID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			task := storagetasks.StorageTask{
+				Identity: identityValue,
+				Location: location.Normalize(model.Location),
+				Properties: storagetasks.StorageTaskProperties{
+					Action:      expandStorageTaskAction(model.Action),
+					Description: model.Description,
+					Enabled:     model.Enabled,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateThenPoll(ctx, id, task); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageTaskModel{
+				Name:              id.StorageTaskName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			var identityValue *[]interface{}
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				identityValue, err = identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+
+				state.Action = flattenStorageTaskAction(model.Properties.Action)
+				state.Description = model.Properties.Description
+				state.Enabled = model.Properties.Enabled
+			}
+
+			if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTaskResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageTaskModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			// the API replaces the whole Storage Task, so the full payload is sent on every update
+			task := storagetasks.StorageTask{
+				Identity: identityValue,
+				Location: existing.Model.Location,
+				Properties: storagetasks.StorageTaskProperties{
+					Action:      expandStorageTaskAction(model.Action),
+					Description: model.Description,
+					Enabled:     model.Enabled,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateThenPoll(ctx, *id, task); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTaskResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.StorageTasksClient
+
+			id, err := storagetasks.ParseStorageTaskID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func storageTaskOperationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForStorageTaskOperationName(), false),
+				},
+
+				"parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"on_success": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(storagetasks.OnSuccessContinue),
+					ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForOnSuccess(), false),
+				},
+
+				"on_failure": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(storagetasks.OnFailureBreak),
+					ValidateFunc: validation.StringInSlice(storagetasks.PossibleValuesForOnFailure(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandStorageTaskAction(input []StorageTaskAction) storagetasks.StorageTaskAction {
+	output := storagetasks.StorageTaskAction{}
+	if len(input) == 0 {
+		return output
+	}
+
+	action := input[0]
+	if len(action.If) > 0 {
+		output.If = storagetasks.IfCondition{
+			Condition:  action.If[0].Condition,
+			Operations: expandStorageTaskOperations(action.If[0].Operation),
+		}
+	}
+
+	if len(action.Else) > 0 {
+		output.Else = &storagetasks.ElseCondition{
+			Operations: expandStorageTaskOperations(action.Else[0].Operation),
+		}
+	}
+
+	return output
+}
+
+func expandStorageTaskOperations(input []StorageTaskOperation) []storagetasks.StorageTaskOperation {
+	output := make([]storagetasks.StorageTaskOperation, 0)
+	for _, v := range input {
+		onSuccess := storagetasks.OnSuccess(v.OnSuccess)
+		onFailure := storagetasks.OnFailure(v.OnFailure)
+		parameters := v.Parameters
+
+		operation := storagetasks.StorageTaskOperation{
+			Name:      storagetasks.StorageTaskOperationName(v.Name),
+			OnSuccess: &onSuccess,
+			OnFailure: &onFailure,
+		}
+		if len(parameters) > 0 {
+			operation.Parameters = &parameters
+		}
+
+		output = append(output, operation)
+	}
+	return output
+}
+
+func flattenStorageTaskAction(input storagetasks.StorageTaskAction) []StorageTaskAction {
+	action := StorageTaskAction{
+		If: []StorageTaskIfCondition{
+			{
+				Condition: input.If.Condition,
+				Operation: flattenStorageTaskOperations(input.If.Operations),
+			},
+		},
+	}
+
+	if input.Else != nil {
+		action.Else = []StorageTaskElseCondition{
+			{
+				Operation: flattenStorageTaskOperations(input.Else.Operations),
+			},
+		}
+	}
+
+	return []StorageTaskAction{action}
+}
+
+func flattenStorageTaskOperations(input []storagetasks.StorageTaskOperation) []StorageTaskOperation {
+	output := make([]StorageTaskOperation, 0)
+	for _, v := range input {
+		operation := StorageTaskOperation{
+			Name:      string(v.Name),
+			OnSuccess: string(storagetasks.OnSuccessContinue),
+			OnFailure: string(storagetasks.OnFailureBreak),
+		}
+		if v.Parameters != nil {
+			operation.Parameters = *v.Parameters
+		}
+		if v.OnSuccess != nil {
+			operation.OnSuccess = string(*v.OnSuccess)
+		}
+		if v.OnFailure != nil {
+			operation.OnFailure = string(*v.OnFailure)
+		}
+
+		output = append(output, operation)
+	}
+	return output
+}
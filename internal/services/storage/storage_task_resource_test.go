@@ -0,0 +1,238 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2023-01-01/storagetasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageTaskResource struct{}
+
+func TestAccStorageTask_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task", "test")
+	r := StorageTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTask_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task", "test")
+	r := StorageTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTask_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task", "test")
+	r := StorageTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTask_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task", "test")
+	r := StorageTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTaskResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := storagetasks.ParseStorageTaskID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.StorageTasksClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageTaskResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StorageTaskResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task" "test" {
+  name                = "acctestst%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  description         = "Storage Task for acceptance testing"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  action {
+    if {
+      condition = "[[equals(AccessTier, 'Cool')]]"
+
+      operation {
+        name = "SetBlobTier"
+        parameters = {
+          tier = "Hot"
+        }
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r StorageTaskResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task" "import" {
+  name                = azurerm_storage_task.test.name
+  resource_group_name = azurerm_storage_task.test.resource_group_name
+  location            = azurerm_storage_task.test.location
+  description         = azurerm_storage_task.test.description
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  action {
+    if {
+      condition = "[[equals(AccessTier, 'Cool')]]"
+
+      operation {
+        name = "SetBlobTier"
+        parameters = {
+          tier = "Hot"
+        }
+      }
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageTaskResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_storage_task" "test" {
+  name                = "acctestst%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  description         = "Updated Storage Task for acceptance testing"
+  enabled             = false
+
+  identity {
+    type         = "SystemAssigned, UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  action {
+    if {
+      condition = "[[and(endsWith(Name, '.log'), lessOrEquals(Creation-Time, 'PT720H'))]]"
+
+      operation {
+        name       = "SetBlobTier"
+        on_success = "continue"
+        on_failure = "break"
+        parameters = {
+          tier = "Cool"
+        }
+      }
+
+      operation {
+        name = "SetBlobTags"
+        parameters = {
+          "tag-category" = "logs"
+        }
+      }
+    }
+
+    else {
+      operation {
+        name = "DeleteBlob"
+      }
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageTaskName(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile("^[0-9a-z]{3,18}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("storage task name %q must only contain lowercase letters and numbers, and be between 3 and 18 characters", input))
+	}
+
+	return warnings, errors
+}
+
+func StorageTaskAssignmentName(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile("^[0-9a-z]{3,24}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("storage task assignment name %q must only contain lowercase letters and numbers, and be between 3 and 24 characters", input))
+	}
+
+	return warnings, errors
+}
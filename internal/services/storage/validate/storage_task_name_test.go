@@ -0,0 +1,59 @@
+package validate
+
+import "testing"
+
+func TestStorageTaskName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"task1", false},
+		{"Task1", true},
+		{"task-1", true},
+		{"task_1", true},
+		{"abcdefghijklmnopqr", false},
+		{"abcdefghijklmnopqrs", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageTaskName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q to succeed but got %+v", test.input, es)
+		}
+	}
+}
+
+func TestStorageTaskAssignmentName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"abc", false},
+		{"assignment1", false},
+		{"Assignment1", true},
+		{"assignment-1", true},
+		{"assignment_1", true},
+		{"abcdefghijklmnopqrstuvwx", false},
+		{"abcdefghijklmnopqrstuvwxy", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageTaskAssignmentName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q to succeed but got %+v", test.input, es)
+		}
+	}
+}
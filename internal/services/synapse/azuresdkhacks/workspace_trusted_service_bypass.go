@@ -0,0 +1,103 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/synapse/mgmt/2021-03-01/synapse"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since `trustedServiceBypassEnabled` is only available from API Version
+// `2021-06-01` of the Synapse API, whereas the Workspace resources are built against API Version `2021-03-01`.
+// Once the Synapse resources have been migrated to a newer API Version this can be removed.
+
+const workspaceTrustedServiceBypassAPIVersion = "2021-06-01"
+
+type WorkspaceTrustedServiceBypassClient struct {
+	sdkClient *synapse.WorkspacesClient
+}
+
+func NewWorkspaceTrustedServiceBypassClient(client *synapse.WorkspacesClient) WorkspaceTrustedServiceBypassClient {
+	return WorkspaceTrustedServiceBypassClient{
+		sdkClient: client,
+	}
+}
+
+// WorkspaceTrustedServiceBypass is the subset of a Synapse Workspace needed to manage the Trusted Service Bypass.
+type WorkspaceTrustedServiceBypass struct {
+	autorest.Response `json:"-"`
+	Properties        *WorkspaceTrustedServiceBypassProperties `json:"properties,omitempty"`
+}
+
+type WorkspaceTrustedServiceBypassProperties struct {
+	TrustedServiceBypassEnabled *bool `json:"trustedServiceBypassEnabled,omitempty"`
+}
+
+func (c WorkspaceTrustedServiceBypassClient) Get(ctx context.Context, resourceGroupName string, workspaceName string) (result WorkspaceTrustedServiceBypass, err error) {
+	req, err := c.preparer(ctx, resourceGroupName, workspaceName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "synapse.WorkspacesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.sdkClient.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "synapse.WorkspacesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "synapse.WorkspacesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c WorkspaceTrustedServiceBypassClient) Update(ctx context.Context, resourceGroupName string, workspaceName string, enabled bool) (result synapse.WorkspacesUpdateFuture, err error) {
+	payload := WorkspaceTrustedServiceBypass{
+		Properties: &WorkspaceTrustedServiceBypassProperties{
+			TrustedServiceBypassEnabled: &enabled,
+		},
+	}
+	req, err := c.preparer(ctx, resourceGroupName, workspaceName, autorest.AsPatch(), autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(payload))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "synapse.WorkspacesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.sdkClient.UpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "synapse.WorkspacesClient", "Update", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+func (c WorkspaceTrustedServiceBypassClient) preparer(ctx context.Context, resourceGroupName string, workspaceName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", c.sdkClient.SubscriptionID),
+		"workspaceName":     autorest.Encode("path", workspaceName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": workspaceTrustedServiceBypassAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.sdkClient.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Synapse/workspaces/{workspaceName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
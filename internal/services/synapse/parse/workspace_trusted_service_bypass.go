@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceTrustedServiceBypassId struct {
+	SubscriptionId                  string
+	ResourceGroup                   string
+	WorkspaceName                   string
+	TrustedServiceBypassSettingName string
+}
+
+func NewWorkspaceTrustedServiceBypassID(subscriptionId, resourceGroup, workspaceName, trustedServiceBypassSettingName string) WorkspaceTrustedServiceBypassId {
+	return WorkspaceTrustedServiceBypassId{
+		SubscriptionId:                  subscriptionId,
+		ResourceGroup:                   resourceGroup,
+		WorkspaceName:                   workspaceName,
+		TrustedServiceBypassSettingName: trustedServiceBypassSettingName,
+	}
+}
+
+func (id WorkspaceTrustedServiceBypassId) String() string {
+	segments := []string{
+		fmt.Sprintf("Trusted Service Bypass Setting Name %q", id.TrustedServiceBypassSettingName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Trusted Service Bypass", segmentsStr)
+}
+
+func (id WorkspaceTrustedServiceBypassId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Synapse/workspaces/%s/trustedServiceBypassSettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.TrustedServiceBypassSettingName)
+}
+
+// WorkspaceTrustedServiceBypassID parses a WorkspaceTrustedServiceBypass ID into an WorkspaceTrustedServiceBypassId struct
+func WorkspaceTrustedServiceBypassID(input string) (*WorkspaceTrustedServiceBypassId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceTrustedServiceBypassId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.TrustedServiceBypassSettingName, err = id.PopSegment("trustedServiceBypassSettings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -106,6 +106,38 @@ func TestAccSynapseWorkspace_update(t *testing.T) {
 	})
 }
 
+func TestAccSynapseWorkspace_linkingAllowedForAadTenantIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace", "test")
+	r := SynapseWorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkingAllowedForAadTenantIds(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linking_allowed_for_aad_tenant_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+		{
+			Config: r.linkingAllowedForAadTenantIdsEmpty(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linking_allowed_for_aad_tenant_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+		{
+			Config: r.linkingAllowedForAadTenantIds(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linking_allowed_for_aad_tenant_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+	})
+}
+
 func TestAccSynapseWorkspace_azdo(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace", "test")
 	r := SynapseWorkspaceResource{}
@@ -297,6 +329,54 @@ resource "azurerm_synapse_workspace" "test" {
 `, template, data.RandomString, data.Locations.Secondary, data.RandomString, data.RandomString, data.RandomInteger, data.RandomInteger)
 }
 
+func (r SynapseWorkspaceResource) linkingAllowedForAadTenantIds(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+  data_exfiltration_protection_enabled = true
+  managed_virtual_network_enabled      = true
+  linking_allowed_for_aad_tenant_ids   = [data.azurerm_client_config.current.tenant_id]
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r SynapseWorkspaceResource) linkingAllowedForAadTenantIdsEmpty(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+  data_exfiltration_protection_enabled = true
+  managed_virtual_network_enabled      = true
+  linking_allowed_for_aad_tenant_ids   = []
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r SynapseWorkspaceResource) withAadAdmin(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,135 @@
+package synapse
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// trustedServiceBypassSettingName is the name used in the ID of this resource, since there's only one per Workspace
+const trustedServiceBypassSettingName = "default"
+
+func resourceSynapseWorkspaceTrustedServiceBypass() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseWorkspaceTrustedServiceBypassCreate,
+		Read:   resourceSynapseWorkspaceTrustedServiceBypassRead,
+		Delete: resourceSynapseWorkspaceTrustedServiceBypassDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.WorkspaceTrustedServiceBypassID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+		},
+	}
+}
+
+func resourceSynapseWorkspaceTrustedServiceBypassCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewWorkspaceTrustedServiceBypassClient(meta.(*clients.Client).Synapse.WorkspaceClient)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewWorkspaceTrustedServiceBypassID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, trustedServiceBypassSettingName)
+
+	existing, err := client.Get(ctx, workspaceId.ResourceGroup, workspaceId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *workspaceId, err)
+	}
+	if existing.Properties != nil && existing.Properties.TrustedServiceBypassEnabled != nil && *existing.Properties.TrustedServiceBypassEnabled {
+		return tf.ImportAsExistsError("azurerm_synapse_workspace_trusted_service_bypass", id.ID())
+	}
+
+	future, err := client.Update(ctx, workspaceId.ResourceGroup, workspaceId.Name, true)
+	if err != nil {
+		return fmt.Errorf("enabling Trusted Service Bypass for %s: %+v", *workspaceId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, meta.(*clients.Client).Synapse.WorkspaceClient.Client); err != nil {
+		return fmt.Errorf("waiting for Trusted Service Bypass to be enabled for %s: %+v", *workspaceId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSynapseWorkspaceTrustedServiceBypassRead(d, meta)
+}
+
+func resourceSynapseWorkspaceTrustedServiceBypassRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewWorkspaceTrustedServiceBypassClient(meta.(*clients.Client).Synapse.WorkspaceClient)
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.WorkspaceTrustedServiceBypassID(d.Id())
+	if err != nil {
+		return err
+	}
+	workspaceId := parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+
+	resp, err := client.Get(ctx, workspaceId.ResourceGroup, workspaceId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", workspaceId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", workspaceId, err)
+	}
+
+	if resp.Properties == nil || resp.Properties.TrustedServiceBypassEnabled == nil || !*resp.Properties.TrustedServiceBypassEnabled {
+		log.Printf("[INFO] Trusted Service Bypass is not enabled for %s - removing from state", workspaceId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("synapse_workspace_id", workspaceId.ID())
+
+	return nil
+}
+
+func resourceSynapseWorkspaceTrustedServiceBypassDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := azuresdkhacks.NewWorkspaceTrustedServiceBypassClient(meta.(*clients.Client).Synapse.WorkspaceClient)
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.WorkspaceTrustedServiceBypassID(d.Id())
+	if err != nil {
+		return err
+	}
+	workspaceId := parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName)
+
+	future, err := client.Update(ctx, workspaceId.ResourceGroup, workspaceId.Name, false)
+	if err != nil {
+		return fmt.Errorf("disabling Trusted Service Bypass for %s: %+v", workspaceId, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, meta.(*clients.Client).Synapse.WorkspaceClient.Client); err != nil {
+		return fmt.Errorf("waiting for Trusted Service Bypass to be disabled for %s: %+v", workspaceId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SynapseWorkspaceTrustedServiceBypassResource struct{}
+
+func TestAccSynapseWorkspaceTrustedServiceBypass_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_trusted_service_bypass", "test")
+	r := SynapseWorkspaceTrustedServiceBypassResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSynapseWorkspaceTrustedServiceBypass_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_trusted_service_bypass", "test")
+	r := SynapseWorkspaceTrustedServiceBypassResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SynapseWorkspaceTrustedServiceBypassResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkspaceTrustedServiceBypassID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := azuresdkhacks.NewWorkspaceTrustedServiceBypassClient(client.Synapse.WorkspaceClient).Get(ctx, id.ResourceGroup, id.WorkspaceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	enabled := resp.Properties != nil && resp.Properties.TrustedServiceBypassEnabled != nil && *resp.Properties.TrustedServiceBypassEnabled
+	return utils.Bool(enabled), nil
+}
+
+func (r SynapseWorkspaceTrustedServiceBypassResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace_trusted_service_bypass" "test" {
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+}
+`, template)
+}
+
+func (r SynapseWorkspaceTrustedServiceBypassResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace_trusted_service_bypass" "import" {
+  synapse_workspace_id = azurerm_synapse_workspace_trusted_service_bypass.test.synapse_workspace_id
+}
+`, config)
+}
+
+func (r SynapseWorkspaceTrustedServiceBypassResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-synapse-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "BlobStorage"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+  managed_virtual_network_enabled      = true
+  data_exfiltration_protection_enabled = true
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, data.RandomInteger)
+}
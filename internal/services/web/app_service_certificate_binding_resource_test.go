@@ -75,6 +75,30 @@ func TestAccAppServiceCertificateBinding_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceCertificateBinding_linuxWebApp(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_certificate_binding", "test")
+	r := AppServiceCertificateBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linuxWebApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("thumbprint").MatchesOtherKey(
+					check.That("azurerm_app_service_managed_certificate.test").Key("thumbprint"),
+				),
+				check.That(data.ResourceName).Key("app_service_name").MatchesOtherKey(
+					check.That("azurerm_linux_web_app.test").Key("name"),
+				),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t AppServiceCertificateBindingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.CertificateBindingID(state.ID)
 	if err != nil {
@@ -222,3 +246,80 @@ resource "azurerm_app_service_managed_certificate" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString, dnsZone, dataResourceGroup, data.RandomString, data.RandomString)
 }
+
+func (AppServiceCertificateBindingResource) linuxWebApp(data acceptance.TestData) string {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dataResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-asmc-%d"
+  location = "%s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "B1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctest%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = "%s"
+  resource_group_name = "%s"
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "%s"
+  zone_name           = data.azurerm_dns_zone.test.name
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  ttl                 = 300
+  record              = azurerm_linux_web_app.test.default_hostname
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = join(".", ["asuid", "%s"])
+  zone_name           = data.azurerm_dns_zone.test.name
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  ttl                 = 300
+
+  record {
+    value = azurerm_linux_web_app.test.custom_domain_verification_id
+  }
+}
+
+resource "azurerm_app_service_custom_hostname_binding" "test" {
+  hostname            = join(".", [azurerm_dns_cname_record.test.name, azurerm_dns_cname_record.test.zone_name])
+  app_service_name    = azurerm_linux_web_app.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  depends_on          = [azurerm_dns_txt_record.test]
+
+  lifecycle {
+    ignore_changes = [ssl_state, thumbprint]
+  }
+}
+
+resource "azurerm_app_service_managed_certificate" "test" {
+  custom_hostname_binding_id = azurerm_app_service_custom_hostname_binding.test.id
+}
+
+resource "azurerm_app_service_certificate_binding" "test" {
+  hostname_binding_id = azurerm_app_service_custom_hostname_binding.test.id
+  certificate_id      = azurerm_app_service_managed_certificate.test.id
+  ssl_state           = "SniEnabled"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString, dnsZone, dataResourceGroup, data.RandomString, data.RandomString)
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -162,8 +164,59 @@ func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"renew_before_expiry_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 90),
+			},
+
+			"next_auto_renewal_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"key_vault_certificate": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"key_vault_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.VaultID,
+						},
+
+						"key_vault_secret_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemName,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// the renewal window is evaluated client-side, so an update needs to be planned once it's been reached
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if d.Id() == "" {
+					return nil
+				}
+				if certificateOrderRequiresRenewal(d.Get("renew_before_expiry_in_days").(int), d.Get("status").(string), d.Get("expiration_time").(string), d.Get("app_service_certificate_not_renewable_reasons").([]interface{})) {
+					return d.SetNewComputed("expiration_time")
+				}
+				return nil
+			},
+		),
 	}
 }
 
@@ -232,6 +285,85 @@ func resourceAppServiceCertificateOrderCreateUpdate(d *pluginsdk.ResourceData, m
 
 	d.SetId(id.ID())
 
+	if d.HasChange("key_vault_certificate") {
+		oldRaw, newRaw := d.GetChange("key_vault_certificate")
+		oldCertificates := oldRaw.([]interface{})
+		newCertificates := newRaw.([]interface{})
+
+		if len(oldCertificates) > 0 && oldCertificates[0] != nil {
+			oldName := oldCertificates[0].(map[string]interface{})["name"].(string)
+			if len(newCertificates) == 0 || newCertificates[0] == nil || newCertificates[0].(map[string]interface{})["name"].(string) != oldName {
+				log.Printf("[DEBUG] Removing Key Vault Certificate %q from %s", oldName, id)
+				resp, err := client.DeleteCertificate(ctx, id.ResourceGroup, id.Name, oldName)
+				if err != nil && !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("removing Key Vault Certificate %q from %s: %+v", oldName, id, err)
+				}
+			}
+		}
+
+		if len(newCertificates) > 0 && newCertificates[0] != nil {
+			certificate := newCertificates[0].(map[string]interface{})
+			name := certificate["name"].(string)
+
+			log.Printf("[DEBUG] Binding Key Vault Certificate %q to %s", name, id)
+			parameters := web.AppServiceCertificateResource{
+				AppServiceCertificate: &web.AppServiceCertificate{
+					KeyVaultID:         utils.String(certificate["key_vault_id"].(string)),
+					KeyVaultSecretName: utils.String(certificate["key_vault_secret_name"].(string)),
+				},
+				Location: utils.String("global"),
+			}
+			future, err := client.CreateOrUpdateCertificate(ctx, id.ResourceGroup, id.Name, name, parameters)
+			if err != nil {
+				return fmt.Errorf("binding Key Vault Certificate %q to %s: %+v", name, id, err)
+			}
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for Key Vault Certificate %q to be bound to %s: %+v", name, id, err)
+			}
+		}
+	}
+
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := existing.AppServiceCertificateOrderProperties; props != nil {
+			expirationTime := ""
+			if props.ExpirationTime != nil {
+				expirationTime = props.ExpirationTime.Format(time.RFC3339)
+			}
+			if certificateOrderRequiresRenewal(d.Get("renew_before_expiry_in_days").(int), string(props.Status), expirationTime, utils.FlattenStringSlice(props.AppServiceCertificateNotRenewableReasons)) {
+				log.Printf("[DEBUG] Renewing %s since it expires at %q", id, expirationTime)
+				renewal := web.RenewCertificateOrderRequest{
+					RenewCertificateOrderRequestProperties: &web.RenewCertificateOrderRequestProperties{
+						KeySize:              utils.Int32(int32(keySize)),
+						IsPrivateKeyExternal: props.IsPrivateKeyExternal,
+					},
+				}
+				if csr != "" {
+					renewal.RenewCertificateOrderRequestProperties.Csr = utils.String(csr)
+				}
+				if _, err := client.Renew(ctx, id.ResourceGroup, id.Name, renewal); err != nil {
+					return fmt.Errorf("renewing %s: %+v", id, err)
+				}
+
+				// the renewal is processed asynchronously, so we need to wait for the renewed certificate to be issued
+				renewWait := pluginsdk.StateChangeConf{
+					Pending:    []string{"Pending"},
+					Target:     []string{"Renewed"},
+					MinTimeout: 1 * time.Minute,
+					Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
+					Refresh:    appServiceCertificateOrderRenewalRefresh(ctx, client, id, expirationTime),
+				}
+				if _, err := renewWait.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for renewal of %s: %+v", id, err)
+				}
+			}
+		}
+	}
+
 	return resourceAppServiceCertificateOrderRead(d, meta)
 }
 
@@ -296,6 +428,16 @@ func resourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta inte
 		if intermediate := props.Intermediate; intermediate != nil {
 			d.Set("intermediate_thumbprint", intermediate.Thumbprint)
 		}
+
+		nextAutoRenewalTime := ""
+		if v := props.NextAutoRenewalTimeStamp; v != nil {
+			nextAutoRenewalTime = v.Format(time.RFC3339)
+		}
+		d.Set("next_auto_renewal_time", nextAutoRenewalTime)
+
+		if err := d.Set("key_vault_certificate", flattenCertificateOrderKeyVaultCertificate(d.Get("key_vault_certificate").([]interface{}), props.Certificates)); err != nil {
+			return fmt.Errorf("setting `key_vault_certificate`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -344,3 +486,68 @@ func flattenArmCertificateOrderCertificate(input map[string]*web.AppServiceCerti
 
 	return results
 }
+
+// flattenCertificateOrderKeyVaultCertificate returns the Key Vault Certificate bound by this resource - since other
+// certificates can be bound to the order outside of Terraform, only the certificate named in the configuration is tracked
+func flattenCertificateOrderKeyVaultCertificate(configured []interface{}, input map[string]*web.AppServiceCertificate) []interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return []interface{}{}
+	}
+
+	name := configured[0].(map[string]interface{})["name"].(string)
+	certificate, ok := input[name]
+	if !ok || certificate == nil {
+		return []interface{}{}
+	}
+
+	keyVaultId := ""
+	if certificate.KeyVaultID != nil {
+		keyVaultId = *certificate.KeyVaultID
+	}
+	keyVaultSecretName := ""
+	if certificate.KeyVaultSecretName != nil {
+		keyVaultSecretName = *certificate.KeyVaultSecretName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                  name,
+			"key_vault_id":          keyVaultId,
+			"key_vault_secret_name": keyVaultSecretName,
+		},
+	}
+}
+
+// certificateOrderRequiresRenewal determines whether an issued, renewable certificate expires within the configured renewal window
+func certificateOrderRequiresRenewal(renewBeforeExpiryInDays int, status string, expirationTime string, notRenewableReasons []interface{}) bool {
+	if renewBeforeExpiryInDays == 0 || expirationTime == "" || len(notRenewableReasons) > 0 {
+		return false
+	}
+	if status != string(web.CertificateOrderStatusIssued) {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expirationTime)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().Add(time.Duration(renewBeforeExpiryInDays) * 24 * time.Hour).After(expiry)
+}
+
+func appServiceCertificateOrderRenewalRefresh(ctx context.Context, client *web.AppServiceCertificateOrdersClient, id parse.CertificateOrderId, previousExpirationTime string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := resp.AppServiceCertificateOrderProperties; props != nil && props.Status == web.CertificateOrderStatusIssued && props.ExpirationTime != nil {
+			if expirationTime := props.ExpirationTime.Format(time.RFC3339); expirationTime != previousExpirationTime {
+				return resp, "Renewed", nil
+			}
+		}
+
+		return resp, "Pending", nil
+	}
+}
@@ -109,6 +109,34 @@ func TestAccAppServiceCertificateOrder_complete(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceCertificateOrder_renewalWindow(t *testing.T) {
+	if os.Getenv("ARM_RUN_TEST_APP_SERVICE_CERTIFICATE") == "" {
+		t.Skip("Skipping as ARM_RUN_TEST_APP_SERVICE_CERTIFICATE is not specified")
+		return
+	}
+	data := acceptance.BuildTestData(t, "azurerm_app_service_certificate_order", "test")
+	r := AppServiceCertificateOrderResource{}
+
+	// `renew_before_expiry_in_days` is evaluated client-side and isn't returned by the API, so can't be imported
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.renewalWindow(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("renew_before_expiry_in_days").HasValue("30"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("renew_before_expiry_in_days").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAppServiceCertificateOrder_update(t *testing.T) {
 	if os.Getenv("ARM_RUN_TEST_APP_SERVICE_CERTIFICATE") == "" {
 		t.Skip("Skipping as ARM_RUN_TEST_APP_SERVICE_CERTIFICATE is not specified")
@@ -248,3 +276,26 @@ resource "azurerm_app_service_certificate_order" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, keySize)
 }
+
+func (r AppServiceCertificateOrderResource) renewalWindow(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_certificate_order" "test" {
+  name                = "acctestASCO-%d"
+  location            = "global"
+  resource_group_name = azurerm_resource_group.test.name
+  distinguished_name  = "CN=example.com"
+  product_type        = "Standard"
+
+  renew_before_expiry_in_days = 30
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -30,6 +30,8 @@ func TestAccAppServiceManagedCertificate_basicLinux(t *testing.T) {
 			Config: r.basicLinux(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+				check.That(data.ResourceName).Key("valid").HasValue("true"),
 			),
 		},
 	})
@@ -0,0 +1,83 @@
+package tags
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// MergeDefault merges the Default Tags specified in the Provider block into the Tags specified
+// on a Resource - where the same key is specified in both, the value on the Resource takes precedence
+func MergeDefault(defaultTags map[string]string, resourceTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(resourceTags))
+
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range resourceTags {
+		output[k] = v
+	}
+
+	return output
+}
+
+// FilterDefault returns the Tags read from the API without the Default Tags which haven't been configured on the
+// Resource - a Tag is only treated as a Default Tag when its value matches the value in the Provider block
+func FilterDefault(defaultTags map[string]string, input map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		if _, ok := configured[k]; !ok && isDefault(defaultTags, k, v) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// DefaultDiffSuppressFunc returns a DiffSuppressFunc which ignores the Default Tags (as returned by the
+// `defaultTags` func) which are present in the state but not in the configuration for the Resource - such
+// as when the Resource was last applied by a version of the Provider which stored these in `tags`
+func DefaultDiffSuppressFunc(defaultTags func() map[string]string) pluginsdk.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *pluginsdk.ResourceData) bool {
+		defaults := defaultTags()
+		if len(defaults) == 0 {
+			return false
+		}
+
+		segments := strings.SplitN(k, ".", 2)
+		if len(segments) != 2 {
+			return false
+		}
+
+		if segments[1] == "%" {
+			// the count only differs because of the Default Tags when every other Tag is present in both
+			oldRaw, newRaw := d.GetChange(segments[0])
+			oldTags, _ := oldRaw.(map[string]interface{})
+			newTags, _ := newRaw.(map[string]interface{})
+
+			for key := range newTags {
+				if _, ok := oldTags[key]; !ok {
+					return false
+				}
+			}
+			for key, value := range oldTags {
+				if _, ok := newTags[key]; !ok && !isDefault(defaults, key, value) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		return new == "" && isDefault(defaults, segments[1], old)
+	}
+}
+
+func isDefault(defaultTags map[string]string, key string, value interface{}) bool {
+	defaultValue, ok := defaultTags[key]
+	return ok && value == defaultValue
+}
@@ -0,0 +1,214 @@
+package tags
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestMergeDefault(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Defaults map[string]string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "Empty",
+			Defaults: map[string]string{},
+			Input:    map[string]interface{}{},
+			Expected: map[string]interface{}{},
+		},
+		{
+			Name: "Defaults Only",
+			Defaults: map[string]string{
+				"environment": "production",
+			},
+			Input: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"environment": "production",
+			},
+		},
+		{
+			Name:     "Resource Only",
+			Defaults: map[string]string{},
+			Input: map[string]interface{}{
+				"hello": "there",
+			},
+			Expected: map[string]interface{}{
+				"hello": "there",
+			},
+		},
+		{
+			Name: "Resource Overrides Default",
+			Defaults: map[string]string{
+				"environment": "production",
+				"owner":       "platform",
+			},
+			Input: map[string]interface{}{
+				"environment": "staging",
+				"hello":       "there",
+			},
+			Expected: map[string]interface{}{
+				"environment": "staging",
+				"hello":       "there",
+				"owner":       "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := MergeDefault(v.Defaults, v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFilterDefault(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Defaults   map[string]string
+		Input      map[string]interface{}
+		Configured map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Name:       "No Defaults",
+			Defaults:   map[string]string{},
+			Input:      map[string]interface{}{"hello": "there"},
+			Configured: map[string]interface{}{},
+			Expected:   map[string]interface{}{"hello": "there"},
+		},
+		{
+			Name:       "Default Removed",
+			Defaults:   map[string]string{"environment": "production"},
+			Input:      map[string]interface{}{"environment": "production", "hello": "there"},
+			Configured: map[string]interface{}{"hello": "there"},
+			Expected:   map[string]interface{}{"hello": "there"},
+		},
+		{
+			Name:       "Default Also Configured",
+			Defaults:   map[string]string{"environment": "production"},
+			Input:      map[string]interface{}{"environment": "production"},
+			Configured: map[string]interface{}{"environment": "production"},
+			Expected:   map[string]interface{}{"environment": "production"},
+		},
+		{
+			Name:       "Default Overridden Outside Of Terraform",
+			Defaults:   map[string]string{"environment": "production"},
+			Input:      map[string]interface{}{"environment": "staging"},
+			Configured: map[string]interface{}{},
+			Expected:   map[string]interface{}{"environment": "staging"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		actual := FilterDefault(v.Defaults, v.Input, v.Configured)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestDefaultDiffSuppressFunc(t *testing.T) {
+	defaults := map[string]string{
+		"environment": "production",
+	}
+
+	testData := []struct {
+		Name          string
+		State         map[string]string
+		Config        map[string]interface{}
+		ExpectChanges bool
+	}{
+		{
+			Name:          "No Changes",
+			State:         map[string]string{"hello": "there"},
+			Config:        map[string]interface{}{"hello": "there"},
+			ExpectChanges: false,
+		},
+		{
+			Name:          "Default Within The State",
+			State:         map[string]string{"environment": "production", "hello": "there"},
+			Config:        map[string]interface{}{"hello": "there"},
+			ExpectChanges: false,
+		},
+		{
+			Name:          "Changed Default Within The State",
+			State:         map[string]string{"environment": "staging", "hello": "there"},
+			Config:        map[string]interface{}{"hello": "there"},
+			ExpectChanges: true,
+		},
+		{
+			Name:          "Tag Removed",
+			State:         map[string]string{"hello": "there", "other": "value"},
+			Config:        map[string]interface{}{"hello": "there"},
+			ExpectChanges: true,
+		},
+		{
+			Name:          "Tag Removed Alongside A Default",
+			State:         map[string]string{"environment": "production", "other": "value"},
+			Config:        map[string]interface{}{},
+			ExpectChanges: true,
+		},
+		{
+			Name:          "Tag Added",
+			State:         map[string]string{"environment": "production"},
+			Config:        map[string]interface{}{"hello": "there"},
+			ExpectChanges: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		resource := &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					DiffSuppressFunc: DefaultDiffSuppressFunc(func() map[string]string {
+						return defaults
+					}),
+				},
+			},
+		}
+
+		attributes := map[string]string{
+			"id":     "example",
+			"tags.%": strconv.Itoa(len(v.State)),
+		}
+		for key, value := range v.State {
+			attributes["tags."+key] = value
+		}
+		state := &terraform.InstanceState{
+			ID:         "example",
+			Attributes: attributes,
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tags": v.Config,
+		})
+
+		diff, err := resource.Diff(context.TODO(), state, config, nil)
+		if err != nil {
+			t.Fatalf("computing the diff: %+v", err)
+		}
+
+		hasChanges := diff != nil && len(diff.Attributes) > 0
+		if hasChanges != v.ExpectChanges {
+			t.Fatalf("expected changes to be %t but got %t: %+v", v.ExpectChanges, hasChanges, diff)
+		}
+	}
+}
@@ -6,12 +6,19 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
 )
 
 type IDValidationFunc func(id string) error
 
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
+// insensitiveImporter is implemented by the Provider's meta, allowing the Resource ID provided at import
+// time to be parsed insensitively when this has been enabled in the `features` block
+type insensitiveImporter interface {
+	ParseResourceIdsInsensitivelyDuringImport() bool
+}
+
 // ImporterValidatingResourceId validates the ID provided at import time is valid
 // using the validateFunc.
 func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.ResourceImporter {
@@ -29,7 +36,18 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
 			if err := validateFunc(d.Id()); err != nil {
-				return []*ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
+				v, ok := meta.(insensitiveImporter)
+				if !ok || !v.ParseResourceIdsInsensitivelyDuringImport() {
+					return []*ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
+				}
+
+				id, err := resourceid.NormalizeSegmentCasing(d.Id(), validateFunc)
+				if err != nil {
+					return []*ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
+				}
+
+				log.Printf("[DEBUG] Importing Resource - parsed %q insensitively as %q", d.Id(), id)
+				d.SetId(id)
 			}
 
 			return thenFunc(ctx, d, meta)
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"sync"
+)
+
+// RunConcurrently calls each of the specified funcs concurrently, with at most `limit` running at once (or all of them
+// at once when `limit` is 0), returning the first error encountered (if any). The Context passed into each func is
+// cancelled as soon as one of them returns an error, at which point any funcs which haven't started are skipped.
+//
+// NOTE: this is used in favour of `golang.org/x/sync/errgroup` since that isn't a dependency of the Provider, and
+// unlike this helper it still calls the remaining funcs (with a cancelled Context) once one of them has failed.
+func RunConcurrently(ctx context.Context, limit int, funcs ...func(ctx context.Context) error) error {
+	parentCtx := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if limit <= 0 {
+		limit = len(funcs)
+	}
+
+	errs := make(chan error, len(funcs))
+	semaphore := make(chan struct{}, limit)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(funcs))
+
+	skipped := false
+	skippedLock := &sync.Mutex{}
+
+	for _, f := range funcs {
+		go func(f func(ctx context.Context) error) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			// funcs which haven't started by the time another has failed (or the parent Context is cancelled) are skipped
+			if ctx.Err() != nil {
+				skippedLock.Lock()
+				skipped = true
+				skippedLock.Unlock()
+				return
+			}
+			if err := f(ctx); err != nil {
+				// the error is queued before cancelling so that it's returned ahead of any cancellation errors
+				errs <- err
+				cancel()
+			}
+		}(f)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+
+	// when the parent Context was cancelled before some of the funcs were called then the work is incomplete, even
+	// though none of the funcs which were called returned an error
+	if skipped {
+		return parentCtx.Err()
+	}
+
+	return nil
+}
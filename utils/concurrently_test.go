@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	var calls int32
+	succeed := func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}
+
+	if err := RunConcurrently(context.Background(), 0, succeed, succeed, succeed); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls but got %d", calls)
+	}
+
+	expected := fmt.Errorf("reading thing")
+	fail := func(ctx context.Context) error {
+		return expected
+	}
+	waitForCancellation := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	if err := RunConcurrently(context.Background(), 0, waitForCancellation, fail, waitForCancellation); err != expected {
+		t.Fatalf("expected %+v but got %+v", expected, err)
+	}
+}
+
+func TestRunConcurrentlyWithLimit(t *testing.T) {
+	var running, maxRunning int32
+	track := func(ctx context.Context) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+
+	funcs := make([]func(ctx context.Context) error, 0)
+	for i := 0; i < 20; i++ {
+		funcs = append(funcs, track)
+	}
+
+	if err := RunConcurrently(context.Background(), 2, funcs...); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+	if maxRunning > 2 {
+		t.Fatalf("expected at most 2 funcs to run at once but got %d", maxRunning)
+	}
+}
+
+func TestRunConcurrentlyWithCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	succeed := func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}
+
+	if err := RunConcurrently(ctx, 1, succeed, succeed, succeed); err != context.Canceled {
+		t.Fatalf("expected %+v but got %+v", context.Canceled, err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no calls but got %d", calls)
+	}
+}